@@ -27,31 +27,47 @@ import (
 	"strconv"
 
 	"github.com/drycc-addons/service-catalog/pkg/util"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	v1coordination "k8s.io/client-go/kubernetes/typed/coordination/v1"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/drycc-addons/service-catalog/pkg/eventmirror"
 	"github.com/drycc-addons/service-catalog/pkg/kubernetes/pkg/util/configz"
 	"github.com/drycc-addons/service-catalog/pkg/metrics"
 	"github.com/drycc-addons/service-catalog/pkg/metrics/osbclientproxy"
+	"github.com/drycc-addons/service-catalog/pkg/paramplugin"
+	"github.com/drycc-addons/service-catalog/pkg/secrettemplate"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/server/healthz"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	"github.com/drycc-addons/service-catalog/cmd/controller-manager/app/options"
 	servicecatalogv1beta1 "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	settingsv1alpha1 "github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1"
+	"github.com/drycc-addons/service-catalog/pkg/catalogcache"
+	"github.com/drycc-addons/service-catalog/pkg/catalogindex"
 	servicecataloginformers "github.com/drycc-addons/service-catalog/pkg/client/informers_generated/externalversions"
 	"github.com/drycc-addons/service-catalog/pkg/controller"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/inventory"
 	"github.com/drycc-addons/service-catalog/pkg/probe"
+	"github.com/drycc-addons/service-catalog/pkg/relabel"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 
 	"context"
@@ -156,10 +172,14 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		return fmt.Errorf("failed to create apiextension clientset: %v", err)
 	}
 
+	// The work queues of the controllers only report their metrics if the
+	// provider is set before they are created.
+	metrics.SetWorkqueueMetricsProvider()
+
 	klog.V(4).Info("Starting http server and mux")
 	// Start http server and handlers
+	mux := http.NewServeMux()
 	go func() {
-		mux := http.NewServeMux()
 		// liveness registered at /healthz indicates if the container is responding
 		healthz.InstallHandler(mux, healthz.PingHealthz, probe.NewCRDProbe(apiextensionsClient, probe.CRDProbeIterationGap))
 
@@ -207,7 +227,18 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 	defer loggingWatch.Stop()
 	recordingWatch := eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: k8sKubeClient.CoreV1().Events("")})
 	defer recordingWatch.Stop()
-	recorder := eventBroadcaster.NewRecorder(eventsScheme, v1.EventSource{Component: controllerManagerAgentName})
+	var recorder record.EventRecorder = eventBroadcaster.NewRecorder(eventsScheme, v1.EventSource{Component: controllerManagerAgentName})
+	if controllerManagerOptions.CentralEventNamespace != "" {
+		// Mirror the Warning events into the central namespace
+		klog.V(4).Infof("Mirroring warning events into namespace %v", controllerManagerOptions.CentralEventNamespace)
+		centralEventBroadcaster := record.NewBroadcaster()
+		centralRecordingWatch := centralEventBroadcaster.StartRecordingToSink(&eventmirror.Sink{
+			EventSink: &v1core.EventSinkImpl{Interface: k8sKubeClient.CoreV1().Events("")},
+			Namespace: controllerManagerOptions.CentralEventNamespace,
+		})
+		defer centralRecordingWatch.Stop()
+		recorder = eventmirror.NewRecorder(recorder, centralEventBroadcaster.NewRecorder(eventsScheme, v1.EventSource{Component: controllerManagerAgentName}))
+	}
 
 	// 'run' is the logic to run the controllers for the controller manager
 	run := func(ctx context.Context) {
@@ -227,7 +258,7 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		// 	k8sClientBuilder = rootClientBuilder
 		// }
 
-		err := StartControllers(controllerManagerOptions, k8sKubeconfig, serviceCatalogClientBuilder, recorder, ctx.Done())
+		err := StartControllers(controllerManagerOptions, k8sKubeconfig, serviceCatalogClientBuilder, recorder, mux, ctx.Done())
 		klog.Fatalf("error running controllers: %v", err)
 		panic("unreachable")
 	}
@@ -282,11 +313,14 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 }
 
 // StartControllers starts all the controllers in the service-catalog
-// controller manager.
+// controller manager. Debug handlers of the controllers are installed on mux
+// when profiling is enabled, and so are the catalog API, the inventory and
+// the operation callbacks when they are enabled.
 func StartControllers(s *options.ControllerManagerServer,
 	coreKubeconfig *rest.Config,
 	serviceCatalogClientBuilder controller.ClientBuilder,
 	recorder record.EventRecorder,
+	mux *http.ServeMux,
 	stop <-chan struct{}) error {
 
 	// It may take some time before Catalog CRDs registration shows up in main API Server.
@@ -308,10 +342,73 @@ func StartControllers(s *options.ControllerManagerServer,
 		serviceCatalogClientBuilder.ClientOrDie("shared-informers"),
 		s.ResyncInterval,
 	)
+	// Persist the cluster service classes and plans, which are the bulk of
+	// large catalogs, so that they are not listed again on restart. The
+	// informers are registered before anything asks the factory for them.
+	var catalogCache *catalogcache.Cache
+	if s.CatalogCacheDir != "" {
+		catalogCache, err = catalogcache.New(s.CatalogCacheDir)
+		if err != nil {
+			return err
+		}
+		informerFactory.InformerFor(&servicecatalogv1beta1.ClusterServiceClass{}, catalogCache.NewClusterServiceClassInformer)
+		informerFactory.InformerFor(&servicecatalogv1beta1.ClusterServicePlan{}, catalogCache.NewClusterServicePlanInformer)
+	}
 	// All shared informers are v1beta1 API level
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
 
+	// Build the informer factory for the instances and bindings reconciled
+	// by the controller, which are restricted to those matching
+	// --watch-label-selector
+	if _, err := labels.Parse(s.WatchLabelSelector); err != nil {
+		return fmt.Errorf("invalid --watch-label-selector: %v", err)
+	}
+	if s.WatchLabelSelector != "" {
+		klog.V(1).Infof("Only reconciling the instances and bindings matching %q", s.WatchLabelSelector)
+	}
+	watchedInformerFactory := servicecataloginformers.NewSharedInformerFactoryWithOptions(
+		serviceCatalogClientBuilder.ClientOrDie("watched-shared-informers"),
+		s.ResyncInterval,
+		servicecataloginformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = s.WatchLabelSelector
+		}),
+	)
+	watchedSharedInformers := watchedInformerFactory.Servicecatalog().V1beta1()
+
+	// Build the informer factory for the core resources watched by the
+	// controller
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(coreClient, s.ResyncInterval)
+	// Build the informer factory for the Secrets holding the credentials of
+	// bindings, which are the only Secrets watched by the controller
+	secretInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(coreClient, s.ResyncInterval,
+		kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = controller.BindingSecretLabel
+		}))
+	var secretInformer coreinformers.SecretInformer
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.BindingSecretDriftRepair) {
+		secretInformer = secretInformerFactory.Core().V1().Secrets()
+	}
+
 	klog.V(5).Infof("Creating controller; broker relist interval: %v", s.ServiceBrokerRelistInterval)
+	var parametersPlugins paramplugin.Registry
+	if s.ParametersPluginDir != "" {
+		parametersPlugins = paramplugin.NewExecRegistry(s.ParametersPluginDir, s.ParametersPluginTimeout)
+	}
+
+	var secretTemplates *secrettemplate.Templates
+	if s.SecretTemplateDir != "" {
+		dynamicClient, err := dynamic.NewForConfig(coreKubeconfig)
+		if err != nil {
+			return err
+		}
+		mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(coreClient.Discovery()))
+		secretTemplates, err = secrettemplate.Load(s.SecretTemplateDir, dynamicClient, mapper)
+		if err != nil {
+			return err
+		}
+		klog.V(1).Infof("Loaded secret templates %v", secretTemplates.Names())
+	}
+
 	serviceCatalogController, err := controller.NewController(
 		coreClient,
 		serviceCatalogClientBuilder.ClientOrDie(controllerManagerAgentName).ServicecatalogV1beta1(),
@@ -319,32 +416,120 @@ func StartControllers(s *options.ControllerManagerServer,
 		serviceCatalogSharedInformers.ServiceBrokers(),
 		serviceCatalogSharedInformers.ClusterServiceClasses(),
 		serviceCatalogSharedInformers.ServiceClasses(),
-		serviceCatalogSharedInformers.ServiceInstances(),
-		serviceCatalogSharedInformers.ServiceBindings(),
+		watchedSharedInformers.ServiceInstances(),
+		watchedSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeInformerFactory.Core().V1().Namespaces(),
+		secretInformer,
 		osbclientproxy.NewClient,
 		s.ServiceBrokerRelistInterval,
+		s.ServiceBrokerRelistJitterFactor,
+		s.ServiceBrokerRelistConcurrency,
+		s.MaxConcurrentProvisions,
+		s.InstanceUpdateDebounce,
 		s.OSBAPIPreferredVersion,
 		recorder,
 		s.ReconciliationRetryDuration,
+		s.ReconciliationMaxAttempts,
 		s.OperationPollingMaximumBackoffDuration,
 		s.ClusterIDConfigMapName,
 		s.ClusterIDConfigMapNamespace,
 		s.OSBAPITimeOut,
+		s.OSBAPIBindingTimeOut,
+		parametersPlugins,
+		s.ReadOnly,
+		secretTemplates,
 	)
 	if err != nil {
 		return err
 	}
 
+	if s.EnableProfiling {
+		mux.Handle("/debug/brokerclients", serviceCatalogController.BrokerClientManager())
+	}
+
+	if s.OperationCallbackURL != "" {
+		if s.OperationCallbackKeyFile == "" {
+			return fmt.Errorf("--operation-callback-url requires --operation-callback-key-file")
+		}
+		key, err := os.ReadFile(s.OperationCallbackKeyFile)
+		if err != nil {
+			return fmt.Errorf("unable to read the operation callback key: %v", err)
+		}
+		if len(key) == 0 {
+			return fmt.Errorf("the operation callback key file %q is empty", s.OperationCallbackKeyFile)
+		}
+		mux.Handle(controller.OperationCallbackPath, serviceCatalogController.EnableOperationCallbacks(s.OperationCallbackURL, key))
+	}
+
+	if s.TunablesConfigMapName != "" {
+		serviceCatalogController.WatchTunables(s.TunablesConfigMapNamespace, s.TunablesConfigMapName)
+	}
+
+	if s.EnableCatalogAPI {
+		catalogindex.NewHandler(catalogindex.Listers{
+			ClusterServiceBrokers: serviceCatalogSharedInformers.ClusterServiceBrokers().Lister(),
+			ServiceBrokers:        serviceCatalogSharedInformers.ServiceBrokers().Lister(),
+			ClusterServiceClasses: serviceCatalogSharedInformers.ClusterServiceClasses().Lister(),
+			ServiceClasses:        serviceCatalogSharedInformers.ServiceClasses().Lister(),
+			ClusterServicePlans:   serviceCatalogSharedInformers.ClusterServicePlans().Lister(),
+			ServicePlans:          serviceCatalogSharedInformers.ServicePlans().Lister(),
+		}).Install(mux)
+	}
+
+	var inv *inventory.Inventory
+	if s.InventoryInterval > 0 {
+		inv = inventory.New(inventory.Listers{
+			ServiceInstances:      watchedSharedInformers.ServiceInstances().Lister(),
+			ServiceBindings:       watchedSharedInformers.ServiceBindings().Lister(),
+			ClusterServiceClasses: serviceCatalogSharedInformers.ClusterServiceClasses().Lister(),
+			ServiceClasses:        serviceCatalogSharedInformers.ServiceClasses().Lister(),
+			ClusterServicePlans:   serviceCatalogSharedInformers.ClusterServicePlans().Lister(),
+			ServicePlans:          serviceCatalogSharedInformers.ServicePlans().Lister(),
+		})
+		inv.Install(mux)
+	}
+
+	var relabeler *relabel.Relabeler
+	if s.FilterLabelCheckInterval > 0 {
+		relabeler = relabel.New(serviceCatalogClientBuilder.ClientOrDie("filter-label-relabeler").ServicecatalogV1beta1(), relabel.Listers{
+			ClusterServiceClasses: serviceCatalogSharedInformers.ClusterServiceClasses().Lister(),
+			ServiceClasses:        serviceCatalogSharedInformers.ServiceClasses().Lister(),
+			ClusterServicePlans:   serviceCatalogSharedInformers.ClusterServicePlans().Lister(),
+			ServicePlans:          serviceCatalogSharedInformers.ServicePlans().Lister(),
+			ServiceInstances:      watchedSharedInformers.ServiceInstances().Lister(),
+		})
+	}
+
 	klog.V(1).Info("Starting shared informers")
 	informerFactory.Start(stop)
+	watchedInformerFactory.Start(stop)
+	kubeInformerFactory.Start(stop)
+	secretInformerFactory.Start(stop)
 
 	klog.V(5).Info("Waiting for caches to sync")
 	informerFactory.WaitForCacheSync(stop)
+	kubeInformerFactory.WaitForCacheSync(stop)
+	secretInformerFactory.WaitForCacheSync(stop)
+
+	if catalogCache != nil {
+		go catalogCache.Run(stop)
+	}
 
+	// The controller reconciles the brokers, classes and plans right away,
+	// and the instances and bindings once their caches have synced too.
 	klog.V(5).Info("Running controller")
 	go serviceCatalogController.Run(s.ConcurrentSyncs, stop)
 
+	watchedInformerFactory.WaitForCacheSync(stop)
+
+	if inv != nil {
+		go inv.Run(s.InventoryInterval, stop)
+	}
+	if relabeler != nil {
+		go relabeler.Run(s.FilterLabelCheckInterval, stop)
+	}
+
 	select {}
 }
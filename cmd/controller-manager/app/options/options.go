@@ -50,6 +50,8 @@ type ControllerManagerServer struct {
 const (
 	defaultResyncInterval                         = 5 * time.Minute
 	defaultServiceBrokerRelistInterval            = 24 * time.Hour
+	defaultServiceBrokerRelistJitterFactor        = 0.1
+	defaultServiceBrokerRelistConcurrency         = 5
 	defaultContentType                            = "application/json"
 	defaultBindAddress                            = "0.0.0.0"
 	defaultPort                                   = 8444
@@ -61,6 +63,8 @@ const (
 	defaultReconciliationRetryDuration            = 7 * 24 * time.Hour
 	defaultOperationPollingMaximumBackoffDuration = 20 * time.Minute
 	defaultOSBAPITimeOut                          = 60 * time.Second
+	defaultOSBAPIBindingTimeOut                   = 30 * time.Second
+	defaultParametersPluginTimeout                = 30 * time.Second
 )
 
 var defaultOSBAPIPreferredVersion = osb.LatestAPIVersion().HeaderValue()
@@ -77,9 +81,13 @@ func NewControllerManagerServer() *ControllerManagerServer {
 			ServiceCatalogKubeconfigPath:           defaultServiceCatalogKubeconfigPath,
 			ResyncInterval:                         defaultResyncInterval,
 			ServiceBrokerRelistInterval:            defaultServiceBrokerRelistInterval,
+			ServiceBrokerRelistJitterFactor:        defaultServiceBrokerRelistJitterFactor,
+			ServiceBrokerRelistConcurrency:         defaultServiceBrokerRelistConcurrency,
 			OSBAPIContextProfile:                   defaultOSBAPIContextProfile,
 			OSBAPIPreferredVersion:                 defaultOSBAPIPreferredVersion,
 			OSBAPITimeOut:                          defaultOSBAPITimeOut,
+			OSBAPIBindingTimeOut:                   defaultOSBAPIBindingTimeOut,
+			ParametersPluginTimeout:                defaultParametersPluginTimeout,
 			ConcurrentSyncs:                        defaultConcurrentSyncs,
 			LeaderElection:                         leaderelectionconfig.DefaultLeaderElectionConfiguration(),
 			LeaderElectionNamespace:                defaultLeaderElectionNamespace,
@@ -112,18 +120,38 @@ func (s *ControllerManagerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.ServiceCatalogInsecureSkipVerify, "service-catalog-insecure-skip-verify", s.ServiceCatalogInsecureSkipVerify, "Skip verification of the TLS certificate for the service-catalog API server")
 	fs.DurationVar(&s.ResyncInterval, "resync-interval", s.ResyncInterval, "The interval on which the controller will resync its informers")
 	fs.DurationVar(&s.ServiceBrokerRelistInterval, "broker-relist-interval", s.ServiceBrokerRelistInterval, "The interval on which a broker's catalog is relisted after the broker becomes ready")
+	fs.Float64Var(&s.ServiceBrokerRelistJitterFactor, "broker-relist-jitter-factor", s.ServiceBrokerRelistJitterFactor, "The largest fraction of a broker's relist interval added to it so that brokers with the same interval are not relisted at the same time")
+	fs.IntVar(&s.ServiceBrokerRelistConcurrency, "broker-relist-concurrency", s.ServiceBrokerRelistConcurrency, "The number of brokers whose catalog may be relisted at the same time; 0 means no limit")
+	fs.IntVar(&s.MaxConcurrentProvisions, "max-concurrent-provisions", s.MaxConcurrentProvisions, "The number of service instances whose provision may be in flight at the same time, across all brokers; 0 means no limit")
+	fs.DurationVar(&s.InstanceUpdateDebounce, "instance-update-debounce", s.InstanceUpdateDebounce, "How long the spec of a provisioned service instance must stay unchanged before its update request is sent to the broker, so that successive edits are sent in a single request; 0 disables debouncing")
 	fs.BoolVar(&s.OSBAPIContextProfile, "enable-osb-api-context-profile", s.OSBAPIContextProfile, "This does nothing.")
 	fs.MarkHidden("enable-osb-api-context-profile")
 	fs.StringVar(&s.OSBAPIPreferredVersion, "osb-api-preferred-version", s.OSBAPIPreferredVersion, "The string to send as the version header.")
-	fs.BoolVar(&s.EnableProfiling, "profiling", s.EnableProfiling, "Enable profiling via web interface host:port/debug/pprof/")
+	fs.BoolVar(&s.EnableProfiling, "profiling", s.EnableProfiling, "Enable profiling via web interface host:port/debug/pprof/ and the list of broker clients at host:port/debug/brokerclients")
 	fs.BoolVar(&s.EnableContentionProfiling, "contention-profiling", s.EnableContentionProfiling, "Enable lock contention profiling, if profiling is enabled")
+	fs.BoolVar(&s.EnableCatalogAPI, "catalog-api", s.EnableCatalogAPI, "Serve a read-only, paginated view of the classes and plans of the catalog at host:port/catalog/v1/classes and host:port/catalog/v1/plans")
+	fs.DurationVar(&s.InventoryInterval, "inventory-interval", s.InventoryInterval, "How often to compute the summary of the instances and bindings per class, plan, broker and namespace served at host:port/inventory/v1/summary; 0 disables it")
+	fs.DurationVar(&s.FilterLabelCheckInterval, "filter-label-check-interval", s.FilterLabelCheckInterval, "How often to check that the filter labels of the classes, plans and instances match their specs and repair the stale ones; 0 disables it")
+	fs.StringVar(&s.CatalogCacheDir, "catalog-cache-dir", s.CatalogCacheDir, "The directory to persist the cached cluster service classes and plans to, so that a restarted controller manager starts from them and only watches the changes made since instead of listing the whole catalog; empty disables it")
 	leaderelectionconfig.BindFlags(&s.LeaderElection, fs)
 	fs.StringVar(&s.LeaderElectionNamespace, "leader-election-namespace", s.LeaderElectionNamespace, "Namespace to use for leader election lock")
 	fs.DurationVar(&s.ReconciliationRetryDuration, "reconciliation-retry-duration", s.ReconciliationRetryDuration, "The maximum amount of time to retry reconciliations on a resource before failing")
+	fs.IntVar(&s.ReconciliationMaxAttempts, "reconciliation-max-attempts", s.ReconciliationMaxAttempts, "The maximum number of requests sent to a broker for an operation on a resource before failing; 0 means no limit. The servicecatalog.k8s.io/max-attempts annotation of a resource overrides it")
+	fs.StringVar(&s.CentralEventNamespace, "central-event-namespace", s.CentralEventNamespace, "The namespace the warning events of the controller are mirrored into, in addition to the namespace of the resource they are about, so that they can be watched from a single namespace; empty means no mirroring")
+	fs.StringVar(&s.WatchLabelSelector, "watch-label-selector", s.WatchLabelSelector, "Only reconcile the service instances and bindings whose labels match this selector, so that several installations of the controller manager can share a cluster, e.g. to try a new version on some instances; empty means all of them")
+	fs.BoolVar(&s.ReadOnly, "read-only", s.ReadOnly, "Report the resources whose state drifted from their spec instead of reconciling them, without sending requests to brokers or changing resources, e.g. to check the catalog of a restored cluster")
 	fs.DurationVar(&s.OperationPollingMaximumBackoffDuration, "operation-polling-maximum-backoff-duration", s.OperationPollingMaximumBackoffDuration, "The maximum amount of time to back-off while polling an OSB API operation")
+	fs.StringVar(&s.OperationCallbackURL, "operation-callback-url", s.OperationCallbackURL, "The external address of the controller at which brokers with operationCallbacks set notify it that an operation completed, instead of being polled. The callbacks are served at host:port/operations/callback/. Requires --operation-callback-key-file")
+	fs.StringVar(&s.OperationCallbackKeyFile, "operation-callback-key-file", s.OperationCallbackKeyFile, "The file holding the key that signs the tokens authenticating operation callbacks")
 	fs.DurationVar(&s.OSBAPITimeOut, "osb-api-request-timeout", s.OSBAPITimeOut, "The maximum amount of timeout to any request to the broker.")
+	fs.DurationVar(&s.OSBAPIBindingTimeOut, "osb-api-binding-request-timeout", s.OSBAPIBindingTimeOut, "The maximum amount of timeout to the bind, unbind and binding last operation requests to the brokers which do not set bindingRequestTimeout.")
+	fs.StringVar(&s.ParametersPluginDir, "parameters-plugin-dir", s.ParametersPluginDir, "The directory holding the parameters plugin executables referenced by parametersFrom. Requires the ParametersPlugins feature.")
+	fs.DurationVar(&s.ParametersPluginTimeout, "parameters-plugin-timeout", s.ParametersPluginTimeout, "The maximum amount of time a parameters plugin may run.")
+	fs.StringVar(&s.SecretTemplateDir, "secret-template-dir", s.SecretTemplateDir, "The directory holding the secret templates, one <name>.yaml file each, that ServiceBindings with secretTemplate set deliver their credentials through instead of a Secret.")
 	s.SecureServingOptions.AddFlags(fs)
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
 	fs.StringVar(&s.ClusterIDConfigMapName, "cluster-id-configmap-name", controller.DefaultClusterIDConfigMapName, "k8s name for clusterid configmap")
 	fs.StringVar(&s.ClusterIDConfigMapNamespace, "cluster-id-configmap-namespace", controller.DefaultClusterIDConfigMapNamespace, "k8s namespace for clusterid configmap")
+	fs.StringVar(&s.TunablesConfigMapName, "tunables-configmap-name", s.TunablesConfigMapName, "The name of the configmap whose reconciliation-retry-duration, reconciliation-max-attempts, broker-relist-jitter-factor and max-concurrent-provisions keys override the flags of the same names while the controller runs; empty means the settings only change on restart")
+	fs.StringVar(&s.TunablesConfigMapNamespace, "tunables-configmap-namespace", controller.DefaultClusterIDConfigMapNamespace, "k8s namespace for the tunables configmap")
 }
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	servicecatalogv1beta1 "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/controller/replay"
+)
+
+// ReplayOptions holds the files a replay is seeded from.
+type ReplayOptions struct {
+	// Filenames are the YAML or JSON files of the objects of the replay.
+	// A file can hold several objects, separated by "---".
+	Filenames []string
+	// BrokerFixture is the YAML or JSON file of the responses of the broker.
+	BrokerFixture string
+}
+
+// RunReplay replays the reconciliation of the ServiceInstance of the files
+// of opts, and writes what it did to out.
+func RunReplay(opts *ReplayOptions, out io.Writer) error {
+	if len(opts.Filenames) == 0 {
+		return fmt.Errorf("at least one file is required")
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := servicecatalogv1beta1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var objects []runtime.Object
+	for _, filename := range opts.Filenames {
+		fileObjects, err := readReplayObjects(filename, decoder)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, fileObjects...)
+	}
+
+	fixture := &replay.BrokerFixture{}
+	if opts.BrokerFixture != "" {
+		data, err := os.ReadFile(opts.BrokerFixture)
+		if err != nil {
+			return err
+		}
+		if err := yaml.UnmarshalStrict(data, fixture); err != nil {
+			return fmt.Errorf("could not read the broker fixture %q: %v", opts.BrokerFixture, err)
+		}
+	}
+
+	return replay.ServiceInstance(objects, fixture, out)
+}
+
+// readReplayObjects decodes the objects of a file.
+func readReplayObjects(filename string, decoder runtime.Decoder) ([]runtime.Object, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var objects []runtime.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read %q: %v", filename, err)
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+		obj, _, err := decoder.Decode(document, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode an object of %q: %v", filename, err)
+		}
+		objects = append(objects, obj)
+	}
+}
@@ -36,6 +36,7 @@ func main() {
 	hk.AddServer(server.NewControllerManager())
 	hk.AddServer(server.NewCleaner())
 	hk.AddServer(server.NewMigration())
+	hk.AddServer(server.NewController())
 
 	hk.RunToExit(os.Args)
 }
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/drycc-addons/service-catalog/cmd/controller-manager/app"
+	"github.com/drycc-addons/service-catalog/pkg/hyperkube"
+)
+
+// NewController creates a new hyperkube Server object that includes the
+// description and flags of the debug commands of the controller.
+func NewController() *hyperkube.Server {
+	opts := &app.ReplayOptions{}
+
+	hks := hyperkube.Server{
+		PrimaryName:     "controller",
+		AlternativeName: "service-catalog-controller",
+		SimpleUsage:     "controller replay -f FILE [--broker-fixture FILE]",
+		Long: `Debug commands of the service-catalog controller.
+
+replay runs one reconciliation of the ServiceInstance of the given files against fake clients seeded with the objects of the files, and a fake broker responding as the broker fixture says. It prints the requests sent to the broker, the actions taken on the APIs and the events recorded, without an API server or a broker.`,
+		Run: func(_ *hyperkube.Server, args []string, stopCh <-chan struct{}) error {
+			if len(args) != 1 || args[0] != "replay" {
+				return fmt.Errorf("expected a single command, replay, got %q", args)
+			}
+			return app.RunReplay(opts, os.Stdout)
+		},
+		RespectsStopCh: false,
+	}
+	hks.Flags().StringSliceVarP(&opts.Filenames, "filename", "f", nil, "YAML or JSON files of the ServiceInstance to replay and of the objects it refers to")
+	hks.Flags().StringVar(&opts.BrokerFixture, "broker-fixture", "", "YAML or JSON file of the responses of the broker, by request: provision, updateInstance, deprovision, lastOperation and errors")
+
+	return &hks
+}
@@ -18,6 +18,8 @@ package binding
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
 	"github.com/drycc-addons/service-catalog/cmd/svcat/output"
@@ -35,6 +37,9 @@ type bindCmd struct {
 	secretName   string
 	rawParams    []string
 	jsonParams   string
+	paramsFile   string
+	expandEnv    bool
+	input        io.Reader
 	params       interface{}
 	rawSecrets   []string
 	secrets      map[string]string
@@ -45,6 +50,7 @@ func NewBindCmd(cxt *command.Context) *cobra.Command {
 	bindCmd := &bindCmd{
 		Namespaced: command.NewNamespaced(cxt),
 		Waitable:   command.NewWaitable(),
+		input:      os.Stdin,
 	}
 	cmd := &cobra.Command{
 		Use:   "bind INSTANCE_NAME",
@@ -62,6 +68,8 @@ func NewBindCmd(cxt *command.Context) *cobra.Command {
 		"sports"
 	]
   }'
+  jq .binding config.json | svcat bind wordpress-instance --params-file -
+  svcat bind wordpress-instance --params-file params.yaml --expand-env
 `),
 		PreRunE: command.PreRunE(bindCmd),
 		RunE:    command.RunE(bindCmd),
@@ -85,11 +93,15 @@ func NewBindCmd(cxt *command.Context) *cobra.Command {
 		"The name of the secret. Defaults to the name of the instance.",
 	)
 	cmd.Flags().StringSliceVarP(&bindCmd.rawParams, "param", "p", nil,
-		"Additional parameter to use when binding the instance, format: NAME=VALUE. Cannot be combined with --params-json, Sensitive information should be placed in a secret and specified with --secret")
+		"Additional parameter to use when binding the instance, format: NAME=VALUE. Cannot be combined with --params-json or --params-file, Sensitive information should be placed in a secret and specified with --secret")
 	cmd.Flags().StringSliceVarP(&bindCmd.rawSecrets, "secret", "s", nil,
 		"Additional parameter, whose value is stored in a secret, to use when binding the instance, format: SECRET[KEY]")
 	cmd.Flags().StringVar(&bindCmd.jsonParams, "params-json", "",
-		"Additional parameters to use when binding the instance, provided as a JSON object. Cannot be combined with --param")
+		"Additional parameters to use when binding the instance, provided as a JSON object. Cannot be combined with --param or --params-file")
+	cmd.Flags().StringVar(&bindCmd.paramsFile, "params-file", "",
+		"Additional parameters to use when binding the instance, read as a JSON or YAML object from a file, or from stdin when the file is -. Cannot be combined with --param or --params-json")
+	cmd.Flags().BoolVar(&bindCmd.expandEnv, "expand-env", false,
+		"Replace ${VAR} in the parameter values with the value of the environment variable VAR")
 	bindCmd.AddWaitFlags(cmd)
 	return cmd
 }
@@ -107,18 +119,35 @@ func (c *bindCmd) Validate(args []string) error {
 		return fmt.Errorf("--params-json cannot be used with --param")
 	}
 
-	if c.jsonParams != "" {
-		c.params, err = parameters.ParseVariableJSON(c.jsonParams)
+	if c.paramsFile != "" && (c.jsonParams != "" || len(c.rawParams) > 0) {
+		return fmt.Errorf("--params-file cannot be used with --param or --params-json")
+	}
+
+	var params map[string]interface{}
+	if c.paramsFile != "" {
+		params, err = parameters.ParseVariableFile(c.paramsFile, c.input)
+		if err != nil {
+			return fmt.Errorf("invalid --params-file value (%s)", err)
+		}
+	} else if c.jsonParams != "" {
+		params, err = parameters.ParseVariableJSON(c.jsonParams)
 		if err != nil {
 			return fmt.Errorf("invalid --params-json value (%s)", err)
 		}
 	} else {
-		c.params, err = parameters.ParseVariableAssignments(c.rawParams)
+		params, err = parameters.ParseVariableAssignments(c.rawParams)
 		if err != nil {
 			return fmt.Errorf("invalid --param value (%s)", err)
 		}
 	}
 
+	if c.expandEnv {
+		if err := parameters.ExpandEnv(params, os.LookupEnv); err != nil {
+			return err
+		}
+	}
+	c.params = params
+
 	c.secrets, err = parameters.ParseKeyMaps(c.rawSecrets)
 	if err != nil {
 		return fmt.Errorf("invalid --secret value (%s)", err)
@@ -149,6 +178,9 @@ func (c *bindCmd) bind() error {
 		// Always print the binding because the bind did succeed,
 		// and just print any errors that occurred while polling
 		output.WriteBindingDetails(c.Output, binding)
+		if err == nil && c.App.IsBindingFailed(binding) {
+			err = command.NewBrokerFailureError("binding %s/%s failed", binding.Namespace, binding.Name)
+		}
 		return err
 	}
 
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	"github.com/drycc-addons/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+)
+
+type retryCmd struct {
+	*command.Namespaced
+	*command.Waitable
+	name string
+}
+
+// NewRetryCmd builds a "svcat retry binding" command
+func NewRetryCmd(cxt *command.Context) *cobra.Command {
+	retryCmd := &retryCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Waitable:   command.NewWaitable(),
+	}
+	cmd := &cobra.Command{
+		Use:     "binding NAME",
+		Aliases: []string{"bindings", "bnd"},
+		Short:   "Retry a binding whose bind request failed",
+		Long: `Retry binding clears the Failed condition of a binding, so that service catalog
+sends the bind request to the broker again. The binding keeps its name and
+secret, unlike deleting and creating it again. Bindings that are being deleted,
+or whose orphan mitigation is in progress, cannot be retried.`,
+		Example: command.NormalizeExamples(`
+  svcat retry binding wordpress-mysql-binding
+  svcat retry binding wordpress-mysql-binding --wait
+`),
+		PreRunE: command.PreRunE(retryCmd),
+		RunE:    command.RunE(retryCmd),
+	}
+	retryCmd.AddNamespaceFlags(cmd.Flags(), false)
+	retryCmd.AddWaitFlags(cmd)
+	return cmd
+}
+
+// Validate checks that the required arguments have been provided
+func (c *retryCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("a binding name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+// Run clears the failure of the binding and optionally waits for the
+// controller to bind it again.
+func (c *retryCmd) Run() error {
+	binding, err := c.App.RetryBinding(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+
+	if c.Wait {
+		fmt.Fprintln(c.Output, "Waiting for binding to be injected...")
+		finalBinding, err := c.App.WaitForBinding(binding.Namespace, binding.Name, c.Interval, c.Timeout)
+		if err == nil {
+			binding = finalBinding
+		}
+
+		output.WriteBindingDetails(c.Output, binding)
+		if err == nil && c.App.IsBindingFailed(binding) {
+			err = command.NewBrokerFailureError("binding %s/%s failed", binding.Namespace, binding.Name)
+		}
+		return err
+	}
+
+	output.WriteBindingDetails(c.Output, binding)
+	return nil
+}
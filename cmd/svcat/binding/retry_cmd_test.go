@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	svcattest "github.com/drycc-addons/service-catalog/cmd/svcat/test"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	svcatfake "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	"github.com/drycc-addons/service-catalog/pkg/svcat"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRetryCommand(t *testing.T) {
+	const ns = "default"
+	testcases := []struct {
+		name       string
+		failed     bool
+		wantOutput string
+		wantError  bool
+	}{
+		{
+			name:       "retry a failed binding",
+			failed:     true,
+			wantOutput: "mybinding",
+		},
+		{
+			name:       "retry a binding that has not failed",
+			wantOutput: "binding 'default.mybinding' has not failed",
+			wantError:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &v1beta1.ServiceBinding{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: ns,
+					Name:      "mybinding",
+				},
+			}
+			if tc.failed {
+				binding.Status.Conditions = []v1beta1.ServiceBindingCondition{
+					{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue},
+				}
+			}
+			svcatClient := svcatfake.NewSimpleClientset(binding)
+			output := &bytes.Buffer{}
+			fakeApp, _ := svcat.NewApp(k8sfake.NewSimpleClientset(), svcatClient, ns)
+			cxt := svcattest.NewContext(output, fakeApp)
+
+			cmd := &retryCmd{
+				Namespaced: command.NewNamespaced(cxt),
+				Waitable:   command.NewWaitable(),
+			}
+			cmd.Namespace = ns
+			cmd.name = binding.Name
+
+			err := cmd.Run()
+
+			if tc.wantError && err == nil {
+				t.Errorf("expected a non-zero exit code, but the command succeeded")
+			}
+			if !tc.wantError && err != nil {
+				t.Errorf("expected the command to succeed but it failed with %q", err)
+			}
+
+			gotOutput := output.String()
+			if err != nil {
+				gotOutput += err.Error()
+			}
+			if !strings.Contains(gotOutput, tc.wantOutput) {
+				t.Errorf("unexpected output \n\nWANT:\n%q\n\nGOT:\n%q\n", tc.wantOutput, gotOutput)
+			}
+		})
+	}
+}
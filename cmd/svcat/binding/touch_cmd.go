@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	"github.com/spf13/cobra"
+)
+
+type touchCmd struct {
+	*command.Namespaced
+	name string
+}
+
+// NewTouchCmd builds a "svcat touch binding" command
+func NewTouchCmd(cxt *command.Context) *cobra.Command {
+	touchCmd := &touchCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:     "binding NAME",
+		Aliases: []string{"bindings", "bnd"},
+		Short:   "Touch a binding to make service catalog bind it again",
+		Long: `Touch binding will increment the updateRequests field on the binding.
+Then, service catalog will unbind it from the broker and bind it again, writing
+the new credentials to the secret of the binding, for example after the broker
+rotated them. Failed bindings cannot be touched, use svcat retry binding instead.`,
+		Example: command.NormalizeExamples(`svcat touch binding wordpress-mysql-binding --namespace mynamespace`),
+		PreRunE: command.PreRunE(touchCmd),
+		RunE:    command.RunE(touchCmd),
+	}
+	touchCmd.AddNamespaceFlags(cmd.Flags(), false)
+
+	return cmd
+}
+
+// Validate checks that the required arguments have been provided
+func (c *touchCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("a binding name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+// Run increments the updateRequests of the binding.
+func (c *touchCmd) Run() error {
+	const retries = 3
+	return c.App.TouchBinding(c.Namespace, c.name, retries)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	svcattest "github.com/drycc-addons/service-catalog/cmd/svcat/test"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	svcatfake "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	"github.com/drycc-addons/service-catalog/pkg/svcat"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTouchCommand(t *testing.T) {
+	const ns = "default"
+	testcases := []struct {
+		name               string
+		failed             bool
+		wantUpdateRequests int64
+		wantError          string
+	}{
+		{
+			name:               "touch a binding",
+			wantUpdateRequests: 2,
+		},
+		{
+			name:               "touch a failed binding",
+			failed:             true,
+			wantUpdateRequests: 1,
+			wantError:          "binding 'default.mybinding' has failed and must be retried instead",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &v1beta1.ServiceBinding{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: ns,
+					Name:      "mybinding",
+				},
+				Spec: v1beta1.ServiceBindingSpec{
+					UpdateRequests: 1,
+				},
+			}
+			if tc.failed {
+				binding.Status.Conditions = []v1beta1.ServiceBindingCondition{
+					{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue},
+				}
+			}
+			svcatClient := svcatfake.NewSimpleClientset(binding)
+			fakeApp, _ := svcat.NewApp(k8sfake.NewSimpleClientset(), svcatClient, ns)
+			cxt := svcattest.NewContext(&bytes.Buffer{}, fakeApp)
+
+			cmd := &touchCmd{Namespaced: command.NewNamespaced(cxt)}
+			cmd.Namespace = ns
+			cmd.name = binding.Name
+
+			err := cmd.Run()
+
+			if tc.wantError == "" && err != nil {
+				t.Errorf("expected the command to succeed but it failed with %q", err)
+			}
+			if tc.wantError != "" && (err == nil || !strings.Contains(err.Error(), tc.wantError)) {
+				t.Errorf("expected the command to fail with %q, got %v", tc.wantError, err)
+			}
+
+			touched, err := svcatClient.ServicecatalogV1beta1().ServiceBindings(ns).Get(context.Background(), binding.Name, v1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if touched.Spec.UpdateRequests != tc.wantUpdateRequests {
+				t.Errorf("expected the updateRequests of the binding to be %d, got %d", tc.wantUpdateRequests, touched.Spec.UpdateRequests)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
 	"github.com/drycc-addons/service-catalog/cmd/svcat/output"
+	servicecatalog "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,6 +39,7 @@ type unbindCmd struct {
 	bindingNames []string
 	abandon      bool
 	skipPrompt   bool
+	all          bool
 }
 
 // NewUnbindCmd builds a "svcat unbind" command
@@ -53,6 +55,7 @@ func NewUnbindCmd(cxt *command.Context) *cobra.Command {
   svcat unbind wordpress-mysql-instance
   svcat unbind --name wordpress-mysql-binding
   svcat unbind --abandon wordpress-mysql-instance
+  svcat unbind --all --instance wordpress-mysql-instance --wait
 `),
 		PreRunE: command.PreRunE(unbindCmd),
 		RunE:    command.RunE(unbindCmd),
@@ -70,6 +73,18 @@ func NewUnbindCmd(cxt *command.Context) *cobra.Command {
 		false,
 		"Forcefully and immediately delete the resource from Service Catalog ONLY, potentially abandoning any broker resources that you may continue to be charged for.",
 	)
+	cmd.Flags().BoolVar(
+		&unbindCmd.all,
+		"all",
+		false,
+		"Remove all bindings of the instance. With --wait, report the progress as each binding is removed.",
+	)
+	cmd.Flags().StringVar(
+		&unbindCmd.instanceName,
+		"instance",
+		"",
+		"The name of the instance whose bindings are removed, used with --all",
+	)
 	cmd.Flags().BoolVarP(
 		&unbindCmd.skipPrompt,
 		"yes",
@@ -85,14 +100,24 @@ func NewUnbindCmd(cxt *command.Context) *cobra.Command {
 
 // Validate checks that the required arguments have been provided
 func (c *unbindCmd) Validate(args []string) error {
-	if len(args) == 0 {
-		if len(c.bindingNames) == 0 {
-			return fmt.Errorf("an instance or binding name is required")
-		}
-	} else {
+	if len(args) > 0 {
 		c.instanceName = args[0]
 	}
 
+	if c.all {
+		if c.instanceName == "" {
+			return fmt.Errorf("an instance name is required with --all")
+		}
+		if len(c.bindingNames) > 0 {
+			return fmt.Errorf("--all cannot be combined with --name")
+		}
+		return nil
+	}
+
+	if c.instanceName == "" && len(c.bindingNames) == 0 {
+		return fmt.Errorf("an instance or binding name is required")
+	}
+
 	return nil
 }
 
@@ -132,6 +157,10 @@ func (c *unbindCmd) Run() error {
 		}
 	}
 
+	if c.all && c.Wait {
+		return c.unbindAllAndWait()
+	}
+
 	if c.instanceName != "" {
 		bindings, err = c.App.Unbind(c.Namespace, c.instanceName)
 	} else {
@@ -158,6 +187,24 @@ func (c *unbindCmd) Run() error {
 	return nil
 }
 
+// unbindAllAndWait removes all bindings of the instance and prints each one
+// as it is removed, along with how many are left.
+func (c *unbindCmd) unbindAllAndWait() error {
+	fmt.Fprintln(c.Output, "waiting for the binding(s) to be deleted...")
+	_, err := c.App.UnbindAndWait(c.Namespace, c.instanceName, c.Interval, c.Timeout,
+		func(p servicecatalog.UnbindProgress) {
+			if p.Err != nil {
+				fmt.Fprintln(c.Output, p.Err)
+				return
+			}
+			fmt.Fprintf(c.Output, "deleted %s (%d/%d)\n", p.Binding.Name, p.Done, p.Total)
+		})
+	if err != nil {
+		return fmt.Errorf("could not remove all bindings")
+	}
+	return nil
+}
+
 func (c *unbindCmd) getBindingsToDelete() []types.NamespacedName {
 	bindings := []types.NamespacedName{}
 	for _, name := range c.bindingNames {
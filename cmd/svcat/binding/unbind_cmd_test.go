@@ -51,6 +51,7 @@ func TestUnbindCommand(t *testing.T) {
 		abandon        bool // delete all finalizers from the service instance so that it is deleted immediately
 		userResponse   string
 		skipPrompt     bool
+		all            bool
 	}{
 		{
 			name:         "delete binding",
@@ -208,6 +209,26 @@ func TestUnbindCommand(t *testing.T) {
 			wantOutput:   "This action is not reversible and may cause you to be charged for the broker resources that are abandoned.\nAre you sure? [y|n]: \ndeleted mybinding",
 			wantError:    false,
 		},
+		{
+			name:           "delete all bindings of an instance and wait",
+			fakeInstance:   "myinstance",
+			fakeBindings:   []string{"binding1", "binding2"},
+			instanceName:   "myinstance",
+			all:            true,
+			wait:           true,
+			wantOutput:     "waiting for the binding(s) to be deleted...\ndeleted binding1 (\ndeleted binding2 (\n(1/2)\n(2/2)",
+			allowDiffOrder: true,
+		},
+		{
+			name:         "delete all bindings of an instance and wait - fail",
+			fakeInstance: "myinstance",
+			fakeBindings: []string{"badbinding"},
+			instanceName: "myinstance",
+			all:          true,
+			wait:         true,
+			wantOutput:   "waiting for the binding(s) to be deleted...\nremove binding default/badbinding failed: sabotaged\ncould not remove all bindings",
+			wantError:    true,
+		},
 	}
 
 	// Create a file for user stdin input
@@ -268,6 +289,7 @@ func TestUnbindCommand(t *testing.T) {
 			cmd.Wait = tc.wait
 			cmd.abandon = tc.abandon
 			cmd.skipPrompt = tc.skipPrompt
+			cmd.all = tc.all
 
 			if tc.userResponse != "" {
 				content := []byte(fmt.Sprintf("%s\n", tc.userResponse))
@@ -30,9 +30,11 @@ import (
 type DescribeCmd struct {
 	*command.Context
 	*command.Namespaced
+	*command.Formatted
 	*command.Scoped
 
-	Name string
+	Name    string
+	Catalog bool
 }
 
 // NewDescribeCmd builds a "svcat describe broker" command
@@ -40,6 +42,7 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 	describeCmd := &DescribeCmd{
 		Context:    cxt,
 		Namespaced: command.NewNamespaced(cxt),
+		Formatted:  command.NewFormatted(),
 		Scoped:     command.NewScoped(),
 	}
 	cmd := &cobra.Command{
@@ -48,12 +51,20 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 		Short:   "Show details of a specific broker",
 		Example: command.NormalizeExamples(`
   svcat describe broker asb
+  svcat describe broker asb --catalog
 `),
 		PreRunE: command.PreRunE(describeCmd),
 		RunE:    command.RunE(describeCmd),
 	}
 	describeCmd.AddNamespaceFlags(cmd.Flags(), false)
 	describeCmd.AddScopedFlags(cmd.Flags(), true)
+	describeCmd.AddOutputFlags(cmd.Flags())
+	cmd.Flags().BoolVar(
+		&describeCmd.Catalog,
+		"catalog",
+		false,
+		"Fetch the catalog the broker currently advertises and print its classes and plans, marking those missing from the cluster. Requires permission to read the broker's auth secret",
+	)
 	return cmd
 }
 
@@ -85,6 +96,54 @@ func (c *DescribeCmd) Run() error {
 		}
 		return err
 	}
+	if c.Catalog {
+		return c.describeCatalog(broker)
+	}
+	if c.OutputFormat != output.FormatTable {
+		output.WriteBroker(c.Output, c.OutputFormat, broker)
+		return nil
+	}
+	output.WriteBrokerDetails(c.Output, broker)
+	return nil
+}
+
+// describeCatalog prints the catalog the broker currently advertises, along
+// with the classes and plans of the broker found in the cluster.
+func (c *DescribeCmd) describeCatalog(broker servicecatalog.Broker) error {
+	catalog, err := c.App.RetrieveBrokerCatalog(broker)
+	if err != nil {
+		return err
+	}
+	if c.OutputFormat != output.FormatTable {
+		output.WriteBrokerCatalog(c.Output, c.OutputFormat, catalog, nil)
+		return nil
+	}
+
+	scopeOpts := servicecatalog.ScopeOptions{Scope: servicecatalog.ClusterScope}
+	if broker.GetNamespace() != "" {
+		scopeOpts = servicecatalog.ScopeOptions{Scope: servicecatalog.NamespaceScope, Namespace: broker.GetNamespace()}
+	}
+	classes, err := c.App.RetrieveClasses(scopeOpts, broker.GetName())
+	if err != nil {
+		return err
+	}
+	plans, err := c.App.RetrievePlans("", scopeOpts)
+	if err != nil {
+		return err
+	}
+	classNames := make(map[string]string, len(classes))
+	materialized := output.MaterializedCatalog{}
+	for _, class := range classes {
+		classNames[class.GetName()] = class.GetExternalName()
+		materialized[class.GetExternalName()] = map[string]bool{}
+	}
+	for _, plan := range plans {
+		if className, ok := classNames[plan.GetClassID()]; ok {
+			materialized[className][plan.GetExternalName()] = true
+		}
+	}
+
 	output.WriteBrokerDetails(c.Output, broker)
+	output.WriteBrokerCatalog(c.Output, c.OutputFormat, catalog, materialized)
 	return nil
 }
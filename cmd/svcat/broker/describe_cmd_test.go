@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	. "github.com/drycc-addons/service-catalog/cmd/svcat/broker"
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
 	svcattest "github.com/drycc-addons/service-catalog/cmd/svcat/test"
@@ -107,6 +108,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       brokerName,
 				Scoped:     command.NewScoped(),
 			}
@@ -140,6 +142,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       brokerName,
 				Scoped:     command.NewScoped(),
 			}
@@ -175,6 +178,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       brokerName,
 				Scoped:     command.NewScoped(),
 			}
@@ -206,6 +210,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       brokerName,
 				Scoped:     command.NewScoped(),
 			}
@@ -226,5 +231,67 @@ var _ = Describe("Describe Command", func() {
 			}
 			Expect(returnedScopeOpts).To(Equal(scopeOpts))
 		})
+		It("prints the catalog the broker advertises when --catalog is set", func() {
+			outputBuffer := &bytes.Buffer{}
+
+			catalog := &osb.CatalogResponse{
+				Services: []osb.Service{
+					{
+						ID:   "mysql-id",
+						Name: "mysql",
+						Plans: []osb.Plan{
+							{ID: "small-id", Name: "small"},
+							{ID: "large-id", Name: "large"},
+						},
+					},
+				},
+			}
+			class := &v1beta1.ClusterServiceClass{
+				ObjectMeta: v1.ObjectMeta{Name: "mysql-id"},
+				Spec: v1beta1.ClusterServiceClassSpec{
+					CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalName: "mysql"},
+				},
+			}
+			plan := &v1beta1.ClusterServicePlan{
+				ObjectMeta: v1.ObjectMeta{Name: "small-id"},
+				Spec: v1beta1.ClusterServicePlanSpec{
+					CommonServicePlanSpec:    v1beta1.CommonServicePlanSpec{ExternalName: "small"},
+					ClusterServiceClassRef:   v1beta1.ClusterObjectReference{Name: "mysql-id"},
+					ClusterServiceBrokerName: brokerName,
+				},
+			}
+
+			fakeApp, _ := svcat.NewApp(nil, nil, namespace)
+			fakeSDK := new(servicecatalogfakes.FakeSvcatClient)
+			fakeSDK.RetrieveBrokerByIDReturns(brokerToReturn, nil)
+			fakeSDK.RetrieveBrokerCatalogReturns(catalog, nil)
+			fakeSDK.RetrieveClassesReturns([]servicecatalog.Class{class}, nil)
+			fakeSDK.RetrievePlansReturns([]servicecatalog.Plan{plan}, nil)
+			fakeApp.SvcatClient = fakeSDK
+			cxt := svcattest.NewContext(outputBuffer, fakeApp)
+			cmd := DescribeCmd{
+				Context:    cxt,
+				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
+				Name:       brokerName,
+				Scoped:     command.NewScoped(),
+				Catalog:    true,
+			}
+			cmd.Namespaced.ApplyNamespaceFlags(&pflag.FlagSet{})
+			cmd.Scope = servicecatalog.AllScope
+			err := cmd.Run()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeSDK.RetrieveBrokerCatalogCallCount()).To(Equal(1))
+			Expect(fakeSDK.RetrieveBrokerCatalogArgsForCall(0)).To(Equal(brokerToReturn))
+			scopeOpts, brokerFilter := fakeSDK.RetrieveClassesArgsForCall(0)
+			Expect(scopeOpts).To(Equal(servicecatalog.ScopeOptions{Scope: servicecatalog.ClusterScope}))
+			Expect(brokerFilter).To(Equal(brokerName))
+
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("Catalog:"))
+			Expect(output).To(MatchRegexp(`mysql\s+small\s+false\s+yes`))
+			Expect(output).To(MatchRegexp(`mysql\s+large\s+false\s+no`))
+		})
 	})
 })
@@ -39,7 +39,7 @@ type GetCmd struct {
 func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd := &GetCmd{
 		Namespaced: command.NewNamespaced(cxt),
-		Formatted:  command.NewFormatted(),
+		Formatted:  command.NewWideFormatted(),
 		Scoped:     command.NewScoped(),
 	}
 	cmd := &cobra.Command{
@@ -48,6 +48,7 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		Short:   "List brokers, optionally filtered by name, scope or namespace",
 		Example: command.NormalizeExamples(`
   svcat get brokers
+  svcat get brokers -o wide
   svcat get brokers --scope=cluster
   svcat get brokers --scope=all
   svcat get broker helmbroker
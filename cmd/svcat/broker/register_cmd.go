@@ -151,6 +151,9 @@ func (c *RegisterCmd) Run() error {
 		}
 
 		output.WriteBrokerDetails(c.Output, broker)
+		if err == nil && c.Context.App.IsBrokerFailed(finalBroker) {
+			err = command.NewBrokerFailureError("registration of broker %s failed", c.BrokerName)
+		}
 		return err
 	}
 
@@ -17,6 +17,7 @@ limitations under the License.
 package broker
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
@@ -27,6 +28,7 @@ import (
 type syncCmd struct {
 	*command.Namespaced
 	*command.Scoped
+	*command.Waitable
 	name string
 }
 
@@ -35,16 +37,21 @@ func NewSyncCmd(cxt *command.Context) *cobra.Command {
 	syncCmd := &syncCmd{
 		Namespaced: command.NewNamespaced(cxt),
 		Scoped:     command.NewScoped(),
+		Waitable:   command.NewWaitable(),
 	}
 	rootCmd := &cobra.Command{
-		Use:     "broker NAME",
-		Short:   "Syncs service catalog for a service broker",
-		Example: command.NormalizeExamples(`svcat sync broker asb`),
+		Use:   "broker NAME",
+		Short: "Syncs service catalog for a service broker",
+		Example: command.NormalizeExamples(`
+  svcat sync broker asb
+  svcat sync broker asb --wait --timeout 2m
+`),
 		PreRunE: command.PreRunE(syncCmd),
 		RunE:    command.RunE(syncCmd),
 	}
 	syncCmd.AddScopedFlags(rootCmd.Flags(), false)
 	syncCmd.AddNamespaceFlags(rootCmd.Flags(), false)
+	syncCmd.AddWaitFlags(rootCmd)
 	return rootCmd
 }
 
@@ -66,6 +73,15 @@ func (c *syncCmd) sync() error {
 		Namespace: c.Namespace,
 	}
 
+	var before []servicecatalog.Class
+	if c.Wait {
+		var err error
+		before, err = c.App.RetrieveClasses(scopeOpts, c.name)
+		if err != nil {
+			return err
+		}
+	}
+
 	const retries = 3
 	err := c.App.Sync(c.name, scopeOpts, retries)
 	if err != nil {
@@ -73,5 +89,24 @@ func (c *syncCmd) sync() error {
 	}
 
 	fmt.Fprintf(c.Output, "Synchronization requested for broker: %s\n", c.name)
+	if !c.Wait {
+		return nil
+	}
+
+	fmt.Fprintln(c.Output, "Waiting for the broker to be relisted...")
+	if _, err := c.App.WaitForBrokerRelist(c.name, scopeOpts, c.Interval, c.Timeout); err != nil {
+		var relistErr *servicecatalog.BrokerRelistError
+		if errors.As(err, &relistErr) {
+			return command.WrapBrokerFailureError(err)
+		}
+		return err
+	}
+	after, err := c.App.RetrieveClasses(scopeOpts, c.name)
+	if err != nil {
+		return err
+	}
+	result := servicecatalog.CompareClasses(before, after)
+	fmt.Fprintf(c.Output, "Relisted broker %s: %d classes added, %d removed, %d changed\n",
+		c.name, result.Added, result.Removed, result.Changed)
 	return nil
 }
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker_test
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/drycc-addons/service-catalog/cmd/svcat/broker"
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	svcattest "github.com/drycc-addons/service-catalog/cmd/svcat/test"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/svcat"
+	servicecatalog "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+	servicecatalogfakes "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Sync Command", func() {
+	var (
+		outputBuffer *bytes.Buffer
+		fakeSDK      *servicecatalogfakes.FakeSvcatClient
+		cxt          *command.Context
+	)
+
+	BeforeEach(func() {
+		outputBuffer = &bytes.Buffer{}
+		fakeApp, _ := svcat.NewApp(nil, nil, "default")
+		fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+		fakeApp.SvcatClient = fakeSDK
+		cxt = svcattest.NewContext(outputBuffer, fakeApp)
+	})
+
+	Describe("NewSyncCmd", func() {
+		It("Builds and returns a cobra command with the wait flags", func() {
+			cmd := NewSyncCmd(cxt)
+			Expect(cmd.Use).To(Equal("broker NAME"))
+			Expect(cmd.Flags().Lookup("wait")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("timeout")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("interval")).NotTo(BeNil())
+		})
+	})
+
+	Describe("Run", func() {
+		run := func(args ...string) error {
+			cmd := NewSyncCmd(cxt)
+			Expect(cmd.ParseFlags(args)).To(Succeed())
+			if err := cmd.PreRunE(cmd, cmd.Flags().Args()); err != nil {
+				return err
+			}
+			return cmd.RunE(cmd, cmd.Flags().Args())
+		}
+
+		It("only requests a sync without --wait", func() {
+			Expect(run("ups-broker")).To(Succeed())
+
+			Expect(fakeSDK.SyncCallCount()).To(Equal(1))
+			Expect(fakeSDK.WaitForBrokerRelistCallCount()).To(Equal(0))
+			Expect(outputBuffer.String()).To(Equal("Synchronization requested for broker: ups-broker\n"))
+		})
+
+		It("reports the changes to the broker's classes with --wait", func() {
+			class := func(name string) servicecatalog.Class {
+				return &v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			}
+			fakeSDK.RetrieveClassesReturnsOnCall(0, []servicecatalog.Class{class("old")}, nil)
+			fakeSDK.RetrieveClassesReturnsOnCall(1, []servicecatalog.Class{class("new")}, nil)
+
+			Expect(run("ups-broker", "--wait", "--scope", "cluster")).To(Succeed())
+
+			name, scopeOpts, _, _ := fakeSDK.WaitForBrokerRelistArgsForCall(0)
+			Expect(name).To(Equal("ups-broker"))
+			Expect(scopeOpts.Scope).To(Equal(servicecatalog.Scope(servicecatalog.ClusterScope)))
+			Expect(outputBuffer.String()).To(ContainSubstring("Relisted broker ups-broker: 1 classes added, 1 removed, 0 changed"))
+		})
+
+		It("fails when the relist fails", func() {
+			fakeSDK.WaitForBrokerRelistReturns(nil, &servicecatalog.BrokerRelistError{Broker: "ups-broker", Message: "connection refused"})
+
+			err := run("ups-broker", "--wait")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+			Expect(command.ExitCode(err)).To(Equal(command.ExitBrokerFailure))
+		})
+
+		It("fails without a broker failure when the relist cannot be watched", func() {
+			fakeSDK.WaitForBrokerRelistReturns(nil, errors.New("connection refused"))
+
+			err := run("ups-broker", "--wait")
+			Expect(err).To(HaveOccurred())
+			Expect(command.ExitCode(err)).To(Equal(command.ExitError))
+		})
+	})
+})
@@ -60,7 +60,8 @@ func (c *MarketplaceCmd) Validate(args []string) error {
 
 // Run retrieves all service classes visible in the current namespace,
 // retrieves the plans belonging to those classes, and then displays
-// that to the user
+// that to the user. Classes and plans hidden by a platform admin are left
+// out.
 func (c *MarketplaceCmd) Run() error {
 	opts := servicecatalog.ScopeOptions{
 		Namespace: c.Namespace,
@@ -70,11 +71,13 @@ func (c *MarketplaceCmd) Run() error {
 	if err != nil {
 		return err
 	}
+	classes = servicecatalog.VisibleClasses(classes)
 	plans := make([][]servicecatalog.Plan, len(classes))
 	classPlans, err := c.App.RetrievePlans("", opts)
 	if err != nil {
 		return err
 	}
+	classPlans = servicecatalog.VisiblePlans(classPlans)
 	for i, class := range classes {
 		for _, plan := range classPlans {
 			if plan.GetClassID() == class.GetName() {
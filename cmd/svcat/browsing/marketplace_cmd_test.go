@@ -173,5 +173,61 @@ var _ = Describe("Register Command", func() {
 			Expect(output).To(ContainSubstring(planName3))
 			Expect(output).To(ContainSubstring(classDescription2))
 		})
+		It("Leaves out the classes and plans hidden by a platform admin", func() {
+			hiddenClass := &v1beta1.ClusterServiceClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "hidden-class-id"},
+				Spec: v1beta1.ClusterServiceClassSpec{
+					CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+						ExternalName:      "hiddenclass",
+						CatalogVisibility: v1beta1.CatalogVisibilityHidden,
+					},
+				},
+			}
+			visibleClass := &v1beta1.ClusterServiceClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "visible-class-id"},
+				Spec: v1beta1.ClusterServiceClassSpec{
+					CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+						ExternalName: "visibleclass",
+					},
+				},
+			}
+			newPlan := func(name, classID string, visibility v1beta1.CatalogVisibility) *v1beta1.ClusterServicePlan {
+				return &v1beta1.ClusterServicePlan{
+					ObjectMeta: metav1.ObjectMeta{Name: name + "-id"},
+					Spec: v1beta1.ClusterServicePlanSpec{
+						CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+							ExternalName:      name,
+							CatalogVisibility: visibility,
+						},
+						ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: classID},
+					},
+				}
+			}
+
+			outputBuffer := &bytes.Buffer{}
+			fakeApp, _ := svcat.NewApp(nil, nil, "default")
+			fakeSDK := new(servicecatalogfakes.FakeSvcatClient)
+			fakeSDK.RetrieveClassesReturns([]servicecatalog.Class{hiddenClass, visibleClass}, nil)
+			fakeSDK.RetrievePlansReturns([]servicecatalog.Plan{
+				newPlan("planofhiddenclass", "hidden-class-id", ""),
+				newPlan("visibleplan", "visible-class-id", v1beta1.CatalogVisibilityVisible),
+				newPlan("hiddenplan", "visible-class-id", v1beta1.CatalogVisibilityHidden),
+			}, nil)
+			fakeApp.SvcatClient = fakeSDK
+			cmd := MarketplaceCmd{
+				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
+			}
+
+			err := cmd.Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("visibleclass"))
+			Expect(output).To(ContainSubstring("visibleplan"))
+			Expect(output).NotTo(ContainSubstring("hiddenclass"))
+			Expect(output).NotTo(ContainSubstring("planofhiddenclass"))
+			Expect(output).NotTo(ContainSubstring("hiddenplan"))
+		})
 	})
 })
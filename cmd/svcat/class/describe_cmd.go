@@ -30,6 +30,7 @@ import (
 type DescribeCmd struct {
 	*command.Context
 	*command.Namespaced
+	*command.Formatted
 	*command.Scoped
 
 	LookupByKubeName bool
@@ -42,6 +43,7 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 	describeCmd := &DescribeCmd{
 		Context:    cxt,
 		Namespaced: command.NewNamespaced(cxt),
+		Formatted:  command.NewFormatted(),
 		Scoped:     command.NewScoped(),
 	}
 	cmd := &cobra.Command{
@@ -64,6 +66,7 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 	)
 	describeCmd.AddNamespaceFlags(cmd.Flags(), true)
 	describeCmd.AddScopedFlags(cmd.Flags(), true)
+	describeCmd.AddOutputFlags(cmd.Flags())
 
 	return cmd
 }
@@ -110,6 +113,11 @@ func (c *DescribeCmd) Run() error {
 		return err
 	}
 
+	if c.OutputFormat != output.FormatTable {
+		output.WriteClass(c.Output, c.OutputFormat, class)
+		return nil
+	}
+
 	output.WriteClassDetails(c.Output, class)
 
 	opts := servicecatalog.ScopeOptions{Scope: servicecatalog.AllScope}
@@ -150,6 +150,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       className,
 				Scoped:     command.NewScoped(),
 			}
@@ -193,6 +194,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       namespacedClassName,
 				Scoped:     command.NewScoped(),
 			}
@@ -226,6 +228,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:          cxt,
 				Namespaced:       command.NewNamespaced(cxt),
+				Formatted:        command.NewFormatted(),
 				KubeName:         classKubeName,
 				LookupByKubeName: true,
 				Scoped:           command.NewScoped(),
@@ -261,6 +264,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       className,
 				Scoped:     command.NewScoped(),
 			}
@@ -283,6 +287,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       className,
 				Scoped:     command.NewScoped(),
 			}
@@ -306,6 +311,7 @@ var _ = Describe("Describe Command", func() {
 			cmd := DescribeCmd{
 				Context:    cxt,
 				Namespaced: command.NewNamespaced(cxt),
+				Formatted:  command.NewFormatted(),
 				Name:       className,
 				Scoped:     command.NewScoped(),
 			}
@@ -36,6 +36,7 @@ type GetCmd struct {
 	LookupByKubeName bool
 	KubeName         string
 	Name             string
+	ShowHidden       bool
 }
 
 // NewGetCmd builds a "svcat get classes" command
@@ -55,6 +56,7 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get classes --scope cluster
   svcat get classes --scope namespace --namespace dev
   svcat get classes --broker mysql-broker
+  svcat get classes --show-hidden
   svcat get class mysqldb
   svcat get class --kube-name 997b8372-8dac-40ac-ae65-758b4a5075a5
 `),
@@ -68,6 +70,12 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		false,
 		"Whether or not to get the class by its Kubernetes name (the default is by external name)",
 	)
+	cmd.Flags().BoolVar(
+		&getCmd.ShowHidden,
+		"show-hidden",
+		false,
+		"Whether or not to list the classes hidden by a platform admin",
+	)
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
 	getCmd.AddScopedFlags(cmd.Flags(), true)
@@ -111,6 +119,9 @@ func (c *GetCmd) getAll() error {
 	if err != nil {
 		return err
 	}
+	if !c.ShowHidden {
+		classes = servicecatalog.VisibleClasses(classes)
+	}
 	output.WriteClassList(c.Output, c.OutputFormat, classes...)
 	return nil
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Exit codes of the svcat commands, so that scripts can tell why a command
+// failed without parsing its output.
+const (
+	// ExitSuccess is returned when the command succeeded.
+	ExitSuccess = 0
+	// ExitError is returned for any failure without a more specific code,
+	// such as invalid arguments or an unreachable API server.
+	ExitError = 1
+	// ExitNotFound is returned when a resource the command needs does not
+	// exist.
+	ExitNotFound = 2
+	// ExitBrokerFailure is returned when the broker failed the operation the
+	// command waited for.
+	ExitBrokerFailure = 3
+	// ExitTimeout is returned when the command gave up waiting for an
+	// operation to complete.
+	ExitTimeout = 4
+)
+
+// exitCodeError is an error carrying the exit code of the command that
+// returned it.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
+}
+
+// NewBrokerFailureError returns an error exiting with ExitBrokerFailure,
+// with the message formatted from format and a.
+func NewBrokerFailureError(format string, a ...interface{}) error {
+	return &exitCodeError{code: ExitBrokerFailure, err: fmt.Errorf(format, a...)}
+}
+
+// WrapBrokerFailureError returns err so that it exits with
+// ExitBrokerFailure, or nil if err is nil.
+func WrapBrokerFailureError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{code: ExitBrokerFailure, err: err}
+}
+
+// ExitCode returns the exit code of a command that returned err. Errors
+// created by this package carry their own code; otherwise, not found errors
+// from the API server exit with ExitNotFound and timed out waits with
+// ExitTimeout.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+	var exitErr *exitCodeError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	if apierrors.IsNotFound(err) {
+		return ExitNotFound
+	}
+	if wait.Interrupted(err) {
+		return ExitTimeout
+	}
+	return ExitError
+}
@@ -34,6 +34,9 @@ type HasFormatFlags interface {
 // Formatted is the base command of all svcat commands that support customizable output formats.
 type Formatted struct {
 	OutputFormat string
+
+	// wide is set for the commands that also support the wide output format.
+	wide bool
 }
 
 // NewFormatted command.
@@ -43,22 +46,50 @@ func NewFormatted() *Formatted {
 	}
 }
 
+// NewWideFormatted command, for the commands that also support the wide
+// output format, a table with additional columns.
+func NewWideFormatted() *Formatted {
+	return &Formatted{
+		OutputFormat: output.FormatTable,
+		wide:         true,
+	}
+}
+
 // AddOutputFlags adds common output flags to a command that can have variable output formats.
 func (c *Formatted) AddOutputFlags(flags *pflag.FlagSet) {
+	options := "table, json, yaml, jsonpath=TEMPLATE or go-template=TEMPLATE"
+	if c.wide {
+		options = "table, wide, json, yaml, jsonpath=TEMPLATE or go-template=TEMPLATE"
+	}
 	flags.StringVarP(&c.OutputFormat, "output", "o", output.FormatTable,
-		"The output format to use. Valid options are table, json or yaml. If not present, defaults to table",
+		"The output format to use. Valid options are "+options+". If not present, defaults to table",
 	)
 }
 
 // ApplyFormatFlags persists the format-related flags:
 // * --output
 func (c *Formatted) ApplyFormatFlags(flags *pflag.FlagSet) error {
-	c.OutputFormat = strings.ToLower(c.OutputFormat)
+	// Only the format name is case-insensitive, a template is kept as given.
+	name, tmpl := output.SplitFormat(c.OutputFormat)
+	name = strings.ToLower(name)
 
-	switch c.OutputFormat {
+	switch name {
 	case output.FormatTable, output.FormatJSON, output.FormatYAML:
+		c.OutputFormat = name
+		return nil
+	case output.FormatWide:
+		if !c.wide {
+			return fmt.Errorf("invalid --output format %q, the wide format is not supported by this command", c.OutputFormat)
+		}
+		c.OutputFormat = name
+		return nil
+	case output.FormatJSONPath, output.FormatGoTemplate:
+		c.OutputFormat = name + "=" + tmpl
+		if err := output.ValidateTemplate(c.OutputFormat); err != nil {
+			return fmt.Errorf("invalid --output %s template: %v", name, err)
+		}
 		return nil
 	default:
-		return fmt.Errorf("invalid --output format %q, allowed values are: table, json and yaml", c.OutputFormat)
+		return fmt.Errorf("invalid --output format %q, allowed values are: table, json, yaml, jsonpath=TEMPLATE and go-template=TEMPLATE", c.OutputFormat)
 	}
 }
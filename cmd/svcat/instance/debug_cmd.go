@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalog "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// redactedValue replaces the values left out of a debug bundle.
+const redactedValue = "<redacted>"
+
+// DebugCmd contains the info needed to gather the debug bundle of an instance
+type DebugCmd struct {
+	*command.Namespaced
+
+	Name                string
+	Filename            string
+	ControllerNamespace string
+	LogLines            int64
+}
+
+// NewDebugCmd builds a "svcat debug instance" command
+func NewDebugCmd(cxt *command.Context) *cobra.Command {
+	debugCmd := &DebugCmd{
+		Namespaced: command.NewNamespaced(cxt),
+	}
+	cmd := &cobra.Command{
+		Use:     "instance NAME",
+		Aliases: []string{"instances", "inst"},
+		Short:   "Gather the details of an instance into a tarball to attach to a support ticket",
+		Long: `Gather the details of an instance into a tarball to attach to a support ticket.
+
+The tarball holds the instance, with its parameters and user info redacted, its
+class, plan and broker, its events, and the lines of the logs of the controller
+manager that mention it. The controller manager pods are found in the
+controller namespace by their ` + servicecatalog.ControllerLogsAnnotation + ` annotation.`,
+		Example: command.NormalizeExamples(`
+  svcat debug instance wordpress-mysql-instance
+  svcat debug instance wordpress-mysql-instance --file /tmp/wordpress.tar.gz --controller-namespace kube-catalog
+`),
+		PreRunE: command.PreRunE(debugCmd),
+		RunE:    command.RunE(debugCmd),
+	}
+	debugCmd.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().StringVar(
+		&debugCmd.Filename,
+		"file",
+		"",
+		"The file the tarball is written to. Defaults to NAME-debug.tar.gz",
+	)
+	cmd.Flags().StringVar(
+		&debugCmd.ControllerNamespace,
+		"controller-namespace",
+		"catalog",
+		"The namespace of the controller manager pods",
+	)
+	cmd.Flags().Int64Var(
+		&debugCmd.LogLines,
+		"log-lines",
+		10000,
+		"The number of lines at the end of the log of each controller manager pod searched for the instance. Pass 0 to search the whole logs",
+	)
+	return cmd
+}
+
+// Validate checks that the required arguments have been provided
+func (c *DebugCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("an instance name is required")
+	}
+	c.Name = args[0]
+
+	if c.Filename == "" {
+		c.Filename = c.Name + "-debug.tar.gz"
+	}
+
+	if c.LogLines < 0 {
+		return fmt.Errorf("--log-lines must not be negative")
+	}
+
+	return nil
+}
+
+// debugFile is a file of a debug bundle.
+type debugFile struct {
+	name string
+	data []byte
+}
+
+// Run gathers the debug bundle of the instance. Only a failure to retrieve
+// the instance itself is an error: what else cannot be gathered is listed in
+// the errors.txt file of the bundle.
+func (c *DebugCmd) Run() error {
+	instance, err := c.App.RetrieveInstance(c.Namespace, c.Name)
+	if err != nil {
+		return err
+	}
+
+	var files []debugFile
+	var errs []string
+	add := func(name string, obj interface{}) {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+		files = append(files, debugFile{name: name, data: data})
+	}
+
+	add("instance.yaml", redactInstance(instance))
+
+	if instance.Spec.ClusterServiceClassRef != nil && instance.Spec.ClusterServicePlanRef != nil {
+		class, plan, broker, err := c.App.InstanceParentHierarchy(instance)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("class, plan and broker: %v", err))
+		} else {
+			add("class.yaml", class)
+			add("plan.yaml", plan)
+			add("broker.yaml", broker)
+		}
+	} else {
+		errs = append(errs, "class, plan and broker: the instance does not reference a resolved cluster class and plan")
+	}
+
+	events, err := c.App.RetrieveEventsByInstance(instance)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("events: %v", err))
+	} else {
+		add("events.yaml", events)
+	}
+
+	lines, err := c.App.RetrieveControllerLogsByInstance(c.ControllerNamespace, instance, c.LogLines)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("controller logs: %v", err))
+	} else {
+		files = append(files, debugFile{name: "controller.log", data: []byte(strings.Join(lines, "\n") + "\n")})
+	}
+
+	if len(errs) > 0 {
+		files = append(files, debugFile{name: "errors.txt", data: []byte(strings.Join(errs, "\n") + "\n")})
+	}
+
+	if err := writeDebugBundle(c.Filename, files); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.Output, "Wrote the debug bundle of instance %s/%s to %s\n", instance.Namespace, instance.Name, c.Filename)
+	if len(errs) > 0 {
+		fmt.Fprintf(c.Output, "Some details could not be gathered, see errors.txt in the bundle\n")
+	}
+	return nil
+}
+
+// redactInstance returns a copy of instance without the values of its
+// parameters, the user info of its requesters and its last applied
+// configuration, which may hold the parameters as well.
+func redactInstance(instance *v1beta1.ServiceInstance) *v1beta1.ServiceInstance {
+	redacted := instance.DeepCopy()
+	redacted.ManagedFields = nil
+	delete(redacted.Annotations, corev1.LastAppliedConfigAnnotation)
+	redacted.Spec.Parameters = redactParameters(redacted.Spec.Parameters)
+	redacted.Spec.UserInfo = nil
+	for _, properties := range []*v1beta1.ServiceInstancePropertiesState{redacted.Status.ExternalProperties, redacted.Status.InProgressProperties} {
+		if properties != nil {
+			properties.Parameters = redactParameters(properties.Parameters)
+			properties.UserInfo = nil
+		}
+	}
+	return redacted
+}
+
+// redactParameters returns parameters with the value of each parameter
+// redacted, so that which parameters were set is kept.
+func redactParameters(parameters *runtime.RawExtension) *runtime.RawExtension {
+	if parameters == nil || len(parameters.Raw) == 0 {
+		return parameters
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(parameters.Raw, &values); err != nil {
+		values = nil
+	}
+	redacted := make(map[string]interface{}, len(values))
+	for k := range values {
+		redacted[k] = redactedValue
+	}
+	raw, err := json.Marshal(redacted)
+	if err != nil {
+		return nil
+	}
+	return &runtime.RawExtension{Raw: raw}
+}
+
+// writeDebugBundle writes files to a gzipped tarball, in a directory named
+// after it.
+func writeDebugBundle(filename string, files []debugFile) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("unable to create %s (%s)", filename, err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	dir := strings.TrimSuffix(filepath.Base(filename), ".tar.gz")
+	now := time.Now()
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    path.Join(dir, file.name),
+			Mode:    0644,
+			Size:    int64(len(file.data)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("unable to write %s (%s)", filename, err)
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			return fmt.Errorf("unable to write %s (%s)", filename, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("unable to write %s (%s)", filename, err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("unable to write %s (%s)", filename, err)
+	}
+	return f.Close()
+}
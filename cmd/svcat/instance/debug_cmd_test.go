@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	. "github.com/drycc-addons/service-catalog/cmd/svcat/instance"
+	svcattest "github.com/drycc-addons/service-catalog/cmd/svcat/test"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/svcat"
+	servicecatalogfakes "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// readDebugBundle returns the content of each file of a debug bundle, by
+// path in the tarball.
+func readDebugBundle(filename string) map[string]string {
+	f, err := os.Open(filename)
+	Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gz)
+
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).NotTo(HaveOccurred())
+		data, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		files[header.Name] = string(data)
+	}
+	return files
+}
+
+var _ = Describe("Debug Command", func() {
+	Describe("NewDebugCmd", func() {
+		It("Builds and returns a cobra command with the correct flags", func() {
+			cxt := &command.Context{}
+			cmd := NewDebugCmd(cxt)
+
+			Expect(*cmd).NotTo(BeNil())
+			Expect(cmd.Use).To(Equal("instance NAME"))
+			Expect(cmd.Example).To(ContainSubstring("svcat debug instance wordpress-mysql-instance"))
+
+			Expect(cmd.Flags().Lookup("file")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("log-lines")).NotTo(BeNil())
+			flag := cmd.Flags().Lookup("controller-namespace")
+			Expect(flag).NotTo(BeNil())
+			Expect(flag.DefValue).To(Equal("catalog"))
+		})
+	})
+	Describe("Validate", func() {
+		It("defaults the file after the instance name", func() {
+			cmd := DebugCmd{}
+			err := cmd.Validate([]string{"myinstance"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Filename).To(Equal("myinstance-debug.tar.gz"))
+		})
+		It("errors if no instance name is provided", func() {
+			cmd := DebugCmd{}
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("an instance name is required"))
+		})
+		It("errors if the number of log lines is negative", func() {
+			cmd := DebugCmd{LogLines: -1}
+			err := cmd.Validate([]string{"myinstance"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--log-lines must not be negative"))
+		})
+	})
+	Describe("Run", func() {
+		var (
+			cxt          *command.Context
+			dir          string
+			fakeSDK      *servicecatalogfakes.FakeSvcatClient
+			instance     *v1beta1.ServiceInstance
+			outputBuffer *bytes.Buffer
+		)
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "svcat-debug")
+			Expect(err).NotTo(HaveOccurred())
+
+			instance = &v1beta1.ServiceInstance{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "myinstance",
+					Namespace: "foobarnamespace",
+					Annotations: map[string]string{
+						corev1.LastAppliedConfigAnnotation: `{"spec":{"parameters":{"password":"hunter2"}}}`,
+					},
+				},
+				Spec: v1beta1.ServiceInstanceSpec{
+					ClusterServiceClassRef: &v1beta1.ClusterObjectReference{Name: "myclass"},
+					ClusterServicePlanRef:  &v1beta1.ClusterObjectReference{Name: "myplan"},
+					Parameters:             &runtime.RawExtension{Raw: []byte(`{"password":"hunter2"}`)},
+					UserInfo:               &v1beta1.UserInfo{Username: "someone"},
+				},
+			}
+
+			outputBuffer = &bytes.Buffer{}
+			fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+			fakeSDK.RetrieveInstanceReturns(instance, nil)
+			fakeSDK.InstanceParentHierarchyReturns(
+				&v1beta1.ClusterServiceClass{ObjectMeta: v1.ObjectMeta{Name: "myclass"}},
+				&v1beta1.ClusterServicePlan{ObjectMeta: v1.ObjectMeta{Name: "myplan"}},
+				&v1beta1.ClusterServiceBroker{ObjectMeta: v1.ObjectMeta{Name: "mybroker"}},
+				nil)
+			fakeSDK.RetrieveEventsByInstanceReturns([]corev1.Event{{Reason: "ProvisionedSuccessfully"}}, nil)
+			fakeSDK.RetrieveControllerLogsByInstanceReturns([]string{`controller-manager-0: ServiceInstance "foobarnamespace/myinstance": Provisioned`}, nil)
+			fakeApp, _ := svcat.NewApp(nil, nil, "foobarnamespace")
+			fakeApp.SvcatClient = fakeSDK
+			cxt = svcattest.NewContext(outputBuffer, fakeApp)
+		})
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("writes the instance, its parents, its events and its logs to the bundle", func() {
+			cmd := DebugCmd{
+				Namespaced:          command.NewNamespaced(cxt),
+				Name:                "myinstance",
+				Filename:            filepath.Join(dir, "myinstance-debug.tar.gz"),
+				ControllerNamespace: "catalog",
+				LogLines:            100,
+			}
+			cmd.Namespaced.ApplyNamespaceFlags(&pflag.FlagSet{})
+
+			err := cmd.Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSDK.RetrieveControllerLogsByInstanceCallCount()).To(Equal(1))
+			namespace, _, logLines := fakeSDK.RetrieveControllerLogsByInstanceArgsForCall(0)
+			Expect(namespace).To(Equal("catalog"))
+			Expect(logLines).To(Equal(int64(100)))
+
+			files := readDebugBundle(cmd.Filename)
+			Expect(files).To(HaveKey("myinstance-debug/class.yaml"))
+			Expect(files).To(HaveKey("myinstance-debug/plan.yaml"))
+			Expect(files).To(HaveKey("myinstance-debug/broker.yaml"))
+			Expect(files).NotTo(HaveKey("myinstance-debug/errors.txt"))
+			Expect(files["myinstance-debug/instance.yaml"]).To(ContainSubstring("password: <redacted>"))
+			Expect(files["myinstance-debug/instance.yaml"]).NotTo(ContainSubstring("hunter2"))
+			Expect(files["myinstance-debug/instance.yaml"]).NotTo(ContainSubstring("someone"))
+			Expect(files["myinstance-debug/events.yaml"]).To(ContainSubstring("ProvisionedSuccessfully"))
+			Expect(files["myinstance-debug/controller.log"]).To(ContainSubstring(`ServiceInstance "foobarnamespace/myinstance": Provisioned`))
+			Expect(outputBuffer.String()).To(ContainSubstring("Wrote the debug bundle of instance foobarnamespace/myinstance"))
+
+			Expect(instance.Annotations).To(HaveKey(corev1.LastAppliedConfigAnnotation))
+		})
+		It("lists what could not be gathered in the bundle", func() {
+			fakeSDK.RetrieveControllerLogsByInstanceReturns(nil, errors.New("no pod in catalog is annotated"))
+			cmd := DebugCmd{
+				Namespaced:          command.NewNamespaced(cxt),
+				Name:                "myinstance",
+				Filename:            filepath.Join(dir, "myinstance-debug.tar.gz"),
+				ControllerNamespace: "catalog",
+			}
+			cmd.Namespaced.ApplyNamespaceFlags(&pflag.FlagSet{})
+
+			err := cmd.Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			files := readDebugBundle(cmd.Filename)
+			Expect(files).NotTo(HaveKey("myinstance-debug/controller.log"))
+			Expect(files["myinstance-debug/errors.txt"]).To(ContainSubstring("controller logs: no pod in catalog is annotated"))
+			Expect(outputBuffer.String()).To(ContainSubstring("see errors.txt in the bundle"))
+		})
+	})
+})
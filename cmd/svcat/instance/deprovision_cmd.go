@@ -126,6 +126,7 @@ func (c *deprovisonCmd) deprovision() error {
 		// The instance failed to deprovision cleanly, dump out more information on why
 		if instance != nil && c.App.IsInstanceFailed(instance) {
 			output.WriteInstanceDetails(c.Output, instance)
+			err = command.WrapBrokerFailureError(err)
 		}
 	}
 
@@ -26,23 +26,36 @@ import (
 
 type describeCmd struct {
 	*command.Namespaced
-	name string
+	*command.Formatted
+	name   string
+	events bool
 }
 
 // NewDescribeCmd builds a "svcat describe instance" command
 func NewDescribeCmd(cxt *command.Context) *cobra.Command {
-	describeCmd := &describeCmd{Namespaced: command.NewNamespaced(cxt)}
+	describeCmd := &describeCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Formatted:  command.NewFormatted(),
+	}
 	cmd := &cobra.Command{
 		Use:     "instance NAME",
 		Aliases: []string{"instances", "inst"},
 		Short:   "Show details of a specific instance",
 		Example: command.NormalizeExamples(`
   svcat describe instance wordpress-mysql-instance
+  svcat describe instance wordpress-mysql-instance --events
 `),
 		PreRunE: command.PreRunE(describeCmd),
 		RunE:    command.RunE(describeCmd),
 	}
 	describeCmd.AddNamespaceFlags(cmd.Flags(), false)
+	describeCmd.AddOutputFlags(cmd.Flags())
+	cmd.Flags().BoolVar(
+		&describeCmd.events,
+		"events",
+		false,
+		"If present, print the recent events of the instance after its bindings. Requires the table output format",
+	)
 	return cmd
 }
 
@@ -52,6 +65,10 @@ func (c *describeCmd) Validate(args []string) error {
 	}
 	c.name = args[0]
 
+	if c.events && c.OutputFormat != output.FormatTable {
+		return fmt.Errorf("--events is only supported with the table output format")
+	}
+
 	return nil
 }
 
@@ -65,6 +82,11 @@ func (c *describeCmd) describe() error {
 		return err
 	}
 
+	if c.OutputFormat != output.FormatTable {
+		output.WriteInstance(c.Output, c.OutputFormat, *instance)
+		return nil
+	}
+
 	output.WriteInstanceDetails(c.Output, instance)
 
 	bindings, err := c.App.RetrieveBindingsByInstance(instance)
@@ -73,5 +95,13 @@ func (c *describeCmd) describe() error {
 	}
 	output.WriteAssociatedBindings(c.Output, bindings)
 
+	if c.events {
+		events, err := c.App.RetrieveEventsByInstance(instance)
+		if err != nil {
+			return err
+		}
+		output.WriteEvents(c.Output, events)
+	}
+
 	return nil
 }
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
+	"github.com/drycc-addons/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+)
+
+type diffCmd struct {
+	*command.Namespaced
+	name string
+}
+
+// NewDiffCmd builds a "svcat diff instance" command
+func NewDiffCmd(cxt *command.Context) *cobra.Command {
+	diffCmd := &diffCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:     "instance NAME",
+		Aliases: []string{"instances", "inst"},
+		Short:   "Show the parameters of an instance that the broker has not accepted yet",
+		Example: command.NormalizeExamples(`
+  svcat diff instance wordpress-mysql-instance
+`),
+		PreRunE: command.PreRunE(diffCmd),
+		RunE:    command.RunE(diffCmd),
+	}
+	diffCmd.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+func (c *diffCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("an instance name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+func (c *diffCmd) Run() error {
+	instance, err := c.App.RetrieveInstance(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+
+	diff, err := c.App.DiffInstanceParameters(instance)
+	if err != nil {
+		return err
+	}
+	output.WriteInstanceParametersDiff(c.Output, instance, diff)
+	return nil
+}
@@ -21,7 +21,10 @@ import (
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
 	"github.com/drycc-addons/service-catalog/cmd/svcat/output"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalog "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type getCmd struct {
@@ -29,7 +32,11 @@ type getCmd struct {
 	*command.Formatted
 	*command.PlanFiltered
 	*command.ClassFiltered
-	name string
+	name      string
+	failed    bool
+	pending   bool
+	events    bool
+	chunkSize int64
 }
 
 // NewGetCmd builds a "svcat get instances" command
@@ -49,7 +56,10 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get instances --class redis
   svcat get instances --plan default
   svcat get instances --all-namespaces
+  svcat get instances --failed
+  svcat get instances --pending -n ci
   svcat get instance wordpress-mysql-instance
+  svcat get instance wordpress-mysql-instance --events
   svcat get instance -n ci concourse-postgres-instance
 `),
 		PreRunE: command.PreRunE(getCmd),
@@ -59,10 +69,44 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddClassFlag(cmd)
 	getCmd.AddPlanFlag(cmd)
+	cmd.Flags().BoolVar(
+		&getCmd.failed,
+		"failed",
+		false,
+		"If present, only list the instances whose last operation failed. Instances in all namespaces are listed unless --namespace is specified",
+	)
+	cmd.Flags().BoolVar(
+		&getCmd.pending,
+		"pending",
+		false,
+		"If present, only list the instances that are not ready yet and have not failed, such as instances being provisioned. Instances in all namespaces are listed unless --namespace is specified",
+	)
+	cmd.Flags().BoolVar(
+		&getCmd.events,
+		"events",
+		false,
+		"If present, print the recent events of the instance after it. Requires an instance name and the table output format",
+	)
+	cmd.Flags().Int64Var(
+		&getCmd.chunkSize,
+		"chunk-size",
+		servicecatalog.DefaultChunkSize,
+		"The number of instances to list per request, so that listing huge numbers of instances does not time out. The instances are still all held in memory before they are printed. Pass 0 to list all the instances in one request",
+	)
 
 	return cmd
 }
 
+// ApplyNamespaceFlags persists the namespace-related flags. Instances are
+// listed across all namespaces when filtered by condition, unless a
+// namespace is specified.
+func (c *getCmd) ApplyNamespaceFlags(flags *pflag.FlagSet) {
+	c.Namespaced.ApplyNamespaceFlags(flags)
+	if (c.failed || c.pending) && !flags.Changed("namespace") {
+		c.Namespace = ""
+	}
+}
+
 func (c *getCmd) Validate(args []string) error {
 	if len(args) > 0 {
 		c.name = args[0]
@@ -74,6 +118,30 @@ func (c *getCmd) Validate(args []string) error {
 		if c.PlanFilter != "" {
 			return fmt.Errorf("plan filter is not supported when specifiying instance name")
 		}
+
+		if c.failed || c.pending {
+			return fmt.Errorf("--failed and --pending are not supported when specifiying instance name")
+		}
+	}
+
+	if c.events && c.name == "" {
+		return fmt.Errorf("--events requires an instance name")
+	}
+
+	if c.events && c.OutputFormat != output.FormatTable {
+		return fmt.Errorf("--events is only supported with the table output format")
+	}
+
+	if c.failed && c.pending {
+		return fmt.Errorf("--failed and --pending cannot be used together")
+	}
+
+	if c.chunkSize < 0 {
+		return fmt.Errorf("--chunk-size must not be negative")
+	}
+
+	if (c.failed || c.pending) && (c.ClassFilter != "" || c.PlanFilter != "") {
+		return fmt.Errorf("--failed and --pending cannot be combined with the class or plan filters")
 	}
 
 	return nil
@@ -88,7 +156,11 @@ func (c *getCmd) Run() error {
 }
 
 func (c *getCmd) getAll() error {
-	instances, err := c.App.RetrieveInstances(c.Namespace, c.ClassFilter, c.PlanFilter)
+	if c.failed || c.pending {
+		return c.getByCondition()
+	}
+
+	instances, err := c.App.RetrieveInstancesInChunks(c.Namespace, c.ClassFilter, c.PlanFilter, c.chunkSize)
 	if err != nil {
 		return err
 	}
@@ -97,6 +169,34 @@ func (c *getCmd) getAll() error {
 	return nil
 }
 
+// getByCondition lists the failed instances, or the instances that are not
+// ready yet and have not failed.
+func (c *getCmd) getByCondition() error {
+	if c.failed {
+		instances, err := c.App.RetrieveInstancesByConditionInChunks(c.Namespace, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, c.chunkSize)
+		if err != nil {
+			return err
+		}
+		output.WriteInstanceList(c.Output, c.OutputFormat, instances)
+		return nil
+	}
+
+	instances, err := c.App.RetrieveInstancesByConditionInChunks(c.Namespace, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, c.chunkSize)
+	if err != nil {
+		return err
+	}
+	pending := &v1beta1.ServiceInstanceList{
+		Items: []v1beta1.ServiceInstance{},
+	}
+	for i := range instances.Items {
+		if !c.App.IsInstanceFailed(&instances.Items[i]) {
+			pending.Items = append(pending.Items, instances.Items[i])
+		}
+	}
+	output.WriteInstanceList(c.Output, c.OutputFormat, pending)
+	return nil
+}
+
 func (c *getCmd) get() error {
 	instance, err := c.App.RetrieveInstance(c.Namespace, c.name)
 	if err != nil {
@@ -105,5 +205,13 @@ func (c *getCmd) get() error {
 
 	output.WriteInstance(c.Output, c.OutputFormat, *instance)
 
+	if c.events {
+		events, err := c.App.RetrieveEventsByInstance(instance)
+		if err != nil {
+			return err
+		}
+		output.WriteEvents(c.Output, events)
+	}
+
 	return nil
 }
@@ -18,6 +18,8 @@ package instance
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
@@ -34,11 +36,15 @@ type ProvisionCmd struct {
 
 	ClassKubeName            string
 	ClassName                string
+	ExpandEnv                bool
 	ExternalID               string
+	Input                    io.Reader
 	InstanceName             string
+	Interactive              bool
 	JSONParams               string
 	LookupByKubeName         bool
 	Params                   interface{}
+	ParamsFile               string
 	PlanKubeName             string
 	PlanName                 string
 	ProvisionClusterInstance bool
@@ -52,6 +58,7 @@ func NewProvisionCmd(cxt *command.Context) *cobra.Command {
 	provisionCmd := &ProvisionCmd{
 		Namespaced: command.NewNamespaced(cxt),
 		Waitable:   command.NewWaitable(),
+		Input:      os.Stdin,
 	}
 	cmd := &cobra.Command{
 		Use:   "provision NAME --plan PLAN --class CLASS",
@@ -70,9 +77,19 @@ func NewProvisionCmd(cxt *command.Context) *cobra.Command {
         }
     ]
   }'
+  jq .mysql config.json | svcat provision secure-instance --class mysqldb --plan secureDB --params-file -
+  svcat provision secure-instance --class mysqldb --plan secureDB --params-file params.yaml --expand-env
+  svcat provision --interactive
 `),
-		PreRunE: command.PreRunE(provisionCmd),
-		RunE:    command.RunE(provisionCmd),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if provisionCmd.Interactive {
+				// The wizard prompts for the class and plan instead.
+				cmd.Flags().SetAnnotation("class", cobra.BashCompOneRequiredFlag, []string{"false"})
+				cmd.Flags().SetAnnotation("plan", cobra.BashCompOneRequiredFlag, []string{"false"})
+			}
+			return command.PreRunE(provisionCmd)(cmd, args)
+		},
+		RunE: command.RunE(provisionCmd),
 	}
 	cmd.Flags().StringVar(&provisionCmd.ClassName, "class", "", "The class name (Required)")
 	cmd.MarkFlagRequired("class")
@@ -80,22 +97,54 @@ func NewProvisionCmd(cxt *command.Context) *cobra.Command {
 	cmd.MarkFlagRequired("plan")
 	cmd.Flags().StringVar(&provisionCmd.ExternalID, "external-id", "", "The ID of the instance for use with the OSB SB API (Optional)")
 	cmd.Flags().BoolVarP(&provisionCmd.LookupByKubeName, "kube-name", "k", false, "Whether or not to interpret the Class/Plan names as Kubernetes names (the default is by external name)")
-	cmd.Flags().StringSliceVarP(&provisionCmd.RawParams, "param", "p", nil, "Additional parameter to use when provisioning the service, format: NAME=VALUE. Cannot be combined with --params-json, Sensitive information should be placed in a secret and specified with --secret")
-	cmd.Flags().StringVar(&provisionCmd.JSONParams, "params-json", "", "Additional parameters to use when provisioning the service, provided as a JSON object. Cannot be combined with --param")
+	cmd.Flags().StringSliceVarP(&provisionCmd.RawParams, "param", "p", nil, "Additional parameter to use when provisioning the service, format: NAME=VALUE. Cannot be combined with --params-json or --params-file, Sensitive information should be placed in a secret and specified with --secret")
+	cmd.Flags().StringVar(&provisionCmd.JSONParams, "params-json", "", "Additional parameters to use when provisioning the service, provided as a JSON object. Cannot be combined with --param or --params-file")
+	cmd.Flags().StringVar(&provisionCmd.ParamsFile, "params-file", "", "Additional parameters to use when provisioning the service, read as a JSON or YAML object from a file, or from stdin when the file is -. Cannot be combined with --param or --params-json")
+	cmd.Flags().BoolVar(&provisionCmd.ExpandEnv, "expand-env", false, "Replace ${VAR} in the parameter values with the value of the environment variable VAR")
 	cmd.Flags().StringSliceVarP(&provisionCmd.RawSecrets, "secret", "s", nil, "Additional parameter, whose value is stored in a secret, to use when provisioning the service, format: SECRET[KEY]")
+	cmd.Flags().BoolVarP(&provisionCmd.Interactive, "interactive", "i", false, "Prompt for the instance name, class, plan and parameters that are not given as arguments, then either provision the instance or print it as YAML")
 	provisionCmd.AddNamespaceFlags(cmd.Flags(), false)
 	provisionCmd.AddWaitFlags(cmd)
+	cmd.RegisterFlagCompletionFunc("param", provisionCmd.completeParam)
 
 	return cmd
 }
 
+// completeParam suggests the names of the parameters of the provisioning
+// schema of the plan given with --class and --plan as --param keys.
+func (c *ProvisionCmd) completeParam(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if c.App == nil || c.ClassName == "" || c.PlanName == "" || strings.Contains(toComplete, "=") {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	c.ApplyNamespaceFlags(cmd.Flags())
+
+	scopeOpts := servicecatalog.ScopeOptions{
+		Namespace: c.Namespace,
+		Scope:     servicecatalog.AllScope,
+	}
+	names, err := c.App.RetrievePlanParameterNames(c.ClassName, c.PlanName, c.LookupByKubeName, scopeOpts)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var completions []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name+"=")
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+}
+
 // Validate ensures the required args were provided
 // and parses provided params and secrets
 func (c *ProvisionCmd) Validate(args []string) error {
-	if len(args) == 0 {
+	if len(args) > 0 {
+		c.InstanceName = args[0]
+	} else if !c.Interactive {
 		return fmt.Errorf("an instance name is required")
 	}
-	c.InstanceName = args[0]
 
 	var err error
 
@@ -103,18 +152,39 @@ func (c *ProvisionCmd) Validate(args []string) error {
 		return fmt.Errorf("--params-json cannot be used with --param")
 	}
 
-	if c.JSONParams != "" {
-		c.Params, err = parameters.ParseVariableJSON(c.JSONParams)
+	if c.ParamsFile != "" && (c.JSONParams != "" || len(c.RawParams) > 0) {
+		return fmt.Errorf("--params-file cannot be used with --param or --params-json")
+	}
+
+	if c.ParamsFile == parameters.StdinFile && c.Interactive {
+		return fmt.Errorf("--params-file - cannot be used with --interactive")
+	}
+
+	var params map[string]interface{}
+	if c.ParamsFile != "" {
+		params, err = parameters.ParseVariableFile(c.ParamsFile, c.Input)
+		if err != nil {
+			return fmt.Errorf("invalid --params-file value (%s)", err)
+		}
+	} else if c.JSONParams != "" {
+		params, err = parameters.ParseVariableJSON(c.JSONParams)
 		if err != nil {
 			return fmt.Errorf("invalid --params-json value (%s)", err)
 		}
 	} else {
-		c.Params, err = parameters.ParseVariableAssignments(c.RawParams)
+		params, err = parameters.ParseVariableAssignments(c.RawParams)
 		if err != nil {
 			return fmt.Errorf("invalid --param value (%s)", err)
 		}
 	}
 
+	if c.ExpandEnv {
+		if err := parameters.ExpandEnv(params, os.LookupEnv); err != nil {
+			return err
+		}
+	}
+	c.Params = params
+
 	c.Secrets, err = parameters.ParseKeyMaps(c.RawSecrets)
 	if err != nil {
 		return fmt.Errorf("invalid --secret value (%s)", err)
@@ -125,6 +195,9 @@ func (c *ProvisionCmd) Validate(args []string) error {
 
 // Run calls the Provision method
 func (c *ProvisionCmd) Run() error {
+	if c.Interactive {
+		return c.runWizard()
+	}
 	err := c.findKubeNames()
 	if err != nil {
 		return err
@@ -199,6 +272,9 @@ func (c *ProvisionCmd) provision() error {
 		// Always print the instance because the provision did succeed,
 		// and just print any errors that occurred while polling
 		output.WriteInstanceDetails(c.Output, instance)
+		if err == nil && c.App.IsInstanceFailed(instance) {
+			err = command.NewBrokerFailureError("provision of instance %s/%s failed", instance.Namespace, instance.Name)
+		}
 		return err
 	}
 
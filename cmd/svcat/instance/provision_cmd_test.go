@@ -19,6 +19,7 @@ package instance_test
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/command"
@@ -31,6 +32,7 @@ import (
 	servicecatalogfakes "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -66,12 +68,16 @@ var _ = Describe("Provision Command", func() {
 
 			flag = cmd.Flags().Lookup("param")
 			Expect(flag).NotTo(BeNil())
-			Expect(flag.Usage).To(ContainSubstring("Additional parameter to use when provisioning the service, format: NAME=VALUE. Cannot be combined with --params-json, Sensitive information should be placed in a secret and specified with --secret"))
+			Expect(flag.Usage).To(ContainSubstring("Additional parameter to use when provisioning the service, format: NAME=VALUE. Cannot be combined with --params-json or --params-file, Sensitive information should be placed in a secret and specified with --secret"))
 
 			flag = cmd.Flags().Lookup("secret")
 			Expect(flag).NotTo(BeNil())
 			Expect(flag.Usage).To(ContainSubstring("Additional parameter, whose value is stored in a secret, to use when provisioning the service, format: SECRET[KEY]"))
 
+			flag = cmd.Flags().Lookup("interactive")
+			Expect(flag).NotTo(BeNil())
+			Expect(flag.Usage).To(ContainSubstring("Prompt for the instance name, class, plan and parameters"))
+
 			flag = cmd.Flags().Lookup("wait")
 			Expect(flag).NotTo(BeNil())
 			flag = cmd.Flags().Lookup("namespace")
@@ -90,6 +96,11 @@ var _ = Describe("Provision Command", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("an instance name is required"))
 		})
+		It("succeeds without an instance name in interactive mode", func() {
+			cmd := ProvisionCmd{Interactive: true}
+			err := cmd.Validate([]string{})
+			Expect(err).NotTo(HaveOccurred())
+		})
 		It("errors if both json params and raw params are provided", func() {
 			cmd := ProvisionCmd{
 				JSONParams: "{\"foo\":\"bar\"}",
@@ -328,6 +339,25 @@ var _ = Describe("Provision Command", func() {
 			Expect(output).To(ContainSubstring(namespace))
 			Expect(output).To(ContainSubstring(className))
 		})
+		It("Exits with a broker failure when the instance failed while waiting", func() {
+			fakeSDK.WaitForInstanceReturns(instanceToReturn, nil)
+			fakeSDK.IsInstanceFailedReturns(true)
+			cmd := ProvisionCmd{
+				ClassName:    className,
+				InstanceName: instanceName,
+				PlanName:     planName,
+				Namespaced:   command.NewNamespaced(cxt),
+				Waitable:     command.NewWaitable(),
+			}
+			cmd.Namespaced.ApplyNamespaceFlags(&pflag.FlagSet{})
+			cmd.Waitable.ApplyWaitFlags()
+			cmd.Wait = true
+
+			err := cmd.Run()
+			Expect(err).To(HaveOccurred())
+			Expect(command.ExitCode(err)).To(Equal(command.ExitBrokerFailure))
+			Expect(outputBuffer.String()).To(ContainSubstring(instanceName))
+		})
 		It("sets ProvisionClusterInstance to true if provisioning a cluster class instance", func() {
 			cmd := ProvisionCmd{
 				ClassName:    className,
@@ -424,4 +454,168 @@ var _ = Describe("Provision Command", func() {
 			Expect(returnedProvisionClusterInstance).To(BeFalse())
 		})
 	})
+	Describe("Run interactively", func() {
+		var (
+			cxt          *command.Context
+			fakeSDK      *servicecatalogfakes.FakeSvcatClient
+			namespace    string
+			outputBuffer *bytes.Buffer
+		)
+		BeforeEach(func() {
+			namespace = "foobarnamespace"
+			classes := []servicecatalog.Class{
+				&v1beta1.ClusterServiceClass{
+					ObjectMeta: v1.ObjectMeta{Name: "redisclass1234"},
+					Spec: v1beta1.ClusterServiceClassSpec{
+						CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalName: "redis"},
+					},
+				},
+				&v1beta1.ClusterServiceClass{
+					ObjectMeta: v1.ObjectMeta{Name: "mysqlclass1234"},
+					Spec: v1beta1.ClusterServiceClassSpec{
+						CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalName: "mysqlclass", Description: "MySQL database"},
+					},
+				},
+			}
+			plans := []servicecatalog.Plan{
+				&v1beta1.ClusterServicePlan{
+					ObjectMeta: v1.ObjectMeta{Name: "mysqlplan1234"},
+					Spec: v1beta1.ClusterServicePlanSpec{
+						CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+							ExternalName: "10mb",
+							InstanceCreateParameterSchema: &runtime.RawExtension{Raw: []byte(`{
+								"properties": {
+									"size": {"type": "integer", "description": "Size in MB"},
+									"tier": {"type": "string", "enum": ["basic", "premium"]},
+									"tls": {"type": "boolean", "default": true}
+								},
+								"required": ["size"]
+							}`)},
+						},
+					},
+				},
+			}
+
+			fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+			fakeSDK.RetrieveClassesReturns(classes, nil)
+			fakeSDK.RetrievePlansReturns(plans, nil)
+			fakeSDK.ProvisionReturns(&v1beta1.ServiceInstance{
+				ObjectMeta: v1.ObjectMeta{Name: "myMysql", Namespace: namespace},
+			}, nil)
+			fakeApp, _ := svcat.NewApp(nil, nil, namespace)
+			fakeApp.SvcatClient = fakeSDK
+			outputBuffer = &bytes.Buffer{}
+			cxt = svcattest.NewContext(outputBuffer, fakeApp)
+		})
+
+		newInteractiveCmd := func(input string) *ProvisionCmd {
+			cmd := &ProvisionCmd{
+				Input:       strings.NewReader(input),
+				Interactive: true,
+				Namespaced:  command.NewNamespaced(cxt),
+				Waitable:    command.NewWaitable(),
+			}
+			cmd.Namespaced.ApplyNamespaceFlags(&pflag.FlagSet{})
+			cmd.Waitable.ApplyWaitFlags()
+			return cmd
+		}
+
+		It("prompts for the name, class, plan and parameters and prints the instance as YAML", func() {
+			// The size is first answered with an invalid value, then the tier
+			// and tls prompts are left empty.
+			cmd := newInteractiveCmd("myMysql\nmysqlclass\n1\nbig\n50\n\n\nn\n")
+
+			err := cmd.Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSDK.ProvisionCallCount()).To(Equal(0))
+			Expect(fakeSDK.RetrievePlansCallCount()).To(Equal(1))
+			classKubeName, scopeOpts := fakeSDK.RetrievePlansArgsForCall(0)
+			Expect(classKubeName).To(Equal("mysqlclass1234"))
+			Expect(scopeOpts.Scope).To(Equal(servicecatalog.Scope(servicecatalog.ClusterScope)))
+
+			Expect(cmd.ClassKubeName).To(Equal("mysqlclass1234"))
+			Expect(cmd.PlanKubeName).To(Equal("mysqlplan1234"))
+			Expect(cmd.ProvisionClusterInstance).To(BeTrue())
+			Expect(cmd.Params).To(Equal(map[string]interface{}{"size": int64(50)}))
+
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("1) mysqlclass - MySQL database"))
+			Expect(output).To(ContainSubstring(`"big" is not an integer`))
+			Expect(output).To(ContainSubstring("kind: ServiceInstance"))
+			Expect(output).To(ContainSubstring("name: myMysql"))
+			Expect(output).To(ContainSubstring("clusterServiceClassName: mysqlclass1234"))
+			Expect(output).To(ContainSubstring("size: 50"))
+		})
+		It("provisions the instance when confirmed and keeps the parameters given as flags", func() {
+			cmd := newInteractiveCmd("2\n1\nbasic\nfalse\ny\n")
+			cmd.InstanceName = "myMysql"
+			cmd.Params = map[string]interface{}{"size": "10"}
+
+			err := cmd.Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSDK.ProvisionCallCount()).To(Equal(1))
+			instanceName, classKubeName, planKubeName, provisionClusterInstance, opts := fakeSDK.ProvisionArgsForCall(0)
+			Expect(instanceName).To(Equal("myMysql"))
+			Expect(classKubeName).To(Equal("redisclass1234"))
+			Expect(planKubeName).To(Equal("mysqlplan1234"))
+			Expect(provisionClusterInstance).To(BeTrue())
+			Expect(opts.Params).To(Equal(map[string]interface{}{
+				"size": "10",
+				"tier": "basic",
+				"tls":  false,
+			}))
+		})
+		It("errors if the input ends before all the answers are given", func() {
+			cmd := newInteractiveCmd("myMysql\n")
+
+			err := cmd.Run()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unexpected end of input"))
+			Expect(fakeSDK.ProvisionCallCount()).To(Equal(0))
+		})
+	})
+	Describe("Completing --param", func() {
+		var (
+			cmd     *cobra.Command
+			fakeSDK *servicecatalogfakes.FakeSvcatClient
+		)
+		BeforeEach(func() {
+			fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+			fakeSDK.RetrievePlanParameterNamesReturns([]string{"location", "size", "sslEnforcement"}, nil)
+			fakeApp, _ := svcat.NewApp(nil, nil, "foobarnamespace")
+			fakeApp.SvcatClient = fakeSDK
+			cmd = NewProvisionCmd(svcattest.NewContext(&bytes.Buffer{}, fakeApp))
+		})
+
+		It("suggests the parameters of the plan's provisioning schema", func() {
+			Expect(cmd.ParseFlags([]string{"--class", "mysqldb", "--plan", "free"})).To(Succeed())
+			complete, found := cmd.GetFlagCompletionFunc("param")
+			Expect(found).To(BeTrue())
+
+			completions, directive := complete(cmd, nil, "s")
+
+			Expect(completions).To(Equal([]string{"size=", "sslEnforcement="}))
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp))
+			Expect(fakeSDK.RetrievePlanParameterNamesCallCount()).To(Equal(1))
+			className, planName, lookupByKubeName, scopeOpts := fakeSDK.RetrievePlanParameterNamesArgsForCall(0)
+			Expect(className).To(Equal("mysqldb"))
+			Expect(planName).To(Equal("free"))
+			Expect(lookupByKubeName).To(BeFalse())
+			Expect(scopeOpts).To(Equal(servicecatalog.ScopeOptions{
+				Namespace: "foobarnamespace",
+				Scope:     servicecatalog.AllScope,
+			}))
+		})
+
+		It("does not suggest parameters without a class and plan", func() {
+			complete, _ := cmd.GetFlagCompletionFunc("param")
+
+			completions, _ := complete(cmd, nil, "")
+
+			Expect(completions).To(BeEmpty())
+			Expect(fakeSDK.RetrievePlanParameterNamesCallCount()).To(Equal(0))
+		})
+	})
 })
@@ -0,0 +1,378 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/drycc-addons/service-catalog/cmd/svcat/output"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalog "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// prompter asks questions on an output and reads the answers, one per line,
+// from an input.
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// ask prints the question and returns the trimmed answer.
+func (p *prompter) ask(question string) (string, error) {
+	fmt.Fprint(p.out, question)
+	line, err := p.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		if err == io.EOF {
+			return "", errors.New("unexpected end of input")
+		}
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// choose lists the options and asks for one of them, either by its number
+// or by its name. It returns the index of the chosen option.
+func (p *prompter) choose(what string, names, descriptions []string) (int, error) {
+	fmt.Fprintf(p.out, "Available %ss:\n", what)
+	for i, name := range names {
+		if descriptions[i] != "" {
+			fmt.Fprintf(p.out, "  %d) %s - %s\n", i+1, name, descriptions[i])
+		} else {
+			fmt.Fprintf(p.out, "  %d) %s\n", i+1, name)
+		}
+	}
+	for {
+		answer, err := p.ask(fmt.Sprintf("Select a %s [1-%d]: ", what, len(names)))
+		if err != nil {
+			return 0, err
+		}
+		if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(names) {
+			return n - 1, nil
+		}
+		for i, name := range names {
+			if answer == name {
+				return i, nil
+			}
+		}
+		fmt.Fprintf(p.out, "%q is not one of the available %ss\n", answer, what)
+	}
+}
+
+// runWizard prompts for everything that was not given on the command line,
+// then either provisions the instance or prints it as YAML.
+func (c *ProvisionCmd) runWizard() error {
+	p := &prompter{in: bufio.NewReader(c.Input), out: c.Output}
+
+	var err error
+	for c.InstanceName == "" {
+		if c.InstanceName, err = p.ask("Instance name: "); err != nil {
+			return err
+		}
+	}
+
+	class, err := c.selectClass(p)
+	if err != nil {
+		return err
+	}
+	plan, err := c.selectPlan(p, class)
+	if err != nil {
+		return err
+	}
+
+	params, _ := c.Params.(map[string]interface{})
+	if params == nil {
+		params = make(map[string]interface{})
+	}
+	if err := promptParameters(p, plan.GetInstanceCreateSchema(), params); err != nil {
+		return err
+	}
+	c.Params = params
+
+	answer, err := p.ask("Provision the instance now? [y|n]: ")
+	if err != nil {
+		return err
+	}
+	if strings.ToLower(answer) == "y" {
+		return c.provision()
+	}
+
+	instance := servicecatalog.NewProvisionRequest(c.InstanceName, c.ClassKubeName, c.PlanKubeName, c.ProvisionClusterInstance, &servicecatalog.ProvisionOptions{
+		ExternalID: c.ExternalID,
+		Namespace:  c.Namespace,
+		Params:     c.Params,
+		Secrets:    c.Secrets,
+	})
+	instance.TypeMeta = metav1.TypeMeta{
+		APIVersion: v1beta1.SchemeGroupVersion.String(),
+		Kind:       "ServiceInstance",
+	}
+	output.WriteInstance(c.Output, output.FormatYAML, *instance)
+	return nil
+}
+
+// selectClass returns the class given on the command line, or asks for one
+// of the available classes.
+func (c *ProvisionCmd) selectClass(p *prompter) (servicecatalog.Class, error) {
+	scopeOpts := servicecatalog.ScopeOptions{
+		Namespace: c.Namespace,
+		Scope:     servicecatalog.AllScope,
+	}
+
+	var class servicecatalog.Class
+	if c.ClassName != "" {
+		var err error
+		if c.LookupByKubeName {
+			class, err = c.App.RetrieveClassByID(c.ClassName, scopeOpts)
+		} else {
+			class, err = c.App.RetrieveClassByName(c.ClassName, scopeOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		classes, err := c.App.RetrieveClasses(scopeOpts, "")
+		if err != nil {
+			return nil, err
+		}
+		classes = servicecatalog.VisibleClasses(classes)
+		if len(classes) == 0 {
+			return nil, errors.New("no classes are available")
+		}
+		sort.Slice(classes, func(i, j int) bool {
+			return classes[i].GetExternalName() < classes[j].GetExternalName()
+		})
+		names := make([]string, len(classes))
+		descriptions := make([]string, len(classes))
+		for i, cl := range classes {
+			names[i] = cl.GetExternalName()
+			descriptions[i] = cl.GetDescription()
+		}
+		i, err := p.choose("class", names, descriptions)
+		if err != nil {
+			return nil, err
+		}
+		class = classes[i]
+	}
+
+	c.ClassName = class.GetExternalName()
+	c.ClassKubeName = class.GetName()
+	c.ProvisionClusterInstance = class.IsClusterServiceClass()
+	return class, nil
+}
+
+// selectPlan returns the plan of class given on the command line, or asks
+// for one of the plans of class.
+func (c *ProvisionCmd) selectPlan(p *prompter, class servicecatalog.Class) (servicecatalog.Plan, error) {
+	scopeOpts := servicecatalog.ScopeOptions{
+		Namespace: c.Namespace,
+		Scope:     servicecatalog.NamespaceScope,
+	}
+	if class.IsClusterServiceClass() {
+		scopeOpts.Scope = servicecatalog.ClusterScope
+	}
+
+	plans, err := c.App.RetrievePlans(class.GetName(), scopeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan servicecatalog.Plan
+	if c.PlanName != "" {
+		for _, pl := range plans {
+			if c.LookupByKubeName && pl.GetName() == c.PlanName || !c.LookupByKubeName && pl.GetExternalName() == c.PlanName {
+				plan = pl
+			}
+		}
+		if plan == nil {
+			return nil, fmt.Errorf("Unable to find plan '%s' of class '%s'", c.PlanName, c.ClassName)
+		}
+	} else {
+		plans = servicecatalog.VisiblePlans(plans)
+		if len(plans) == 0 {
+			return nil, fmt.Errorf("class '%s' has no plans", c.ClassName)
+		}
+		sort.Slice(plans, func(i, j int) bool {
+			return plans[i].GetExternalName() < plans[j].GetExternalName()
+		})
+		names := make([]string, len(plans))
+		descriptions := make([]string, len(plans))
+		for i, pl := range plans {
+			names[i] = pl.GetExternalName()
+			descriptions[i] = pl.GetDescription()
+			if available, _ := pl.GetAvailability(); !available {
+				descriptions[i] = "(unavailable) " + descriptions[i]
+			}
+		}
+		i, err := p.choose("plan", names, descriptions)
+		if err != nil {
+			return nil, err
+		}
+		plan = plans[i]
+	}
+
+	c.PlanName = plan.GetExternalName()
+	c.PlanKubeName = plan.GetName()
+	return plan, nil
+}
+
+// parameterSchema is the part of a plan's JSON schema for instance creation
+// that drives the parameter prompts.
+type parameterSchema struct {
+	Properties map[string]parameterProperty `json:"properties"`
+	Required   []string                     `json:"required"`
+}
+
+type parameterProperty struct {
+	Type        interface{}   `json:"type"`
+	Description string        `json:"description"`
+	Default     interface{}   `json:"default"`
+	Enum        []interface{} `json:"enum"`
+}
+
+// typeName returns the JSON type of the property, or "string" if it does
+// not have exactly one.
+func (p parameterProperty) typeName() string {
+	switch t := p.Type.(type) {
+	case string:
+		return t
+	case []interface{}:
+		if len(t) == 1 {
+			if s, ok := t[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return "string"
+}
+
+// parse converts an answer to a value of the property's type.
+func (p parameterProperty) parse(answer string) (interface{}, error) {
+	var value interface{}
+	switch p.typeName() {
+	case "integer":
+		i, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", answer)
+		}
+		value = i
+	case "number":
+		f, err := strconv.ParseFloat(answer, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", answer)
+		}
+		value = f
+	case "boolean":
+		b, err := strconv.ParseBool(answer)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", answer)
+		}
+		value = b
+	case "object", "array":
+		if err := json.Unmarshal([]byte(answer), &value); err != nil {
+			return nil, fmt.Errorf("%q is not valid JSON (%s)", answer, err)
+		}
+	default:
+		value = answer
+	}
+
+	if len(p.Enum) > 0 {
+		for _, allowed := range p.Enum {
+			if fmt.Sprint(allowed) == fmt.Sprint(value) {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not one of %v", answer, p.Enum)
+	}
+	return value, nil
+}
+
+// promptParameters asks for the value of each parameter in the schema that
+// is not already set in params, and stores the answers in params. Optional
+// parameters left empty are not set, so that the broker applies its
+// defaults.
+func promptParameters(p *prompter, schema *runtime.RawExtension, params map[string]interface{}) error {
+	if schema == nil || len(schema.Raw) == 0 {
+		return nil
+	}
+	var s parameterSchema
+	if err := json.Unmarshal(schema.Raw, &s); err != nil {
+		fmt.Fprintf(p.out, "Unable to read the plan's parameter schema (%s), skipping parameter prompts\n", err)
+		return nil
+	}
+
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		if _, ok := params[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := s.Properties[name]
+		question := name + " (" + property.typeName()
+		if required[name] {
+			question += ", required"
+		}
+		question += ")"
+		if property.Description != "" {
+			question += " - " + property.Description
+		}
+		if len(property.Enum) > 0 {
+			question += fmt.Sprintf(" %v", property.Enum)
+		}
+		if property.Default != nil {
+			question += fmt.Sprintf(" [%v]", property.Default)
+		}
+		question += ": "
+
+		for {
+			answer, err := p.ask(question)
+			if err != nil {
+				return err
+			}
+			if answer == "" {
+				if required[name] && property.Default == nil {
+					fmt.Fprintln(p.out, "A value is required")
+					continue
+				}
+				break
+			}
+			value, err := property.parse(answer)
+			if err != nil {
+				fmt.Fprintln(p.out, err)
+				continue
+			}
+			params[name] = value
+			break
+		}
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/drycc-addons/service-catalog/cmd/svcat/binding"
@@ -58,7 +59,7 @@ func main() {
 	}
 	cmd := buildRootCommand(cxt)
 	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(command.ExitCode(err))
 	}
 }
 
@@ -73,6 +74,7 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 	var opts struct {
 		KubeConfig  string
 		KubeContext string
+		Quiet       bool
 	}
 
 	cmd := &cobra.Command{
@@ -90,6 +92,11 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 				plugin.BindEnvironmentVariables(cxt.Viper, cmd)
 			}
 
+			// Only the errors, printed by cobra, remain in quiet mode
+			if opts.Quiet {
+				cxt.Output = io.Discard
+			}
+
 			// Initialize the context if not already configured (by tests)
 			if cxt.App == nil {
 				k8sClient, svcatClient, namespace, err := getClients(opts.KubeConfig, opts.KubeContext)
@@ -115,10 +122,13 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&opts.KubeContext, "context", "", "name of the kubeconfig context to use.")
 	cmd.PersistentFlags().StringVar(&opts.KubeConfig, "kubeconfig", "", "path to kubeconfig file. Overrides $KUBECONFIG")
+	cmd.PersistentFlags().BoolVarP(&opts.Quiet, "quiet", "q", false, "suppress all output except errors")
 
 	cmd.AddCommand(newCreateCmd(cxt))
 	cmd.AddCommand(newGetCmd(cxt))
 	cmd.AddCommand(newDescribeCmd(cxt))
+	cmd.AddCommand(newDiffCmd(cxt))
+	cmd.AddCommand(newDebugCmd(cxt))
 	cmd.AddCommand(broker.NewRegisterCmd(cxt))
 	cmd.AddCommand(broker.NewDeregisterCmd(cxt))
 	cmd.AddCommand(instance.NewProvisionCmd(cxt))
@@ -131,6 +141,7 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 		cmd.AddCommand(newInstallCmd(cxt))
 	}
 	cmd.AddCommand(newTouchCmd(cxt))
+	cmd.AddCommand(newRetryCmd(cxt))
 	cmd.AddCommand(versions.NewVersionCmd(cxt))
 	cmd.AddCommand(newCompletionCmd(cxt))
 
@@ -186,6 +197,26 @@ func newDescribeCmd(cxt *command.Context) *cobra.Command {
 	return cmd
 }
 
+func newDiffCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes to a resource that have not taken effect yet",
+	}
+	cmd.AddCommand(instance.NewDiffCmd(cxt))
+
+	return cmd
+}
+
+func newDebugCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Gather the details of a resource for troubleshooting",
+	}
+	cmd.AddCommand(instance.NewDebugCmd(cxt))
+
+	return cmd
+}
+
 func newInstallCmd(cxt *command.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "install",
@@ -201,10 +232,20 @@ func newTouchCmd(cxt *command.Context) *cobra.Command {
 		Use:   "touch",
 		Short: "Force Service Catalog to reprocess a resource",
 	}
+	cmd.AddCommand(binding.NewTouchCmd(cxt))
 	cmd.AddCommand(instance.NewTouchCommand(cxt))
 	return cmd
 }
 
+func newRetryCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retry a resource that failed",
+	}
+	cmd.AddCommand(binding.NewRetryCmd(cxt))
+	return cmd
+}
+
 func newCompletionCmd(ctx *command.Context) *cobra.Command {
 	return completion.NewCompletionCmd(ctx)
 }
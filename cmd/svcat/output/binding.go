@@ -23,6 +23,7 @@ import (
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	svcatsdk "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+	"github.com/olekukonko/tablewriter"
 	"k8s.io/api/core/v1"
 )
 
@@ -36,6 +37,12 @@ func getBindingStatusFull(status v1beta1.ServiceBindingStatus) string {
 	return formatStatusFull(string(lastCond.Type), lastCond.Status, lastCond.Reason, lastCond.Message, lastCond.LastTransitionTime)
 }
 
+func appendBindingLastOperationKey(status v1beta1.ServiceBindingStatus, table *tablewriter.Table) {
+	if status.LastOperationKey != "" {
+		table.Append([]string{"Operation Key:", status.LastOperationKey})
+	}
+}
+
 func writeBindingListTable(w io.Writer, bindingList *v1beta1.ServiceBindingList) {
 	t := NewListTable(w)
 	t.SetHeader([]string{
@@ -58,11 +65,13 @@ func writeBindingListTable(w io.Writer, bindingList *v1beta1.ServiceBindingList)
 
 // WriteBindingList prints a list of bindings in the specified output format.
 func WriteBindingList(w io.Writer, outputFormat string, bindingList *v1beta1.ServiceBindingList) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, bindingList)
 	case FormatYAML:
 		writeYAML(w, bindingList, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, bindingList)
 	case FormatTable:
 		writeBindingListTable(w, bindingList)
 	}
@@ -70,11 +79,13 @@ func WriteBindingList(w io.Writer, outputFormat string, bindingList *v1beta1.Ser
 
 // WriteBinding prints a single bindings in the specified output format.
 func WriteBinding(w io.Writer, outputFormat string, binding v1beta1.ServiceBinding) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, binding)
 	case FormatYAML:
 		writeYAML(w, binding, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, binding)
 	case FormatTable:
 		l := v1beta1.ServiceBindingList{
 			Items: []v1beta1.ServiceBinding{binding},
@@ -93,6 +104,7 @@ func WriteBindingDetails(w io.Writer, binding *v1beta1.ServiceBinding) {
 		{"Secret:", binding.Spec.SecretName},
 		{"Instance:", binding.Spec.InstanceRef.Name},
 	})
+	appendBindingLastOperationKey(binding.Status, t)
 	t.Render()
 
 	writeParameters(w, binding.Spec.Parameters)
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func Test_appendBindingLastOperationKey(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         v1beta1.ServiceBindingStatus
+		expectedString string
+	}{
+		{"operationKeyOK", v1beta1.ServiceBindingStatus{
+			LastOperationKey: "bind-42",
+		}, "Operation Key:   bind-42"},
+		{"operationKeyEmpty", v1beta1.ServiceBindingStatus{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stringBuilder strings.Builder
+			table := NewDetailsTable(&stringBuilder)
+			appendBindingLastOperationKey(tt.status, table)
+			table.Render()
+			actualString := strings.Trim(stringBuilder.String(), " \n")
+
+			if actualString != tt.expectedString {
+				t.Fatalf("%v failed; expected %v; got %v", tt.name, tt.expectedString, actualString)
+			}
+		})
+	}
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package output
 
 import (
+	"fmt"
 	"io"
+	"strconv"
+	"time"
 
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
 )
@@ -47,46 +51,113 @@ func getBrokerStatusFull(status v1beta1.CommonServiceBrokerStatus) string {
 	return formatStatusFull(string(lastCond.Type), lastCond.Status, lastCond.Reason, lastCond.Message, lastCond.LastTransitionTime)
 }
 
-func writeBrokerListTable(w io.Writer, brokers []servicecatalog.Broker) {
+func getBrokerAuthType(broker servicecatalog.Broker) string {
+	if authType := broker.GetAuthType(); authType != "" {
+		return authType
+	}
+	return "none"
+}
+
+func getBrokerRelist(spec v1beta1.CommonServiceBrokerSpec) string {
+	if spec.RelistBehavior == v1beta1.ServiceBrokerRelistBehaviorDuration && spec.RelistDuration != nil {
+		return fmt.Sprintf("%s (%s)", spec.RelistBehavior, spec.RelistDuration.Duration)
+	}
+	return string(spec.RelistBehavior)
+}
+
+func getBrokerLastRelist(status v1beta1.CommonServiceBrokerStatus) string {
+	if status.LastCatalogRetrievalTime == nil {
+		return ""
+	}
+	return status.LastCatalogRetrievalTime.UTC().Format(time.RFC3339)
+}
+
+// getBrokerLastResult returns the reason of the broker's Ready condition,
+// which tells how its last relist went.
+func getBrokerLastResult(status v1beta1.CommonServiceBrokerStatus) string {
+	for _, cond := range status.Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionReady {
+			return cond.Reason
+		}
+	}
+	return ""
+}
+
+func getBrokerClassCount(status v1beta1.CommonServiceBrokerStatus) string {
+	if status.CatalogSummary == nil {
+		return ""
+	}
+	return strconv.Itoa(int(status.CatalogSummary.ClassCount))
+}
+
+func writeBrokerListTable(w io.Writer, brokers []servicecatalog.Broker, wide bool) {
 	t := NewListTable(w)
-	t.SetHeader([]string{
+	header := []string{
 		"Name",
 		"Namespace",
 		"URL",
 		"Status",
-	})
+	}
+	if wide {
+		header = append(header,
+			"Auth",
+			"Relist",
+			"Last Relist",
+			"Last Result",
+			"Classes",
+		)
+	}
+	t.SetHeader(header)
 	for _, broker := range brokers {
-		t.Append([]string{
+		row := []string{
 			broker.GetName(),
 			broker.GetNamespace(),
 			broker.GetURL(),
 			getBrokerStatusShort(broker.GetStatus()),
-		})
+		}
+		if wide {
+			row = append(row,
+				getBrokerAuthType(broker),
+				getBrokerRelist(broker.GetSpec()),
+				getBrokerLastRelist(broker.GetStatus()),
+				getBrokerLastResult(broker.GetStatus()),
+				getBrokerClassCount(broker.GetStatus()),
+			)
+		}
+		t.Append(row)
 	}
 	t.Render()
 }
 
 // WriteBrokerList prints a list of brokers in the specified output format.
 func WriteBrokerList(w io.Writer, outputFormat string, brokers ...servicecatalog.Broker) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, brokers)
 	case FormatYAML:
 		writeYAML(w, brokers, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, brokers)
 	case FormatTable:
-		writeBrokerListTable(w, brokers)
+		writeBrokerListTable(w, brokers, false)
+	case FormatWide:
+		writeBrokerListTable(w, brokers, true)
 	}
 }
 
 // WriteBroker prints a broker in the specified output format.
 func WriteBroker(w io.Writer, outputFormat string, broker servicecatalog.Broker) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, broker)
 	case FormatYAML:
 		writeYAML(w, broker, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, broker)
 	case FormatTable:
-		writeBrokerListTable(w, []servicecatalog.Broker{broker})
+		writeBrokerListTable(w, []servicecatalog.Broker{broker}, false)
+	case FormatWide:
+		writeBrokerListTable(w, []servicecatalog.Broker{broker}, true)
 	}
 }
 
@@ -104,3 +175,62 @@ func WriteBrokerDetails(w io.Writer, broker servicecatalog.Broker) {
 	t.AppendBulk(table)
 	t.Render()
 }
+
+// MaterializedCatalog maps the external name of each class of a broker found
+// in the cluster to the external names of its plans.
+type MaterializedCatalog map[string]map[string]bool
+
+func writeBrokerCatalogTable(w io.Writer, catalog *osb.CatalogResponse, materialized MaterializedCatalog) {
+	fmt.Fprintln(w, "\nCatalog:")
+	if len(catalog.Services) == 0 {
+		fmt.Fprintln(w, "No classes advertised")
+		return
+	}
+
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Class",
+		"Plan",
+		"Free",
+		"In Cluster",
+	})
+	for _, service := range catalog.Services {
+		plans, classFound := materialized[service.Name]
+		if len(service.Plans) == 0 {
+			t.Append([]string{service.Name, "", "", formatFound(classFound)})
+		}
+		for _, plan := range service.Plans {
+			free := plan.Free != nil && *plan.Free
+			t.Append([]string{
+				service.Name,
+				plan.Name,
+				fmt.Sprintf("%t", free),
+				formatFound(plans[plan.Name]),
+			})
+		}
+	}
+	t.Render()
+}
+
+func formatFound(found bool) string {
+	if found {
+		return "yes"
+	}
+	return "no"
+}
+
+// WriteBrokerCatalog prints the classes and plans of the catalog a broker
+// currently advertises in the specified output format. The table format
+// tells whether each class and plan is found in the cluster.
+func WriteBrokerCatalog(w io.Writer, outputFormat string, catalog *osb.CatalogResponse, materialized MaterializedCatalog) {
+	switch formatName(outputFormat) {
+	case FormatJSON:
+		writeJSON(w, catalog)
+	case FormatYAML:
+		writeYAML(w, catalog, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, catalog)
+	case FormatTable:
+		writeBrokerCatalogTable(w, catalog, materialized)
+	}
+}
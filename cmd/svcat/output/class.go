@@ -43,7 +43,7 @@ func writeClassListTable(w io.Writer, classes []servicecatalog.Class) {
 
 	for _, class := range classes {
 		t.Append([]string{
-			class.GetExternalName(),
+			getClassListName(class),
 			class.GetNamespace(),
 			class.GetServiceBrokerName(),
 			class.GetDescription(),
@@ -53,13 +53,24 @@ func writeClassListTable(w io.Writer, classes []servicecatalog.Class) {
 	t.Render()
 }
 
+// getClassListName returns the external name of the class, marked if a
+// platform admin has hidden the class.
+func getClassListName(class servicecatalog.Class) string {
+	if class.IsHidden() {
+		return class.GetExternalName() + " (hidden)"
+	}
+	return class.GetExternalName()
+}
+
 // WriteClassList prints a list of classes in the specified output format.
 func WriteClassList(w io.Writer, outputFormat string, classes ...servicecatalog.Class) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, classes)
 	case FormatYAML:
 		writeYAML(w, classes, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, classes)
 	case FormatTable:
 		writeClassListTable(w, classes)
 	}
@@ -67,11 +78,13 @@ func WriteClassList(w io.Writer, outputFormat string, classes ...servicecatalog.
 
 // WriteClass prints a single class in the specified output format.
 func WriteClass(w io.Writer, outputFormat string, class servicecatalog.Class) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, class)
 	case FormatYAML:
 		writeYAML(w, class, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, class)
 	case FormatTable:
 		writeClassListTable(w, []servicecatalog.Class{class})
 	}
@@ -94,6 +107,9 @@ func WriteClassDetails(w io.Writer, class servicecatalog.Class) {
 		{"Tags:", strings.Join(spec.Tags, ", ")},
 		{"Broker:", class.GetServiceBrokerName()},
 	})
+	if brokers := class.GetStatus().ConflictingBrokers; len(brokers) > 0 {
+		t.Append([]string{"Conflicting Brokers:", strings.Join(brokers, ", ")})
+	}
 	t.Render()
 }
 
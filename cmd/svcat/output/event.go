@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// WriteEvents prints the events of a resource, oldest first.
+func WriteEvents(w io.Writer, events []v1.Event) {
+	fmt.Fprintln(w, "\nEvents:")
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events")
+		return
+	}
+
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Type",
+		"Reason",
+		"Age",
+		"From",
+		"Message",
+	})
+	for _, event := range events {
+		t.Append([]string{
+			event.Type,
+			event.Reason,
+			getEventAge(event),
+			getEventSource(event),
+			strings.TrimSpace(event.Message),
+		})
+	}
+	t.Render()
+}
+
+// getEventAge returns how long ago an event last occurred, and how many
+// times it did if it repeated.
+func getEventAge(event v1.Event) string {
+	last := event.LastTimestamp.Time
+	if last.IsZero() {
+		last = event.EventTime.Time
+	}
+	if last.IsZero() {
+		return "<unknown>"
+	}
+	age := duration.HumanDuration(time.Since(last))
+	if event.Count > 1 {
+		return fmt.Sprintf("%s (x%d)", age, event.Count)
+	}
+	return age
+}
+
+func getEventSource(event v1.Event) string {
+	if event.Source.Component != "" {
+		return event.Source.Component
+	}
+	return event.ReportingController
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
 	"github.com/olekukonko/tablewriter"
 )
 
@@ -50,6 +52,15 @@ func appendInstanceDashboardURL(status v1beta1.ServiceInstanceStatus, table *tab
 	}
 }
 
+func appendInstanceBroker(status v1beta1.ServiceInstanceStatus, table *tablewriter.Table) {
+	if status.BrokerName != "" {
+		table.Append([]string{"Broker:", status.BrokerName})
+	}
+	if status.BrokerURL != "" {
+		table.Append([]string{"Broker URL:", status.BrokerURL})
+	}
+}
+
 func writeInstanceListTable(w io.Writer, instanceList *v1beta1.ServiceInstanceList) {
 	t := NewListTable(w)
 	t.SetHeader([]string{
@@ -75,11 +86,13 @@ func writeInstanceListTable(w io.Writer, instanceList *v1beta1.ServiceInstanceLi
 
 // WriteInstanceList prints a list of instances.
 func WriteInstanceList(w io.Writer, outputFormat string, instanceList *v1beta1.ServiceInstanceList) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, instanceList)
 	case FormatYAML:
 		writeYAML(w, instanceList, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, instanceList)
 	case FormatTable:
 		writeInstanceListTable(w, instanceList)
 	}
@@ -87,11 +100,13 @@ func WriteInstanceList(w io.Writer, outputFormat string, instanceList *v1beta1.S
 
 // WriteInstance prints a single instance
 func WriteInstance(w io.Writer, outputFormat string, instance v1beta1.ServiceInstance) {
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
 		writeJSON(w, instance)
 	case FormatYAML:
 		writeYAML(w, instance, 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, instance)
 	case FormatTable:
 		p := v1beta1.ServiceInstanceList{
 			Items: []v1beta1.ServiceInstance{instance},
@@ -149,8 +164,63 @@ func WriteInstanceDetails(w io.Writer, instance *v1beta1.ServiceInstance) {
 		{"Class:", instance.Spec.GetSpecifiedClusterServiceClass()},
 		{"Plan:", instance.Spec.GetSpecifiedClusterServicePlan()},
 	})
+	appendInstanceBroker(instance.Status, t)
 	t.Render()
 
 	writeParameters(w, instance.Spec.Parameters)
 	writeParametersFrom(w, instance.Spec.ParametersFrom)
 }
+
+// WriteInstanceParametersDiff prints the parameters of an instance that
+// differ between its spec and what the broker last accepted.
+func WriteInstanceParametersDiff(w io.Writer, instance *v1beta1.ServiceInstance, diff *servicecatalog.InstanceParametersDiff) {
+	if !diff.Applied {
+		fmt.Fprintln(w, "The broker has not accepted any parameters of the instance yet.")
+	}
+	if diff.InProgress {
+		fmt.Fprintln(w, "An operation sending the parameters to the broker is in progress.")
+	}
+
+	if len(diff.Changes) == 0 {
+		fmt.Fprintln(w, "No pending parameter changes.")
+	} else {
+		t := NewListTable(w)
+		t.SetHeader([]string{
+			"Parameter",
+			"Spec",
+			"Broker",
+		})
+		for _, change := range diff.Changes {
+			t.Append([]string{
+				change.Name,
+				formatParameterValue(change.Spec),
+				formatParameterValue(change.Observed),
+			})
+		}
+		t.Render()
+	}
+
+	if len(instance.Spec.ParametersFrom) == 0 {
+		return
+	}
+	switch {
+	case diff.RedactedChanged == nil:
+		fmt.Fprintln(w, "The values of the parameters from parametersFrom sources were not compared.")
+	case *diff.RedactedChanged:
+		fmt.Fprintln(w, "The values of the parameters from parametersFrom sources changed.")
+	}
+}
+
+func formatParameterValue(value interface{}) string {
+	if value == nil {
+		return "-"
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
@@ -53,3 +53,35 @@ func Test_appendInstanceDashboardURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_appendInstanceBroker(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       v1beta1.ServiceInstanceStatus
+		expectedRows []string
+	}{
+		{"brokerOK", v1beta1.ServiceInstanceStatus{
+			BrokerName: "ups-broker",
+			BrokerURL:  "http://ups-broker.example.com",
+		}, []string{"Broker:", "ups-broker", "Broker URL:", "http://ups-broker.example.com"}},
+		{"brokerEmpty", v1beta1.ServiceInstanceStatus{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stringBuilder strings.Builder
+			table := NewDetailsTable(&stringBuilder)
+			appendInstanceBroker(tt.status, table)
+			table.Render()
+			actualString := strings.Trim(stringBuilder.String(), " \n")
+
+			if len(tt.expectedRows) == 0 && actualString != "" {
+				t.Fatalf("%v failed; expected no rows; got %q", tt.name, actualString)
+			}
+			for _, expected := range tt.expectedRows {
+				if !strings.Contains(actualString, expected) {
+					t.Fatalf("%v failed; expected %q in %q", tt.name, expected, actualString)
+				}
+			}
+		})
+	}
+}
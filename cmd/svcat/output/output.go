@@ -26,8 +26,9 @@ import (
 )
 
 const (
-	statusActive     = "Active"
-	statusDeprecated = "Deprecated"
+	statusActive      = "Active"
+	statusDeprecated  = "Deprecated"
+	statusUnavailable = "Unavailable"
 )
 
 const (
@@ -37,8 +38,20 @@ const (
 	// FormatTable is the --output flag value for tablular output.
 	FormatTable = "table"
 
+	// FormatWide is the --output flag value for tabular output with
+	// additional columns, for the commands that support it.
+	FormatWide = "wide"
+
 	// FormatYAML is the --output flag value for yaml output.
 	FormatYAML = "yaml"
+
+	// FormatJSONPath is the --output flag prefix for jsonpath template output,
+	// as in jsonpath={.metadata.name}.
+	FormatJSONPath = "jsonpath"
+
+	// FormatGoTemplate is the --output flag prefix for go-template output,
+	// as in go-template={{.metadata.name}}.
+	FormatGoTemplate = "go-template"
 )
 
 func formatStatusShort(condition string, conditionStatus v1beta1.ConditionStatus, reason string) string {
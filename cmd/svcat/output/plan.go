@@ -17,22 +17,39 @@ limitations under the License.
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
 )
 
-func getPlanStatusShort(status v1beta1.ClusterServicePlanStatus) string {
-	if status.RemovedFromBrokerCatalog {
+func getPlanStatusShort(plan *v1beta1.ClusterServicePlan) string {
+	if plan.Status.RemovedFromBrokerCatalog {
 		return statusDeprecated
 	}
+	if available, _ := plan.GetAvailability(); !available {
+		return statusUnavailable
+	}
 	return statusActive
 }
 
+// getPlanListName returns the external name of the plan, marked if a
+// platform admin has hidden the plan or the broker reports it as unavailable.
+func getPlanListName(plan servicecatalog.Plan) string {
+	if plan.IsHidden() {
+		return plan.GetExternalName() + " (hidden)"
+	}
+	if available, _ := plan.GetAvailability(); !available {
+		return plan.GetExternalName() + " (unavailable)"
+	}
+	return plan.GetExternalName()
+}
+
 // ByAge implements sort.Interface for []Person based on
 // the Age field.
 type byClass []servicecatalog.Plan
@@ -47,24 +64,96 @@ func (a byClass) Less(i, j int) bool {
 	return a[i].GetClassID() < a[j].GetClassID()
 }
 
+// formatPlanCosts returns the costs of the plan, such as "99 USD/MONTHLY",
+// separated by commas.
+func formatPlanCosts(plan servicecatalog.Plan) string {
+	var costs []string
+	for _, cost := range plan.GetCosts() {
+		amount := strconv.FormatFloat(cost.Amount, 'f', -1, 64) + " " + cost.Currency
+		if cost.Unit != "" {
+			amount += "/" + cost.Unit
+		}
+		costs = append(costs, amount)
+	}
+	return strings.Join(costs, ", ")
+}
+
+// havePlanCosts returns whether the broker published the costs of any of the
+// plans.
+func havePlanCosts(plans []servicecatalog.Plan) bool {
+	for _, plan := range plans {
+		if len(plan.GetCosts()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterPlanWithCosts is a cluster plan with its costs as a top level field.
+type clusterPlanWithCosts struct {
+	*v1beta1.ClusterServicePlan
+	Costs []v1beta1.PlanCost `json:"costs"`
+}
+
+// planWithCosts is a namespaced plan with its costs as a top level field.
+type planWithCosts struct {
+	*v1beta1.ServicePlan
+	Costs []v1beta1.PlanCost `json:"costs"`
+}
+
+// normalizePlans adds the costs of the plans that have any as a top level
+// costs field, for the JSON and YAML output.
+func normalizePlans(plans []servicecatalog.Plan) []interface{} {
+	normalized := make([]interface{}, 0, len(plans))
+	for _, plan := range plans {
+		normalized = append(normalized, normalizePlan(plan))
+	}
+	return normalized
+}
+
+func normalizePlan(plan servicecatalog.Plan) interface{} {
+	costs := plan.GetCosts()
+	if len(costs) == 0 {
+		return plan
+	}
+	switch p := plan.(type) {
+	case *v1beta1.ClusterServicePlan:
+		return clusterPlanWithCosts{ClusterServicePlan: p, Costs: costs}
+	case *v1beta1.ServicePlan:
+		return planWithCosts{ServicePlan: p, Costs: costs}
+	default:
+		return plan
+	}
+}
+
 func writePlanListTable(w io.Writer, plans []servicecatalog.Plan, classNames map[string]string) {
 
 	sort.Sort(byClass(plans))
 
-	t := NewListTable(w)
-	t.SetHeader([]string{
+	withCosts := havePlanCosts(plans)
+	header := []string{
 		"Name",
 		"Namespace",
 		"Class",
 		"Description",
-	})
+	}
+	if withCosts {
+		header = append(header, "Cost")
+	}
+
+	t := NewListTable(w)
+	t.SetHeader(header)
 	for _, plan := range plans {
-		t.Append([]string{
-			plan.GetExternalName(),
+		row := []string{
+			getPlanListName(plan),
 			plan.GetNamespace(),
 			classNames[plan.GetClassID()],
 			plan.GetDescription(),
-		})
+		}
+		if withCosts {
+			row = append(row, formatPlanCosts(plan))
+		}
+		t.Append(row)
 	}
 	t.SetVariableColumn(4)
 
@@ -77,11 +166,13 @@ func WritePlanList(w io.Writer, outputFormat string, plans []servicecatalog.Plan
 	for _, class := range classes {
 		classNames[class.GetName()] = class.GetExternalName()
 	}
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
-		writeJSON(w, plans)
+		writeJSON(w, normalizePlans(plans))
 	case FormatYAML:
-		writeYAML(w, plans, 0)
+		writeYAML(w, normalizePlans(plans), 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, plans)
 	case FormatTable:
 		writePlanListTable(w, plans, classNames)
 	}
@@ -90,11 +181,13 @@ func WritePlanList(w io.Writer, outputFormat string, plans []servicecatalog.Plan
 // WritePlan prints a single plan in the specified output format.
 func WritePlan(w io.Writer, outputFormat string, plan servicecatalog.Plan, class servicecatalog.Class) {
 
-	switch outputFormat {
+	switch formatName(outputFormat) {
 	case FormatJSON:
-		writeJSON(w, plan)
+		writeJSON(w, normalizePlan(plan))
 	case FormatYAML:
-		writeYAML(w, plan, 0)
+		writeYAML(w, normalizePlan(plan), 0)
+	case FormatJSONPath, FormatGoTemplate:
+		writeTemplate(w, outputFormat, plan)
 	case FormatTable:
 		classNames := map[string]string{}
 		classNames[class.GetName()] = class.GetExternalName()
@@ -117,7 +210,7 @@ func WriteAssociatedPlans(w io.Writer, plans []servicecatalog.Plan) {
 	})
 	for _, plan := range plans {
 		t.Append([]string{
-			plan.GetExternalName(),
+			getPlanListName(plan),
 			plan.GetDescription(),
 		})
 	}
@@ -131,7 +224,7 @@ func WriteParentPlan(w io.Writer, plan *v1beta1.ClusterServicePlan) {
 	t.AppendBulk([][]string{
 		{"Name:", plan.Spec.ExternalName},
 		{"Kubernetes Name:", string(plan.Name)},
-		{"Status:", getPlanStatusShort(plan.Status)},
+		{"Status:", getPlanStatusShort(plan)},
 	})
 	t.Render()
 }
@@ -148,6 +241,12 @@ func WritePlanDetails(w io.Writer, plan servicecatalog.Plan, class servicecatalo
 		{"Free:", strconv.FormatBool(plan.GetFree())},
 		{"Class:", class.GetExternalName()},
 	})
+	if costs := formatPlanCosts(plan); costs != "" {
+		t.Append([]string{"Cost:", costs})
+	}
+	if available, reason := plan.GetAvailability(); !available && reason != "" {
+		t.Append([]string{"Unavailable Reason:", reason})
+	}
 
 	t.Render()
 }
@@ -183,3 +282,76 @@ func WritePlanSchemas(w io.Writer, plan servicecatalog.Plan) {
 		writeYAML(w, bindingCreateSchema, 2)
 	}
 }
+
+// bindingResponseSchema is the subset of a JSON schema needed to describe the
+// credential keys returned by a broker.
+type bindingResponseSchema struct {
+	Properties map[string]struct {
+		Type        interface{} `json:"type"`
+		Description string      `json:"description"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+// WriteBindingResponseSchema prints the credential keys that a binding to an
+// instance of the plan is expected to return.
+func WriteBindingResponseSchema(w io.Writer, plan servicecatalog.Plan) {
+	fmt.Fprintln(w, "\nBinding Credentials:")
+	raw := plan.GetBindingResponseSchema()
+	if raw == nil {
+		fmt.Fprintln(w, "No binding response schema published by the broker")
+		return
+	}
+
+	var schema bindingResponseSchema
+	if err := json.Unmarshal(raw.Raw, &schema); err != nil || len(schema.Properties) == 0 {
+		writeYAML(w, raw, 2)
+		return
+	}
+
+	required := map[string]bool{}
+	for _, key := range schema.Required {
+		required[key] = true
+	}
+	keys := make([]string, 0, len(schema.Properties))
+	for key := range schema.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Key",
+		"Type",
+		"Required",
+		"Description",
+	})
+	for _, key := range keys {
+		property := schema.Properties[key]
+		t.Append([]string{
+			key,
+			schemaTypeString(property.Type),
+			strconv.FormatBool(required[key]),
+			property.Description,
+		})
+	}
+	t.SetVariableColumn(4)
+	t.Render()
+}
+
+// schemaTypeString formats a JSON schema type, which may be a single type or
+// a list of types.
+func schemaTypeString(schemaType interface{}) string {
+	switch t := schemaType.(type) {
+	case string:
+		return t
+	case []interface{}:
+		types := make([]string, 0, len(t))
+		for _, item := range t {
+			types = append(types, fmt.Sprint(item))
+		}
+		return strings.Join(types, ",")
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newTestPlanWithMetadata(name, metadata string) *v1beta1.ClusterServicePlan {
+	plan := &v1beta1.ClusterServicePlan{}
+	plan.Name = name
+	plan.Spec.ExternalName = name
+	if metadata != "" {
+		plan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(metadata)}
+	}
+	return plan
+}
+
+func Test_formatPlanCosts(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata string
+		expected string
+	}{
+		{"noMetadata", "", ""},
+		{"noCosts", `{"bullets": ["Shared server"]}`, ""},
+		{"malformedCosts", `{"costs": "free"}`, ""},
+		{"costs", `{"costs": [{"amount": {"usd": 99.0, "eur": 89.5}, "unit": "MONTHLY"}, {"amount": {"usd": 0.99}}]}`,
+			"89.5 EUR/MONTHLY, 99 USD/MONTHLY, 0.99 USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatPlanCosts(newTestPlanWithMetadata("default", tt.metadata))
+			if actual != tt.expected {
+				t.Fatalf("%v failed; expected %q; got %q", tt.name, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_writePlanListTableCostColumn(t *testing.T) {
+	free := newTestPlanWithMetadata("free", "")
+	paid := newTestPlanWithMetadata("paid", `{"costs": [{"amount": {"usd": 99.0}, "unit": "MONTHLY"}]}`)
+
+	var output bytes.Buffer
+	writePlanListTable(&output, []servicecatalog.Plan{free}, nil)
+	if strings.Contains(output.String(), "COST") {
+		t.Fatalf("expected no cost column without costs; got %q", output.String())
+	}
+
+	output.Reset()
+	writePlanListTable(&output, []servicecatalog.Plan{free, paid}, nil)
+	for _, expected := range []string{"COST", "99 USD/MONTHLY"} {
+		if !strings.Contains(output.String(), expected) {
+			t.Fatalf("expected %q in the table; got %q", expected, output.String())
+		}
+	}
+}
+
+func Test_normalizePlan(t *testing.T) {
+	free := newTestPlanWithMetadata("free", "")
+	if normalizePlan(free) != servicecatalog.Plan(free) {
+		t.Fatal("expected a plan without costs to be left as is")
+	}
+
+	paid := newTestPlanWithMetadata("paid", `{"costs": [{"amount": {"usd": 99.0}, "unit": "MONTHLY"}]}`)
+	var output bytes.Buffer
+	writeJSON(&output, normalizePlan(paid))
+
+	var normalized struct {
+		Spec  v1beta1.ClusterServicePlanSpec `json:"spec"`
+		Costs []v1beta1.PlanCost             `json:"costs"`
+	}
+	if err := json.Unmarshal(output.Bytes(), &normalized); err != nil {
+		t.Fatalf("failed to unmarshal %q: %v", output.String(), err)
+	}
+	if normalized.Spec.ExternalName != "paid" {
+		t.Fatalf("expected the fields of the plan to be kept; got %q", output.String())
+	}
+	expected := v1beta1.PlanCost{Amount: 99, Currency: "USD", Unit: "MONTHLY"}
+	if len(normalized.Costs) != 1 || normalized.Costs[0] != expected {
+		t.Fatalf("expected costs %+v; got %+v", expected, normalized.Costs)
+	}
+}
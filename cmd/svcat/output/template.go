@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// SplitFormat splits an --output flag value into the format name and, for
+// the template formats, the template that follows the "=", e.g.
+// jsonpath={.metadata.name} yields "jsonpath" and "{.metadata.name}".
+func SplitFormat(outputFormat string) (string, string) {
+	if i := strings.Index(outputFormat, "="); i >= 0 {
+		return outputFormat[:i], outputFormat[i+1:]
+	}
+	return outputFormat, ""
+}
+
+// formatName returns the format name of an --output flag value.
+func formatName(outputFormat string) string {
+	name, _ := SplitFormat(outputFormat)
+	return name
+}
+
+// ValidateTemplate checks that the template given with a jsonpath or
+// go-template --output flag value parses.
+func ValidateTemplate(outputFormat string) error {
+	name, tmpl := SplitFormat(outputFormat)
+	if tmpl == "" {
+		return fmt.Errorf("a template must be specified with --output %s, e.g. %s=...", name, name)
+	}
+	switch name {
+	case FormatJSONPath:
+		return jsonpath.New("output").Parse(tmpl)
+	case FormatGoTemplate:
+		_, err := template.New("output").Parse(tmpl)
+		return err
+	default:
+		return fmt.Errorf("%q is not a template format", name)
+	}
+}
+
+// writeTemplate writes obj to w using the jsonpath or go-template template
+// given in the --output flag value. As with kubectl, templates refer to
+// fields by their JSON names.
+func writeTemplate(w io.Writer, outputFormat string, obj interface{}) {
+	name, tmpl := SplitFormat(outputFormat)
+
+	data, err := toJSONData(obj)
+	if err != nil {
+		fmt.Fprintf(w, "err marshaling json: %v\n", err)
+		return
+	}
+
+	switch name {
+	case FormatJSONPath:
+		jp := jsonpath.New("output").AllowMissingKeys(true)
+		if err := jp.Parse(tmpl); err != nil {
+			fmt.Fprintf(w, "err parsing jsonpath template: %v\n", err)
+			return
+		}
+		if err := jp.Execute(w, data); err != nil {
+			fmt.Fprintf(w, "err executing jsonpath template: %v\n", err)
+		}
+	case FormatGoTemplate:
+		t, err := template.New("output").Parse(tmpl)
+		if err != nil {
+			fmt.Fprintf(w, "err parsing go-template: %v\n", err)
+			return
+		}
+		if err := t.Execute(w, data); err != nil {
+			fmt.Fprintf(w, "err executing go-template: %v\n", err)
+		}
+	}
+}
+
+// toJSONData round-trips obj through JSON so that templates see the same
+// field names as the json output format.
+func toJSONData(obj interface{}) (interface{}, error) {
+	j, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	if err := json.Unmarshal(j, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
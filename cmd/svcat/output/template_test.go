@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	_ "github.com/drycc-addons/service-catalog/internal/test"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWriteTemplate(t *testing.T) {
+	instance := v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "myinstance", Namespace: "ns"},
+		Spec: v1beta1.ServiceInstanceSpec{
+			PlanReference: v1beta1.PlanReference{ClusterServicePlanExternalName: "default"},
+		},
+	}
+	instanceList := &v1beta1.ServiceInstanceList{
+		Items: []v1beta1.ServiceInstance{instance, instance},
+	}
+
+	testcases := []struct {
+		name         string
+		outputFormat string
+		obj          interface{}
+		output       string
+	}{
+		{"jsonpath field", "jsonpath={.metadata.name}", instance, "myinstance"},
+		{"jsonpath list", "jsonpath={.items[*].metadata.namespace}", instanceList, "ns ns"},
+		{"jsonpath missing key", "jsonpath={.status.dashboardURL}", instance, ""},
+		{"go-template field", "go-template={{.spec.clusterServicePlanExternalName}}", instance, "default"},
+		{"go-template range", "go-template={{range .items}}{{.metadata.name}} {{end}}", instanceList, "myinstance myinstance "},
+	}
+
+	for _, tc := range testcases {
+		output := &bytes.Buffer{}
+		writeTemplate(output, tc.outputFormat, tc.obj)
+		if tc.output != output.String() {
+			t.Errorf("%v: Output mismatch: expected \"%v\", actual \"%v\"", tc.name, tc.output, output.String())
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	testcases := []struct {
+		name         string
+		outputFormat string
+		wantErr      bool
+	}{
+		{"valid jsonpath", "jsonpath={.metadata.name}", false},
+		{"valid go-template", "go-template={{.metadata.name}}", false},
+		{"missing template", "jsonpath=", true},
+		{"invalid jsonpath", "jsonpath={.metadata.name", true},
+		{"invalid go-template", "go-template={{.metadata.name", true},
+		{"not a template format", "json=foo", true},
+	}
+
+	for _, tc := range testcases {
+		err := ValidateTemplate(tc.outputFormat)
+		if tc.wantErr && err == nil {
+			t.Errorf("%v: expected an error", tc.name)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.name, err)
+		}
+	}
+}
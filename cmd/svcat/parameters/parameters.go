@@ -19,12 +19,21 @@ package parameters
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"regexp"
 	"strings"
+
+	"sigs.k8s.io/yaml"
 )
 
+// StdinFile is the file name that ParseVariableFile reads from stdin.
+const StdinFile = "-"
+
 var keymapRegex = regexp.MustCompile(`^([^\[]+)\[(.+)\]\s*$`)
 
+var envVarRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
 // ParseVariableJSON converts a JSON object into a map of keys and values
 // Example:
 // `{ "location" : "east", "group" : "demo" }' becomes map[location:east group:demo]
@@ -37,6 +46,88 @@ func ParseVariableJSON(params string) (map[string]interface{}, error) {
 	return p, nil
 }
 
+// ParseVariableFile converts a JSON or YAML object read from the named
+// file, or from stdin when the name is "-", into a map of keys and values
+func ParseVariableFile(name string, stdin io.Reader) (map[string]interface{}, error) {
+	var data []byte
+	var err error
+	if name == StdinFile {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parameters (%s)", err)
+	}
+
+	p := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters (%s)", err)
+	}
+	return p, nil
+}
+
+// ExpandEnv replaces ${VAR} in the string values of params, at any depth,
+// with the value of the environment variable VAR, as returned by lookupEnv.
+// A variable that is not set is an error, so that a parameter is not sent
+// empty by mistake.
+// Example:
+// map[password:${DB_PASSWORD}] becomes map[password:s3cr3t] when DB_PASSWORD=s3cr3t
+func ExpandEnv(params map[string]interface{}, lookupEnv func(string) (string, bool)) error {
+	for key, value := range params {
+		expanded, err := expandEnvValue(value, lookupEnv)
+		if err != nil {
+			return fmt.Errorf("invalid parameter (%s), %s", key, err)
+		}
+		params[key] = expanded
+	}
+	return nil
+}
+
+func expandEnvValue(value interface{}, lookupEnv func(string) (string, bool)) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		var missing string
+		expanded := envVarRegex.ReplaceAllStringFunc(v, func(ref string) string {
+			name := envVarRegex.FindStringSubmatch(ref)[1]
+			env, ok := lookupEnv(name)
+			if !ok && missing == "" {
+				missing = name
+			}
+			return env
+		})
+		if missing != "" {
+			return nil, fmt.Errorf("environment variable %s is not set", missing)
+		}
+		return expanded, nil
+	case []string:
+		for i := range v {
+			expanded, err := expandEnvValue(v[i], lookupEnv)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = expanded.(string)
+		}
+	case []interface{}:
+		for i := range v {
+			expanded, err := expandEnvValue(v[i], lookupEnv)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = expanded
+		}
+	case map[string]interface{}:
+		for key := range v {
+			expanded, err := expandEnvValue(v[key], lookupEnv)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = expanded
+		}
+	}
+	return value, nil
+}
+
 // ParseVariableAssignments converts a string array of variable assignments
 // into a map of keys and values
 // Example:
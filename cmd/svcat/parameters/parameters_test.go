@@ -18,6 +18,7 @@ package parameters
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	_ "github.com/drycc-addons/service-catalog/internal/test"
@@ -78,6 +79,81 @@ func TestParseVariableAssignments_OneVariableThreeValues(t *testing.T) {
 	}
 }
 
+func TestParseVariableFile_Stdin(t *testing.T) {
+	testcases := []struct {
+		Name, Raw string
+	}{
+		{"json", `{"name": "db", "replicas": 2, "zones": ["a", "b"]}`},
+		{"yaml", "name: db\nreplicas: 2\nzones:\n- a\n- b\n"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := ParseVariableFile(StdinFile, strings.NewReader(tc.Raw))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := map[string]interface{}{
+				"name":     "db",
+				"replicas": float64(2),
+				"zones":    []interface{}{"a", "b"},
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Fatalf("%s\nexpected:\n\t%v\ngot:\n\t%v\n", tc.Raw, want, got)
+			}
+		})
+	}
+}
+
+func TestParseVariableFile_NotAnObject(t *testing.T) {
+	_, err := ParseVariableFile(StdinFile, strings.NewReader("- a\n- b\n"))
+	if err == nil {
+		t.Fatal("should have failed due to parameters that are not an object")
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	env := map[string]string{"DB_USER": "admin", "DB_PASSWORD": "s3cr3t"}
+	lookupEnv := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	params := map[string]interface{}{
+		"url":      "mysql://${DB_USER}:${DB_PASSWORD}@db",
+		"replicas": float64(2),
+		"users":    []interface{}{map[string]interface{}{"name": "${DB_USER}"}},
+		"tags":     []string{"$DB_USER", "${DB_USER}"},
+	}
+	if err := ExpandEnv(params, lookupEnv); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"url":      "mysql://admin:s3cr3t@db",
+		"replicas": float64(2),
+		"users":    []interface{}{map[string]interface{}{"name": "admin"}},
+		"tags":     []string{"$DB_USER", "admin"},
+	}
+	if !reflect.DeepEqual(want, params) {
+		t.Fatalf("expected:\n\t%v\ngot:\n\t%v\n", want, params)
+	}
+}
+
+func TestExpandEnv_UnsetVariable(t *testing.T) {
+	params := map[string]interface{}{"password": "${DB_PASSWORD}"}
+	lookupEnv := func(string) (string, bool) { return "", false }
+
+	err := ExpandEnv(params, lookupEnv)
+	if err == nil {
+		t.Fatal("should have failed due to an unset environment variable")
+	}
+	if !strings.Contains(err.Error(), "DB_PASSWORD") {
+		t.Fatalf("expected the error to name the unset variable, got %v", err)
+	}
+}
+
 func TestParseKeyMaps(t *testing.T) {
 	testcases := []struct {
 		Name, Raw, MapName, Key string
@@ -30,9 +30,11 @@ import (
 // plan
 type DescribeCmd struct {
 	*command.Namespaced
+	*command.Formatted
 	*command.Scoped
 	LookupByKubeName bool
 	ShowSchemas      bool
+	BindingSchema    bool
 	KubeName         string
 	Name             string
 }
@@ -41,6 +43,7 @@ type DescribeCmd struct {
 func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 	describeCmd := &DescribeCmd{
 		Namespaced: command.NewNamespaced(cxt),
+		Formatted:  command.NewFormatted(),
 		Scoped:     command.NewScoped(),
 	}
 	cmd := &cobra.Command{
@@ -52,6 +55,7 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
   svcat describe plan --kube-name 08e4b43a-36bc-447e-a81f-8202b13e339c
   svcat describe plan PLAN_NAME --scope cluster
   svcat describe plan PLAN_NAME --scope namespace --namespace NAMESPACE_NAME
+  svcat describe plan PLAN_NAME --binding-schema
 `),
 		PreRunE: command.PreRunE(describeCmd),
 		RunE:    command.RunE(describeCmd),
@@ -70,8 +74,16 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 		true,
 		"Whether or not to show instance and binding parameter schemas",
 	)
+	cmd.Flags().BoolVarP(
+		&describeCmd.BindingSchema,
+		"binding-schema",
+		"",
+		false,
+		"Whether or not to show the credential keys returned when binding to an instance of the plan",
+	)
 	describeCmd.AddNamespaceFlags(cmd.Flags(), false)
 	describeCmd.AddScopedFlags(cmd.Flags(), false)
+	describeCmd.AddOutputFlags(cmd.Flags())
 	return cmd
 }
 
@@ -123,6 +135,11 @@ func (c *DescribeCmd) Run() error {
 		return err
 	}
 
+	if c.OutputFormat != output.FormatTable {
+		output.WritePlan(c.Output, c.OutputFormat, plan, class)
+		return nil
+	}
+
 	output.WritePlanDetails(c.Output, plan, class)
 
 	output.WriteDefaultProvisionParameters(c.Output, plan)
@@ -137,5 +154,9 @@ func (c *DescribeCmd) Run() error {
 		output.WritePlanSchemas(c.Output, plan)
 	}
 
+	if c.BindingSchema {
+		output.WriteBindingResponseSchema(c.Output, plan)
+	}
+
 	return nil
 }
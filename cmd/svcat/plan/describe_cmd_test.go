@@ -30,6 +30,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var _ = Describe("Describe Command", func() {
@@ -54,6 +55,10 @@ var _ = Describe("Describe Command", func() {
 			Expect(showSchemaFlag).NotTo(BeNil())
 			Expect(showSchemaFlag.Usage).To(ContainSubstring("Whether or not to show instance and binding parameter schemas"))
 
+			bindingSchemaFlag := cmd.Flags().Lookup("binding-schema")
+			Expect(bindingSchemaFlag).NotTo(BeNil())
+			Expect(bindingSchemaFlag.Usage).To(ContainSubstring("Whether or not to show the credential keys returned when binding to an instance of the plan"))
+
 			scopeFlag := cmd.Flags().Lookup("scope")
 			Expect(scopeFlag).NotTo(BeNil())
 			Expect(scopeFlag.Usage).To(ContainSubstring("Limit the command to a particular scope: cluster or namespace"))
@@ -92,6 +97,7 @@ var _ = Describe("Describe Command", func() {
 
 			cmd = &DescribeCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
 				Scoped:     command.NewScoped(),
 			}
 
@@ -164,11 +170,49 @@ var _ = Describe("Describe Command", func() {
 			Expect(output).To(ContainSubstring(clusterServicePlan.Spec.ExternalName))
 			Expect(output).To(ContainSubstring(clusterServiceClass.Spec.ExternalName))
 		})
+		It("Prints the credential keys from the binding response schema", func() {
+			clusterServicePlan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(`{
+				"bindingResponseSchema": {
+					"type": "object",
+					"properties": {
+						"uri": {"type": "string", "description": "Connection URI"},
+						"port": {"type": "integer"}
+					},
+					"required": ["uri"]
+				}
+			}`)}
+			fakeSDK.RetrievePlanByNameReturns(clusterServicePlan, nil)
+			fakeSDK.RetrieveClassByPlanReturns(clusterServiceClass, nil)
+
+			cmd.Scope = servicecatalog.ClusterScope
+			cmd.Name = clusterServicePlan.Spec.ExternalName
+			cmd.BindingSchema = true
+			err := cmd.Run()
+
+			Expect(err).NotTo(HaveOccurred())
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("Binding Credentials:"))
+			Expect(output).To(MatchRegexp(`uri\s+string\s+true\s+Connection URI`))
+			Expect(output).To(MatchRegexp(`port\s+integer\s+false`))
+		})
+		It("Reports when the broker does not publish a binding response schema", func() {
+			fakeSDK.RetrievePlanByNameReturns(clusterServicePlan, nil)
+			fakeSDK.RetrieveClassByPlanReturns(clusterServiceClass, nil)
+
+			cmd.Scope = servicecatalog.ClusterScope
+			cmd.Name = clusterServicePlan.Spec.ExternalName
+			cmd.BindingSchema = true
+			err := cmd.Run()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputBuffer.String()).To(ContainSubstring("No binding response schema published by the broker"))
+		})
 		It("Calls the pkg/svcat libs RetrievePlanByName with namespace scope options", func() {
 			fakeSDK.RetrievePlanByNameReturns(defaultServicePlan, nil)
 			fakeSDK.RetrieveClassByPlanReturns(defaultServiceClass, nil)
 			cmd := DescribeCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
 				Scoped:     command.NewScoped(),
 			}
 			cmd.Scope = servicecatalog.NamespaceScope
@@ -194,6 +238,7 @@ var _ = Describe("Describe Command", func() {
 			fakeSDK.RetrieveClassByPlanReturns(clusterServiceClass, nil)
 			cmd := DescribeCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
 				Scoped:     command.NewScoped(),
 			}
 			cmd.Scope = servicecatalog.ClusterScope
@@ -247,6 +292,7 @@ var _ = Describe("Describe Command", func() {
 			fakeApp.SvcatClient = fakeSDK
 			cmd := DescribeCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
 				Scoped:     command.NewScoped(),
 			}
 			cmd.Scope = servicecatalog.NamespaceScope
@@ -299,6 +345,7 @@ var _ = Describe("Describe Command", func() {
 			fakeApp.SvcatClient = fakeSDK
 			cmd := DescribeCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
 				Scoped:     command.NewScoped(),
 			}
 			cmd.Scope = servicecatalog.ClusterScope
@@ -351,6 +398,7 @@ var _ = Describe("Describe Command", func() {
 			fakeApp.SvcatClient = fakeSDK
 			cmd := DescribeCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Formatted:  command.NewFormatted(),
 				Scoped:     command.NewScoped(),
 			}
 			cmd.Scope = servicecatalog.NamespaceScope
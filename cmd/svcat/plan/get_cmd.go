@@ -38,6 +38,8 @@ type GetCmd struct {
 	ClassFilter   string
 	ClassKubeName string
 	ClassName     string
+
+	ShowHidden bool
 }
 
 // NewGetCmd builds a "svcat get plans" command
@@ -61,6 +63,7 @@ func NewGetCmd(ctx *command.Context) *cobra.Command {
   svcat get plans --class CLASS_NAME
   svcat get plan --class CLASS_NAME PLAN_NAME
   svcat get plans --kube-name --class CLASS_KUBE_NAME
+  svcat get plans --show-hidden
   svcat get plan --kube-name --class CLASS_KUBE_NAME PLAN_KUBE_NAME
 `),
 		PreRunE: command.PreRunE(getCmd),
@@ -80,6 +83,12 @@ func NewGetCmd(ctx *command.Context) *cobra.Command {
 		"",
 		"Filter plans based on class. When --kube-name is specified, the class name is interpreted as a kubernetes name.",
 	)
+	cmd.Flags().BoolVar(
+		&getCmd.ShowHidden,
+		"show-hidden",
+		false,
+		"Whether or not to list the plans hidden by a platform admin, and the plans of the hidden classes",
+	)
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
 	getCmd.AddScopedFlags(cmd.Flags(), true)
@@ -165,6 +174,9 @@ func (c *GetCmd) getAll() error {
 	if err != nil {
 		return fmt.Errorf("unable to list plans (%s)", err)
 	}
+	if !c.ShowHidden {
+		plans = visiblePlans(plans, classes)
+	}
 	output.WritePlanList(c.Output, c.OutputFormat, plans, classes)
 	return nil
 }
@@ -202,3 +214,21 @@ func (c *GetCmd) get() error {
 
 	return nil
 }
+
+// visiblePlans returns the plans that a platform admin has hidden neither
+// directly nor through their class.
+func visiblePlans(plans []servicecatalog.Plan, classes []servicecatalog.Class) []servicecatalog.Plan {
+	hiddenClasses := map[string]bool{}
+	for _, class := range classes {
+		if class.IsHidden() {
+			hiddenClasses[class.GetName()] = true
+		}
+	}
+	var visible []servicecatalog.Plan
+	for _, plan := range servicecatalog.VisiblePlans(plans) {
+		if !hiddenClasses[plan.GetClassID()] {
+			visible = append(visible, plan)
+		}
+	}
+	return visible
+}
@@ -194,6 +194,7 @@ func TestCommandOutput(t *testing.T) {
 		continueOnError bool   // Should the test stop immediately if the command fails or continue and capture the console output
 	}{
 		{name: "list all brokers", cmd: "get brokers", golden: "output/get-brokers.txt"},
+		{name: "list all brokers (wide)", cmd: "get brokers -o wide", golden: "output/get-brokers-wide.txt"},
 		{name: "list all brokers (json)", cmd: "get brokers -o json", golden: "output/get-brokers.json"},
 		{name: "list all brokers (yaml)", cmd: "get brokers -o yaml", golden: "output/get-brokers.yaml"},
 		{name: "get cluster scoped broker", cmd: "get broker ups-broker --scope cluster", golden: "output/get-broker.txt"},
@@ -232,6 +233,9 @@ func TestCommandOutput(t *testing.T) {
 		{name: "get plan by name", cmd: "get plan --scope cluster default", golden: "output/get-plan.txt"},
 		{name: "get plan by name (json)", cmd: "get plan --scope cluster default -o json", golden: "output/get-plan.json"},
 		{name: "get plan by name (yaml)", cmd: "get plan --scope cluster default -o yaml", golden: "output/get-plan.yaml"},
+		{name: "get plan with costs", cmd: "get plan --scope cluster premium", golden: "output/get-plan-with-costs.txt"},
+		{name: "get plan with costs (json)", cmd: "get plan --scope cluster premium -o json", golden: "output/get-plan-with-costs.json"},
+		{name: "get plan with costs (yaml)", cmd: "get plan --scope cluster premium -o yaml", golden: "output/get-plan-with-costs.yaml"},
 		{name: "get plan by Kubernetes name", cmd: "get plan --scope cluster --kube-name 86064792-7ea2-467b-af93-ac9694d96d52", golden: "output/get-plan.txt"},
 		{name: "get plan by class/plan name combo", cmd: "get plan --scope cluster user-provided-service/default", golden: "output/get-plan.txt"},
 		{name: "get plan by class name", cmd: "get plan --scope cluster --class user-provided-service", golden: "output/get-plans-by-class.txt"},
@@ -255,6 +259,8 @@ func TestCommandOutput(t *testing.T) {
 		{name: "list all instances filtered by existing class", cmd: "get instances --all-namespaces --class user-provided-service", golden: "output/get-instances-all-namespaces-by-class.txt"},
 		{name: "list all instances filtered by not existing class", cmd: "get instances --all-namespaces --class wrong", golden: "output/get-instances-all-namespaces-by-wrong-class.txt"},
 		{name: "list all instances", cmd: "get instances --all-namespaces", golden: "output/get-instances-all-namespaces.txt"},
+		{name: "list failed instances", cmd: "get instances --failed", golden: "output/get-instances-all-namespaces-by-wrong-plan.txt"},
+		{name: "list pending instances", cmd: "get instances --pending", golden: "output/get-instances-all-namespaces-by-wrong-plan.txt"},
 		{name: "get instance", cmd: "get instance ups-instance -n test-ns", golden: "output/get-instance.txt"},
 		{name: "get instance (json)", cmd: "get instance ups-instance -n test-ns -o json", golden: "output/get-instance.json"},
 		{name: "get instance (yaml)", cmd: "get instance ups-instance -n test-ns -o yaml", golden: "output/get-instance.yaml"},
@@ -38,6 +38,12 @@ type WebhookServerOptions struct {
 	ReleaseName                  string
 	HealthzServerBindPort        int
 	ControllerManagerMetricsPort int
+	// MaxParametersSize is the maximum size, in bytes, of the parameters
+	// of ServiceInstances and ServiceBindings; 0 means no limit.
+	MaxParametersSize int64
+	// MaxParametersFromSize is the maximum size, in bytes, of the value of
+	// each Secret key referenced by their parametersFrom; 0 means no limit.
+	MaxParametersFromSize int64
 }
 
 // NewWebhookServerOptions creates a new WebhookServerOptions with a default settings.
@@ -57,6 +63,8 @@ func NewWebhookServerOptions() *WebhookServerOptions {
 func (s *WebhookServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&s.HealthzServerBindPort, "healthz-server-bind-port", defaultHealthzServerPort, "The port on which to serve HTTP  /healthz endpoint")
 	fs.IntVar(&s.ControllerManagerMetricsPort, "controller-manager-metrics-bind-port", defaultControllerManagerMetricsPort, "The address the metric endpoint binds to")
+	fs.Int64Var(&s.MaxParametersSize, "max-parameters-size", 0, "The maximum size in bytes of the spec.parameters of ServiceInstances and ServiceBindings. 0 means no limit")
+	fs.Int64Var(&s.MaxParametersFromSize, "max-parameters-from-size", 0, "The maximum size in bytes of the value of each Secret key referenced by the spec.parametersFrom of ServiceInstances and ServiceBindings. 0 means no limit")
 
 	s.SecureServingOptions.AddFlags(fs)
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
@@ -71,6 +79,12 @@ func (s *WebhookServerOptions) Validate() error {
 	if s.SecureServingOptions.BindPort == s.HealthzServerBindPort {
 		errors = append(errors, fmt.Errorf("validation erorr: --secure-port and --healthz-server-bind-port MUST have different values"))
 	}
+	if s.MaxParametersSize < 0 {
+		errors = append(errors, fmt.Errorf("validation error: --max-parameters-size must not be negative"))
+	}
+	if s.MaxParametersFromSize < 0 {
+		errors = append(errors, fmt.Errorf("validation error: --max-parameters-from-size must not be negative"))
+	}
 
 	return utilerrors.NewAggregate(errors)
 }
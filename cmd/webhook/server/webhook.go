@@ -28,6 +28,7 @@ import (
 	csbmutation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/clusterservicebroker/mutation"
 	cscmutation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/clusterserviceclass/mutation"
 	cspmutation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/clusterserviceplan/mutation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
 
 	sbmutation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/servicebinding/mutation"
 	brmutation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/servicebroker/mutation"
@@ -44,9 +45,11 @@ import (
 	sivalidation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
 	spvalidation "github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceplan/validation"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apiserver/pkg/server/healthz"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -103,6 +106,11 @@ func run(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
 		Metrics: metricsserver.Options{
 			BindAddress: fmt.Sprintf(":%d", opts.ControllerManagerMetricsPort),
 		},
+		// The Secrets referenced by parametersFrom are only read to check
+		// their size, which does not justify caching every Secret.
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}}},
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("while set up overall controller manager for webhook server: %w", err)
@@ -120,6 +128,11 @@ func run(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
 		CertDir: opts.SecureServingOptions.ServerCert.CertDirectory,
 	})
 
+	parametersSizeLimits := webhookutil.ParametersSizeLimits{
+		MaxParametersSize:     opts.MaxParametersSize,
+		MaxParametersFromSize: opts.MaxParametersFromSize,
+	}
+
 	webhooks := map[string]admission.Handler{
 		"/mutating-clusterservicebrokers": &csbmutation.CreateUpdateHandler{},
 		"/mutating-clusterserviceclasses": &cscmutation.CreateUpdateHandler{},
@@ -136,13 +149,13 @@ func run(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
 		"/validating-clusterserviceclasses":        cscvalidation.NewSpecValidationHandler(),
 		"/validating-clusterserviceplans":          cspvalidation.NewSpecValidationHandler(),
 
-		"/validating-servicebindings":        sbvalidation.NewSpecValidationHandler(),
+		"/validating-servicebindings":        sbvalidation.NewSpecValidationHandler(parametersSizeLimits),
 		"/validating-servicebindings/status": &sbvalidation.StatusValidationHandler{},
 		"/validating-servicebrokers":         sbrvalidation.NewSpecValidationHandler(),
 		"/validating-servicebrokers/status":  &sbrvalidation.StatusValidationHandler{},
 		"/validating-serviceclasses":         scvalidation.NewSpecValidationHandler(),
 		"/validating-serviceplans":           spvalidation.NewSpecValidationHandler(),
-		"/validating-serviceinstances":       sivalidation.NewSpecValidationHandler(),
+		"/validating-serviceinstances":       sivalidation.NewSpecValidationHandler(parametersSizeLimits),
 	}
 
 	for path, handler := range webhooks {
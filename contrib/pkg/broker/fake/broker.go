@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-process broker that serves the Open Service
+// Broker API over HTTP. Unlike the fakeosb client, requests go through a real
+// HTTP server, and the latency and failures of each operation can be
+// programmed, so that manifests and controllers can be tested against
+// realistic broker behavior.
+package fake
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/contrib/pkg/broker/controller"
+	"github.com/drycc-addons/service-catalog/contrib/pkg/broker/server"
+	"github.com/drycc-addons/service-catalog/contrib/pkg/brokerapi"
+)
+
+// Operation is an OSB API operation served by the broker.
+type Operation string
+
+const (
+	// OperationCatalog fetches the catalog.
+	OperationCatalog Operation = "catalog"
+	// OperationProvision provisions an instance.
+	OperationProvision Operation = "provision"
+	// OperationUpdate updates an instance.
+	OperationUpdate Operation = "update"
+	// OperationDeprovision deprovisions an instance.
+	OperationDeprovision Operation = "deprovision"
+	// OperationLastOperation polls the state of an asynchronous operation.
+	OperationLastOperation Operation = "last_operation"
+	// OperationBind creates a binding.
+	OperationBind Operation = "bind"
+	// OperationUnbind deletes a binding.
+	OperationUnbind Operation = "unbind"
+)
+
+// FailAlways makes every request for an operation fail when used as a
+// Behavior's FailTimes.
+const FailAlways = math.MaxInt32
+
+// Behavior programs how the broker responds to an operation.
+type Behavior struct {
+	// Latency delays every response to the operation.
+	Latency time.Duration
+	// FailTimes is the number of requests for the operation that fail
+	// before it starts succeeding.
+	FailTimes int
+	// HTTPStatus is the status code returned by failed requests. It
+	// defaults to 500.
+	HTTPStatus int
+	// Async makes provision, update and deprovision requests asynchronous.
+	Async bool
+	// PollsUntilDone is the number of last_operation requests an
+	// asynchronous operation stays in progress for.
+	PollsUntilDone int
+}
+
+type fakeInstance struct {
+	serviceID string
+	planID    string
+	bindings  map[string]bool
+	// operation is the asynchronous operation in progress, if any, and
+	// polls the number of times it has been polled.
+	operation Operation
+	polls     int
+}
+
+// Broker is an in-process OSB broker. Its zero value is not usable; create
+// one with NewBroker. It is safe for concurrent use.
+type Broker struct {
+	mu          sync.Mutex
+	catalog     *brokerapi.Catalog
+	credentials brokerapi.Credential
+	behaviors   map[Operation]Behavior
+	calls       map[Operation]int
+	instances   map[string]*fakeInstance
+	server      *httptest.Server
+}
+
+var _ controller.Controller = &Broker{}
+
+// NewBroker creates a broker serving the given catalog. Every operation
+// succeeds immediately until programmed otherwise with SetBehavior.
+func NewBroker(catalog *brokerapi.Catalog) *Broker {
+	return &Broker{
+		catalog:     catalog,
+		credentials: brokerapi.Credential{},
+		behaviors:   make(map[Operation]Behavior),
+		calls:       make(map[Operation]int),
+		instances:   make(map[string]*fakeInstance),
+	}
+}
+
+// Start starts the broker listening on a random local port, passing back
+// its URL.
+func (b *Broker) Start() string {
+	b.server = httptest.NewServer(server.CreateHandler(b))
+	return b.server.URL
+}
+
+// Close shuts down the broker.
+func (b *Broker) Close() {
+	b.server.Close()
+}
+
+// SetBehavior programs how the broker responds to op. It resets the number
+// of requests counted for op.
+func (b *Broker) SetBehavior(op Operation, behavior Behavior) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.behaviors[op] = behavior
+	b.calls[op] = 0
+}
+
+// SetCredentials sets the credentials returned by bind requests.
+func (b *Broker) SetCredentials(credentials brokerapi.Credential) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.credentials = credentials
+}
+
+// Calls returns the number of requests the broker received for op.
+func (b *Broker) Calls(op Operation) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.calls[op]
+}
+
+// HasInstance returns whether the instance with the given ID is provisioned.
+func (b *Broker) HasInstance(instanceID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.instances[instanceID]
+	return ok
+}
+
+// HasBinding returns whether the binding with the given ID exists.
+func (b *Broker) HasBinding(instanceID, bindingID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	instance, ok := b.instances[instanceID]
+	return ok && instance.bindings[bindingID]
+}
+
+// begin counts a request for op and waits for the operation's latency. It
+// returns the operation's behavior and, if the request is programmed to
+// fail, the error to respond with.
+func (b *Broker) begin(op Operation) (Behavior, error) {
+	b.mu.Lock()
+	b.calls[op]++
+	calls := b.calls[op]
+	behavior := b.behaviors[op]
+	b.mu.Unlock()
+
+	time.Sleep(behavior.Latency)
+	if calls <= behavior.FailTimes {
+		status := behavior.HTTPStatus
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		return behavior, server.NewErrorWithHTTPStatus(fmt.Sprintf("%s request %d failed as programmed", op, calls), status)
+	}
+	return behavior, nil
+}
+
+// Catalog returns the broker's catalog.
+func (b *Broker) Catalog() (*brokerapi.Catalog, error) {
+	if _, err := b.begin(OperationCatalog); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.catalog, nil
+}
+
+// GetServiceInstanceLastOperation reports the state of the instance's
+// asynchronous operation, which succeeds once it has been polled the
+// programmed number of times.
+func (b *Broker) GetServiceInstanceLastOperation(instanceID, serviceID, planID, operation string) (*brokerapi.LastOperationResponse, error) {
+	if _, err := b.begin(OperationLastOperation); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	instance, ok := b.instances[instanceID]
+	if !ok {
+		return nil, server.NewErrorWithHTTPStatus(fmt.Sprintf("no such instance %s", instanceID), http.StatusGone)
+	}
+	if instance.operation == "" {
+		return &brokerapi.LastOperationResponse{State: brokerapi.StateSucceeded}, nil
+	}
+
+	instance.polls++
+	if instance.polls <= b.behaviors[instance.operation].PollsUntilDone {
+		return &brokerapi.LastOperationResponse{State: brokerapi.StateInProgress}, nil
+	}
+	if instance.operation == OperationDeprovision {
+		delete(b.instances, instanceID)
+	}
+	instance.operation = ""
+	return &brokerapi.LastOperationResponse{State: brokerapi.StateSucceeded}, nil
+}
+
+// CreateServiceInstance provisions an instance.
+func (b *Broker) CreateServiceInstance(instanceID string, req *brokerapi.CreateServiceInstanceRequest) (*brokerapi.CreateServiceInstanceResponse, error) {
+	behavior, err := b.begin(OperationProvision)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if instance, ok := b.instances[instanceID]; ok {
+		if instance.serviceID != req.ServiceID || instance.planID != req.PlanID {
+			return nil, server.NewErrorWithHTTPStatus(fmt.Sprintf("instance %s already exists with different attributes", instanceID), http.StatusConflict)
+		}
+		return &brokerapi.CreateServiceInstanceResponse{Operation: string(instance.operation)}, nil
+	}
+
+	instance := &fakeInstance{
+		serviceID: req.ServiceID,
+		planID:    req.PlanID,
+		bindings:  make(map[string]bool),
+	}
+	b.instances[instanceID] = instance
+	if behavior.Async {
+		instance.operation = OperationProvision
+	}
+	return &brokerapi.CreateServiceInstanceResponse{Operation: string(instance.operation)}, nil
+}
+
+// UpdateServiceInstance updates an instance's plan.
+func (b *Broker) UpdateServiceInstance(instanceID string, req *brokerapi.UpdateServiceInstanceRequest) (*brokerapi.UpdateServiceInstanceResponse, error) {
+	behavior, err := b.begin(OperationUpdate)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	instance, ok := b.instances[instanceID]
+	if !ok {
+		return nil, server.NewErrorWithHTTPStatus(fmt.Sprintf("no such instance %s", instanceID), http.StatusNotFound)
+	}
+	if req.PlanID != "" {
+		instance.planID = req.PlanID
+	}
+	if behavior.Async {
+		instance.operation = OperationUpdate
+		instance.polls = 0
+	}
+	return &brokerapi.UpdateServiceInstanceResponse{Operation: string(instance.operation)}, nil
+}
+
+// RemoveServiceInstance deprovisions an instance.
+func (b *Broker) RemoveServiceInstance(instanceID, serviceID, planID string, acceptsIncomplete bool) (*brokerapi.DeleteServiceInstanceResponse, error) {
+	behavior, err := b.begin(OperationDeprovision)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	instance, ok := b.instances[instanceID]
+	if !ok {
+		return nil, server.NewErrorWithHTTPStatus(fmt.Sprintf("no such instance %s", instanceID), http.StatusGone)
+	}
+	if behavior.Async {
+		if !acceptsIncomplete {
+			return nil, server.NewErrorWithHTTPStatus("this broker deprovisions instances asynchronously", http.StatusUnprocessableEntity)
+		}
+		instance.operation = OperationDeprovision
+		instance.polls = 0
+		return &brokerapi.DeleteServiceInstanceResponse{Operation: string(instance.operation)}, nil
+	}
+	delete(b.instances, instanceID)
+	return &brokerapi.DeleteServiceInstanceResponse{}, nil
+}
+
+// Bind creates a binding returning the programmed credentials.
+func (b *Broker) Bind(instanceID, bindingID string, req *brokerapi.BindingRequest) (*brokerapi.CreateServiceBindingResponse, error) {
+	if _, err := b.begin(OperationBind); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	instance, ok := b.instances[instanceID]
+	if !ok {
+		return nil, server.NewErrorWithHTTPStatus(fmt.Sprintf("no such instance %s", instanceID), http.StatusNotFound)
+	}
+	instance.bindings[bindingID] = true
+	return &brokerapi.CreateServiceBindingResponse{Credentials: b.credentials}, nil
+}
+
+// UnBind deletes a binding.
+func (b *Broker) UnBind(instanceID, bindingID, serviceID, planID string) error {
+	if _, err := b.begin(OperationUnbind); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	instance, ok := b.instances[instanceID]
+	if !ok || !instance.bindings[bindingID] {
+		return server.NewErrorWithHTTPStatus(fmt.Sprintf("no such binding %s", bindingID), http.StatusGone)
+	}
+	delete(instance.bindings, bindingID)
+	return nil
+}
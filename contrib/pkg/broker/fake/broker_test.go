@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+
+	"github.com/drycc-addons/service-catalog/contrib/pkg/brokerapi"
+)
+
+const (
+	testServiceID  = "service-id"
+	testPlanID     = "plan-id"
+	testInstanceID = "instance-id"
+	testBindingID  = "binding-id"
+)
+
+func newTestBroker(t *testing.T) (*Broker, osb.Client) {
+	broker := NewBroker(&brokerapi.Catalog{
+		Services: []*brokerapi.Service{{
+			Name:     "fake-service",
+			ID:       testServiceID,
+			Bindable: true,
+			Plans:    []brokerapi.ServicePlan{{Name: "fake-plan", ID: testPlanID}},
+		}},
+	})
+	config := osb.DefaultClientConfiguration()
+	config.URL = broker.Start()
+	client, err := osb.NewClient(config)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	return broker, client
+}
+
+func provisionRequest() *osb.ProvisionRequest {
+	return &osb.ProvisionRequest{
+		InstanceID:        testInstanceID,
+		ServiceID:         testServiceID,
+		PlanID:            testPlanID,
+		OrganizationGUID:  "org",
+		SpaceGUID:         "space",
+		AcceptsIncomplete: true,
+	}
+}
+
+// TestBrokerLifecycle tests a synchronous provision, bind, unbind and
+// deprovision over HTTP.
+func TestBrokerLifecycle(t *testing.T) {
+	broker, client := newTestBroker(t)
+	defer broker.Close()
+	broker.SetCredentials(brokerapi.Credential{"password": "secret"})
+
+	catalog, err := client.GetCatalog()
+	if err != nil {
+		t.Fatalf("unexpected error fetching catalog: %v", err)
+	}
+	if e, a := testServiceID, catalog.Services[0].ID; e != a {
+		t.Fatalf("unexpected service ID: expected %q, got %q", e, a)
+	}
+
+	if _, err := client.ProvisionInstance(provisionRequest()); err != nil {
+		t.Fatalf("unexpected error provisioning: %v", err)
+	}
+	if !broker.HasInstance(testInstanceID) {
+		t.Fatal("expected the instance to be provisioned")
+	}
+
+	binding, err := client.Bind(&osb.BindRequest{
+		InstanceID: testInstanceID,
+		BindingID:  testBindingID,
+		ServiceID:  testServiceID,
+		PlanID:     testPlanID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error binding: %v", err)
+	}
+	if e, a := "secret", binding.Credentials["password"]; e != a {
+		t.Fatalf("unexpected credentials: expected %q, got %q", e, a)
+	}
+
+	if _, err := client.Unbind(&osb.UnbindRequest{
+		InstanceID: testInstanceID,
+		BindingID:  testBindingID,
+		ServiceID:  testServiceID,
+		PlanID:     testPlanID,
+	}); err != nil {
+		t.Fatalf("unexpected error unbinding: %v", err)
+	}
+	if broker.HasBinding(testInstanceID, testBindingID) {
+		t.Fatal("expected the binding to be deleted")
+	}
+
+	if _, err := client.DeprovisionInstance(&osb.DeprovisionRequest{
+		InstanceID: testInstanceID,
+		ServiceID:  testServiceID,
+		PlanID:     testPlanID,
+	}); err != nil {
+		t.Fatalf("unexpected error deprovisioning: %v", err)
+	}
+	if broker.HasInstance(testInstanceID) {
+		t.Fatal("expected the instance to be deprovisioned")
+	}
+}
+
+// TestBrokerFailures tests that requests fail the programmed number of times
+// with the programmed status.
+func TestBrokerFailures(t *testing.T) {
+	broker, client := newTestBroker(t)
+	defer broker.Close()
+	broker.SetBehavior(OperationProvision, Behavior{FailTimes: 2, HTTPStatus: http.StatusServiceUnavailable})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.ProvisionInstance(provisionRequest())
+		httpErr, ok := osb.IsHTTPError(err)
+		if !ok {
+			t.Fatalf("expected an HTTP error, got %v", err)
+		}
+		if e, a := http.StatusServiceUnavailable, httpErr.StatusCode; e != a {
+			t.Fatalf("unexpected status: expected %v, got %v", e, a)
+		}
+	}
+	if _, err := client.ProvisionInstance(provisionRequest()); err != nil {
+		t.Fatalf("unexpected error provisioning: %v", err)
+	}
+	if e, a := 3, broker.Calls(OperationProvision); e != a {
+		t.Fatalf("unexpected number of calls: expected %v, got %v", e, a)
+	}
+}
+
+// TestBrokerAsyncProvision tests that an asynchronous provision stays in
+// progress for the programmed number of polls.
+func TestBrokerAsyncProvision(t *testing.T) {
+	broker, client := newTestBroker(t)
+	defer broker.Close()
+	broker.SetBehavior(OperationProvision, Behavior{Async: true, PollsUntilDone: 2})
+
+	response, err := client.ProvisionInstance(provisionRequest())
+	if err != nil {
+		t.Fatalf("unexpected error provisioning: %v", err)
+	}
+	if !response.Async {
+		t.Fatal("expected an asynchronous response")
+	}
+
+	request := &osb.LastOperationRequest{InstanceID: testInstanceID}
+	for _, expected := range []osb.LastOperationState{osb.StateInProgress, osb.StateInProgress, osb.StateSucceeded} {
+		lastOperation, err := client.PollLastOperation(request)
+		if err != nil {
+			t.Fatalf("unexpected error polling: %v", err)
+		}
+		if e, a := expected, lastOperation.State; e != a {
+			t.Fatalf("unexpected state: expected %q, got %q", e, a)
+		}
+	}
+}
+
+// TestBrokerLatency tests that responses are delayed by the programmed
+// latency.
+func TestBrokerLatency(t *testing.T) {
+	broker, client := newTestBroker(t)
+	defer broker.Close()
+	latency := 100 * time.Millisecond
+	broker.SetBehavior(OperationCatalog, Behavior{Latency: latency})
+
+	start := time.Now()
+	if _, err := client.GetCatalog(); err != nil {
+		t.Fatalf("unexpected error fetching catalog: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < latency {
+		t.Fatalf("expected the response to take at least %v, took %v", latency, elapsed)
+	}
+}
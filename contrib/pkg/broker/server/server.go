@@ -60,7 +60,7 @@ func (e ErrorWithHTTPStatus) HTTPStatus() int {
 
 // CreateHandler creates Broker HTTP handler based on an implementation
 // of a controller.Controller interface.
-func createHandler(c controller.Controller) http.Handler {
+func CreateHandler(c controller.Controller) http.Handler {
 	s := server{
 		controller: c,
 	}
@@ -117,7 +117,7 @@ func run(ctx context.Context, addr string, listenAndServe func(srv *http.Server)
 	klog.Infof("Starting server on %s\n", addr)
 	srv := &http.Server{
 		Addr:    addr,
-		Handler: createHandler(c),
+		Handler: CreateHandler(c),
 	}
 	go func() {
 		<-ctx.Done()
@@ -36,7 +36,7 @@ var _ controller.Controller = &Controller{}
 
 // /v2/catalog returns HTTP error on error.
 func TestCatalogReturnsHTTPErrorOnError(t *testing.T) {
-	handler := createHandler(&Controller{
+	handler := CreateHandler(&Controller{
 		t: t,
 		catalog: func() (*brokerapi.Catalog, error) {
 			return nil, errors.New("Catalog retrieval error")
@@ -61,7 +61,7 @@ func TestCatalogReturnsHTTPErrorOnError(t *testing.T) {
 
 // /v2/catalog returns compliant JSON
 func TestCatalogReturnsCompliantJSON(t *testing.T) {
-	handler := createHandler(&Controller{
+	handler := CreateHandler(&Controller{
 		t: t,
 		catalog: func() (*brokerapi.Catalog, error) {
 			return &brokerapi.Catalog{Services: []*brokerapi.Service{
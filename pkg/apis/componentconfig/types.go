@@ -72,6 +72,26 @@ type ControllerManagerConfiguration struct {
 	// listed.
 	ServiceBrokerRelistInterval time.Duration
 
+	// ServiceBrokerRelistJitterFactor is the largest fraction of a broker's
+	// relist interval added to it, so that brokers with the same interval
+	// are not relisted at the same time.
+	ServiceBrokerRelistJitterFactor float64
+
+	// ServiceBrokerRelistConcurrency is the number of brokers whose catalog
+	// may be relisted at the same time. Zero means no limit.
+	ServiceBrokerRelistConcurrency int
+
+	// MaxConcurrentProvisions is the number of ServiceInstances whose
+	// provision may be in flight at the same time, across all brokers.
+	// Zero means no limit.
+	MaxConcurrentProvisions int
+
+	// InstanceUpdateDebounce is how long the spec of a provisioned
+	// ServiceInstance must stay unchanged before its update request is
+	// sent, so that successive edits are coalesced into a single request.
+	// Zero disables debouncing.
+	InstanceUpdateDebounce time.Duration
+
 	// Whether or not to send the proposed optional
 	// OpenServiceBroker API Context Profile field
 	OSBAPIContextProfile   bool
@@ -80,6 +100,24 @@ type ControllerManagerConfiguration struct {
 	// OSBAPITimeOut the length of the timeout of any request to the broker.
 	OSBAPITimeOut time.Duration
 
+	// OSBAPIBindingTimeOut the length of the timeout of the bind, unbind and
+	// binding last operation requests to the brokers which do not set their
+	// own binding request timeout.
+	OSBAPIBindingTimeOut time.Duration
+
+	// ParametersPluginDir is the directory holding the executables that
+	// parametersFrom plugin references are resolved against.
+	ParametersPluginDir string
+
+	// ParametersPluginTimeout is the longest a parameters plugin may run
+	// before it is killed.
+	ParametersPluginTimeout time.Duration
+
+	// SecretTemplateDir is the directory holding the secret templates that
+	// ServiceBindings may deliver their credentials through instead of a
+	// Secret.
+	SecretTemplateDir string
+
 	// ConcurrentSyncs is the number of resources, per resource type,
 	// that are allowed to sync concurrently. Larger number = more responsive
 	// SC operations, but more CPU (and network) load.
@@ -98,18 +136,75 @@ type ControllerManagerConfiguration struct {
 	// enableContentionProfiling enables lock contention profiling, if enableProfiling is true.
 	EnableContentionProfiling bool
 
+	// EnableCatalogAPI enables the read-only, paginated view of the classes
+	// and plans of the catalog at host:port/catalog/v1/.
+	EnableCatalogAPI bool
+
+	// InventoryInterval is how often the summary of the instances and
+	// bindings served at host:port/inventory/v1/summary is computed. Zero
+	// disables the summary.
+	InventoryInterval time.Duration
+
+	// FilterLabelCheckInterval is how often the filter labels of the
+	// classes, plans and instances are checked against their specs and
+	// repaired. Zero disables the check.
+	FilterLabelCheckInterval time.Duration
+
+	// CatalogCacheDir is the directory the informer caches of the
+	// ClusterServiceClasses and ClusterServicePlans are persisted to, so
+	// that a restarted controller manager starts from them instead of
+	// listing the whole catalog. Empty disables the persistence.
+	CatalogCacheDir string
+
 	// ReconciliationRetryDuration is the longest time to attempt reconciliation
 	// on a given resource before failing the reconciliation
 	ReconciliationRetryDuration time.Duration
 
+	// ReconciliationMaxAttempts is the number of requests sent to a broker
+	// for an operation on a given resource before failing the operation.
+	// Zero means no limit. The MaxAttempts annotation of a resource
+	// overrides it.
+	ReconciliationMaxAttempts int
+
+	// ReadOnly makes the controller report the resources whose state
+	// drifted from their spec instead of reconciling them: it sends no
+	// requests to brokers and changes no resources.
+	ReadOnly bool
+
+	// CentralEventNamespace is the namespace the Warning events of the
+	// controller are mirrored into, in addition to the namespace of the
+	// resource they are about. Empty means no mirroring.
+	CentralEventNamespace string
+
+	// WatchLabelSelector restricts the ServiceInstances and ServiceBindings
+	// reconciled by the controller to those whose labels match it, so that
+	// several installations can share a cluster. Empty means all of them.
+	WatchLabelSelector string
+
 	// OperationPollingMaximumBackoffDuration is the maximum duration that exponential
 	// backoff for polling OSB API operations will use.
 	OperationPollingMaximumBackoffDuration time.Duration
 
+	// OperationCallbackURL is the address at which brokers with
+	// OperationCallbacks set notify the controller that an operation
+	// completed. Operation callbacks are disabled when it is empty.
+	OperationCallbackURL string
+
+	// OperationCallbackKeyFile is the file holding the key that signs the
+	// tokens authenticating operation callbacks.
+	OperationCallbackKeyFile string
+
 	SecureServingOptions *genericoptions.SecureServingOptions
 
 	// ClusterIDConfigMapName is the k8s name that the clusterid configmap will have
 	ClusterIDConfigMapName string
 	// ClusterIDConfigMapNamespace is the k8s namespace that the clusterid configmap will be stored in.
 	ClusterIDConfigMapNamespace string
+
+	// TunablesConfigMapName is the name of the ConfigMap whose settings
+	// override those of the controller while it runs, without a restart.
+	// Empty means the settings only change on restart.
+	TunablesConfigMapName string
+	// TunablesConfigMapNamespace is the namespace of the tunables ConfigMap.
+	TunablesConfigMapNamespace string
 }
@@ -65,3 +65,34 @@ func (b *ClusterServiceBroker) GetStatus() CommonServiceBrokerStatus {
 func (b *ServiceBroker) GetStatus() CommonServiceBrokerStatus {
 	return b.Status.CommonServiceBrokerStatus
 }
+
+// GetAuthType returns the mechanism the controller authenticates to the
+// broker with: "basic", "bearer", or "" when it does not authenticate.
+func (b *ClusterServiceBroker) GetAuthType() string {
+	switch {
+	case b.Spec.AuthInfo == nil:
+		return ""
+	case b.Spec.AuthInfo.Basic != nil:
+		return "basic"
+	case b.Spec.AuthInfo.Bearer != nil:
+		return "bearer"
+	}
+	return ""
+}
+
+// GetAuthType returns the mechanism the controller authenticates to the
+// broker with: "basic", "bearer", "serviceAccountToken", or "" when it does
+// not authenticate.
+func (b *ServiceBroker) GetAuthType() string {
+	switch {
+	case b.Spec.AuthInfo == nil:
+		return ""
+	case b.Spec.AuthInfo.Basic != nil:
+		return "basic"
+	case b.Spec.AuthInfo.Bearer != nil:
+		return "bearer"
+	case b.Spec.AuthInfo.ServiceAccountToken != nil:
+		return "serviceAccountToken"
+	}
+	return ""
+}
@@ -19,6 +19,7 @@ package v1beta1
 const (
 	statusActive     = "Active"
 	statusDeprecated = "Deprecated"
+	statusConflict   = "Conflict"
 )
 
 // GetName returns the class's name.
@@ -71,6 +72,16 @@ func (c *ClusterServiceClass) GetSpec() CommonServiceClassSpec {
 	return c.Spec.CommonServiceClassSpec
 }
 
+// GetStatus returns the status for the class.
+func (c *ServiceClass) GetStatus() CommonServiceClassStatus {
+	return c.Status.CommonServiceClassStatus
+}
+
+// GetStatus returns the status for the class.
+func (c *ClusterServiceClass) GetStatus() CommonServiceClassStatus {
+	return c.Status.CommonServiceClassStatus
+}
+
 // GetServiceBrokerName returns the name of the service broker for the class.
 func (c *ServiceClass) GetServiceBrokerName() string {
 	return c.Spec.ServiceBrokerName
@@ -96,6 +107,11 @@ func (c *CommonServiceClassStatus) GetStatusText() string {
 	if c.RemovedFromBrokerCatalog {
 		return statusDeprecated
 	}
+	for _, cond := range c.Conditions {
+		if cond.Type == ServiceClassConditionConflict && cond.Status == ConditionTrue {
+			return statusConflict
+		}
+	}
 	return statusActive
 }
 
@@ -108,3 +124,13 @@ func (c *ClusterServiceClass) IsClusterServiceClass() bool {
 func (c *ServiceClass) IsClusterServiceClass() bool {
 	return false
 }
+
+// IsHidden returns true if a platform admin has hidden the class.
+func (c *ClusterServiceClass) IsHidden() bool {
+	return c.Spec.CatalogVisibility == CatalogVisibilityHidden
+}
+
+// IsHidden returns true if a platform admin has hidden the class.
+func (c *ServiceClass) IsHidden() bool {
+	return c.Spec.CatalogVisibility == CatalogVisibilityHidden
+}
@@ -17,9 +17,56 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/json"
+	"sort"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// BindingResponseSchemaMetadataKey is the key in a plan's external metadata
+// under which a broker may publish the JSON schema of the credentials it
+// returns when a binding is created.
+const BindingResponseSchemaMetadataKey = "bindingResponseSchema"
+
+// AvailabilityMetadataKey is the key in a plan's external metadata under
+// which a broker may publish whether new instances of the plan can be
+// provisioned, for example when the plan is sold out:
+//
+//	"availability": {"available": false, "reason": "No capacity left in the region"}
+//
+// A plan without availability metadata is available.
+const AvailabilityMetadataKey = "availability"
+
+// CostsMetadataKey is the key in a plan's external metadata under which a
+// broker may publish the costs of the plan, following the OSB metadata
+// conventions:
+//
+//	"costs": [{"amount": {"usd": 99.0}, "unit": "MONTHLY"}]
+const CostsMetadataKey = "costs"
+
+// MaxBindingsMetadataKey is the key in a plan's external metadata under
+// which a broker may publish the number of bindings each instance of the
+// plan may have:
+//
+//	"maxBindings": 5
+//
+// The MaxBindings field of the plan overrides it.
+const MaxBindingsMetadataKey = "maxBindings"
+
+// PlanCost is a cost of a plan in a single currency.
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+type PlanCost struct {
+	// Amount is the amount charged per unit.
+	Amount float64 `json:"amount"`
+	// Currency is the upper case code of the currency of the amount, such
+	// as USD.
+	Currency string `json:"currency"`
+	// Unit is what the amount is charged for, such as MONTHLY.
+	Unit string `json:"unit"`
+}
+
 // GetName returns the plan's name.
 func (p *ClusterServicePlan) GetName() string {
 	return p.Name
@@ -45,6 +92,12 @@ func (p *ClusterServicePlan) GetShortStatus() string {
 	if p.Status.RemovedFromBrokerCatalog {
 		return "Deprecated"
 	}
+	if p.Status.inConflict() {
+		return statusConflict
+	}
+	if available, _ := p.GetAvailability(); !available {
+		return "Unavailable"
+	}
 	return "Active"
 }
 
@@ -53,9 +106,25 @@ func (p *ServicePlan) GetShortStatus() string {
 	if p.Status.RemovedFromBrokerCatalog {
 		return "Deprecated"
 	}
+	if p.Status.inConflict() {
+		return statusConflict
+	}
+	if available, _ := p.GetAvailability(); !available {
+		return "Unavailable"
+	}
 	return "Active"
 }
 
+// inConflict returns whether the Conflict condition of the plan is true.
+func (s *CommonServicePlanStatus) inConflict() bool {
+	for _, cond := range s.Conditions {
+		if cond.Type == ServicePlanConditionConflict && cond.Status == ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
 // GetExternalName returns the plan's external name.
 func (p *ClusterServicePlan) GetExternalName() string {
 	return p.Spec.ExternalName
@@ -135,3 +204,146 @@ func (p *ClusterServicePlan) GetBindingCreateSchema() *runtime.RawExtension {
 func (p *ServicePlan) GetBindingCreateSchema() *runtime.RawExtension {
 	return p.Spec.ServiceBindingCreateParameterSchema
 }
+
+// GetBindingResponseSchema returns the binding response schema published in
+// the plan's external metadata, or nil if the broker does not publish one.
+func (p *ClusterServicePlan) GetBindingResponseSchema() *runtime.RawExtension {
+	return bindingResponseSchema(p.Spec.ExternalMetadata)
+}
+
+// GetBindingResponseSchema returns the binding response schema published in
+// the plan's external metadata, or nil if the broker does not publish one.
+func (p *ServicePlan) GetBindingResponseSchema() *runtime.RawExtension {
+	return bindingResponseSchema(p.Spec.ExternalMetadata)
+}
+
+func bindingResponseSchema(metadata *runtime.RawExtension) *runtime.RawExtension {
+	if metadata == nil || len(metadata.Raw) == 0 {
+		return nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(metadata.Raw, &fields); err != nil {
+		return nil
+	}
+	schema, ok := fields[BindingResponseSchemaMetadataKey]
+	if !ok || string(schema) == "null" {
+		return nil
+	}
+	return &runtime.RawExtension{Raw: schema}
+}
+
+// GetAvailability returns whether new instances of the plan can be
+// provisioned and, if not, the reason published by the broker in the plan's
+// external metadata.
+func (p *ClusterServicePlan) GetAvailability() (bool, string) {
+	return planAvailability(p.Spec.ExternalMetadata)
+}
+
+// GetAvailability returns whether new instances of the plan can be
+// provisioned and, if not, the reason published by the broker in the plan's
+// external metadata.
+func (p *ServicePlan) GetAvailability() (bool, string) {
+	return planAvailability(p.Spec.ExternalMetadata)
+}
+
+// IsHidden returns true if a platform admin has hidden the plan.
+func (p *ClusterServicePlan) IsHidden() bool {
+	return p.Spec.CatalogVisibility == CatalogVisibilityHidden
+}
+
+// IsHidden returns true if a platform admin has hidden the plan.
+func (p *ServicePlan) IsHidden() bool {
+	return p.Spec.CatalogVisibility == CatalogVisibilityHidden
+}
+
+func planAvailability(metadata *runtime.RawExtension) (bool, string) {
+	if metadata == nil || len(metadata.Raw) == 0 {
+		return true, ""
+	}
+	fields := struct {
+		Availability *struct {
+			Available *bool  `json:"available"`
+			Reason    string `json:"reason"`
+		} `json:"availability"`
+	}{}
+	if err := json.Unmarshal(metadata.Raw, &fields); err != nil {
+		return true, ""
+	}
+	if fields.Availability == nil || fields.Availability.Available == nil || *fields.Availability.Available {
+		return true, ""
+	}
+	return false, fields.Availability.Reason
+}
+
+// GetMaxBindings returns the number of bindings each instance of the plan
+// may have, from its MaxBindings field or else its external metadata, and
+// whether there is such a limit.
+func (p *ClusterServicePlan) GetMaxBindings() (int, bool) {
+	return planMaxBindings(p.Spec.MaxBindings, p.Spec.ExternalMetadata)
+}
+
+// GetMaxBindings returns the number of bindings each instance of the plan
+// may have, from its MaxBindings field or else its external metadata, and
+// whether there is such a limit.
+func (p *ServicePlan) GetMaxBindings() (int, bool) {
+	return planMaxBindings(p.Spec.MaxBindings, p.Spec.ExternalMetadata)
+}
+
+func planMaxBindings(maxBindings *int32, metadata *runtime.RawExtension) (int, bool) {
+	if maxBindings != nil {
+		return int(*maxBindings), true
+	}
+	if metadata == nil || len(metadata.Raw) == 0 {
+		return 0, false
+	}
+	fields := struct {
+		MaxBindings *int32 `json:"maxBindings"`
+	}{}
+	if err := json.Unmarshal(metadata.Raw, &fields); err != nil || fields.MaxBindings == nil || *fields.MaxBindings < 1 {
+		return 0, false
+	}
+	return int(*fields.MaxBindings), true
+}
+
+// GetCosts returns the costs published by the broker in the plan's external
+// metadata, one per currency of each cost, or nil if there are none.
+func (p *ClusterServicePlan) GetCosts() []PlanCost {
+	return planCosts(p.Spec.ExternalMetadata)
+}
+
+// GetCosts returns the costs published by the broker in the plan's external
+// metadata, one per currency of each cost, or nil if there are none.
+func (p *ServicePlan) GetCosts() []PlanCost {
+	return planCosts(p.Spec.ExternalMetadata)
+}
+
+func planCosts(metadata *runtime.RawExtension) []PlanCost {
+	if metadata == nil || len(metadata.Raw) == 0 {
+		return nil
+	}
+	fields := struct {
+		Costs []struct {
+			Amount map[string]float64 `json:"amount"`
+			Unit   string             `json:"unit"`
+		} `json:"costs"`
+	}{}
+	if err := json.Unmarshal(metadata.Raw, &fields); err != nil {
+		return nil
+	}
+	var costs []PlanCost
+	for _, cost := range fields.Costs {
+		currencies := make([]string, 0, len(cost.Amount))
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+		for _, currency := range currencies {
+			costs = append(costs, PlanCost{
+				Amount:   cost.Amount[currency],
+				Currency: strings.ToUpper(currency),
+				Unit:     cost.Unit,
+			})
+		}
+	}
+	return costs
+}
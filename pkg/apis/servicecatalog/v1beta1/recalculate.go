@@ -42,6 +42,7 @@ func (in *ServiceInstance) RecalculatePrinterColumnStatusFields() {
 	in.Status.UserSpecifiedPlanName = plan
 
 	in.Status.LastConditionState = getServiceInstanceLastConditionState(&in.Status)
+	in.Status.PrintableStatus = getServiceInstancePrintableStatus(in)
 }
 
 // RecalculatePrinterColumnStatusFields sets column status fields using status conditions
@@ -65,6 +66,39 @@ func getServiceInstanceLastConditionState(status *ServiceInstanceStatus) string
 	return ""
 }
 
+// getServiceInstancePrintableStatus summarizes the status of an instance,
+// giving precedence to deletion and failures over the current operation.
+func getServiceInstancePrintableStatus(in *ServiceInstance) ServiceInstancePrintableStatus {
+	status := &in.Status
+	switch {
+	case status.OrphanMitigationInProgress:
+		return ServiceInstancePrintableStatusOrphanMitigation
+	case in.DeletionTimestamp != nil || status.CurrentOperation == ServiceInstanceOperationDeprovision:
+		return ServiceInstancePrintableStatusDeprovisioning
+	case serviceInstanceConditionIsTrue(status, ServiceInstanceConditionFailed):
+		return ServiceInstancePrintableStatusFailed
+	case status.CurrentOperation == ServiceInstanceOperationProvision:
+		return ServiceInstancePrintableStatusProvisioning
+	case status.CurrentOperation == ServiceInstanceOperationUpdate:
+		return ServiceInstancePrintableStatusUpdating
+	case serviceInstanceConditionIsTrue(status, ServiceInstanceConditionReady):
+		return ServiceInstancePrintableStatusReady
+	case status.ProvisionStatus == ServiceInstanceProvisionStatusProvisioned:
+		return ServiceInstancePrintableStatusUpdating
+	default:
+		return ServiceInstancePrintableStatusProvisioning
+	}
+}
+
+func serviceInstanceConditionIsTrue(status *ServiceInstanceStatus, conditionType ServiceInstanceConditionType) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == ConditionTrue
+		}
+	}
+	return false
+}
+
 func serviceBrokerLastConditionState(status *CommonServiceBrokerStatus) string {
 	if len(status.Conditions) > 0 {
 		condition := status.Conditions[len(status.Conditions)-1]
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceInstancePrintableStatus(t *testing.T) {
+	ready := ServiceInstanceCondition{Type: ServiceInstanceConditionReady, Status: ConditionTrue}
+	notReady := ServiceInstanceCondition{Type: ServiceInstanceConditionReady, Status: ConditionFalse}
+	failed := ServiceInstanceCondition{Type: ServiceInstanceConditionFailed, Status: ConditionTrue}
+	now := metav1.Now()
+
+	cases := []struct {
+		name     string
+		instance ServiceInstance
+		expected ServiceInstancePrintableStatus
+	}{
+		{
+			name:     "new",
+			expected: ServiceInstancePrintableStatusProvisioning,
+		},
+		{
+			name: "provisioning",
+			instance: ServiceInstance{Status: ServiceInstanceStatus{
+				Conditions:       []ServiceInstanceCondition{notReady},
+				CurrentOperation: ServiceInstanceOperationProvision,
+			}},
+			expected: ServiceInstancePrintableStatusProvisioning,
+		},
+		{
+			name: "ready",
+			instance: ServiceInstance{Status: ServiceInstanceStatus{
+				Conditions:      []ServiceInstanceCondition{ready},
+				ProvisionStatus: ServiceInstanceProvisionStatusProvisioned,
+			}},
+			expected: ServiceInstancePrintableStatusReady,
+		},
+		{
+			name: "updating",
+			instance: ServiceInstance{Status: ServiceInstanceStatus{
+				Conditions:       []ServiceInstanceCondition{ready},
+				CurrentOperation: ServiceInstanceOperationUpdate,
+				ProvisionStatus:  ServiceInstanceProvisionStatusProvisioned,
+			}},
+			expected: ServiceInstancePrintableStatusUpdating,
+		},
+		{
+			name: "provisioned but not ready",
+			instance: ServiceInstance{Status: ServiceInstanceStatus{
+				Conditions:      []ServiceInstanceCondition{notReady},
+				ProvisionStatus: ServiceInstanceProvisionStatusProvisioned,
+			}},
+			expected: ServiceInstancePrintableStatusUpdating,
+		},
+		{
+			name: "failed",
+			instance: ServiceInstance{Status: ServiceInstanceStatus{
+				Conditions: []ServiceInstanceCondition{notReady, failed},
+			}},
+			expected: ServiceInstancePrintableStatusFailed,
+		},
+		{
+			name: "deprovisioning",
+			instance: ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now},
+				Status: ServiceInstanceStatus{
+					Conditions:      []ServiceInstanceCondition{notReady, failed},
+					ProvisionStatus: ServiceInstanceProvisionStatusProvisioned,
+				},
+			},
+			expected: ServiceInstancePrintableStatusDeprovisioning,
+		},
+		{
+			name: "orphan mitigation",
+			instance: ServiceInstance{Status: ServiceInstanceStatus{
+				Conditions:                 []ServiceInstanceCondition{notReady, failed},
+				CurrentOperation:           ServiceInstanceOperationDeprovision,
+				OrphanMitigationInProgress: true,
+			}},
+			expected: ServiceInstancePrintableStatusOrphanMitigation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.instance.RecalculatePrinterColumnStatusFields()
+			if tc.instance.Status.PrintableStatus != tc.expected {
+				t.Errorf("unexpected printable status: expected %v, got %v", tc.expected, tc.instance.Status.PrintableStatus)
+			}
+		})
+	}
+}
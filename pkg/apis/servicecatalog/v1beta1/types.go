@@ -102,6 +102,11 @@ type CommonServiceBrokerSpec struct {
 	// +optional
 	CABundle []byte `json:"caBundle,omitempty"`
 
+	// TLSConfig restricts the TLS versions, cipher suites and server
+	// identities used when communicating with this Broker.
+	// +optional
+	TLSConfig *ServiceBrokerTLSConfig `json:"tlsConfig,omitempty"`
+
 	// RelistBehavior specifies the type of relist behavior the catalog should
 	// exhibit when relisting ServiceClasses available from a broker.
 	// +optional
@@ -126,8 +131,196 @@ type CommonServiceBrokerSpec struct {
 	// and plans have resources created for them.
 	// +optional
 	CatalogRestrictions *CatalogRestrictions `json:"catalogRestrictions,omitempty"`
+
+	// OSBAPIVersion pins the version of the Open Service Broker API that the
+	// controller uses to communicate with this broker, for example "2.13".
+	// If unset, the controller uses its preferred version, which defaults to
+	// the latest version it supports.
+	// +optional
+	OSBAPIVersion string `json:"osbAPIVersion,omitempty"`
+
+	// MaintenanceWindows are recurring periods during which the controller
+	// defers non-critical operations against the broker. Deletions are never
+	// deferred.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// OperationCallbacks declares that the broker notifies the controller
+	// when an asynchronous operation completes, through the callback URL
+	// and token passed in the context of provision and update requests.
+	// The controller then polls the last operation of the instances of
+	// this broker only when notified, and at the maximum polling interval
+	// as a fallback.
+	// +optional
+	OperationCallbacks bool `json:"operationCallbacks,omitempty"`
+
+	// BindingRequestTimeout is the timeout of the bind, unbind and binding
+	// last operation requests sent to the broker, which often answers them
+	// much faster than provision requests. If unset, the controller uses its
+	// default binding request timeout.
+	// +optional
+	BindingRequestTimeout *metav1.Duration `json:"bindingRequestTimeout,omitempty"`
+
+	// RequestRateLimit limits the rate of the requests the controller sends
+	// to the broker, such as provision, bind and last operation requests, so
+	// that a slow broker is not overwhelmed while many instances are
+	// reconciled. If unset, the requests are not limited.
+	// +optional
+	RequestRateLimit *BrokerRequestRateLimit `json:"requestRateLimit,omitempty"`
+
+	// RetryPolicy is the backoff of the retries of the failed provision,
+	// update and bind requests sent to the broker, for brokers whose long
+	// operations make the default backoff of the controller too noisy. If
+	// unset, the controller uses its default backoff and reconciliation
+	// retry duration.
+	// +optional
+	RetryPolicy *BrokerRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// CatalogSource is where the catalog of the broker is loaded from
+	// instead of its /v2/catalog endpoint. Provision, update, bind and the
+	// other requests are still sent to URL. Requires the
+	// BrokerCatalogSources feature.
+	// +optional
+	CatalogSource *CatalogSource `json:"catalogSource,omitempty"`
+}
+
+// BrokerRequestRateLimit is a token bucket limit of the rate of the requests
+// sent to a broker.
+type BrokerRequestRateLimit struct {
+	// QPS is the number of requests per second sent to the broker.
+	QPS int32 `json:"qps"`
+
+	// Burst is the number of requests that can be sent at once, above QPS.
+	// Defaults to QPS.
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+}
+
+// BrokerRetryPolicy is an exponential backoff of the retries of the
+// operations of the instances and bindings of a broker.
+type BrokerRetryPolicy struct {
+	// InitialInterval is the delay before the first retry of a failed
+	// request, which is doubled on each following retry. Defaults to 1s
+	// when MaxInterval is set.
+	// +optional
+	InitialInterval *metav1.Duration `json:"initialInterval,omitempty"`
+
+	// MaxInterval is the maximum delay between two retries. Defaults to
+	// 20m when InitialInterval is set.
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+
+	// MaxElapsedTime is how long the operation of an instance or binding
+	// is retried before it fails. If unset, the controller uses its
+	// reconciliation retry duration.
+	// +optional
+	MaxElapsedTime *metav1.Duration `json:"maxElapsedTime,omitempty"`
+}
+
+// CatalogSource is a source of the catalog of a broker other than its
+// /v2/catalog endpoint, such as a catalog reviewed offline or one served
+// more reliably than by the broker. The catalog is a document in the format
+// of the response of the /v2/catalog endpoint, in JSON or YAML. Exactly one
+// of the members must be set.
+type CatalogSource struct {
+	// ConfigMapRef is the key of a ConfigMap holding the catalog. The
+	// ConfigMap of a ServiceBroker must be in the namespace of the broker.
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+
+	// URL is the HTTP or HTTPS address of the catalog.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// OCIArtifact is the reference of an OCI artifact holding the catalog,
+	// such as registry.example.com/catalogs/broker:1.0 or
+	// registry.example.com/catalogs/broker@sha256:<digest>. The artifact is
+	// pulled anonymously, and the catalog is its layer of media type
+	// application/vnd.servicecatalog.catalog.v1+json, or its only layer.
+	// +optional
+	OCIArtifact string `json:"ociArtifact,omitempty"`
+}
+
+// ConfigMapKeyReference references a key of a ConfigMap.
+type ConfigMapKeyReference struct {
+	// Namespace of the ConfigMap. Defaults to the namespace of the
+	// referencing object, and is required if it is cluster-scoped.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+	// Key of the ConfigMap to select.
+	Key string `json:"key"`
+}
+
+// ServiceBrokerTLSConfig restricts the TLS connections to a broker.
+type ServiceBrokerTLSConfig struct {
+	// MinVersion is the minimum TLS version accepted from the broker: "1.0",
+	// "1.1", "1.2" or "1.3". Defaults to the controller's default.
+	// +optional
+	MinVersion string `json:"minVersion,omitempty"`
+
+	// CipherSuites is the list of cipher suites offered to the broker for
+	// TLS 1.2 and earlier, by their IANA names, for example
+	// "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". The cipher suites of TLS 1.3
+	// are not configurable. Defaults to the controller's defaults.
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+
+	// ServerDNSNames are the DNS names expected in the serving certificate
+	// of the broker. The certificate must hold one of them as a subject
+	// alternative name, possibly through a wildcard, or the connection is
+	// rejected. This protects against the hijack of the DNS name of the
+	// broker URL by a server with another certificate issued by a trusted
+	// CA. Cannot be used with insecureSkipTLSVerify.
+	// +optional
+	ServerDNSNames []string `json:"serverDNSNames,omitempty"`
+
+	// ServerSPIFFEIDs are the SPIFFE IDs expected in the serving
+	// certificate of the broker, for example
+	// "spiffe://example.org/ns/brokers/sa/broker". The certificate must
+	// hold one of them as a URI subject alternative name, or the connection
+	// is rejected. Cannot be used with insecureSkipTLSVerify.
+	// +optional
+	ServerSPIFFEIDs []string `json:"serverSPIFFEIDs,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period during which the controller
+// defers operations against a broker.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression in the standard five-field format
+	// ("minute hour day-of-month month day-of-week"), evaluated in UTC,
+	// that gives the times at which the window starts.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window lasts after each start time.
+	Duration metav1.Duration `json:"duration"`
+
+	// DeferredOperations is the list of operations that are deferred while
+	// the window is active. Defaults to Relist, Update and Provision.
+	// +optional
+	DeferredOperations []MaintenanceOperation `json:"deferredOperations,omitempty"`
 }
 
+// MaintenanceOperation is an operation that may be deferred during a
+// broker's maintenance window.
+type MaintenanceOperation string
+
+const (
+	// MaintenanceOperationRelist defers fetching the broker's catalog.
+	MaintenanceOperationRelist MaintenanceOperation = "Relist"
+
+	// MaintenanceOperationUpdate defers updates of the broker's instances.
+	MaintenanceOperationUpdate MaintenanceOperation = "Update"
+
+	// MaintenanceOperationProvision defers the provisioning of new instances
+	// of the broker's services.
+	MaintenanceOperationProvision MaintenanceOperation = "Provision"
+)
+
 // CatalogRestrictions is a set of restrictions on which of a broker's services
 // and plans have resources created for them.
 //
@@ -196,6 +389,13 @@ type ClusterServiceBrokerSpec struct {
 	// AuthInfo contains the data that the service catalog should use to authenticate
 	// with the ClusterServiceBroker.
 	AuthInfo *ClusterServiceBrokerAuthInfo `json:"authInfo,omitempty"`
+
+	// Priority decides which ClusterServiceBroker a ClusterServiceClass
+	// belongs to when several brokers offer a class with the same external
+	// ID: the broker with the highest priority wins, and ties go to the
+	// broker whose name sorts first. Defaults to 0.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // ServiceBrokerSpec represents a description of a Broker.
@@ -267,6 +467,14 @@ type ServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *BearerTokenAuthConfig `json:"bearer,omitempty"`
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// ServiceAccountTokenAuthConfig provides configuration to send a token of
+	// a service account in the namespace of the broker as a bearer token. The
+	// broker can verify the token with a TokenReview. Requires the
+	// BrokerServiceAccountTokenAuth feature.
+	ServiceAccountToken *ServiceAccountTokenAuthConfig `json:"serviceAccountToken,omitempty"`
 }
 
 // BasicAuthConfig provides config for the basic authentication of
@@ -292,6 +500,21 @@ type BearerTokenAuthConfig struct {
 	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
 }
 
+// ServiceAccountTokenAuthConfig provides config for the authentication of
+// namespaced brokers with the token of a service account.
+type ServiceAccountTokenAuthConfig struct {
+	// ServiceAccountName is the name of the service account, in the
+	// namespace of the broker, the token is requested for. The audience of
+	// the token is the URL of the broker.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// ExpirationSeconds is the requested lifetime of the token. The token is
+	// renewed once 80% of its lifetime has passed. Defaults to 3600, must be
+	// at least 600.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
 const (
 	// BasicAuthUsernameKey is the key of the username for SecretTypeBasicAuth secrets
 	BasicAuthUsernameKey = "username"
@@ -321,6 +544,46 @@ type CommonServiceBrokerStatus struct {
 	// LastConditionState aggregates state from the Conditions array
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
+
+	// OSBAPIVersion is the version of the Open Service Broker API used for
+	// the requests to this broker, as of the last successful catalog fetch.
+	// It is the version the controller sends, not one advertised by the
+	// broker.
+	// +optional
+	OSBAPIVersion string `json:"osbAPIVersion,omitempty"`
+
+	// CatalogSummary summarizes the catalog fetched by the last successful
+	// catalog fetch and the classes and plans it added and removed.
+	// +optional
+	CatalogSummary *CatalogSummary `json:"catalogSummary,omitempty"`
+}
+
+// CatalogSummary summarizes the catalog of a broker, so that changes to it
+// can be audited from the broker itself.
+type CatalogSummary struct {
+	// Checksum is the SHA-256 checksum of the catalog returned by the broker,
+	// before the catalog restrictions are applied.
+	Checksum string `json:"checksum"`
+
+	// ClassCount is the number of classes offered by the broker, after the
+	// catalog restrictions are applied.
+	ClassCount int32 `json:"classCount"`
+
+	// PlanCount is the number of plans offered by the broker, after the
+	// catalog restrictions are applied.
+	PlanCount int32 `json:"planCount"`
+
+	// Added lists the external names of the classes, and of the plans as
+	// CLASS/PLAN, added to the catalog by the last fetch that changed the
+	// classes or plans offered. At most 10 names are listed.
+	// +optional
+	Added []string `json:"added,omitempty"`
+
+	// Removed lists the external names of the classes, and of the plans as
+	// CLASS/PLAN, removed from the catalog by the last fetch that changed the
+	// classes or plans offered. At most 10 names are listed.
+	// +optional
+	Removed []string `json:"removed,omitempty"`
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -468,8 +731,62 @@ type CommonServiceClassStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the service from its
 	// catalog.
 	RemovedFromBrokerCatalog bool `json:"removedFromBrokerCatalog"`
+
+	// Conditions is an array of ServiceClassConditions capturing aspects of
+	// the class's status.
+	// +optional
+	Conditions []ServiceClassCondition `json:"conditions,omitempty"`
+
+	// ConflictingBrokers lists the other brokers whose catalogs offer a
+	// class with the same external ID. Their classes are not reconciled
+	// while this class belongs to a broker taking precedence over them.
+	// +optional
+	ConflictingBrokers []string `json:"conflictingBrokers,omitempty"`
+}
+
+// ServiceClassCondition contains condition information for a ServiceClass.
+type ServiceClassCondition struct {
+	// Type of the condition, currently ('Conflict').
+	Type ServiceClassConditionType `json:"type"`
+
+	// Status of the condition, one of ('True', 'False', 'Unknown').
+	Status ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	Reason string `json:"reason"`
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	Message string `json:"message"`
 }
 
+// ServiceClassConditionType represents a class condition value.
+type ServiceClassConditionType string
+
+const (
+	// ServiceClassConditionConflict represents the fact that other brokers
+	// offer a class with the same external ID as the class.
+	ServiceClassConditionConflict ServiceClassConditionType = "Conflict"
+)
+
+// CatalogVisibility is whether a class or plan is offered to users.
+type CatalogVisibility string
+
+const (
+	// CatalogVisibilityVisible means the class or plan is listed and can be
+	// provisioned. It is the default.
+	CatalogVisibilityVisible CatalogVisibility = "Visible"
+
+	// CatalogVisibilityHidden means the class or plan is left out of the
+	// listings of svcat and new ServiceInstances of it are rejected.
+	CatalogVisibilityHidden CatalogVisibility = "Hidden"
+)
+
 // CommonServiceClassSpec represents details about a ServiceClass
 type CommonServiceClassSpec struct {
 	// ExternalName is the name of this object that the Service Broker
@@ -502,6 +819,11 @@ type CommonServiceClassSpec struct {
 	// provisioned.
 	PlanUpdatable bool `json:"planUpdatable"`
 
+	// AllowContextUpdates indicates whether the broker accepts update
+	// requests for instances of this ServiceClass that change only the
+	// context, such as the labels of the instance.
+	AllowContextUpdates bool `json:"allowContextUpdates,omitempty"`
+
 	// ExternalMetadata is a blob of information about the
 	// ServiceClass, meant to be user-facing content and display
 	// instructions. This field may contain platform-specific conventional
@@ -533,6 +855,15 @@ type CommonServiceClassSpec struct {
 	// plan and then instance-defined parameters taking precedence over the class
 	// defaults.
 	DefaultProvisionParameters *runtime.RawExtension `json:"defaultProvisionParameters,omitempty"`
+
+	// CatalogVisibility is whether this ServiceClass is offered to users.
+	// Platform admins can set it to Hidden to leave the class out of the
+	// listings of svcat and to reject new ServiceInstances of it, without
+	// changing the catalog restrictions of the broker. Existing
+	// ServiceInstances are not affected. Unlike the fields set from the
+	// broker's catalog, it is kept when the catalog is relisted.
+	// +optional
+	CatalogVisibility CatalogVisibility `json:"catalogVisibility,omitempty"`
 }
 
 // ClusterServiceClassSpec represents the details about a ClusterServiceClass
@@ -641,11 +972,45 @@ type CommonServicePlanSpec struct {
 	// may be supplied binding to a ServiceInstance on this plan.
 	ServiceBindingCreateParameterSchema *runtime.RawExtension `json:"serviceBindingCreateParameterSchema,omitempty"`
 
+	// MaintenanceInfo is the maintenance info the broker publishes for this
+	// plan in its catalog, as of version 2.15 of the OSB API.
+	// +optional
+	MaintenanceInfo *MaintenanceInfo `json:"maintenanceInfo,omitempty"`
+
 	// DefaultProvisionParameters are default parameters passed to the broker
 	// when an instance of this plan is provisioned. Any parameters defined on
 	// the instance are merged with these defaults, with instance-defined
 	// parameters taking precedence over defaults.
 	DefaultProvisionParameters *runtime.RawExtension `json:"defaultProvisionParameters,omitempty"`
+
+	// MaxBindings is the number of ServiceBindings each ServiceInstance of
+	// this plan may have. When unset, the limit the broker publishes in the
+	// plan's external metadata applies, if any. Unlike the fields set from
+	// the broker's catalog, it is kept when the catalog is relisted, so that
+	// it can be set by hand.
+	// +optional
+	MaxBindings *int32 `json:"maxBindings,omitempty"`
+
+	// CatalogVisibility is whether this ServicePlan is offered to users.
+	// Platform admins can set it to Hidden to leave the plan out of the
+	// listings of svcat and to reject new ServiceInstances of it, without
+	// changing the catalog restrictions of the broker. Existing
+	// ServiceInstances are not affected. Unlike the fields set from the
+	// broker's catalog, it is kept when the catalog is relisted.
+	// +optional
+	CatalogVisibility CatalogVisibility `json:"catalogVisibility,omitempty"`
+}
+
+// MaintenanceInfo identifies the version of the software a broker deploys
+// for a plan, as of version 2.15 of the OSB API.
+type MaintenanceInfo struct {
+	// Version is the version of the maintenance info, in the format of
+	// semantic versioning.
+	Version string `json:"version"`
+
+	// Description is a human readable description of the version.
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
 // ClusterServicePlanSpec represents details about a ClusterServicePlan.
@@ -674,8 +1039,49 @@ type CommonServicePlanStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the plan
 	// from its catalog.
 	RemovedFromBrokerCatalog bool `json:"removedFromBrokerCatalog"`
+
+	// Conditions is an array of ServicePlanConditions capturing aspects of
+	// the plan's status.
+	// +optional
+	Conditions []ServicePlanCondition `json:"conditions,omitempty"`
+
+	// ConflictingBrokers lists the other brokers whose catalogs offer a
+	// plan with the same external ID. Their plans are not reconciled
+	// while this plan belongs to a broker taking precedence over them.
+	// +optional
+	ConflictingBrokers []string `json:"conflictingBrokers,omitempty"`
+}
+
+// ServicePlanCondition contains condition information for a ServicePlan.
+type ServicePlanCondition struct {
+	// Type of the condition, currently ('Conflict').
+	Type ServicePlanConditionType `json:"type"`
+
+	// Status of the condition, one of ('True', 'False', 'Unknown').
+	Status ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	Reason string `json:"reason"`
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	Message string `json:"message"`
 }
 
+// ServicePlanConditionType represents a plan condition value.
+type ServicePlanConditionType string
+
+const (
+	// ServicePlanConditionConflict represents the fact that other brokers
+	// offer a plan with the same external ID as the plan.
+	ServicePlanConditionConflict ServicePlanConditionType = "Conflict"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ServicePlanList is a list of rServicePlans.
@@ -924,6 +1330,62 @@ type ServiceInstanceSpec struct {
 	// been made to the secrets from which the parameters are sourced.
 	// +optional
 	UpdateRequests int64 `json:"updateRequests"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// VerifyBinding requests that, once the instance is ready, the controller
+	// creates and immediately deletes a temporary binding with the broker to
+	// check that it issues working credentials. The outcome is reported in
+	// the Verified condition. It requires the BindingVerification feature.
+	// +optional
+	VerifyBinding bool `json:"verifyBinding,omitempty"`
+
+	// Description is a human readable description of the instance. It is
+	// sent to the broker as instance_description in the OSB context so that
+	// broker-side inventories can show something more meaningful than the
+	// instance's external ID.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// ContextLabels lists the keys of the instance's labels that are sent
+	// to the broker as instance_labels in the OSB context. Labels that are
+	// not set on the instance are left out.
+	// +optional
+	ContextLabels []string `json:"contextLabels,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// ContextNamespaceOverride is the namespace presented to the broker in
+	// the OSB context instead of the instance's own, so that automation can
+	// provision from a management namespace on behalf of a tenant. Only
+	// users allowed to create ServiceInstances in that namespace may set it.
+	// It requires the ContextNamespaceOverride feature.
+	//
+	// Immutable.
+	// +optional
+	ContextNamespaceOverride string `json:"contextNamespaceOverride,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// ReadinessPublish makes the controller mirror the readiness of the
+	// instance, along with connection hints that are not secret, into a
+	// ConfigMap of its namespace, so that applications can wait for the
+	// instance by mounting it instead of reading the catalog API. It
+	// requires the InstanceReadinessPublish feature.
+	// +optional
+	ReadinessPublish *ServiceInstanceReadinessPublish `json:"readinessPublish,omitempty"`
+}
+
+// ServiceInstanceReadinessPublish is where the readiness of a
+// ServiceInstance is published.
+type ServiceInstanceReadinessPublish struct {
+	// ConfigMapName is the name of the ConfigMap, in the namespace of the
+	// instance, the readiness is written to. The controller creates it, and
+	// does not write to a ConfigMap it does not control.
+	ConfigMapName string `json:"configMapName"`
 }
 
 // ServiceInstanceStatus represents the current status of an Instance.
@@ -968,6 +1430,43 @@ type ServiceInstanceStatus struct {
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time `json:"operationStartTime,omitempty"`
 
+	// OperationAttempts is the number of requests sent to the broker for the
+	// current or, once it has finished, the last operation. The operation
+	// fails once the attempts reach the budget set by the MaxAttempts
+	// annotation or, without it, by the controller.
+	// +optional
+	OperationAttempts int32 `json:"operationAttempts,omitempty"`
+
+	// LastAttemptTime is the time at which the last request of the current
+	// or last operation was sent to the broker, by the clock of the
+	// controller that sent it. The controller compares it with its own
+	// clock to detect skew between the clocks of the controllers.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// ProvisionQueuePosition is the position, starting at 1, of the
+	// ServiceInstance among those waiting to send their provision request
+	// while the controller already has as many provisions in flight as it
+	// allows. It is unset once the request may be sent.
+	// +optional
+	ProvisionQueuePosition int32 `json:"provisionQueuePosition,omitempty"`
+
+	// OperationTimeline lists the phases the current or, once it has
+	// finished, the last operation went through, oldest first. It is reset
+	// when a new operation starts and holds at most the latest
+	// ServiceInstanceOperationTimelineMaxLength entries.
+	// +optional
+	OperationTimeline []ServiceInstanceOperationTimelineEntry `json:"operationTimeline,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// OperationLease is held by the controller that is sending the current
+	// operation's request to the broker. It requires the OperationLease
+	// feature.
+	// +optional
+	OperationLease *ServiceInstanceOperationLease `json:"operationLease,omitempty"`
+
 	// InProgressProperties is the properties state of the ServiceInstance when
 	// a Provision, Update or Deprovision is in progress.
 	InProgressProperties *ServiceInstancePropertiesState `json:"inProgressProperties,omitempty"`
@@ -976,6 +1475,23 @@ type ServiceInstanceStatus struct {
 	// broker knows about.
 	ExternalProperties *ServiceInstancePropertiesState `json:"externalProperties,omitempty"`
 
+	// BrokerName is the name of the ClusterServiceBroker or ServiceBroker
+	// that the provision request of the ServiceInstance was sent to.
+	// +optional
+	BrokerName string `json:"brokerName,omitempty"`
+
+	// BrokerURL is the URL of the broker that the provision request of the
+	// ServiceInstance was sent to, as it was when the request was sent.
+	// +optional
+	BrokerURL string `json:"brokerURL,omitempty"`
+
+	// MaintenanceInfo is the maintenance info of the plan of the
+	// ServiceInstance when the broker last provisioned the ServiceInstance
+	// or moved it to another plan. It is unset if the plan published no
+	// maintenance info then.
+	// +optional
+	MaintenanceInfo *MaintenanceInfo `json:"maintenanceInfo,omitempty"`
+
 	// ProvisionStatus describes whether the instance is in the provisioned state.
 	ProvisionStatus ServiceInstanceProvisionStatus `json:"provisionStatus"`
 
@@ -991,6 +1507,12 @@ type ServiceInstanceStatus struct {
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
 
+	// PrintableStatus summarizes the status of the ServiceInstance in a
+	// single word. It is used for printing in a kubectl output via
+	// additionalPrinterColumns
+	// +optional
+	PrintableStatus ServiceInstancePrintableStatus `json:"printableStatus,omitempty"`
+
 	// UserSpecifiedPlanName aggregates cluster or namespace PlanName
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	UserSpecifiedPlanName string `json:"userSpecifiedPlanName"`
@@ -1000,6 +1522,90 @@ type ServiceInstanceStatus struct {
 	UserSpecifiedClassName string `json:"userSpecifiedClassName"`
 }
 
+// MaxAttemptsAnnotation is the annotation of a ServiceInstance or a
+// ServiceBinding that sets how many requests may be sent to the broker for
+// one of its operations before the operation is marked failed. It overrides
+// the budget set by the controller; "0" removes the limit.
+const MaxAttemptsAnnotation = GroupName + "/max-attempts"
+
+// CaptureRequestAnnotation is the annotation of a ServiceInstance that, set
+// to "true", makes the controller keep a redacted snapshot of the last
+// provision or update request it sent to the broker for the instance, when
+// the InstanceRequestSnapshots feature is enabled.
+const CaptureRequestAnnotation = GroupName + "/capture-request"
+
+// SkipContextAnnotation is the annotation of a ServiceInstance that, set to
+// "true", makes the controller send its provision and update requests
+// without an OSB context, when the DebugAnnotations feature is enabled.
+const SkipContextAnnotation = GroupName + "/skip-context"
+
+// SkipDefaultsAnnotation is the annotation of a ServiceInstance that, set to
+// "true", makes the controller provision it without applying the default
+// provisioning parameters of its class and plan, when the DebugAnnotations
+// feature is enabled.
+const SkipDefaultsAnnotation = GroupName + "/skip-defaults"
+
+// HoldUpdatesAnnotation is the annotation of a ServiceInstance that, set to
+// "true", makes the controller hold the update requests it would send to the
+// broker for the instance, for change-freeze windows. Changes to the spec are
+// still accepted, and sent once the annotation is removed. Deprovisioning is
+// not held.
+const HoldUpdatesAnnotation = GroupName + "/hold-updates"
+
+// ServiceInstanceOperationTimelineMaxLength is the number of entries kept in
+// a ServiceInstance's operation timeline.
+const ServiceInstanceOperationTimelineMaxLength = 10
+
+// ServiceInstanceOperationLease records which controller may send requests
+// to the broker for a ServiceInstance's current operation.
+type ServiceInstanceOperationLease struct {
+	// HolderIdentity identifies the controller holding the lease.
+	HolderIdentity string `json:"holderIdentity"`
+
+	// ExpireTime is when the lease expires unless the operation ends first.
+	ExpireTime metav1.Time `json:"expireTime"`
+}
+
+// ServiceInstanceOperationTimelineEntry records a phase an operation on a
+// ServiceInstance went through.
+type ServiceInstanceOperationTimelineEntry struct {
+	// Phase is the phase the operation entered.
+	Phase ServiceInstanceOperationPhase `json:"phase"`
+
+	// Time is when the operation entered the phase.
+	Time metav1.Time `json:"time"`
+
+	// Message is a human readable description of the phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceInstanceOperationPhase represents a phase of an operation on a
+// ServiceInstance.
+type ServiceInstanceOperationPhase string
+
+const (
+	// ServiceInstanceOperationPhaseValidated indicates that the operation's
+	// request has been built and is ready to be sent to the broker.
+	ServiceInstanceOperationPhaseValidated ServiceInstanceOperationPhase = "Validated"
+
+	// ServiceInstanceOperationPhaseRequestSent indicates that the request
+	// has been sent to the broker.
+	ServiceInstanceOperationPhaseRequestSent ServiceInstanceOperationPhase = "RequestSent"
+
+	// ServiceInstanceOperationPhasePolling indicates that the broker is
+	// processing the request asynchronously and is being polled.
+	ServiceInstanceOperationPhasePolling ServiceInstanceOperationPhase = "Polling"
+
+	// ServiceInstanceOperationPhaseSucceeded indicates that the operation
+	// completed successfully.
+	ServiceInstanceOperationPhaseSucceeded ServiceInstanceOperationPhase = "Succeeded"
+
+	// ServiceInstanceOperationPhaseFailed indicates that the operation
+	// failed and will not be retried.
+	ServiceInstanceOperationPhaseFailed ServiceInstanceOperationPhase = "Failed"
+)
+
 // ServiceInstanceCondition contains condition information about an Instance.
 type ServiceInstanceCondition struct {
 	// Type of the condition, currently ('Ready').
@@ -1036,6 +1642,21 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionVerified represents the outcome of the
+	// temporary binding made to check the credentials issued by the broker
+	// for an instance that requested binding verification.
+	ServiceInstanceConditionVerified ServiceInstanceConditionType = "Verified"
+
+	// ServiceInstanceConditionUpdatesHeld represents that changes to the
+	// spec of an instance are not sent to the broker because the instance
+	// is annotated to hold its updates.
+	ServiceInstanceConditionUpdatesHeld ServiceInstanceConditionType = "UpdatesHeld"
+
+	// ServiceInstanceConditionMaintenanceInfoConflict represents that the
+	// broker rejected the last provision or update request of an instance
+	// because of a conflict with the maintenance info of its catalog.
+	ServiceInstanceConditionMaintenanceInfoConflict ServiceInstanceConditionType = "MaintenanceInfoConflict"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -1054,6 +1675,32 @@ const (
 	ServiceInstanceOperationDeprovision ServiceInstanceOperation = "Deprovision"
 )
 
+// ServiceInstancePrintableStatus is a single word summary of the status of a
+// ServiceInstance.
+type ServiceInstancePrintableStatus string
+
+const (
+	// ServiceInstancePrintableStatusProvisioning indicates that the
+	// ServiceInstance has not been provisioned yet.
+	ServiceInstancePrintableStatusProvisioning ServiceInstancePrintableStatus = "Provisioning"
+	// ServiceInstancePrintableStatusReady indicates that the ServiceInstance
+	// is provisioned and ready to use.
+	ServiceInstancePrintableStatusReady ServiceInstancePrintableStatus = "Ready"
+	// ServiceInstancePrintableStatusUpdating indicates that changes to a
+	// provisioned ServiceInstance have not been applied yet.
+	ServiceInstancePrintableStatusUpdating ServiceInstancePrintableStatus = "Updating"
+	// ServiceInstancePrintableStatusFailed indicates that the last operation
+	// of the ServiceInstance failed and will not be retried.
+	ServiceInstancePrintableStatusFailed ServiceInstancePrintableStatus = "Failed"
+	// ServiceInstancePrintableStatusDeprovisioning indicates that the
+	// ServiceInstance is being deleted.
+	ServiceInstancePrintableStatusDeprovisioning ServiceInstancePrintableStatus = "Deprovisioning"
+	// ServiceInstancePrintableStatusOrphanMitigation indicates that the
+	// controller is deprovisioning a ServiceInstance whose provisioning
+	// failed, to clean up the resources the broker may have created.
+	ServiceInstancePrintableStatusOrphanMitigation ServiceInstancePrintableStatus = "OrphanMitigation"
+)
+
 // ServiceInstancePropertiesState is the state of a ServiceInstance that
 // the ClusterServiceBroker knows about.
 type ServiceInstancePropertiesState struct {
@@ -1083,6 +1730,9 @@ type ServiceInstancePropertiesState struct {
 	// ParameterChecksum is the checksum of the parameters that were sent.
 	ParameterChecksum string `json:"parameterChecksum,omitempty"`
 
+	// ContextChecksum is the checksum of the context that was sent.
+	ContextChecksum string `json:"contextChecksum,omitempty"`
+
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
 }
@@ -1196,6 +1846,38 @@ type ServiceBindingSpec struct {
 	// associated with the ServiceBinding before they are inserted into the Secret.
 	SecretTransforms []SecretTransform `json:"secretTransforms,omitempty"`
 
+	// SecretType is the type of the secret named by SecretName, such as
+	// kubernetes.io/basic-auth or kubernetes.io/tls, so that the secret can be
+	// consumed by controllers that require a specific type. The credentials,
+	// after SecretTransforms are applied, must hold the keys the type
+	// requires. Defaults to Opaque.
+	// +optional
+	SecretType string `json:"secretType,omitempty"`
+
+	// AdditionalSecretTargets is a list of further secrets in the
+	// ServiceBinding's namespace that will hold the credentials associated
+	// with the ServiceBinding. Each target applies its own transformations
+	// to the credentials returned by the broker, independently of
+	// SecretTransforms.
+	// +optional
+	AdditionalSecretTargets []SecretTarget `json:"additionalSecretTargets,omitempty"`
+
+	// SecretConflictPolicy decides what happens when the secret named by
+	// SecretName already exists and is not owned by this ServiceBinding.
+	// Defaults to Fail.
+	// +optional
+	SecretConflictPolicy SecretConflictPolicy `json:"secretConflictPolicy,omitempty"`
+
+	// SecretTemplate is the name of a secret template configured in the
+	// controller manager. When it is set, the credentials associated with
+	// the ServiceBinding are delivered through the objects rendered from
+	// the template, such as an ExternalSecret or a PushSecret handled by
+	// an external secret store operator, instead of the secret named by
+	// SecretName. SecretTransforms are applied to the credentials before
+	// the template is rendered.
+	// +optional
+	SecretTemplate string `json:"secretTemplate,omitempty"`
+
 	// ExternalID is the identity of this object for use with the OSB API.
 	//
 	// Immutable.
@@ -1210,8 +1892,68 @@ type ServiceBindingSpec struct {
 	// settable by the end-user. User-provided values for this field are not saved.
 	// +optional
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
+
+	// UpdateRequests is a strictly increasing, non-negative integer counter that
+	// can be manually incremented by a user to renew the binding. The binding
+	// is deleted at the broker and bound again, and the new credentials are
+	// written to its secrets. It is the only field of the spec that may be
+	// changed once the ServiceBinding is created.
+	// +optional
+	UpdateRequests int64 `json:"updateRequests,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// AdoptExisting records the ServiceBinding as ready without sending a
+	// bind request, for a binding created at the broker outside of Service
+	// Catalog whose credentials are already in the secret named by
+	// SecretName. ExternalID must be the ID of that binding at the broker,
+	// so that it is unbound when the ServiceBinding is deleted. Requires the
+	// BindingAdoption feature.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
 }
 
+// SecretTarget is an additional secret a ServiceBinding writes its
+// credentials to.
+type SecretTarget struct {
+	// SecretName is the name of the secret to create in the ServiceBinding's
+	// namespace. It must differ from the ServiceBinding's SecretName and from
+	// the names of the other targets.
+	SecretName string `json:"secretName"`
+
+	// List of transformations that should be applied to the credentials
+	// associated with the ServiceBinding before they are inserted into this
+	// Secret.
+	// +optional
+	SecretTransforms []SecretTransform `json:"secretTransforms,omitempty"`
+
+	// SecretType is the type of this Secret. Defaults to Opaque.
+	// +optional
+	SecretType string `json:"secretType,omitempty"`
+}
+
+// SecretConflictPolicy is the policy applied by the controller when the
+// secret a ServiceBinding should write its credentials to already exists and
+// is not owned by the ServiceBinding.
+type SecretConflictPolicy string
+
+const (
+	// SecretConflictPolicyFail leaves the existing secret untouched and fails
+	// the injection of the credentials.
+	SecretConflictPolicyFail SecretConflictPolicy = "Fail"
+
+	// SecretConflictPolicyAdopt makes the ServiceBinding the controller of
+	// an existing secret that has no controller and writes the credentials
+	// to it. A secret controlled by another object is left untouched.
+	SecretConflictPolicyAdopt SecretConflictPolicy = "Adopt"
+
+	// SecretConflictPolicyOverwrite makes the ServiceBinding the controller
+	// of an existing secret, replacing any other controller, and writes the
+	// credentials to it.
+	SecretConflictPolicyOverwrite SecretConflictPolicy = "Overwrite"
+)
+
 // ServiceBindingStatus represents the current status of a ServiceBinding.
 type ServiceBindingStatus struct {
 	Conditions []ServiceBindingCondition `json:"conditions"`
@@ -1231,6 +1973,13 @@ type ServiceBindingStatus struct {
 	// on poll requests as a query param.
 	LastOperation *string `json:"lastOperation,omitempty"`
 
+	// LastOperationKey is the operation key the broker returned for the
+	// last asynchronous bind or unbind of the ServiceBinding. Unlike
+	// LastOperation, it is kept once the operation has finished, so that
+	// the operation can be found in the logs of the broker.
+	// +optional
+	LastOperationKey string `json:"lastOperationKey,omitempty"`
+
 	// CurrentOperation is the operation the Controller is currently performing
 	// on the ServiceBinding.
 	CurrentOperation ServiceBindingOperation `json:"currentOperation,omitempty"`
@@ -1244,6 +1993,20 @@ type ServiceBindingStatus struct {
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time `json:"operationStartTime,omitempty"`
 
+	// OperationAttempts is the number of requests sent to the broker for the
+	// current or, once it has finished, the last operation. The operation
+	// fails once the attempts reach the budget set by the MaxAttempts
+	// annotation or, without it, by the controller.
+	// +optional
+	OperationAttempts int32 `json:"operationAttempts,omitempty"`
+
+	// LastAttemptTime is the time at which the last request of the current
+	// or last operation was sent to the broker, by the clock of the
+	// controller that sent it. The controller compares it with its own
+	// clock to detect skew between the clocks of the controllers.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
 	// InProgressProperties is the properties state of the
 	// ServiceBinding when a Bind is in progress. If the current
 	// operation is an Unbind, this will be nil.
@@ -1263,6 +2026,11 @@ type ServiceBindingStatus struct {
 	// LastConditionState aggregates state from the Conditions array
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
+
+	// LastCredentialsRotationTime is the time at which the credentials of
+	// the ServiceBinding were last written to its secrets.
+	// +optional
+	LastCredentialsRotationTime *metav1.Time `json:"lastCredentialsRotationTime,omitempty"`
 }
 
 // ServiceBindingCondition condition information for a ServiceBinding.
@@ -1358,6 +2126,47 @@ type ParametersFromSource struct {
 	// The value must be a JSON object.
 	// +optional
 	SecretKeyRef *SecretKeyReference `json:"secretKeyRef,omitempty"`
+
+	// Currently, this field is ALPHA: it may change or disappear at any time
+	// and its data will not be migrated.
+	//
+	// The parameters plugin to fetch the parameters from, such as one that
+	// looks them up in an external secret manager. The plugin must return a
+	// JSON object. Requires the ParametersPlugins feature.
+	// +optional
+	PluginRef *ParametersPluginReference `json:"pluginRef,omitempty"`
+
+	// Coerce maps the names of top-level parameters from this source to the
+	// type their values are converted to before they are sent to the broker,
+	// so that a secret holding {"port": "5432"} can provide the integer 5432.
+	// Parameters that are missing from the source are ignored.
+	// +optional
+	Coerce map[string]ParameterType `json:"coerce,omitempty"`
+}
+
+// ParameterType is the type a parameter from a ParametersFromSource is
+// converted to.
+type ParameterType string
+
+const (
+	// ParameterTypeInteger converts a string such as "5432" to an integer.
+	ParameterTypeInteger ParameterType = "integer"
+
+	// ParameterTypeNumber converts a string such as "0.5" to a number.
+	ParameterTypeNumber ParameterType = "number"
+
+	// ParameterTypeBoolean converts a string such as "true" to a boolean.
+	ParameterTypeBoolean ParameterType = "boolean"
+)
+
+// ParametersPluginReference references a value provided by a parameters
+// plugin installed alongside the controller manager.
+type ParametersPluginReference struct {
+	// The name of the plugin to fetch the parameters from.
+	Name string `json:"name"`
+	// The key identifying the parameters within the plugin, such as the path
+	// of a secret in an external secret manager.
+	Key string `json:"key"`
 }
 
 // SecretKeyReference references a key of a Secret.
@@ -120,6 +120,53 @@ func (in *BearerTokenAuthConfig) DeepCopy() *BearerTokenAuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerRequestRateLimit) DeepCopyInto(out *BrokerRequestRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerRequestRateLimit.
+func (in *BrokerRequestRateLimit) DeepCopy() *BrokerRequestRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerRequestRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerRetryPolicy) DeepCopyInto(out *BrokerRetryPolicy) {
+	*out = *in
+	if in.InitialInterval != nil {
+		in, out := &in.InitialInterval, &out.InitialInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxElapsedTime != nil {
+		in, out := &in.MaxElapsedTime, &out.MaxElapsedTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerRetryPolicy.
+func (in *BrokerRetryPolicy) DeepCopy() *BrokerRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatalogRestrictions) DeepCopyInto(out *CatalogRestrictions) {
 	*out = *in
@@ -146,6 +193,53 @@ func (in *CatalogRestrictions) DeepCopy() *CatalogRestrictions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogSource) DeepCopyInto(out *CatalogSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogSource.
+func (in *CatalogSource) DeepCopy() *CatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogSummary) DeepCopyInto(out *CatalogSummary) {
+	*out = *in
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogSummary.
+func (in *CatalogSummary) DeepCopy() *CatalogSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterBasicAuthConfig) DeepCopyInto(out *ClusterBasicAuthConfig) {
 	*out = *in
@@ -336,7 +430,7 @@ func (in *ClusterServiceClass) DeepCopyInto(out *ClusterServiceClass) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -411,7 +505,7 @@ func (in *ClusterServiceClassSpec) DeepCopy() *ClusterServiceClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterServiceClassStatus) DeepCopyInto(out *ClusterServiceClassStatus) {
 	*out = *in
-	out.CommonServiceClassStatus = in.CommonServiceClassStatus
+	in.CommonServiceClassStatus.DeepCopyInto(&out.CommonServiceClassStatus)
 	return
 }
 
@@ -431,7 +525,7 @@ func (in *ClusterServicePlan) DeepCopyInto(out *ClusterServicePlan) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -507,7 +601,7 @@ func (in *ClusterServicePlanSpec) DeepCopy() *ClusterServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterServicePlanStatus) DeepCopyInto(out *ClusterServicePlanStatus) {
 	*out = *in
-	out.CommonServicePlanStatus = in.CommonServicePlanStatus
+	in.CommonServicePlanStatus.DeepCopyInto(&out.CommonServicePlanStatus)
 	return
 }
 
@@ -521,6 +615,22 @@ func (in *ClusterServicePlanStatus) DeepCopy() *ClusterServicePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonServiceBrokerSpec) DeepCopyInto(out *CommonServiceBrokerSpec) {
 	*out = *in
@@ -529,6 +639,11 @@ func (in *CommonServiceBrokerSpec) DeepCopyInto(out *CommonServiceBrokerSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(ServiceBrokerTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RelistDuration != nil {
 		in, out := &in.RelistDuration, &out.RelistDuration
 		*out = new(v1.Duration)
@@ -539,6 +654,33 @@ func (in *CommonServiceBrokerSpec) DeepCopyInto(out *CommonServiceBrokerSpec) {
 		*out = new(CatalogRestrictions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BindingRequestTimeout != nil {
+		in, out := &in.BindingRequestTimeout, &out.BindingRequestTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestRateLimit != nil {
+		in, out := &in.RequestRateLimit, &out.RequestRateLimit
+		*out = new(BrokerRequestRateLimit)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(BrokerRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogSource != nil {
+		in, out := &in.CatalogSource, &out.CatalogSource
+		*out = new(CatalogSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -570,6 +712,11 @@ func (in *CommonServiceBrokerStatus) DeepCopyInto(out *CommonServiceBrokerStatus
 		in, out := &in.LastCatalogRetrievalTime, &out.LastCatalogRetrievalTime
 		*out = (*in).DeepCopy()
 	}
+	if in.CatalogSummary != nil {
+		in, out := &in.CatalogSummary, &out.CatalogSummary
+		*out = new(CatalogSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -622,6 +769,18 @@ func (in *CommonServiceClassSpec) DeepCopy() *CommonServiceClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonServiceClassStatus) DeepCopyInto(out *CommonServiceClassStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ServiceClassCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConflictingBrokers != nil {
+		in, out := &in.ConflictingBrokers, &out.ConflictingBrokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -663,11 +822,21 @@ func (in *CommonServicePlanSpec) DeepCopyInto(out *CommonServicePlanSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceInfo != nil {
+		in, out := &in.MaintenanceInfo, &out.MaintenanceInfo
+		*out = new(MaintenanceInfo)
+		**out = **in
+	}
 	if in.DefaultProvisionParameters != nil {
 		in, out := &in.DefaultProvisionParameters, &out.DefaultProvisionParameters
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxBindings != nil {
+		in, out := &in.MaxBindings, &out.MaxBindings
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -684,6 +853,18 @@ func (in *CommonServicePlanSpec) DeepCopy() *CommonServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonServicePlanStatus) DeepCopyInto(out *CommonServicePlanStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ServicePlanCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConflictingBrokers != nil {
+		in, out := &in.ConflictingBrokers, &out.ConflictingBrokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -733,6 +914,44 @@ func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceInfo) DeepCopyInto(out *MaintenanceInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceInfo.
+func (in *MaintenanceInfo) DeepCopy() *MaintenanceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.DeferredOperations != nil {
+		in, out := &in.DeferredOperations, &out.DeferredOperations
+		*out = make([]MaintenanceOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
 	*out = *in
@@ -757,6 +976,18 @@ func (in *ParametersFromSource) DeepCopyInto(out *ParametersFromSource) {
 		*out = new(SecretKeyReference)
 		**out = **in
 	}
+	if in.PluginRef != nil {
+		in, out := &in.PluginRef, &out.PluginRef
+		*out = new(ParametersPluginReference)
+		**out = **in
+	}
+	if in.Coerce != nil {
+		in, out := &in.Coerce, &out.Coerce
+		*out = make(map[string]ParameterType, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -770,6 +1001,22 @@ func (in *ParametersFromSource) DeepCopy() *ParametersFromSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersPluginReference) DeepCopyInto(out *ParametersPluginReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersPluginReference.
+func (in *ParametersPluginReference) DeepCopy() *ParametersPluginReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersPluginReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlanReference) DeepCopyInto(out *PlanReference) {
 	*out = *in
@@ -834,6 +1081,29 @@ func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretTarget) DeepCopyInto(out *SecretTarget) {
+	*out = *in
+	if in.SecretTransforms != nil {
+		in, out := &in.SecretTransforms, &out.SecretTransforms
+		*out = make([]SecretTransform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTarget.
+func (in *SecretTarget) DeepCopy() *SecretTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretTransform) DeepCopyInto(out *SecretTransform) {
 	*out = *in
@@ -870,6 +1140,27 @@ func (in *SecretTransform) DeepCopy() *SecretTransform {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenAuthConfig) DeepCopyInto(out *ServiceAccountTokenAuthConfig) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenAuthConfig.
+func (in *ServiceAccountTokenAuthConfig) DeepCopy() *ServiceAccountTokenAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
 	*out = *in
@@ -997,6 +1288,13 @@ func (in *ServiceBindingSpec) DeepCopyInto(out *ServiceBindingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AdditionalSecretTargets != nil {
+		in, out := &in.AdditionalSecretTargets, &out.AdditionalSecretTargets
+		*out = make([]SecretTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.UserInfo != nil {
 		in, out := &in.UserInfo, &out.UserInfo
 		*out = new(UserInfo)
@@ -1034,6 +1332,10 @@ func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 		in, out := &in.OperationStartTime, &out.OperationStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
 	if in.InProgressProperties != nil {
 		in, out := &in.InProgressProperties, &out.InProgressProperties
 		*out = new(ServiceBindingPropertiesState)
@@ -1044,6 +1346,10 @@ func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 		*out = new(ServiceBindingPropertiesState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastCredentialsRotationTime != nil {
+		in, out := &in.LastCredentialsRotationTime, &out.LastCredentialsRotationTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1098,6 +1404,11 @@ func (in *ServiceBrokerAuthInfo) DeepCopyInto(out *ServiceBrokerAuthInfo) {
 		*out = new(BearerTokenAuthConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountTokenAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1183,6 +1494,37 @@ func (in *ServiceBrokerSpec) DeepCopy() *ServiceBrokerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBrokerTLSConfig) DeepCopyInto(out *ServiceBrokerTLSConfig) {
+	*out = *in
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerDNSNames != nil {
+		in, out := &in.ServerDNSNames, &out.ServerDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerSPIFFEIDs != nil {
+		in, out := &in.ServerSPIFFEIDs, &out.ServerSPIFFEIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerTLSConfig.
+func (in *ServiceBrokerTLSConfig) DeepCopy() *ServiceBrokerTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBrokerTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBrokerStatus) DeepCopyInto(out *ServiceBrokerStatus) {
 	*out = *in
@@ -1206,7 +1548,7 @@ func (in *ServiceClass) DeepCopyInto(out *ServiceClass) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1228,6 +1570,23 @@ func (in *ServiceClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClassCondition) DeepCopyInto(out *ServiceClassCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClassCondition.
+func (in *ServiceClassCondition) DeepCopy() *ServiceClassCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClassCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClassList) DeepCopyInto(out *ServiceClassList) {
 	*out = *in
@@ -1281,7 +1640,7 @@ func (in *ServiceClassSpec) DeepCopy() *ServiceClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClassStatus) DeepCopyInto(out *ServiceClassStatus) {
 	*out = *in
-	out.CommonServiceClassStatus = in.CommonServiceClassStatus
+	in.CommonServiceClassStatus.DeepCopyInto(&out.CommonServiceClassStatus)
 	return
 }
 
@@ -1373,6 +1732,40 @@ func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceOperationLease) DeepCopyInto(out *ServiceInstanceOperationLease) {
+	*out = *in
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceOperationLease.
+func (in *ServiceInstanceOperationLease) DeepCopy() *ServiceInstanceOperationLease {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstanceOperationLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceOperationTimelineEntry) DeepCopyInto(out *ServiceInstanceOperationTimelineEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceOperationTimelineEntry.
+func (in *ServiceInstanceOperationTimelineEntry) DeepCopy() *ServiceInstanceOperationTimelineEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstanceOperationTimelineEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstancePropertiesState) DeepCopyInto(out *ServiceInstancePropertiesState) {
 	*out = *in
@@ -1399,6 +1792,22 @@ func (in *ServiceInstancePropertiesState) DeepCopy() *ServiceInstancePropertiesS
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceReadinessPublish) DeepCopyInto(out *ServiceInstanceReadinessPublish) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceReadinessPublish.
+func (in *ServiceInstanceReadinessPublish) DeepCopy() *ServiceInstanceReadinessPublish {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstanceReadinessPublish)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 	*out = *in
@@ -1440,6 +1849,16 @@ func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 		*out = new(UserInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ContextLabels != nil {
+		in, out := &in.ContextLabels, &out.ContextLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadinessPublish != nil {
+		in, out := &in.ReadinessPublish, &out.ReadinessPublish
+		*out = new(ServiceInstanceReadinessPublish)
+		**out = **in
+	}
 	return
 }
 
@@ -1477,6 +1896,22 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 		in, out := &in.OperationStartTime, &out.OperationStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.OperationTimeline != nil {
+		in, out := &in.OperationTimeline, &out.OperationTimeline
+		*out = make([]ServiceInstanceOperationTimelineEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OperationLease != nil {
+		in, out := &in.OperationLease, &out.OperationLease
+		*out = new(ServiceInstanceOperationLease)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InProgressProperties != nil {
 		in, out := &in.InProgressProperties, &out.InProgressProperties
 		*out = new(ServiceInstancePropertiesState)
@@ -1487,6 +1922,11 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 		*out = new(ServiceInstancePropertiesState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceInfo != nil {
+		in, out := &in.MaintenanceInfo, &out.MaintenanceInfo
+		*out = new(MaintenanceInfo)
+		**out = **in
+	}
 	if in.DefaultProvisionParameters != nil {
 		in, out := &in.DefaultProvisionParameters, &out.DefaultProvisionParameters
 		*out = new(runtime.RawExtension)
@@ -1511,7 +1951,7 @@ func (in *ServicePlan) DeepCopyInto(out *ServicePlan) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1533,6 +1973,23 @@ func (in *ServicePlan) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanCondition) DeepCopyInto(out *ServicePlanCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanCondition.
+func (in *ServicePlanCondition) DeepCopy() *ServicePlanCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServicePlanList) DeepCopyInto(out *ServicePlanList) {
 	*out = *in
@@ -1587,7 +2044,7 @@ func (in *ServicePlanSpec) DeepCopy() *ServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServicePlanStatus) DeepCopyInto(out *ServicePlanStatus) {
 	*out = *in
-	out.CommonServicePlanStatus = in.CommonServicePlanStatus
+	in.CommonServicePlanStatus.DeepCopyInto(&out.CommonServicePlanStatus)
 	return
 }
 
@@ -19,7 +19,9 @@ package validation
 import (
 	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"sigs.k8s.io/yaml"
@@ -61,6 +63,22 @@ var validServiceBindingUnbindStatusValues = func() []string {
 	return validValues
 }()
 
+var validSecretConflictPolicies = map[sc.SecretConflictPolicy]bool{
+	sc.SecretConflictPolicyFail:      true,
+	sc.SecretConflictPolicyAdopt:     true,
+	sc.SecretConflictPolicyOverwrite: true,
+}
+
+var validSecretConflictPolicyValues = func() []string {
+	validValues := make([]string, len(validSecretConflictPolicies))
+	i := 0
+	for policy := range validSecretConflictPolicies {
+		validValues[i] = string(policy)
+		i++
+	}
+	return validValues
+}()
+
 // ValidateServiceBinding validates a ServiceBinding and returns a list of errors.
 func ValidateServiceBinding(binding *sc.ServiceBinding) field.ErrorList {
 	return internalValidateServiceBinding(binding, true)
@@ -91,10 +109,74 @@ func validateServiceBindingSpec(spec *sc.ServiceBindingSpec, fldPath *field.Path
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("secretName"), spec.SecretName, msg))
 	}
 
+	secretNames := map[string]bool{spec.SecretName: true}
+	for i, target := range spec.AdditionalSecretTargets {
+		targetPath := fldPath.Child("additionalSecretTargets").Index(i).Child("secretName")
+		for _, msg := range apivalidation.NameIsDNSSubdomain(target.SecretName, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(targetPath, target.SecretName, msg))
+		}
+		if secretNames[target.SecretName] {
+			allErrs = append(allErrs, field.Duplicate(targetPath, target.SecretName))
+		}
+		secretNames[target.SecretName] = true
+		allErrs = append(allErrs, validateSecretType(target.SecretType, fldPath.Child("additionalSecretTargets").Index(i).Child("secretType"))...)
+	}
+
+	allErrs = append(allErrs, validateSecretType(spec.SecretType, fldPath.Child("secretType"))...)
+
+	if spec.SecretConflictPolicy != "" && !validSecretConflictPolicies[spec.SecretConflictPolicy] {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("secretConflictPolicy"), spec.SecretConflictPolicy, validSecretConflictPolicyValues))
+	}
+
+	if spec.SecretTemplate != "" {
+		for _, msg := range apivalidation.NameIsDNSLabel(spec.SecretTemplate, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secretTemplate"), spec.SecretTemplate, msg))
+		}
+		if len(spec.AdditionalSecretTargets) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalSecretTargets"), "additionalSecretTargets must not be present when secretTemplate is set"))
+		}
+		if spec.SecretType != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretType"), "secretType must not be present when secretTemplate is set"))
+		}
+	}
+
 	if spec.ParametersFrom != nil {
 		allErrs = append(allErrs, validateParametersFromSource(spec.ParametersFrom, fldPath)...)
 	}
 
+	// An adopted binding already exists at the broker, and its credentials
+	// are only in the secret named by secretName.
+	if spec.AdoptExisting {
+		if spec.ExternalID == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("externalID"), "externalID is required when adoptExisting is set"))
+		}
+		if spec.SecretTemplate != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretTemplate"), "secretTemplate must not be present when adoptExisting is set"))
+		}
+		if len(spec.AdditionalSecretTargets) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalSecretTargets"), "additionalSecretTargets must not be present when adoptExisting is set"))
+		}
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.UpdateRequests, fldPath.Child("updateRequests"))...)
+
+	return allErrs
+}
+
+// validateSecretType validates the type of a secret a ServiceBinding writes
+// its credentials to. Service account tokens are populated by Kubernetes
+// itself, so the credentials cannot be written to one.
+func validateSecretType(secretType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if secretType == "" {
+		return allErrs
+	}
+	for _, msg := range utilvalidation.IsQualifiedName(secretType) {
+		allErrs = append(allErrs, field.Invalid(fldPath, secretType, msg))
+	}
+	if secretType == string(corev1.SecretTypeServiceAccountToken) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "secretType must not be "+secretType))
+	}
 	return allErrs
 }
 
@@ -231,6 +313,10 @@ func internalValidateServiceBindingUpdateAllowed(new *sc.ServiceBinding, old *sc
 		}
 	}
 
+	if new.Spec.UpdateRequests < old.Spec.UpdateRequests {
+		errors = append(errors, field.Invalid(field.NewPath("spec").Child("updateRequests"), new.Spec.UpdateRequests, "new updateRequests value must not be less than the old one"))
+	}
+
 	return errors
 }
 
@@ -17,13 +17,16 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	servicecatalog "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
 )
 
 func validServiceBinding() *servicecatalog.ServiceBinding {
@@ -128,6 +131,195 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid additionalSecretTargets",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{
+					{SecretName: "test-secret-env"},
+					{
+						SecretName: "test-secret-url",
+						SecretTransforms: []servicecatalog.SecretTransform{
+							{RemoveKey: &servicecatalog.RemoveKeyTransform{Key: "password"}},
+						},
+					},
+				}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid additionalSecretTargets secretName",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{{SecretName: "T_T"}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "additionalSecretTargets secretName duplicates secretName",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{{SecretName: b.Spec.SecretName}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "duplicate additionalSecretTargets secretName",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{
+					{SecretName: "test-secret-env"},
+					{SecretName: "test-secret-env"},
+				}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid secretConflictPolicy",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretConflictPolicy = servicecatalog.SecretConflictPolicyAdopt
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "valid secretTemplate",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretTemplate = "external-secret"
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid secretTemplate",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretTemplate = "external.secret"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "secretTemplate with additionalSecretTargets",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretTemplate = "external-secret"
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{{SecretName: "test-secret-env"}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid secretType",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretType = "kubernetes.io/basic-auth"
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "valid custom secretType",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretType = "example.com/database"
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid secretType",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretType = "example.com/data base"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "service account token secretType",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretType = "kubernetes.io/service-account-token"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid additionalSecretTargets secretType",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{{SecretName: "test-secret-env", SecretType: "kubernetes.io/service-account-token"}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "secretTemplate with secretType",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretTemplate = "external-secret"
+				b.Spec.SecretType = "kubernetes.io/tls"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid adoptExisting",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdoptExisting = true
+				b.Spec.ExternalID = "manual-binding"
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "adoptExisting without externalID",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdoptExisting = true
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "adoptExisting with secretTemplate",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdoptExisting = true
+				b.Spec.ExternalID = "manual-binding"
+				b.Spec.SecretTemplate = "external-secret"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "adoptExisting with additionalSecretTargets",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdoptExisting = true
+				b.Spec.ExternalID = "manual-binding"
+				b.Spec.AdditionalSecretTargets = []servicecatalog.SecretTarget{{SecretName: "test-secret-env"}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid secretConflictPolicy",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretConflictPolicy = "Merge"
+				return b
+			}(),
+			valid: false,
+		},
 		{
 			name: "valid parametersFrom",
 			binding: func() *servicecatalog.ServiceBinding {
@@ -171,6 +363,56 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "pluginRef in parametersFrom without ParametersPlugins feature",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{PluginRef: &servicecatalog.ParametersPluginReference{Name: "vault", Key: "db"}}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "coerce in parametersFrom",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{{
+						SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+						Coerce:       map[string]servicecatalog.ParameterType{"port": servicecatalog.ParameterTypeInteger},
+					}}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "unknown type in parametersFrom coerce",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{{
+						SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+						Coerce:       map[string]servicecatalog.ParameterType{"port": "int"},
+					}}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "both secretKeyRef and pluginRef in parametersFrom",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{{
+						SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+						PluginRef:    &servicecatalog.ParametersPluginReference{Name: "vault", Key: "db"},
+					}}
+				return b
+			}(),
+			valid: false,
+		},
 
 		{
 			name:    "valid with in-progress bind",
@@ -573,6 +815,24 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: true,
 		},
+		{
+			name: "valid updateRequests",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.UpdateRequests = 1
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "negative updateRequests",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.UpdateRequests = -1
+				return b
+			}(),
+			valid: false,
+		},
 		{
 			name: "LastOperation too long",
 			binding: func() *servicecatalog.ServiceBinding {
@@ -597,6 +857,53 @@ func TestValidateServiceBinding(t *testing.T) {
 	}
 }
 
+func TestValidateServiceBindingParametersPluginRef(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ParametersPlugins)); err != nil {
+		t.Fatalf("Failed to enable ParametersPlugins feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ParametersPlugins))
+
+	cases := []struct {
+		name      string
+		pluginRef *servicecatalog.ParametersPluginReference
+		valid     bool
+	}{
+		{
+			name:      "valid",
+			pluginRef: &servicecatalog.ParametersPluginReference{Name: "vault", Key: "db/password"},
+			valid:     true,
+		},
+		{
+			name:      "missing name",
+			pluginRef: &servicecatalog.ParametersPluginReference{Key: "db/password"},
+			valid:     false,
+		},
+		{
+			name:      "name is not a DNS label",
+			pluginRef: &servicecatalog.ParametersPluginReference{Name: "../vault", Key: "db/password"},
+			valid:     false,
+		},
+		{
+			name:      "missing key",
+			pluginRef: &servicecatalog.ParametersPluginReference{Name: "vault"},
+			valid:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := validServiceBinding()
+			b.Spec.ParametersFrom = []servicecatalog.ParametersFromSource{{PluginRef: tc.pluginRef}}
+			errs := internalValidateServiceBinding(b, false)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
+
 func TestInternalValidateServiceBindingUpdateAllowed(t *testing.T) {
 	cases := []struct {
 		name              string
@@ -657,3 +964,47 @@ func TestInternalValidateServiceBindingUpdateAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestInternalValidateServiceBindingUpdateAllowedUpdateRequests(t *testing.T) {
+	cases := []struct {
+		name              string
+		oldUpdateRequests int64
+		newUpdateRequests int64
+		valid             bool
+	}{
+		{
+			name:              "updateRequests incremented",
+			oldUpdateRequests: 1,
+			newUpdateRequests: 2,
+			valid:             true,
+		},
+		{
+			name:              "updateRequests unchanged",
+			oldUpdateRequests: 1,
+			newUpdateRequests: 1,
+			valid:             true,
+		},
+		{
+			name:              "updateRequests decremented",
+			oldUpdateRequests: 2,
+			newUpdateRequests: 1,
+			valid:             false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldBinding := validServiceBinding()
+			oldBinding.Spec.UpdateRequests = tc.oldUpdateRequests
+			newBinding := validServiceBinding()
+			newBinding.Spec.UpdateRequests = tc.newUpdateRequests
+
+			errs := internalValidateServiceBindingUpdateAllowed(newBinding, oldBinding)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
@@ -18,19 +18,47 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/catalogsource"
 	"github.com/drycc-addons/service-catalog/pkg/filter"
+	"github.com/drycc-addons/service-catalog/pkg/util/cron"
+	"github.com/drycc-addons/service-catalog/pkg/util/tlsconfig"
 )
 
 // validateCommonServiceBrokerName is the validation function for common
 // broker names.
 var validateCommonServiceBrokerName = apivalidation.NameIsDNSSubdomain
 
+// maxMaintenanceWindowDuration is the longest a single broker maintenance
+// window may last.
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// minServiceAccountTokenExpirationSeconds is the shortest lifetime of a token
+// the TokenRequest API issues.
+const minServiceAccountTokenExpirationSeconds = 600
+
+var validMaintenanceOperations = map[sc.MaintenanceOperation]bool{
+	sc.MaintenanceOperationRelist:    true,
+	sc.MaintenanceOperationUpdate:    true,
+	sc.MaintenanceOperationProvision: true,
+}
+
+var validMaintenanceOperationValues = []string{
+	string(sc.MaintenanceOperationRelist),
+	string(sc.MaintenanceOperationUpdate),
+	string(sc.MaintenanceOperationProvision),
+}
+
 // ValidateClusterServiceBroker implements the validation rules for a
 // ClusterServiceBroker.
 func ValidateClusterServiceBroker(broker *sc.ClusterServiceBroker) field.ErrorList {
@@ -143,6 +171,8 @@ func validateServiceBrokerSpec(spec *sc.ServiceBrokerSpec, fldPath *field.Path)
 					field.Required(fldPath.Child("authInfo", "bearer", "secretRef"), "a basic auth secret is required"),
 				)
 			}
+		} else if spec.AuthInfo.ServiceAccountToken != nil {
+			allErrs = append(allErrs, validateServiceAccountTokenAuthConfig(spec.AuthInfo.ServiceAccountToken, fldPath.Child("authInfo", "serviceAccountToken"))...)
 		} else {
 			// Authentication
 			allErrs = append(
@@ -174,6 +204,13 @@ func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *
 		commonErrs = append(commonErrs, field.Invalid(fldPath.Child("caBundle"), spec.CABundle, "caBundle cannot be used when insecureSkipTLSVerify is true"))
 	}
 
+	if spec.TLSConfig != nil {
+		commonErrs = append(commonErrs, validateServiceBrokerTLSConfig(spec.TLSConfig, fldPath.Child("tlsConfig"))...)
+		if spec.InsecureSkipTLSVerify && (len(spec.TLSConfig.ServerDNSNames) > 0 || len(spec.TLSConfig.ServerSPIFFEIDs) > 0) {
+			commonErrs = append(commonErrs, field.Invalid(fldPath.Child("insecureSkipTLSVerify"), spec.InsecureSkipTLSVerify, "the server identities of tlsConfig cannot be verified when insecureSkipTLSVerify is true"))
+		}
+	}
+
 	if "" == spec.RelistBehavior {
 		commonErrs = append(commonErrs,
 			field.Required(fldPath.Child("relistBehavior"),
@@ -207,6 +244,39 @@ func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *
 		}
 	}
 
+	if spec.BindingRequestTimeout != nil && spec.BindingRequestTimeout.Duration <= 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("bindingRequestTimeout"), spec.BindingRequestTimeout.Duration.String(), "bindingRequestTimeout must be greater than zero"),
+		)
+	}
+
+	if spec.RequestRateLimit != nil {
+		if spec.RequestRateLimit.QPS <= 0 {
+			commonErrs = append(commonErrs,
+				field.Invalid(fldPath.Child("requestRateLimit", "qps"), spec.RequestRateLimit.QPS, "qps must be greater than zero"))
+		}
+		if spec.RequestRateLimit.Burst < 0 {
+			commonErrs = append(commonErrs,
+				field.Invalid(fldPath.Child("requestRateLimit", "burst"), spec.RequestRateLimit.Burst, "burst must not be negative"))
+		}
+	}
+
+	if spec.RetryPolicy != nil {
+		commonErrs = append(commonErrs, validateBrokerRetryPolicy(spec.RetryPolicy, fldPath.Child("retryPolicy"))...)
+	}
+
+	if spec.OSBAPIVersion != "" {
+		supported := []string{}
+		for _, version := range osb.APIVersions() {
+			supported = append(supported, version.HeaderValue())
+		}
+		if !sets.NewString(supported...).Has(spec.OSBAPIVersion) {
+			commonErrs = append(commonErrs,
+				field.NotSupported(fldPath.Child("osbAPIVersion"), spec.OSBAPIVersion, supported))
+		}
+	}
+
 	if spec.CatalogRestrictions != nil && len(spec.CatalogRestrictions.ServiceClass) > 0 {
 		// confirm that the restrictions can turn into a predicate.
 		_, err := filter.CreatePredicate(spec.CatalogRestrictions.ServiceClass)
@@ -246,9 +316,132 @@ func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *
 		}
 	}
 
+	for i, window := range spec.MaintenanceWindows {
+		commonErrs = append(commonErrs, validateMaintenanceWindow(&window, fldPath.Child("maintenanceWindows").Index(i))...)
+	}
+
+	if spec.CatalogSource != nil {
+		commonErrs = append(commonErrs, validateCatalogSource(spec.CatalogSource, fldPath.Child("catalogSource"), isClusterServiceBroker)...)
+	}
+
 	return commonErrs
 }
 
+func validateCatalogSource(source *sc.CatalogSource, fldPath *field.Path, isClusterServiceBroker bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	numSources := 0
+	if source.ConfigMapRef != nil {
+		numSources++
+		ref := source.ConfigMapRef
+		refPath := fldPath.Child("configMapRef")
+		if isClusterServiceBroker {
+			for _, msg := range apivalidation.ValidateNamespaceName(ref.Namespace, false /* prefix */) {
+				allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), ref.Namespace, msg))
+			}
+		} else if ref.Namespace != "" {
+			allErrs = append(allErrs, field.Forbidden(refPath.Child("namespace"), "the ConfigMap of a ServiceBroker must be in the namespace of the broker"))
+		}
+		for _, msg := range apivalidation.NameIsDNSSubdomain(ref.Name, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("name"), ref.Name, msg))
+		}
+		if ref.Key == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("key"), "key is required"))
+		}
+	}
+	if source.URL != "" {
+		numSources++
+		if u, err := url.Parse(source.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), source.URL, "url must be an absolute HTTP or HTTPS URL"))
+		}
+	}
+	if source.OCIArtifact != "" {
+		numSources++
+		if _, err := catalogsource.ParseReference(source.OCIArtifact); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ociArtifact"), source.OCIArtifact, err.Error()))
+		}
+	}
+	if numSources != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, source, "exactly one of configMapRef, url and ociArtifact must be set"))
+	}
+
+	return allErrs
+}
+
+func validateServiceBrokerTLSConfig(config *sc.ServiceBrokerTLSConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.MinVersion != "" {
+		if _, err := tlsconfig.ParseVersion(config.MinVersion); err != nil {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("minVersion"), config.MinVersion, tlsconfig.Versions()))
+		} else if config.MinVersion == "1.3" && len(config.CipherSuites) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cipherSuites"), config.CipherSuites, "the cipher suites of TLS 1.3 are not configurable"))
+		}
+	}
+
+	for i, name := range config.CipherSuites {
+		if _, err := tlsconfig.ParseCipherSuites([]string{name}); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cipherSuites").Index(i), name, err.Error()))
+		}
+	}
+
+	for i, name := range config.ServerDNSNames {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serverDNSNames").Index(i), name, msg))
+		}
+	}
+
+	for i, id := range config.ServerSPIFFEIDs {
+		if err := tlsconfig.ValidateSPIFFEID(id); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serverSPIFFEIDs").Index(i), id, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateServiceAccountTokenAuthConfig(config *sc.ServiceAccountTokenAuthConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.ServiceAccountName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountName"), "a service account name is required"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(config.ServiceAccountName, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAccountName"), config.ServiceAccountName, msg))
+		}
+	}
+
+	if config.ExpirationSeconds != nil && *config.ExpirationSeconds < minServiceAccountTokenExpirationSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expirationSeconds"), *config.ExpirationSeconds, fmt.Sprintf("must be at least %d", minServiceAccountTokenExpirationSeconds)))
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceWindow(window *sc.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if window.Schedule == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), "schedule is required"))
+	} else if _, err := cron.Parse(window.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), window.Schedule, err.Error()))
+	}
+
+	if window.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), window.Duration.Duration.String(), "duration must be greater than zero"))
+	} else if window.Duration.Duration > maxMaintenanceWindowDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), window.Duration.Duration.String(), fmt.Sprintf("duration must not exceed %v", maxMaintenanceWindowDuration)))
+	}
+
+	for i, operation := range window.DeferredOperations {
+		if !validMaintenanceOperations[operation] {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("deferredOperations").Index(i), operation, validMaintenanceOperationValues))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateClusterServiceBrokerUpdate checks that when changing from an older broker to a newer broker is okay ?
 func ValidateClusterServiceBrokerUpdate(new *sc.ClusterServiceBroker, old *sc.ClusterServiceBroker) field.ErrorList {
 	allErrs := validateCommonServiceBrokerUpdate(&new.Spec.CommonServiceBrokerSpec, &old.Spec.CommonServiceBrokerSpec)
@@ -288,3 +481,29 @@ func ValidateServiceBrokerStatusUpdate(new *sc.ServiceBroker, old *sc.ServiceBro
 	allErrs = append(allErrs, ValidateServiceBrokerUpdate(new, old)...)
 	return allErrs
 }
+
+// validateBrokerRetryPolicy validates that the durations of the retry
+// policy of a broker are positive, and that its initial interval does not
+// exceed its maximum interval.
+func validateBrokerRetryPolicy(policy *sc.BrokerRetryPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	durations := []struct {
+		name     string
+		duration *metav1.Duration
+	}{
+		{"initialInterval", policy.InitialInterval},
+		{"maxInterval", policy.MaxInterval},
+		{"maxElapsedTime", policy.MaxElapsedTime},
+	}
+	for _, d := range durations {
+		if d.duration != nil && d.duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(d.name), d.duration.Duration.String(), d.name+" must be greater than zero"))
+		}
+	}
+
+	if policy.InitialInterval != nil && policy.MaxInterval != nil && policy.InitialInterval.Duration > policy.MaxInterval.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialInterval"), policy.InitialInterval.Duration.String(), "initialInterval must not be greater than maxInterval"))
+	}
+	return allErrs
+}
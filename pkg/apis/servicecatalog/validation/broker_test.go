@@ -369,6 +369,549 @@ func TestValidateClusterServiceBroker(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid clusterservicebroker - bindingRequestTimeout",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                   "http://example.com",
+						RelistBehavior:        servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration:        &metav1.Duration{Duration: 15 * time.Minute},
+						BindingRequestTimeout: &metav1.Duration{Duration: 10 * time.Second},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - zero bindingRequestTimeout",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                   "http://example.com",
+						RelistBehavior:        servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration:        &metav1.Duration{Duration: 15 * time.Minute},
+						BindingRequestTimeout: &metav1.Duration{},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - requestRateLimit",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:              "http://example.com",
+						RelistBehavior:   servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration:   &metav1.Duration{Duration: 15 * time.Minute},
+						RequestRateLimit: &servicecatalog.BrokerRequestRateLimit{QPS: 5, Burst: 10},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - zero requestRateLimit qps",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:              "http://example.com",
+						RelistBehavior:   servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration:   &metav1.Duration{Duration: 15 * time.Minute},
+						RequestRateLimit: &servicecatalog.BrokerRequestRateLimit{Burst: 10},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - negative requestRateLimit burst",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:              "http://example.com",
+						RelistBehavior:   servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration:   &metav1.Duration{Duration: 15 * time.Minute},
+						RequestRateLimit: &servicecatalog.BrokerRequestRateLimit{QPS: 5, Burst: -1},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - retryPolicy",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						RetryPolicy: &servicecatalog.BrokerRetryPolicy{
+							InitialInterval: &metav1.Duration{Duration: 30 * time.Second},
+							MaxInterval:     &metav1.Duration{Duration: 10 * time.Minute},
+							MaxElapsedTime:  &metav1.Duration{Duration: 2 * time.Hour},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - zero retryPolicy maxElapsedTime",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						RetryPolicy: &servicecatalog.BrokerRetryPolicy{
+							MaxElapsedTime: &metav1.Duration{},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - retryPolicy initialInterval greater than maxInterval",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						RetryPolicy: &servicecatalog.BrokerRetryPolicy{
+							InitialInterval: &metav1.Duration{Duration: 10 * time.Minute},
+							MaxInterval:     &metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - supported osbAPIVersion",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						OSBAPIVersion:  "2.13",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - unsupported osbAPIVersion",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						OSBAPIVersion:  "2.10",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - maintenance window",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						MaintenanceWindows: []servicecatalog.MaintenanceWindow{
+							{
+								Schedule:           "0 2 * * 6",
+								Duration:           metav1.Duration{Duration: 2 * time.Hour},
+								DeferredOperations: []servicecatalog.MaintenanceOperation{servicecatalog.MaintenanceOperationProvision},
+							},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - maintenance window schedule",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						MaintenanceWindows: []servicecatalog.MaintenanceWindow{
+							{
+								Schedule: "0 25 * * *",
+								Duration: metav1.Duration{Duration: 2 * time.Hour},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - maintenance window duration",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						MaintenanceWindows: []servicecatalog.MaintenanceWindow{
+							{
+								Schedule: "0 2 * * *",
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - maintenance window deferred operation",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						MaintenanceWindows: []servicecatalog.MaintenanceWindow{
+							{
+								Schedule:           "0 2 * * *",
+								Duration:           metav1.Duration{Duration: 2 * time.Hour},
+								DeferredOperations: []servicecatalog.MaintenanceOperation{"Deprovision"},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - catalog source configmap",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{ConfigMapRef: &servicecatalog.ConfigMapKeyReference{Namespace: "test-ns", Name: "test-catalog", Key: "catalog.yaml"}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "valid clusterservicebroker - catalog source oci artifact",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{OCIArtifact: "registry.example.com/catalogs/broker:1.0"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - catalog source configmap without namespace",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{ConfigMapRef: &servicecatalog.ConfigMapKeyReference{Name: "test-catalog", Key: "catalog.yaml"}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - catalog source url",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{URL: "example.com/catalog.json"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - catalog source oci artifact without registry",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{OCIArtifact: "catalogs/broker:1.0"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - several catalog sources",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{URL: "https://example.com/catalog.json", OCIArtifact: "registry.example.com/catalogs/broker:1.0"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - empty catalog source",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - tls config",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							MinVersion:   "1.2",
+							CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - tls min version",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							MinVersion: "1.4",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - tls cipher suite",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - tls cipher suites with TLS 1.3",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							MinVersion:   "1.3",
+							CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "valid clusterservicebroker - tls server identities",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							ServerDNSNames:  []string{"broker.example.com"},
+							ServerSPIFFEIDs: []string{"spiffe://example.com/ns/brokers/sa/broker"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid clusterservicebroker - tls server DNS name",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							ServerDNSNames: []string{"Broker_Example"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - tls server SPIFFE ID",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "https://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							ServerSPIFFEIDs: []string{"https://example.com/broker"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid clusterservicebroker - tls server identities with insecureSkipTLSVerify",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusterservicebroker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:                   "https://example.com",
+						RelistBehavior:        servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration:        &metav1.Duration{Duration: 15 * time.Minute},
+						InsecureSkipTLSVerify: true,
+						TLSConfig: &servicecatalog.ServiceBrokerTLSConfig{
+							ServerDNSNames: []string{"broker.example.com"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			name: "valid clusterservicebroker - catalogRequirements.serviceClass",
 			broker: &servicecatalog.ClusterServiceBroker{
@@ -703,6 +1246,42 @@ func TestValidateServiceBroker(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			name: "valid servicebroker - catalog source configmap",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{ConfigMapRef: &servicecatalog.ConfigMapKeyReference{Name: "test-catalog", Key: "catalog.yaml"}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - catalog source configmap in another namespace",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-servicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						CatalogSource:  &servicecatalog.CatalogSource{ConfigMapRef: &servicecatalog.ConfigMapKeyReference{Namespace: "other-ns", Name: "test-catalog", Key: "catalog.yaml"}},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			name: "valid servicebroker - basic auth - secret",
 			broker: &servicecatalog.ServiceBroker{
@@ -811,6 +1390,96 @@ func TestValidateServiceBroker(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid servicebroker - service account token auth",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+						ServiceAccountToken: &servicecatalog.ServiceAccountTokenAuthConfig{
+							ServiceAccountName: "broker-client",
+							ExpirationSeconds:  serviceAccountTokenExpiration(1200),
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid servicebroker - service account token auth - missing service account name",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+						ServiceAccountToken: &servicecatalog.ServiceAccountTokenAuthConfig{
+							ServiceAccountName: "",
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - service account token auth - invalid service account name",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+						ServiceAccountToken: &servicecatalog.ServiceAccountTokenAuthConfig{
+							ServiceAccountName: "Broker_Client",
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid servicebroker - service account token auth - expiration too short",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ServiceBrokerAuthInfo{
+						ServiceAccountToken: &servicecatalog.ServiceAccountTokenAuthConfig{
+							ServiceAccountName: "broker-client",
+							ExpirationSeconds:  serviceAccountTokenExpiration(60),
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			name: "invalid servicebroker - CABundle present with InsecureSkipTLSVerify",
 			broker: &servicecatalog.ServiceBroker{
@@ -984,6 +1653,24 @@ func TestValidateServiceBroker(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "invalid servicebroker - unsupported osbAPIVersion",
+			broker: &servicecatalog.ServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-clusterservicebroker",
+					Namespace: "test-ns",
+				},
+				Spec: servicecatalog.ServiceBrokerSpec{
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: servicecatalog.ServiceBrokerRelistBehaviorDuration,
+						RelistDuration: &metav1.Duration{Duration: 15 * time.Minute},
+						OSBAPIVersion:  "latest",
+					},
+				},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1111,3 +1798,7 @@ func TestValidateServiceBroker(t *testing.T) {
 		})
 	}
 }
+
+func serviceAccountTokenExpiration(seconds int64) *int64 {
+	return &seconds
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/drycc-addons/service-catalog/pkg/controller"
 	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"sigs.k8s.io/yaml"
@@ -30,6 +31,8 @@ import (
 
 const lastOperationMaxLength int = 10000
 
+const serviceInstanceDescriptionMaxLength int = 1024
+
 // validateServiceInstanceName is the validation function for Instance names.
 var validateServiceInstanceName = apivalidation.NameIsDNSSubdomain
 
@@ -106,6 +109,30 @@ func validateServiceInstanceSpec(spec *sc.ServiceInstanceSpec, fldPath *field.Pa
 
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.UpdateRequests, fldPath.Child("updateRequests"))...)
 
+	if len(spec.Description) > serviceInstanceDescriptionMaxLength {
+		allErrs = append(allErrs, field.TooLong(fldPath.Child("description"), spec.Description, serviceInstanceDescriptionMaxLength))
+	}
+	for i, key := range spec.ContextLabels {
+		for _, msg := range utilvalidation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("contextLabels").Index(i), key, msg))
+		}
+	}
+	if spec.ContextNamespaceOverride != "" {
+		for _, msg := range utilvalidation.IsDNS1123Label(spec.ContextNamespaceOverride) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("contextNamespaceOverride"), spec.ContextNamespaceOverride, msg))
+		}
+	}
+	if spec.ReadinessPublish != nil {
+		configMapNamePath := fldPath.Child("readinessPublish", "configMapName")
+		if spec.ReadinessPublish.ConfigMapName == "" {
+			allErrs = append(allErrs, field.Required(configMapNamePath, "configMapName is required"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(spec.ReadinessPublish.ConfigMapName) {
+				allErrs = append(allErrs, field.Invalid(configMapNamePath, spec.ReadinessPublish.ConfigMapName, msg))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -340,6 +367,7 @@ func ValidateServiceInstanceUpdate(new *sc.ServiceInstance, old *sc.ServiceInsta
 	allErrs = append(allErrs, internalValidateServiceInstance(new, false)...)
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ExternalID, old.Spec.ExternalID, specFieldPath.Child("externalID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ContextNamespaceOverride, old.Spec.ContextNamespaceOverride, specFieldPath.Child("contextNamespaceOverride"))...)
 
 	if new.Spec.UpdateRequests < old.Spec.UpdateRequests {
 		allErrs = append(allErrs, field.Invalid(specFieldPath.Child("updateRequests"), new.Spec.UpdateRequests, "new updateRequests value must not be less than the old one"))
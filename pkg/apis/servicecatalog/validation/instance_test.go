@@ -888,6 +888,70 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid description and context labels",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.Description = "orders database"
+				i.Spec.ContextLabels = []string{"app", "example.com/team"}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "description too long",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.Description = strings.Repeat("a", serviceInstanceDescriptionMaxLength+1)
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid context label key",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ContextLabels = []string{"not a label"}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid context namespace override",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ContextNamespaceOverride = "tenant-a"
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid context namespace override",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ContextNamespaceOverride = "Tenant A"
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid readiness publish",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ReadinessPublish = &servicecatalog.ServiceInstanceReadinessPublish{ConfigMapName: "db-ready"}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid readiness publish config map name",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ReadinessPublish = &servicecatalog.ServiceInstanceReadinessPublish{ConfigMapName: "DB Ready"}
+				return i
+			}(),
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
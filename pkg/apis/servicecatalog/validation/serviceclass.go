@@ -166,5 +166,17 @@ func validateCommonServiceClassSpec(spec *sc.CommonServiceClassSpec, fldPath *fi
 		commonErrs = append(commonErrs, field.Invalid(fldPath.Child("externalID"), spec.ExternalID, msg))
 	}
 
+	commonErrs = append(commonErrs, validateCatalogVisibility(spec.CatalogVisibility, fldPath.Child("catalogVisibility"))...)
+
 	return commonErrs
 }
+
+// validateCatalogVisibility checks that the catalog visibility of a class or
+// plan is empty, Visible or Hidden.
+func validateCatalogVisibility(visibility sc.CatalogVisibility, fldPath *field.Path) field.ErrorList {
+	switch visibility {
+	case "", sc.CatalogVisibilityVisible, sc.CatalogVisibilityHidden:
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fldPath, visibility, []string{string(sc.CatalogVisibilityVisible), string(sc.CatalogVisibilityHidden)})}
+}
@@ -88,6 +88,24 @@ func TestValidateClusterServiceClass(t *testing.T) {
 			}(),
 			valid: true,
 		},
+		{
+			name: "valid serviceClass - hidden",
+			serviceClass: func() *servicecatalog.ClusterServiceClass {
+				s := validClusterServiceClass()
+				s.Spec.CatalogVisibility = servicecatalog.CatalogVisibilityHidden
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid serviceClass - unknown catalogVisibility",
+			serviceClass: func() *servicecatalog.ClusterServiceClass {
+				s := validClusterServiceClass()
+				s.Spec.CatalogVisibility = "Secret"
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "invalid serviceClass - has namespace",
 			serviceClass: func() *servicecatalog.ClusterServiceClass {
@@ -100,6 +100,12 @@ func validateCommonServicePlanSpec(spec sc.CommonServicePlanSpec, fldPath *field
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("externalName"), spec.ExternalName, msg))
 	}
 
+	if spec.MaxBindings != nil && *spec.MaxBindings < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBindings"), *spec.MaxBindings, "maxBindings must be at least 1"))
+	}
+
+	allErrs = append(allErrs, validateCatalogVisibility(spec.CatalogVisibility, fldPath.Child("catalogVisibility"))...)
+
 	return allErrs
 
 }
@@ -128,6 +128,44 @@ func TestValidateClusterServicePlan(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid maxBindings",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				maxBindings := int32(3)
+				s.Spec.MaxBindings = &maxBindings
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "zero maxBindings",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				maxBindings := int32(0)
+				s.Spec.MaxBindings = &maxBindings
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "hidden plan",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.CatalogVisibility = servicecatalog.CatalogVisibilityHidden
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "unknown catalogVisibility",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.CatalogVisibility = "Secret"
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "missing external id",
 			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
@@ -17,9 +17,13 @@ limitations under the License.
 package validation
 
 import (
+	"regexp"
+
 	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"regexp"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
 
 var hexademicalStringRegexp = regexp.MustCompile("^[[:xdigit:]]*$")
@@ -28,20 +32,58 @@ func stringIsHexadecimal(s string) bool {
 	return hexademicalStringRegexp.MatchString(s)
 }
 
+var validParameterTypes = map[sc.ParameterType]bool{
+	sc.ParameterTypeInteger: true,
+	sc.ParameterTypeNumber:  true,
+	sc.ParameterTypeBoolean: true,
+}
+
+var validParameterTypeValues = func() []string {
+	validValues := make([]string, len(validParameterTypes))
+	i := 0
+	for t := range validParameterTypes {
+		validValues[i] = string(t)
+		i++
+	}
+	return validValues
+}()
+
 func validateParametersFromSource(parametersFrom []sc.ParametersFromSource, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	for _, paramsFrom := range parametersFrom {
-		if paramsFrom.SecretKeyRef != nil {
+		if paramsFrom.SecretKeyRef != nil && paramsFrom.PluginRef != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("parametersFrom"), paramsFrom, "only one of secretKeyRef and pluginRef may be specified"))
+		} else if paramsFrom.SecretKeyRef != nil {
 			if paramsFrom.SecretKeyRef.Name == "" {
 				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.secretKeyRef.name"), "name is required"))
 			}
 			if paramsFrom.SecretKeyRef.Key == "" {
 				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.secretKeyRef.key"), "key is required"))
 			}
+		} else if paramsFrom.PluginRef != nil {
+			if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ParametersPlugins) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("parametersFrom.pluginRef"), "pluginRef requires the ParametersPlugins feature"))
+				continue
+			}
+			if paramsFrom.PluginRef.Name == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.pluginRef.name"), "name is required"))
+			} else {
+				for _, msg := range utilvalidation.IsDNS1123Label(paramsFrom.PluginRef.Name) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("parametersFrom.pluginRef.name"), paramsFrom.PluginRef.Name, msg))
+				}
+			}
+			if paramsFrom.PluginRef.Key == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.pluginRef.key"), "key is required"))
+			}
 		} else {
 			allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom"), "source must not be empty if present"))
 		}
+		for name, t := range paramsFrom.Coerce {
+			if !validParameterTypes[t] {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("parametersFrom.coerce").Key(name), t, validParameterTypeValues))
+			}
+		}
 	}
 
 	return allErrs
@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogcache persists the informer caches of the
+// ClusterServiceClasses and ClusterServicePlans to disk, so that a restarted
+// controller manager starts these informers from the last snapshot and only
+// watches the changes made since, instead of listing the whole catalog from
+// the API server again.
+//
+// A snapshot holds the objects of an informer and the resource version they
+// were cached at. The first list of the informer is served from it, and the
+// watch that follows starts at its resource version. When the API server no
+// longer has the history of that resource version, the watch fails with
+// 410 Gone and the informer falls back to a full list, so a stale snapshot
+// costs one failed watch.
+package catalogcache
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+// saveInterval is how often the snapshots of the informers that changed
+// are written.
+const saveInterval = 30 * time.Second
+
+// Cache writes snapshots of the informers it creates to a directory, and
+// starts them from the snapshots found there.
+type Cache struct {
+	dir string
+
+	mutex     sync.Mutex
+	resources []*resource
+}
+
+// resource is an informer whose cache is persisted.
+type resource struct {
+	kind     string
+	informer cache.SharedIndexInformer
+	newList  func() runtime.Object
+	// savedResourceVersion is the resource version of the last snapshot
+	// written, so that unchanged informers are not written again.
+	savedResourceVersion string
+}
+
+// New creates a Cache persisting the informers to dir, which is created if
+// it does not exist.
+func New(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create the catalog cache directory %q: %v", dir, err)
+	}
+	return &Cache{dir: dir}, nil
+}
+
+// NewClusterServiceClassInformer creates a ClusterServiceClass informer
+// persisted by the cache. Its signature is the one of the informer
+// constructors of the shared informer factory, so that the factory can be
+// made to use it with InformerFor.
+func (c *Cache) NewClusterServiceClassInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return c.newInformer("ClusterServiceClass", &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return client.ServicecatalogV1beta1().ClusterServiceClasses().List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return client.ServicecatalogV1beta1().ClusterServiceClasses().Watch(context.TODO(), options)
+		},
+	}, &v1beta1.ClusterServiceClass{}, func() runtime.Object { return &v1beta1.ClusterServiceClassList{} }, resyncPeriod)
+}
+
+// NewClusterServicePlanInformer creates a ClusterServicePlan informer
+// persisted by the cache. Its signature is the one of the informer
+// constructors of the shared informer factory, so that the factory can be
+// made to use it with InformerFor.
+func (c *Cache) NewClusterServicePlanInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return c.newInformer("ClusterServicePlan", &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return client.ServicecatalogV1beta1().ClusterServicePlans().List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return client.ServicecatalogV1beta1().ClusterServicePlans().Watch(context.TODO(), options)
+		},
+	}, &v1beta1.ClusterServicePlan{}, func() runtime.Object { return &v1beta1.ClusterServicePlanList{} }, resyncPeriod)
+}
+
+func (c *Cache) newInformer(kind string, lw cache.ListerWatcher, obj runtime.Object, newList func() runtime.Object, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	informer := cache.NewSharedIndexInformer(
+		&snapshotListerWatcher{
+			ListerWatcher: lw,
+			load:          func() runtime.Object { return c.load(kind, newList()) },
+		},
+		obj,
+		resyncPeriod,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+	)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.resources = append(c.resources, &resource{kind: kind, informer: informer, newList: newList})
+	return informer
+}
+
+// Run writes the snapshots of the informers that changed every
+// saveInterval, and once more when stopCh is closed.
+func (c *Cache) Run(stopCh <-chan struct{}) {
+	wait.Until(c.Save, saveInterval, stopCh)
+	c.Save()
+}
+
+// Save writes the snapshots of the synced informers that changed since
+// their last snapshot.
+func (c *Cache) Save() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, r := range c.resources {
+		if err := c.save(r); err != nil {
+			klog.Warningf("Unable to save the %s catalog cache: %v", r.kind, err)
+		}
+	}
+}
+
+func (c *Cache) save(r *resource) error {
+	if !r.informer.HasSynced() {
+		return nil
+	}
+	// The resource version is read before the objects, so that the watch
+	// started from the snapshot replays the changes made in between
+	// rather than missing them.
+	resourceVersion := r.informer.LastSyncResourceVersion()
+	if resourceVersion == "" || resourceVersion == r.savedResourceVersion {
+		return nil
+	}
+	items := r.informer.GetStore().List()
+	objects := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		objects = append(objects, item.(runtime.Object))
+	}
+	list := r.newList()
+	if err := meta.SetList(list, objects); err != nil {
+		return err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+	listMeta.SetResourceVersion(resourceVersion)
+
+	if err := c.write(r.kind, list); err != nil {
+		return err
+	}
+	r.savedResourceVersion = resourceVersion
+	klog.V(4).Infof("Saved the %s catalog cache of %d objects at resource version %s", r.kind, len(objects), resourceVersion)
+	return nil
+}
+
+// write writes list to the snapshot file of kind, replacing it atomically.
+func (c *Cache) write(kind string, list runtime.Object) error {
+	f, err := os.CreateTemp(c.dir, "."+kind+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	zw := gzip.NewWriter(f)
+	if err := json.NewEncoder(zw).Encode(list); err != nil {
+		f.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path(kind))
+}
+
+// load reads the snapshot of kind into list, and returns nil if there is
+// no usable snapshot.
+func (c *Cache) load(kind string, list runtime.Object) runtime.Object {
+	f, err := os.Open(c.path(kind))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			klog.Warningf("Unable to read the %s catalog cache: %v", kind, err)
+		}
+		return nil
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		klog.Warningf("Unable to read the %s catalog cache: %v", kind, err)
+		return nil
+	}
+	if err := json.NewDecoder(zr).Decode(list); err != nil {
+		klog.Warningf("Unable to read the %s catalog cache: %v", kind, err)
+		return nil
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil || listMeta.GetResourceVersion() == "" {
+		klog.Warningf("Ignoring the %s catalog cache, which has no resource version", kind)
+		return nil
+	}
+
+	klog.V(1).Infof("Starting the %s informer from its catalog cache of %d objects at resource version %s", kind, meta.LenList(list), listMeta.GetResourceVersion())
+	metrics.CatalogCacheWarmStartCount.WithLabelValues(kind).Inc()
+	return list
+}
+
+func (c *Cache) path(kind string) string {
+	return filepath.Join(c.dir, kind+".json.gz")
+}
+
+// snapshotListerWatcher serves the first list of an informer from a
+// snapshot, if there is one, and everything else from its ListerWatcher.
+type snapshotListerWatcher struct {
+	cache.ListerWatcher
+	load func() runtime.Object
+	once sync.Once
+}
+
+// List returns the snapshot the first time it is called, and the list of
+// the ListerWatcher afterwards, such as when the informer relists because
+// the resource version of the snapshot is too old to be watched from.
+func (lw *snapshotListerWatcher) List(options metav1.ListOptions) (runtime.Object, error) {
+	var snapshot runtime.Object
+	lw.once.Do(func() {
+		snapshot = lw.load()
+	})
+	if snapshot != nil {
+		return snapshot, nil
+	}
+	return lw.ListerWatcher.List(options)
+}
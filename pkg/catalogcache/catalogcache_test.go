@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogcache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// fakeListerWatcher lists classes at a resource version, and records the
+// lists and watches of the informer.
+type fakeListerWatcher struct {
+	mutex                 sync.Mutex
+	lists                 int
+	watchResourceVersions []string
+	list                  *v1beta1.ClusterServiceClassList
+}
+
+func (lw *fakeListerWatcher) List(options metav1.ListOptions) (runtime.Object, error) {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+	lw.lists++
+	return lw.list.DeepCopy(), nil
+}
+
+func (lw *fakeListerWatcher) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+	lw.watchResourceVersions = append(lw.watchResourceVersions, options.ResourceVersion)
+	return watch.NewFake(), nil
+}
+
+func (lw *fakeListerWatcher) listed() int {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+	return lw.lists
+}
+
+func (lw *fakeListerWatcher) watched() []string {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+	return append([]string(nil), lw.watchResourceVersions...)
+}
+
+func newClassList(resourceVersion string, names ...string) *v1beta1.ClusterServiceClassList {
+	list := &v1beta1.ClusterServiceClassList{ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion}}
+	for _, name := range names {
+		list.Items = append(list.Items, v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return list
+}
+
+func startInformer(t *testing.T, c *Cache, lw cache.ListerWatcher, stopCh <-chan struct{}) cache.SharedIndexInformer {
+	t.Helper()
+	informer := c.newInformer("ClusterServiceClass", lw, &v1beta1.ClusterServiceClass{}, func() runtime.Object { return &v1beta1.ClusterServiceClassList{} }, 0)
+	go informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		t.Fatal("the informer did not sync")
+	}
+	return informer
+}
+
+func TestWarmStart(t *testing.T) {
+	dir := t.TempDir()
+
+	// The first informer lists from the API server, and its cache is saved.
+	stopCh := make(chan struct{})
+	first, err := New(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	startInformer(t, first, &fakeListerWatcher{list: newClassList("10", "class-a", "class-b")}, stopCh)
+	first.Save()
+	close(stopCh)
+
+	// The second informer starts from the snapshot, and watches from its
+	// resource version.
+	stopCh = make(chan struct{})
+	defer close(stopCh)
+	second, err := New(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lw := &fakeListerWatcher{list: newClassList("20")}
+	informer := startInformer(t, second, lw, stopCh)
+
+	if e, a := 2, len(informer.GetStore().List()); e != a {
+		t.Fatalf("unexpected number of cached classes: expected %v, got %v", e, a)
+	}
+	if _, exists, _ := informer.GetStore().GetByKey("class-a"); !exists {
+		t.Fatal("expected class-a to be started from the snapshot")
+	}
+	if e, a := 0, lw.listed(); e != a {
+		t.Fatalf("unexpected number of lists: expected %v, got %v", e, a)
+	}
+	var watched []string
+	for i := 0; i < 100 && len(watched) == 0; i++ {
+		time.Sleep(50 * time.Millisecond)
+		watched = lw.watched()
+	}
+	if len(watched) == 0 {
+		t.Fatal("the informer did not watch")
+	}
+	if e, a := "10", watched[0]; e != a {
+		t.Fatalf("unexpected watch resource version: expected %q, got %q", e, a)
+	}
+}
+
+func TestColdStart(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lw := &fakeListerWatcher{list: newClassList("20", "class-a")}
+	informer := startInformer(t, c, lw, stopCh)
+
+	if e, a := 1, lw.listed(); e != a {
+		t.Fatalf("unexpected number of lists: expected %v, got %v", e, a)
+	}
+	if e, a := 1, len(informer.GetStore().List()); e != a {
+		t.Fatalf("unexpected number of cached classes: expected %v, got %v", e, a)
+	}
+}
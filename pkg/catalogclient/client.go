@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogclient provides a high-level client for programs, such as
+// operators, that compose service-catalog resources and need to block until
+// the controller has finished acting on them. It lives outside of pkg/client
+// because that tree is entirely generated.
+package catalogclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultPollInterval is the interval used to check the status of a resource
+// when the Client has not been configured with one.
+const DefaultPollInterval = 2 * time.Second
+
+// Client wraps the generated service-catalog clientset with operations that
+// create or delete a resource and wait for the controller to reconcile it.
+// Every wait is bounded by the deadline of the context passed in.
+type Client struct {
+	client       clientset.Interface
+	pollInterval time.Duration
+}
+
+// Option configures optional behavior of a Client.
+type Option func(*Client)
+
+// WithPollInterval sets how often the Client checks the status of the
+// resource it is waiting on.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = interval
+	}
+}
+
+// New returns a Client that talks to service-catalog through the given
+// clientset.
+func New(client clientset.Interface, opts ...Option) *Client {
+	c := &Client{
+		client:       client,
+		pollInterval: DefaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// OperationFailedError is returned when the controller reports that an
+// operation has terminally failed. The reason and message are taken from the
+// Failed condition of the resource.
+type OperationFailedError struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Reason    string
+	Message   string
+}
+
+func (e *OperationFailedError) Error() string {
+	return fmt.Sprintf("%s %s/%s failed: %s: %s", e.Kind, e.Namespace, e.Name, e.Reason, e.Message)
+}
+
+// ProvisionResult is the outcome of a successful ProvisionAndWait call.
+type ProvisionResult struct {
+	// Instance is the ServiceInstance as last observed by the Client.
+	Instance *v1beta1.ServiceInstance
+	// DashboardURL is the dashboard URL returned by the broker, if any.
+	DashboardURL string
+	// Duration is how long the Client waited for the instance to become ready.
+	Duration time.Duration
+}
+
+// BindResult is the outcome of a successful BindAndWait call.
+type BindResult struct {
+	// Binding is the ServiceBinding as last observed by the Client.
+	Binding *v1beta1.ServiceBinding
+	// SecretName is the name of the Secret holding the credentials.
+	SecretName string
+	// Duration is how long the Client waited for the binding to become ready.
+	Duration time.Duration
+}
+
+// ProvisionAndWait creates the given ServiceInstance and waits until it is
+// ready. An *OperationFailedError is returned if provisioning fails, and the
+// context error is returned if the context expires first.
+func (c *Client) ProvisionAndWait(ctx context.Context, instance *v1beta1.ServiceInstance) (*ProvisionResult, error) {
+	start := time.Now()
+	created, err := c.client.ServicecatalogV1beta1().ServiceInstances(instance.Namespace).Create(ctx, instance, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create instance %s/%s: %w", instance.Namespace, instance.Name, err)
+	}
+
+	result, err := c.WaitForInstance(ctx, created.Namespace, created.Name)
+	if err != nil {
+		return nil, err
+	}
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// WaitForInstance waits until the current operation on an existing
+// ServiceInstance has completed successfully.
+func (c *Client) WaitForInstance(ctx context.Context, namespace, name string) (*ProvisionResult, error) {
+	start := time.Now()
+	var instance *v1beta1.ServiceInstance
+	err := wait.PollUntilContextCancel(ctx, c.pollInterval, true, func(ctx context.Context) (bool, error) {
+		var err error
+		instance, err = c.client.ServicecatalogV1beta1().ServiceInstances(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if cond := instanceCondition(instance, v1beta1.ServiceInstanceConditionFailed); cond != nil {
+			return false, &OperationFailedError{
+				Kind:      "ServiceInstance",
+				Namespace: namespace,
+				Name:      name,
+				Reason:    cond.Reason,
+				Message:   cond.Message,
+			}
+		}
+		if instance.Status.AsyncOpInProgress || instance.Status.ObservedGeneration < instance.Generation {
+			return false, nil
+		}
+		return instanceCondition(instance, v1beta1.ServiceInstanceConditionReady) != nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ProvisionResult{
+		Instance: instance,
+		Duration: time.Since(start),
+	}
+	if instance.Status.DashboardURL != nil {
+		result.DashboardURL = *instance.Status.DashboardURL
+	}
+	return result, nil
+}
+
+// DeprovisionAndWait deletes the named ServiceInstance and waits until it has
+// been removed. A missing instance is not an error.
+func (c *Client) DeprovisionAndWait(ctx context.Context, namespace, name string) error {
+	instances := c.client.ServicecatalogV1beta1().ServiceInstances(namespace)
+	err := instances.Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to delete instance %s/%s: %w", namespace, name, err)
+	}
+
+	return wait.PollUntilContextCancel(ctx, c.pollInterval, true, func(ctx context.Context) (bool, error) {
+		instance, err := instances.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if instance.Status.DeprovisionStatus == v1beta1.ServiceInstanceDeprovisionStatusFailed {
+			cond := instanceCondition(instance, v1beta1.ServiceInstanceConditionFailed)
+			failure := &OperationFailedError{
+				Kind:      "ServiceInstance",
+				Namespace: namespace,
+				Name:      name,
+			}
+			if cond != nil {
+				failure.Reason = cond.Reason
+				failure.Message = cond.Message
+			}
+			return false, failure
+		}
+		return false, nil
+	})
+}
+
+// BindAndWait creates the given ServiceBinding and waits until it is ready.
+// An *OperationFailedError is returned if binding fails, and the context
+// error is returned if the context expires first.
+func (c *Client) BindAndWait(ctx context.Context, binding *v1beta1.ServiceBinding) (*BindResult, error) {
+	start := time.Now()
+	bindings := c.client.ServicecatalogV1beta1().ServiceBindings(binding.Namespace)
+	created, err := bindings.Create(ctx, binding, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create binding %s/%s: %w", binding.Namespace, binding.Name, err)
+	}
+
+	var current *v1beta1.ServiceBinding
+	err = wait.PollUntilContextCancel(ctx, c.pollInterval, true, func(ctx context.Context) (bool, error) {
+		var err error
+		current, err = bindings.Get(ctx, created.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if cond := bindingCondition(current, v1beta1.ServiceBindingConditionFailed); cond != nil {
+			return false, &OperationFailedError{
+				Kind:      "ServiceBinding",
+				Namespace: current.Namespace,
+				Name:      current.Name,
+				Reason:    cond.Reason,
+				Message:   cond.Message,
+			}
+		}
+		if current.Status.AsyncOpInProgress {
+			return false, nil
+		}
+		return bindingCondition(current, v1beta1.ServiceBindingConditionReady) != nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BindResult{
+		Binding:    current,
+		SecretName: current.Spec.SecretName,
+		Duration:   time.Since(start),
+	}, nil
+}
+
+// instanceCondition returns the condition of the given type if it is true.
+func instanceCondition(instance *v1beta1.ServiceInstance, conditionType v1beta1.ServiceInstanceConditionType) *v1beta1.ServiceInstanceCondition {
+	for i, cond := range instance.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == v1beta1.ConditionTrue {
+			return &instance.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// bindingCondition returns the condition of the given type if it is true.
+func bindingCondition(binding *v1beta1.ServiceBinding, conditionType v1beta1.ServiceBindingConditionType) *v1beta1.ServiceBindingCondition {
+	for i, cond := range binding.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == v1beta1.ConditionTrue {
+			return &binding.Status.Conditions[i]
+		}
+	}
+	return nil
+}
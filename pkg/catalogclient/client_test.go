@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+const (
+	testNamespace    = "test-ns"
+	testInstanceName = "test-instance"
+	testBindingName  = "test-binding"
+)
+
+func newTestInstance() *v1beta1.ServiceInstance {
+	return &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testNamespace},
+	}
+}
+
+func newTestBinding() *v1beta1.ServiceBinding {
+	return &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: testBindingName, Namespace: testNamespace},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef: v1beta1.LocalObjectReference{Name: testInstanceName},
+			SecretName:  testBindingName,
+		},
+	}
+}
+
+func TestProvisionAndWaitReady(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	dashboard := "https://dashboard"
+	fakeClient.PrependReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		instance := newTestInstance()
+		instance.Status.DashboardURL = &dashboard
+		instance.Status.Conditions = []v1beta1.ServiceInstanceCondition{
+			{Type: v1beta1.ServiceInstanceConditionReady, Status: v1beta1.ConditionTrue},
+		}
+		return true, instance, nil
+	})
+
+	c := New(fakeClient, WithPollInterval(time.Millisecond))
+	result, err := c.ProvisionAndWait(context.Background(), newTestInstance())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.DashboardURL != dashboard {
+		t.Fatalf("expected dashboard URL %q, got %q", dashboard, result.DashboardURL)
+	}
+
+	actions := fakeClient.Actions()
+	if !actions[0].Matches("create", "serviceinstances") {
+		t.Fatalf("expected first action to be a create, got %v", actions[0])
+	}
+}
+
+func TestProvisionAndWaitFailed(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	fakeClient.PrependReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		instance := newTestInstance()
+		instance.Status.Conditions = []v1beta1.ServiceInstanceCondition{
+			{Type: v1beta1.ServiceInstanceConditionFailed, Status: v1beta1.ConditionTrue, Reason: "ProvisionCallFailed", Message: "boom"},
+		}
+		return true, instance, nil
+	})
+
+	c := New(fakeClient, WithPollInterval(time.Millisecond))
+	_, err := c.ProvisionAndWait(context.Background(), newTestInstance())
+	var failure *OperationFailedError
+	if !errors.As(err, &failure) {
+		t.Fatalf("expected an OperationFailedError, got %v", err)
+	}
+	if failure.Reason != "ProvisionCallFailed" {
+		t.Fatalf("expected reason %q, got %q", "ProvisionCallFailed", failure.Reason)
+	}
+}
+
+func TestProvisionAndWaitDeadline(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+
+	c := New(fakeClient, WithPollInterval(time.Millisecond))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.ProvisionAndWait(ctx, newTestInstance())
+	if err == nil {
+		t.Fatal("expected an error when the context deadline is exceeded")
+	}
+}
+
+func TestBindAndWaitReady(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	fakeClient.PrependReactor("get", "servicebindings", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		binding := newTestBinding()
+		binding.Status.Conditions = []v1beta1.ServiceBindingCondition{
+			{Type: v1beta1.ServiceBindingConditionReady, Status: v1beta1.ConditionTrue},
+		}
+		return true, binding, nil
+	})
+
+	c := New(fakeClient, WithPollInterval(time.Millisecond))
+	result, err := c.BindAndWait(context.Background(), newTestBinding())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.SecretName != testBindingName {
+		t.Fatalf("expected secret name %q, got %q", testBindingName, result.SecretName)
+	}
+}
+
+func TestDeprovisionAndWait(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(newTestInstance())
+	fakeClient.PrependReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Resource: "serviceinstances"}, testInstanceName)
+	})
+
+	c := New(fakeClient, WithPollInterval(time.Millisecond))
+	if err := c.DeprovisionAndWait(context.Background(), testNamespace, testInstanceName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeprovisionAndWaitMissingInstance(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+
+	c := New(fakeClient, WithPollInterval(time.Millisecond))
+	if err := c.DeprovisionAndWait(context.Background(), testNamespace, testInstanceName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
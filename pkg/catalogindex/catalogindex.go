@@ -0,0 +1,421 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogindex serves a read-only, denormalized view of the service
+// classes and plans of the catalog from the controller's informer caches.
+// Portals can page through and filter the catalog with it instead of listing
+// the custom resources from the API server on every refresh.
+package catalogindex
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/util"
+)
+
+const (
+	// ClassesPath is the path at which the classes of the catalog are served.
+	ClassesPath = "/catalog/v1/classes"
+	// PlansPath is the path at which the plans of the catalog are served.
+	PlansPath = "/catalog/v1/plans"
+
+	// DefaultLimit is the number of items in a page when the request does
+	// not set a limit.
+	DefaultLimit = 100
+	// MaxLimit is the largest number of items in a page.
+	MaxLimit = 500
+)
+
+// Broker describes the broker offering a class or plan.
+type Broker struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	URL       string `json:"url"`
+	Ready     bool   `json:"ready"`
+}
+
+// ClassReference identifies the class of a plan.
+type ClassReference struct {
+	Name         string `json:"name"`
+	ExternalName string `json:"externalName"`
+}
+
+// Class is a service class, with the broker offering it.
+type Class struct {
+	Name          string   `json:"name"`
+	Namespace     string   `json:"namespace,omitempty"`
+	ExternalName  string   `json:"externalName"`
+	ExternalID    string   `json:"externalID"`
+	Description   string   `json:"description"`
+	Bindable      bool     `json:"bindable"`
+	PlanUpdatable bool     `json:"planUpdatable"`
+	Tags          []string `json:"tags,omitempty"`
+	Broker        Broker   `json:"broker"`
+}
+
+// Plan is a service plan, with the class and the broker offering it.
+type Plan struct {
+	Name         string             `json:"name"`
+	Namespace    string             `json:"namespace,omitempty"`
+	ExternalName string             `json:"externalName"`
+	ExternalID   string             `json:"externalID"`
+	Description  string             `json:"description"`
+	Free         bool               `json:"free"`
+	Bindable     *bool              `json:"bindable,omitempty"`
+	Costs        []v1beta1.PlanCost `json:"costs,omitempty"`
+	Class        ClassReference     `json:"class"`
+	Broker       Broker             `json:"broker"`
+}
+
+// ClassList is a page of classes. Continue is set when there are more
+// classes, and is passed back to get the next page.
+type ClassList struct {
+	Items    []Class `json:"items"`
+	Continue string  `json:"continue,omitempty"`
+}
+
+// PlanList is a page of plans. Continue is set when there are more plans,
+// and is passed back to get the next page.
+type PlanList struct {
+	Items    []Plan `json:"items"`
+	Continue string `json:"continue,omitempty"`
+}
+
+// Listers are the listers the catalog is read from.
+type Listers struct {
+	ClusterServiceBrokers listers.ClusterServiceBrokerLister
+	ServiceBrokers        listers.ServiceBrokerLister
+	ClusterServiceClasses listers.ClusterServiceClassLister
+	ServiceClasses        listers.ServiceClassLister
+	ClusterServicePlans   listers.ClusterServicePlanLister
+	ServicePlans          listers.ServicePlanLister
+}
+
+// Handler serves the classes and plans of the catalog.
+type Handler struct {
+	listers Listers
+}
+
+// NewHandler creates a Handler reading the catalog from the given listers.
+func NewHandler(listers Listers) *Handler {
+	return &Handler{listers: listers}
+}
+
+// Install registers the handler at ClassesPath and PlansPath of mux.
+func (h *Handler) Install(mux *http.ServeMux) {
+	mux.HandleFunc(ClassesPath, h.serveClasses)
+	mux.HandleFunc(PlansPath, h.servePlans)
+}
+
+// query holds the filters and the page of a request. Cluster-scoped classes
+// and plans are always listed; the ones of namespace are listed as well when
+// it is set. Removed classes and plans are left out.
+type query struct {
+	namespace string
+	broker    string
+	class     string
+	search    string
+	freeOnly  bool
+	limit     int
+	after     string
+}
+
+func parseQuery(r *http.Request) (*query, error) {
+	values := r.URL.Query()
+	q := &query{
+		namespace: values.Get("namespace"),
+		broker:    values.Get("broker"),
+		class:     values.Get("class"),
+		search:    strings.ToLower(values.Get("search")),
+		limit:     DefaultLimit,
+	}
+	if free := values.Get("free"); free != "" {
+		freeOnly, err := strconv.ParseBool(free)
+		if err != nil {
+			return nil, fmt.Errorf("invalid free %q: %v", free, err)
+		}
+		q.freeOnly = freeOnly
+	}
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid limit %q: must be a positive integer", limit)
+		}
+		if n > MaxLimit {
+			n = MaxLimit
+		}
+		q.limit = n
+	}
+	if token := values.Get("continue"); token != "" {
+		after, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid continue token %q", token)
+		}
+		q.after = string(after)
+	}
+	return q, nil
+}
+
+// matches returns whether an item with the given fields passes the broker
+// and search filters of the query.
+func (q *query) matches(broker, externalName, description string) bool {
+	if q.broker != "" && q.broker != broker {
+		return false
+	}
+	if q.search == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(externalName), q.search) ||
+		strings.Contains(strings.ToLower(description), q.search)
+}
+
+// itemKey orders the items of a listing, cluster-scoped ones first.
+func itemKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// page returns the range of the sorted keys making up the page the query
+// asks for, and the continue token of the next page.
+func (q *query) page(keys []string) (int, int, string) {
+	start := sort.SearchStrings(keys, q.after)
+	if start < len(keys) && keys[start] == q.after {
+		start++
+	}
+	end := start + q.limit
+	if end >= len(keys) {
+		return start, len(keys), ""
+	}
+	return start, end, base64.RawURLEncoding.EncodeToString([]byte(keys[end-1]))
+}
+
+func (h *Handler) serveClasses(w http.ResponseWriter, r *http.Request) {
+	q, err := parseQuery(r)
+	if err != nil {
+		util.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	classes, err := h.classes(q)
+	if err != nil {
+		util.WriteErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	sort.Slice(classes, func(i, j int) bool {
+		return itemKey(classes[i].Namespace, classes[i].Name) < itemKey(classes[j].Namespace, classes[j].Name)
+	})
+	keys := make([]string, len(classes))
+	for i, class := range classes {
+		keys[i] = itemKey(class.Namespace, class.Name)
+	}
+	start, end, next := q.page(keys)
+	util.WriteResponse(w, http.StatusOK, &ClassList{Items: classes[start:end], Continue: next})
+}
+
+func (h *Handler) servePlans(w http.ResponseWriter, r *http.Request) {
+	q, err := parseQuery(r)
+	if err != nil {
+		util.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	plans, err := h.plans(q)
+	if err != nil {
+		util.WriteErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		return itemKey(plans[i].Namespace, plans[i].Name) < itemKey(plans[j].Namespace, plans[j].Name)
+	})
+	keys := make([]string, len(plans))
+	for i, plan := range plans {
+		keys[i] = itemKey(plan.Namespace, plan.Name)
+	}
+	start, end, next := q.page(keys)
+	util.WriteResponse(w, http.StatusOK, &PlanList{Items: plans[start:end], Continue: next})
+}
+
+// classes returns the classes passing the filters of the query.
+func (h *Handler) classes(q *query) ([]Class, error) {
+	brokers := newBrokerCache(h.listers)
+	classes := []Class{}
+
+	clusterClasses, err := h.listers.ClusterServiceClasses.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range clusterClasses {
+		spec := class.Spec
+		if class.Status.RemovedFromBrokerCatalog || !q.matches(spec.ClusterServiceBrokerName, spec.ExternalName, spec.Description) {
+			continue
+		}
+		classes = append(classes, newClass(class.Name, "", spec.CommonServiceClassSpec, brokers.clusterBroker(spec.ClusterServiceBrokerName)))
+	}
+
+	if q.namespace == "" {
+		return classes, nil
+	}
+	namespacedClasses, err := h.listers.ServiceClasses.ServiceClasses(q.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range namespacedClasses {
+		spec := class.Spec
+		if class.Status.RemovedFromBrokerCatalog || !q.matches(spec.ServiceBrokerName, spec.ExternalName, spec.Description) {
+			continue
+		}
+		classes = append(classes, newClass(class.Name, class.Namespace, spec.CommonServiceClassSpec, brokers.broker(class.Namespace, spec.ServiceBrokerName)))
+	}
+	return classes, nil
+}
+
+func newClass(name, namespace string, spec v1beta1.CommonServiceClassSpec, broker Broker) Class {
+	return Class{
+		Name:          name,
+		Namespace:     namespace,
+		ExternalName:  spec.ExternalName,
+		ExternalID:    spec.ExternalID,
+		Description:   spec.Description,
+		Bindable:      spec.Bindable,
+		PlanUpdatable: spec.PlanUpdatable,
+		Tags:          spec.Tags,
+		Broker:        broker,
+	}
+}
+
+// plans returns the plans passing the filters of the query.
+func (h *Handler) plans(q *query) ([]Plan, error) {
+	brokers := newBrokerCache(h.listers)
+	plans := []Plan{}
+
+	clusterPlans, err := h.listers.ClusterServicePlans.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range clusterPlans {
+		spec := plan.Spec
+		if plan.Status.RemovedFromBrokerCatalog || (q.freeOnly && !spec.Free) ||
+			!q.matches(spec.ClusterServiceBrokerName, spec.ExternalName, spec.Description) {
+			continue
+		}
+		class := ClassReference{Name: spec.ClusterServiceClassRef.Name}
+		if c, err := h.listers.ClusterServiceClasses.Get(class.Name); err == nil {
+			class.ExternalName = c.Spec.ExternalName
+		}
+		if q.class != "" && q.class != class.ExternalName {
+			continue
+		}
+		plans = append(plans, newPlan(plan.Name, "", spec.CommonServicePlanSpec, plan.GetCosts(), class, brokers.clusterBroker(spec.ClusterServiceBrokerName)))
+	}
+
+	if q.namespace == "" {
+		return plans, nil
+	}
+	namespacedPlans, err := h.listers.ServicePlans.ServicePlans(q.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range namespacedPlans {
+		spec := plan.Spec
+		if plan.Status.RemovedFromBrokerCatalog || (q.freeOnly && !spec.Free) ||
+			!q.matches(spec.ServiceBrokerName, spec.ExternalName, spec.Description) {
+			continue
+		}
+		class := ClassReference{Name: spec.ServiceClassRef.Name}
+		if c, err := h.listers.ServiceClasses.ServiceClasses(plan.Namespace).Get(class.Name); err == nil {
+			class.ExternalName = c.Spec.ExternalName
+		}
+		if q.class != "" && q.class != class.ExternalName {
+			continue
+		}
+		plans = append(plans, newPlan(plan.Name, plan.Namespace, spec.CommonServicePlanSpec, plan.GetCosts(), class, brokers.broker(plan.Namespace, spec.ServiceBrokerName)))
+	}
+	return plans, nil
+}
+
+func newPlan(name, namespace string, spec v1beta1.CommonServicePlanSpec, costs []v1beta1.PlanCost, class ClassReference, broker Broker) Plan {
+	return Plan{
+		Name:         name,
+		Namespace:    namespace,
+		ExternalName: spec.ExternalName,
+		ExternalID:   spec.ExternalID,
+		Description:  spec.Description,
+		Free:         spec.Free,
+		Bindable:     spec.Bindable,
+		Costs:        costs,
+		Class:        class,
+		Broker:       broker,
+	}
+}
+
+// brokerCache looks up each broker once per request.
+type brokerCache struct {
+	listers Listers
+	brokers map[string]Broker
+}
+
+func newBrokerCache(listers Listers) *brokerCache {
+	return &brokerCache{listers: listers, brokers: map[string]Broker{}}
+}
+
+// clusterBroker returns the ClusterServiceBroker with the given name. A
+// broker that cannot be found is returned with only its name.
+func (c *brokerCache) clusterBroker(name string) Broker {
+	key := itemKey("", name)
+	if broker, ok := c.brokers[key]; ok {
+		return broker
+	}
+	broker := Broker{Name: name}
+	if b, err := c.listers.ClusterServiceBrokers.Get(name); err == nil {
+		broker.URL = b.Spec.URL
+		broker.Ready = isBrokerReady(b.Status.CommonServiceBrokerStatus)
+	}
+	c.brokers[key] = broker
+	return broker
+}
+
+// broker returns the ServiceBroker with the given namespace and name. A
+// broker that cannot be found is returned with only its name.
+func (c *brokerCache) broker(namespace, name string) Broker {
+	key := itemKey(namespace, name)
+	if broker, ok := c.brokers[key]; ok {
+		return broker
+	}
+	broker := Broker{Name: name, Namespace: namespace}
+	if b, err := c.listers.ServiceBrokers.ServiceBrokers(namespace).Get(name); err == nil {
+		broker.URL = b.Spec.URL
+		broker.Ready = isBrokerReady(b.Status.CommonServiceBrokerStatus)
+	}
+	c.brokers[key] = broker
+	return broker
+}
+
+func isBrokerReady(status v1beta1.CommonServiceBrokerStatus) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == v1beta1.ServiceBrokerConditionReady {
+			return condition.Status == v1beta1.ConditionTrue
+		}
+	}
+	return false
+}
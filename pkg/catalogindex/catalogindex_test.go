@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogindex
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+)
+
+func newIndexer(objects ...interface{}) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, object := range objects {
+		indexer.Add(object)
+	}
+	return indexer
+}
+
+func newTestHandler() *Handler {
+	clusterBroker := &v1beta1.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-broker"},
+		Spec: v1beta1.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{URL: "https://cluster-broker"},
+		},
+		Status: v1beta1.ClusterServiceBrokerStatus{
+			CommonServiceBrokerStatus: v1beta1.CommonServiceBrokerStatus{
+				Conditions: []v1beta1.ServiceBrokerCondition{{Type: v1beta1.ServiceBrokerConditionReady, Status: v1beta1.ConditionTrue}},
+			},
+		},
+	}
+	broker := &v1beta1.ServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "team-broker"},
+		Spec: v1beta1.ServiceBrokerSpec{
+			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{URL: "https://team-broker"},
+		},
+	}
+	clusterClass := &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-class"},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			CommonServiceClassSpec:   v1beta1.CommonServiceClassSpec{ExternalName: "db", Description: "A database"},
+			ClusterServiceBrokerName: "cluster-broker",
+		},
+	}
+	removedClass := &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "removed-class"},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			CommonServiceClassSpec:   v1beta1.CommonServiceClassSpec{ExternalName: "removed"},
+			ClusterServiceBrokerName: "cluster-broker",
+		},
+		Status: v1beta1.ClusterServiceClassStatus{
+			CommonServiceClassStatus: v1beta1.CommonServiceClassStatus{RemovedFromBrokerCatalog: true},
+		},
+	}
+	class := &v1beta1.ServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "queue-class"},
+		Spec: v1beta1.ServiceClassSpec{
+			CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalName: "queue", Description: "A message queue"},
+			ServiceBrokerName:      "team-broker",
+		},
+	}
+	clusterPlan := func(name string, free bool) *v1beta1.ClusterServicePlan {
+		return &v1beta1.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1beta1.ClusterServicePlanSpec{
+				CommonServicePlanSpec:    v1beta1.CommonServicePlanSpec{ExternalName: name, Free: free},
+				ClusterServiceBrokerName: "cluster-broker",
+				ClusterServiceClassRef:   v1beta1.ClusterObjectReference{Name: "db-class"},
+			},
+		}
+	}
+	plan := &v1beta1.ServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "queue-plan"},
+		Spec: v1beta1.ServicePlanSpec{
+			CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "standard"},
+			ServiceBrokerName:     "team-broker",
+			ServiceClassRef:       v1beta1.LocalObjectReference{Name: "queue-class"},
+		},
+	}
+
+	return NewHandler(Listers{
+		ClusterServiceBrokers: listers.NewClusterServiceBrokerLister(newIndexer(clusterBroker)),
+		ServiceBrokers:        listers.NewServiceBrokerLister(newIndexer(broker)),
+		ClusterServiceClasses: listers.NewClusterServiceClassLister(newIndexer(clusterClass, removedClass)),
+		ServiceClasses:        listers.NewServiceClassLister(newIndexer(class)),
+		ClusterServicePlans:   listers.NewClusterServicePlanLister(newIndexer(clusterPlan("db-small", true), clusterPlan("db-large", false))),
+		ServicePlans:          listers.NewServicePlanLister(newIndexer(plan)),
+	})
+}
+
+func get(t *testing.T, handler *Handler, url string, into interface{}) int {
+	mux := http.NewServeMux()
+	handler.Install(mux)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+	if recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), into); err != nil {
+			t.Fatalf("unexpected error decoding the response: %v", err)
+		}
+	}
+	return recorder.Code
+}
+
+func TestClasses(t *testing.T) {
+	handler := newTestHandler()
+
+	list := &ClassList{}
+	if code := get(t, handler, ClassesPath, list); code != http.StatusOK {
+		t.Fatalf("unexpected status %d", code)
+	}
+	expected := []Class{{
+		Name:         "db-class",
+		ExternalName: "db",
+		Description:  "A database",
+		Broker:       Broker{Name: "cluster-broker", URL: "https://cluster-broker", Ready: true},
+	}}
+	if !reflect.DeepEqual(expected, list.Items) {
+		t.Fatalf("unexpected classes: expected %+v, got %+v", expected, list.Items)
+	}
+
+	list = &ClassList{}
+	get(t, handler, ClassesPath+"?namespace=team&search=QUEUE", list)
+	expected = []Class{{
+		Name:         "queue-class",
+		Namespace:    "team",
+		ExternalName: "queue",
+		Description:  "A message queue",
+		Broker:       Broker{Name: "team-broker", Namespace: "team", URL: "https://team-broker"},
+	}}
+	if !reflect.DeepEqual(expected, list.Items) {
+		t.Fatalf("unexpected classes: expected %+v, got %+v", expected, list.Items)
+	}
+}
+
+func TestPlansFilters(t *testing.T) {
+	handler := newTestHandler()
+
+	cases := []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"db-large", "db-small"}},
+		{"?free=true", []string{"db-small"}},
+		{"?namespace=team", []string{"db-large", "db-small", "queue-plan"}},
+		{"?namespace=team&class=queue", []string{"queue-plan"}},
+		{"?namespace=team&broker=cluster-broker", []string{"db-large", "db-small"}},
+	}
+	for _, tc := range cases {
+		list := &PlanList{}
+		if code := get(t, handler, PlansPath+tc.query, list); code != http.StatusOK {
+			t.Fatalf("%q: unexpected status %d", tc.query, code)
+		}
+		var names []string
+		for _, plan := range list.Items {
+			names = append(names, plan.Name)
+		}
+		if !reflect.DeepEqual(tc.expected, names) {
+			t.Errorf("%q: unexpected plans: expected %v, got %v", tc.query, tc.expected, names)
+		}
+	}
+
+	list := &PlanList{}
+	get(t, handler, PlansPath+"?namespace=team&class=queue", list)
+	if e, a := (ClassReference{Name: "queue-class", ExternalName: "queue"}), list.Items[0].Class; e != a {
+		t.Fatalf("unexpected class: expected %+v, got %+v", e, a)
+	}
+}
+
+func TestPlansPagination(t *testing.T) {
+	handler := newTestHandler()
+
+	var names []string
+	url := PlansPath + "?namespace=team&limit=2"
+	for pages := 0; ; pages++ {
+		if pages > 2 {
+			t.Fatal("too many pages")
+		}
+		list := &PlanList{}
+		if code := get(t, handler, url, list); code != http.StatusOK {
+			t.Fatalf("unexpected status %d", code)
+		}
+		for _, plan := range list.Items {
+			names = append(names, plan.Name)
+		}
+		if list.Continue == "" {
+			break
+		}
+		url = PlansPath + "?namespace=team&limit=2&continue=" + list.Continue
+	}
+	if e := []string{"db-large", "db-small", "queue-plan"}; !reflect.DeepEqual(e, names) {
+		t.Fatalf("unexpected plans: expected %v, got %v", e, names)
+	}
+}
+
+func TestInvalidQuery(t *testing.T) {
+	handler := newTestHandler()
+	for _, query := range []string{"?limit=0", "?limit=many", "?free=maybe", "?continue=!"} {
+		if code := get(t, handler, PlansPath+query, nil); code != http.StatusBadRequest {
+			t.Errorf("%q: unexpected status %d", query, code)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogsource loads the catalogs of brokers from the sources of
+// their spec.catalogSource, a ConfigMap, a URL or an OCI artifact, instead of
+// their /v2/catalog endpoint.
+//
+// A catalog is a document in the format of the response of the /v2/catalog
+// endpoint of the Open Service Broker API, in JSON or YAML.
+package catalogsource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// maxCatalogSize is the largest catalog, in bytes, that is read from a URL or
+// an OCI artifact. ConfigMaps are already limited by the API server.
+const maxCatalogSize = 16 << 20
+
+// Loader loads the catalogs of brokers from their catalog sources.
+type Loader struct {
+	kubeClient kubernetes.Interface
+	httpClient *http.Client
+}
+
+// NewLoader returns a Loader that reads ConfigMaps through kubeClient, and
+// URLs and OCI artifacts through httpClient.
+func NewLoader(kubeClient kubernetes.Interface, httpClient *http.Client) *Loader {
+	return &Loader{
+		kubeClient: kubeClient,
+		httpClient: httpClient,
+	}
+}
+
+// Load loads the catalog of source. namespace is the namespace of the
+// broker, or empty for a ClusterServiceBroker, which the ConfigMap of source
+// defaults to.
+func (l *Loader) Load(source *v1beta1.CatalogSource, namespace string) (*osb.CatalogResponse, error) {
+	var data []byte
+	var err error
+	switch {
+	case source.ConfigMapRef != nil:
+		data, err = l.loadConfigMap(source.ConfigMapRef, namespace)
+	case source.URL != "":
+		data, err = l.get(source.URL, "", "")
+	case source.OCIArtifact != "":
+		data, err = l.loadOCIArtifact(source.OCIArtifact)
+	default:
+		return nil, fmt.Errorf("the catalog source is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &osb.CatalogResponse{}
+	if err := yaml.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("could not parse the catalog: %v", err)
+	}
+	return catalog, nil
+}
+
+func (l *Loader) loadConfigMap(ref *v1beta1.ConfigMapKeyReference, namespace string) ([]byte, error) {
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	configMap, err := l.kubeClient.CoreV1().ConfigMaps(namespace).Get(context.Background(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf(`could not get the ConfigMap "%s/%s" of the catalog: %v`, namespace, ref.Name, err)
+	}
+	if data, ok := configMap.Data[ref.Key]; ok {
+		return []byte(data), nil
+	}
+	if data, ok := configMap.BinaryData[ref.Key]; ok {
+		return data, nil
+	}
+	return nil, fmt.Errorf(`the ConfigMap "%s/%s" of the catalog has no key %q`, namespace, ref.Name, ref.Key)
+}
+
+// get returns the body of a successful GET request to url, sent with the
+// given Accept and Authorization headers if they are not empty.
+func (l *Loader) get(url, accept, authorization string) ([]byte, error) {
+	resp, err := l.do(url, accept, authorization)
+	if err != nil {
+		return nil, err
+	}
+	return readBody(url, resp)
+}
+
+func (l *Loader) do(url, accept, authorization string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return l.httpClient.Do(req)
+}
+
+// readBody reads and closes the body of resp, a response from url, which
+// must be successful and no larger than maxCatalogSize.
+func readBody(url string, resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCatalogSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %v", url, err)
+	}
+	if len(data) > maxCatalogSize {
+		return nil, fmt.Errorf("GET %s: the response exceeds the maximum of %d bytes", url, maxCatalogSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const testCatalogYAML = `services:
+- id: test-service-id
+  name: test-service
+  description: A test service
+  bindable: true
+  plans:
+  - id: test-plan-id
+    name: test-plan
+    description: A test plan
+`
+
+const testCatalogJSON = `{"services":[{"id":"test-service-id","name":"test-service","description":"A test service","bindable":true,"plans":[{"id":"test-plan-id","name":"test-plan","description":"A test plan"}]}]}`
+
+func checkCatalog(t *testing.T, loader *Loader, source *v1beta1.CatalogSource, namespace string) {
+	t.Helper()
+	catalog, err := loader.Load(source, namespace)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(catalog.Services) != 1 || len(catalog.Services[0].Plans) != 1 {
+		t.Fatalf("unexpected catalog: %+v", catalog)
+	}
+	if e, a := "test-plan-id", catalog.Services[0].Plans[0].ID; e != a {
+		t.Fatalf("unexpected plan ID: expected %q, got %q", e, a)
+	}
+}
+
+func TestLoadConfigMap(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-catalog"},
+		Data:       map[string]string{"catalog.yaml": testCatalogYAML},
+	})
+	loader := NewLoader(kubeClient, http.DefaultClient)
+
+	// The ConfigMap defaults to the namespace of the broker.
+	checkCatalog(t, loader, &v1beta1.CatalogSource{
+		ConfigMapRef: &v1beta1.ConfigMapKeyReference{Name: "test-catalog", Key: "catalog.yaml"},
+	}, "test-ns")
+	checkCatalog(t, loader, &v1beta1.CatalogSource{
+		ConfigMapRef: &v1beta1.ConfigMapKeyReference{Namespace: "test-ns", Name: "test-catalog", Key: "catalog.yaml"},
+	}, "")
+
+	_, err := loader.Load(&v1beta1.CatalogSource{
+		ConfigMapRef: &v1beta1.ConfigMapKeyReference{Name: "test-catalog", Key: "other.yaml"},
+	}, "test-ns")
+	if err == nil || !strings.Contains(err.Error(), "has no key") {
+		t.Fatalf("expected a missing key error, got %v", err)
+	}
+}
+
+func TestLoadURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/catalog.json" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, testCatalogJSON)
+	}))
+	defer server.Close()
+	loader := NewLoader(fake.NewSimpleClientset(), server.Client())
+
+	checkCatalog(t, loader, &v1beta1.CatalogSource{URL: server.URL + "/catalog.json"}, "")
+
+	if _, err := loader.Load(&v1beta1.CatalogSource{URL: server.URL + "/missing.json"}, ""); err == nil {
+		t.Fatal("expected an error for a missing catalog")
+	}
+}
+
+func TestLoadOCIArtifact(t *testing.T) {
+	sum := sha256.Sum256([]byte(testCatalogJSON))
+	layerDigest := "sha256:" + hex.EncodeToString(sum[:])
+	manifest := fmt.Sprintf(`{"schemaVersion":2,"mediaType":%q,"layers":[{"mediaType":"application/vnd.example.readme","digest":"sha256:%s"},{"mediaType":%q,"digest":%q}]}`,
+		ociManifestMediaType, strings.Repeat("0", 64), CatalogMediaType, layerDigest)
+	sum = sha256.Sum256([]byte(manifest))
+	manifestDigest := "sha256:" + hex.EncodeToString(sum[:])
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if e, a := "repository:catalogs/broker:pull", r.URL.Query().Get("scope"); e != a {
+				t.Errorf("unexpected token scope: expected %q, got %q", e, a)
+			}
+			fmt.Fprint(w, `{"token":"test-token"}`)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test-registry"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/catalogs/broker/manifests/1.0", "/v2/catalogs/broker/manifests/" + manifestDigest:
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			fmt.Fprint(w, manifest)
+		case "/v2/catalogs/broker/blobs/" + layerDigest:
+			fmt.Fprint(w, testCatalogJSON)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "https://")
+	loader := NewLoader(fake.NewSimpleClientset(), server.Client())
+
+	checkCatalog(t, loader, &v1beta1.CatalogSource{OCIArtifact: registry + "/catalogs/broker:1.0"}, "")
+	checkCatalog(t, loader, &v1beta1.CatalogSource{OCIArtifact: registry + "/catalogs/broker@" + manifestDigest}, "")
+
+	otherDigest := "sha256:" + strings.Repeat("1", 64)
+	if _, err := loader.Load(&v1beta1.CatalogSource{OCIArtifact: registry + "/catalogs/broker@" + otherDigest}, ""); err == nil {
+		t.Fatal("expected an error for a missing manifest")
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	cases := []struct {
+		reference string
+		expected  *Reference
+	}{
+		{
+			reference: "registry.example.com/catalogs/broker:1.0",
+			expected:  &Reference{Registry: "registry.example.com", Repository: "catalogs/broker", Tag: "1.0"},
+		},
+		{
+			reference: "localhost:5000/broker",
+			expected:  &Reference{Registry: "localhost:5000", Repository: "broker", Tag: "latest"},
+		},
+		{
+			reference: "registry.example.com/broker@sha256:" + strings.Repeat("a", 64),
+			expected:  &Reference{Registry: "registry.example.com", Repository: "broker", Digest: "sha256:" + strings.Repeat("a", 64)},
+		},
+		{reference: "catalogs/broker:1.0"},
+		{reference: "broker"},
+		{reference: "registry.example.com/Broker:1.0"},
+		{reference: "registry.example.com/broker:-1.0"},
+		{reference: "registry.example.com/broker@md5:" + strings.Repeat("a", 32)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.reference, func(t *testing.T) {
+			ref, err := ParseReference(tc.reference)
+			if tc.expected == nil {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *ref != *tc.expected {
+				t.Fatalf("unexpected reference: expected %+v, got %+v", tc.expected, ref)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	// CatalogMediaType is the media type of the layer of an OCI artifact
+	// that holds a catalog.
+	CatalogMediaType = "application/vnd.servicecatalog.catalog.v1+json"
+
+	// defaultTag is the tag of the OCI artifacts referenced by neither a tag
+	// nor a digest.
+	defaultTag = "latest"
+
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+var (
+	repositoryRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegexp        = regexp.MustCompile(`^\w[\w.-]{0,127}$`)
+	digestRegexp     = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Reference is a parsed reference of an OCI artifact.
+type Reference struct {
+	// Registry is the host, and optional port, of the registry.
+	Registry string
+	// Repository is the path of the repository in the registry.
+	Repository string
+	// Tag is the tag of the artifact, if it is not referenced by digest.
+	Tag string
+	// Digest is the sha256 digest of the manifest of the artifact.
+	Digest string
+}
+
+// ParseReference parses the reference of an OCI artifact, such as
+// registry.example.com/catalogs/broker:1.0 or
+// registry.example.com/catalogs/broker@sha256:<digest>. The registry must be
+// explicit, and the tag defaults to latest.
+func ParseReference(s string) (*Reference, error) {
+	i := strings.Index(s, "/")
+	if i < 0 {
+		return nil, fmt.Errorf("%q has no registry", s)
+	}
+	ref := &Reference{Registry: s[:i]}
+	if !strings.ContainsAny(ref.Registry, ".:") && ref.Registry != "localhost" {
+		return nil, fmt.Errorf("%q has no registry: %q is not a host", s, ref.Registry)
+	}
+
+	rest := s[i+1:]
+	if i := strings.Index(rest, "@"); i >= 0 {
+		ref.Digest = rest[i+1:]
+		rest = rest[:i]
+		if !digestRegexp.MatchString(ref.Digest) {
+			return nil, fmt.Errorf("%q has an invalid digest: only sha256 digests are supported", s)
+		}
+	} else if i := strings.LastIndex(rest, ":"); i >= 0 {
+		ref.Tag = rest[i+1:]
+		rest = rest[:i]
+		if !tagRegexp.MatchString(ref.Tag) {
+			return nil, fmt.Errorf("%q has an invalid tag", s)
+		}
+	} else {
+		ref.Tag = defaultTag
+	}
+	ref.Repository = rest
+	if !repositoryRegexp.MatchString(ref.Repository) {
+		return nil, fmt.Errorf("%q has an invalid repository", s)
+	}
+	return ref, nil
+}
+
+// reference returns the tag or digest the manifest of the artifact is
+// fetched by.
+func (r *Reference) reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// catalogLayer returns the layer of the manifest of media type
+// CatalogMediaType, or its only layer.
+func (m *ociManifest) catalogLayer() (*ociDescriptor, error) {
+	for i := range m.Layers {
+		if m.Layers[i].MediaType == CatalogMediaType {
+			return &m.Layers[i], nil
+		}
+	}
+	if len(m.Layers) == 1 {
+		return &m.Layers[0], nil
+	}
+	return nil, fmt.Errorf("the artifact has %d layers, none of media type %s", len(m.Layers), CatalogMediaType)
+}
+
+// loadOCIArtifact pulls the catalog of an OCI artifact.
+func (l *Loader) loadOCIArtifact(s string) ([]byte, error) {
+	ref, err := ParseReference(s)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := l.registryGet(ref, "manifests/"+ref.reference(), ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Digest != "" {
+		if err := verifyDigest(ref.Digest, data); err != nil {
+			return nil, fmt.Errorf("the manifest of %s: %v", s, err)
+		}
+	}
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("could not parse the manifest of %s: %v", s, err)
+	}
+	if manifest.MediaType != "" && manifest.MediaType != ociManifestMediaType && manifest.MediaType != dockerManifestMediaType {
+		return nil, fmt.Errorf("the manifest of %s is of unsupported media type %s", s, manifest.MediaType)
+	}
+	layer, err := manifest.catalogLayer()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", s, err)
+	}
+	if !digestRegexp.MatchString(layer.Digest) {
+		return nil, fmt.Errorf("the catalog layer of %s has an unsupported digest %q", s, layer.Digest)
+	}
+
+	data, err = l.registryGet(ref, "blobs/"+layer.Digest, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDigest(layer.Digest, data); err != nil {
+		return nil, fmt.Errorf("the catalog layer of %s: %v", s, err)
+	}
+	return data, nil
+}
+
+// registryGet sends a GET request for path, relative to the repository of
+// ref, to the registry of ref. The registries that require a bearer token
+// are sent the token their authorization service issues anonymously.
+func (l *Loader) registryGet(ref *Reference, path, accept string) ([]byte, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", ref.Registry, ref.Repository, path)
+	resp, err := l.do(u, accept, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		token, err := l.registryToken(challenge, ref)
+		if err != nil {
+			return nil, err
+		}
+		if resp, err = l.do(u, accept, "Bearer "+token); err != nil {
+			return nil, err
+		}
+	}
+	return readBody(u, resp)
+}
+
+// registryToken requests an anonymous pull token from the authorization
+// service of a bearer challenge.
+func (l *Loader) registryToken(challenge string, ref *Reference) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "Bearer") || params["realm"] == "" {
+		return "", fmt.Errorf("the registry %s requires unsupported authentication %q", ref.Registry, challenge)
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", fmt.Errorf("the registry %s has an invalid authorization realm: %v", ref.Registry, err)
+	}
+	query := realm.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", ref.Repository)
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	data, err := l.get(realm.String(), "", "")
+	if err != nil {
+		return "", err
+	}
+	var response struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return "", fmt.Errorf("could not parse the token of the registry %s: %v", ref.Registry, err)
+	}
+	if response.Token != "" {
+		return response.Token, nil
+	}
+	if response.AccessToken != "" {
+		return response.AccessToken, nil
+	}
+	return "", fmt.Errorf("the registry %s issued no token", ref.Registry)
+}
+
+// parseChallenge parses a WWW-Authenticate challenge, such as
+// Bearer realm="https://auth.example.com/token",service="registry".
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := map[string]string{}
+	challenge = strings.TrimSpace(challenge)
+	i := strings.Index(challenge, " ")
+	if i < 0 {
+		return challenge, params
+	}
+	scheme, rest := challenge[:i], challenge[i+1:]
+	for {
+		rest = strings.TrimLeft(rest, " ,")
+		i := strings.Index(rest, "=")
+		if i < 0 {
+			return scheme, params
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:i]))
+		rest = rest[i+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				return scheme, params
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else if end := strings.Index(rest, ","); end >= 0 {
+			value, rest = rest[:end], rest[end:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = value
+	}
+}
+
+// verifyDigest checks that data has the given sha256 digest.
+func verifyDigest(digest string, data []byte) error {
+	sum := sha256.Sum256(data)
+	if actual := "sha256:" + hex.EncodeToString(sum[:]); actual != digest {
+		return fmt.Errorf("digest %s does not match the expected %s", actual, digest)
+	}
+	return nil
+}
@@ -17,18 +17,31 @@ limitations under the License.
 package controller
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"net/http"
 	"reflect"
+	"sort"
 	"sync"
+	"time"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	"k8s.io/apimachinery/pkg/util/dump"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+	"github.com/drycc-addons/service-catalog/pkg/util"
 )
 
 // BrokerKey defines a key which points to a broker (cluster wide or namespaced)
 type BrokerKey struct {
 	name      string
 	namespace string
+	// bindings is set for the key of the client used for the binding
+	// requests of the broker.
+	bindings bool
 }
 
 // IsClusterScoped whether this broker key points to cluster scoped service broker.
@@ -38,10 +51,32 @@ func (bk *BrokerKey) IsClusterScoped() bool {
 
 // String returns string representation of the broker key
 func (bk *BrokerKey) String() string {
-	if bk.IsClusterScoped() {
-		return bk.name
+	name := bk.name
+	if !bk.IsClusterScoped() {
+		name = fmt.Sprintf("%s/%s", bk.namespace, bk.name)
+	}
+	if bk.bindings {
+		return name + " (bindings)"
+	}
+	return name
+}
+
+// ForBindings returns the key of the client used for the bind, unbind and
+// binding last operation requests of the broker, which has its own timeout.
+func (bk *BrokerKey) ForBindings() BrokerKey {
+	return BrokerKey{
+		name:      bk.name,
+		namespace: bk.namespace,
+		bindings:  true,
+	}
+}
+
+// forBroker returns the key of the broker itself, shared by its clients.
+func (bk *BrokerKey) forBroker() BrokerKey {
+	return BrokerKey{
+		name:      bk.name,
+		namespace: bk.namespace,
 	}
-	return fmt.Sprintf("%s/%s", bk.namespace, bk.name)
 }
 
 // NewServiceBrokerKey creates a BrokerKey instance which points to namespaced broker
@@ -60,10 +95,21 @@ func NewClusterServiceBrokerKey(name string) BrokerKey {
 	}
 }
 
-// BrokerClientManager stores OSB client instances per broker
+// BrokerClientManager stores OSB client instances per broker. It is safe for
+// concurrent use.
 type BrokerClientManager struct {
+	// mu guards clients and limiters
 	mu      sync.RWMutex
 	clients map[BrokerKey]clientWithConfig
+	// limiters are the request rate limiters of the brokers which set a
+	// request rate limit, shared by the clients of a broker.
+	limiters map[BrokerKey]brokerRateLimiter
+
+	// tokensMu guards tokens
+	tokensMu sync.Mutex
+	// tokens are the service account tokens of the brokers which
+	// authenticate with one, shared by the clients of a broker.
+	tokens map[BrokerKey]brokerServiceAccountToken
 
 	brokerClientCreateFunc osb.CreateFunc
 }
@@ -72,33 +118,53 @@ type BrokerClientManager struct {
 func NewBrokerClientManager(brokerClientCreateFunc osb.CreateFunc) *BrokerClientManager {
 	return &BrokerClientManager{
 		clients:                map[BrokerKey]clientWithConfig{},
+		limiters:               map[BrokerKey]brokerRateLimiter{},
+		tokens:                 map[BrokerKey]brokerServiceAccountToken{},
 		brokerClientCreateFunc: brokerClientCreateFunc,
 	}
 }
 
-// UpdateBrokerClient creates new broker client if necessary (the ClientConfig has changed or there is no client for the broker),
-// the method returns created or stored osb.Client instance.
-func (m *BrokerClientManager) UpdateBrokerClient(brokerKey BrokerKey, clientConfig *osb.ClientConfiguration) (osb.Client, error) {
+// UpdateBrokerClient creates new broker client if necessary (the ClientConfig, the TLS configuration or the request rate
+// limit has changed or there is no client for the broker), the method returns created or stored osb.Client instance. The
+// requests of the clients of a broker with a request rate limit share a rate limiter. The TLS configuration of the broker
+// spec is compared instead of the one of clientConfig, whose callbacks never compare equal.
+func (m *BrokerClientManager) UpdateBrokerClient(brokerKey BrokerKey, clientConfig *osb.ClientConfiguration, rateLimit *v1beta1.BrokerRequestRateLimit, tlsConfig *v1beta1.ServiceBrokerTLSConfig) (osb.Client, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	existing, found := m.clients[brokerKey]
 
-	if !found || configHasChanged(existing.clientConfig, clientConfig) {
+	if !found {
+		klog.V(4).Infof("Creating OSB client for broker %q, URL: %s", brokerKey.String(), clientConfig.URL)
+		return m.createClient(brokerKey, clientConfig, rateLimit, tlsConfig, "new")
+	}
+	if configHasChanged(existing.clientConfig, clientConfig) || !reflect.DeepEqual(existing.tlsConfig, tlsConfig) {
 		klog.V(4).Infof("Updating OSB client for broker %q, URL: %s", brokerKey.String(), clientConfig.URL)
-		return m.createClient(brokerKey, clientConfig)
+		return m.createClient(brokerKey, clientConfig, rateLimit, tlsConfig, "config-changed")
+	}
+	if !reflect.DeepEqual(existing.rateLimit, rateLimit) {
+		klog.V(4).Infof("Updating the request rate limit of the OSB client for broker %q", brokerKey.String())
+		return m.createClient(brokerKey, clientConfig, rateLimit, tlsConfig, "rate-limit-changed")
 	}
 
 	return existing.OSBClient, nil
 }
 
-// RemoveBrokerClient removes broker client broker
+// RemoveBrokerClient removes the broker clients of a broker, including the
+// one used for its binding requests
 func (m *BrokerClientManager) RemoveBrokerClient(brokerKey BrokerKey) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	klog.V(4).Infof("Removing OSB client for broker %q", brokerKey.String())
 	delete(m.clients, brokerKey)
+	delete(m.clients, brokerKey.ForBindings())
+	delete(m.limiters, brokerKey.forBroker())
+	metrics.BrokerClientCount.Set(float64(len(m.clients)))
+
+	m.tokensMu.Lock()
+	defer m.tokensMu.Unlock()
+	delete(m.tokens, brokerKey.forBroker())
 }
 
 // BrokerClient returns broker client for a broker specified by the brokerKey
@@ -110,24 +176,141 @@ func (m *BrokerClientManager) BrokerClient(brokerKey BrokerKey) (osb.Client, boo
 	return existing.OSBClient, found
 }
 
-func (m *BrokerClientManager) createClient(brokerKey BrokerKey, clientConfig *osb.ClientConfiguration) (osb.Client, error) {
+// BrokerClientInfo describes the OSB client stored for a broker.
+type BrokerClientInfo struct {
+	// Broker is the name of the broker.
+	Broker string `json:"broker"`
+	// Namespace is the namespace of the broker, empty for a cluster scoped
+	// broker.
+	Namespace string `json:"namespace,omitempty"`
+	// Bindings is whether the client is the one used for the binding
+	// requests of the broker.
+	Bindings bool `json:"bindings,omitempty"`
+	// URL is the URL of the broker the client sends requests to.
+	URL string `json:"url"`
+	// ConfigHash is a hash of the configuration of the client, including the
+	// credentials. It changes whenever the client is recreated with a new
+	// configuration.
+	ConfigHash string `json:"configHash"`
+	// Created is when the client was created.
+	Created time.Time `json:"created"`
+}
+
+// BrokerClients returns the clients stored for brokers, sorted by broker key.
+func (m *BrokerClientManager) BrokerClients() []BrokerClientInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]BrokerKey, 0, len(m.clients))
+	for key := range m.clients {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	infos := make([]BrokerClientInfo, 0, len(keys))
+	for _, key := range keys {
+		existing := m.clients[key]
+		infos = append(infos, BrokerClientInfo{
+			Broker:     key.name,
+			Namespace:  key.namespace,
+			Bindings:   key.bindings,
+			URL:        existing.clientConfig.URL,
+			ConfigHash: existing.configHash,
+			Created:    existing.created,
+		})
+	}
+	return infos
+}
+
+// ServeHTTP lists the clients stored for brokers as JSON.
+func (m *BrokerClientManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	util.WriteResponse(w, http.StatusOK, m.BrokerClients())
+}
+
+// createClient creates and stores the client of a broker. The caller must
+// hold the write lock.
+func (m *BrokerClientManager) createClient(brokerKey BrokerKey, clientConfig *osb.ClientConfiguration, rateLimit *v1beta1.BrokerRequestRateLimit, tlsConfig *v1beta1.ServiceBrokerTLSConfig, reason string) (osb.Client, error) {
 	client, err := m.brokerClientCreateFunc(clientConfig)
 	if err != nil {
 		return nil, err
 	}
+	if rateLimit != nil {
+		client = &rateLimitedClient{
+			Client:  client,
+			limiter: m.rateLimiter(brokerKey.forBroker(), rateLimit),
+		}
+	}
 
 	m.clients[brokerKey] = clientWithConfig{
 		OSBClient:    client,
 		clientConfig: clientConfig,
+		rateLimit:    rateLimit,
+		tlsConfig:    tlsConfig.DeepCopy(),
+		configHash:   configHash(clientConfig),
+		created:      time.Now(),
 	}
+	metrics.BrokerClientCount.Set(float64(len(m.clients)))
+	metrics.BrokerClientCreationCount.WithLabelValues(brokerKey.String(), reason).Inc()
 	return client, nil
 }
 
+// rateLimiter returns the rate limiter of a broker, which is replaced when
+// its request rate limit has changed. The caller must hold the write lock.
+func (m *BrokerClientManager) rateLimiter(brokerKey BrokerKey, rateLimit *v1beta1.BrokerRequestRateLimit) flowcontrol.RateLimiter {
+	if existing, found := m.limiters[brokerKey]; found && existing.rateLimit == *rateLimit {
+		return existing.limiter
+	}
+	burst := int(rateLimit.Burst)
+	if burst == 0 {
+		burst = int(rateLimit.QPS)
+	}
+	limiter := flowcontrol.NewTokenBucketRateLimiter(float32(rateLimit.QPS), burst)
+	m.limiters[brokerKey] = brokerRateLimiter{
+		rateLimit: *rateLimit,
+		limiter:   limiter,
+	}
+	return limiter
+}
+
+// configHasChanged returns whether two client configurations differ,
+// leaving out the callbacks of their TLS configurations: func values are
+// never deeply equal, and UpdateBrokerClient compares the TLS configuration
+// of the broker spec they are built from instead.
 func configHasChanged(cfg1 *osb.ClientConfiguration, cfg2 *osb.ClientConfiguration) bool {
-	return !reflect.DeepEqual(cfg1, cfg2)
+	return !reflect.DeepEqual(withoutTLSCallbacks(cfg1), withoutTLSCallbacks(cfg2))
+}
+
+// withoutTLSCallbacks returns a copy of clientConfig whose TLS configuration
+// has no verification callbacks.
+func withoutTLSCallbacks(clientConfig *osb.ClientConfiguration) *osb.ClientConfiguration {
+	if clientConfig == nil || clientConfig.TLSConfig == nil {
+		return clientConfig
+	}
+	copied := *clientConfig
+	copied.TLSConfig = clientConfig.TLSConfig.Clone()
+	copied.TLSConfig.VerifyConnection = nil
+	copied.TLSConfig.VerifyPeerCertificate = nil
+	return &copied
+}
+
+// configHash returns a hash of the client configuration which identifies it
+// without revealing the credentials it contains.
+func configHash(clientConfig *osb.ClientConfiguration) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(dump.ForHash(withoutTLSCallbacks(clientConfig)))))[:16]
 }
 
 type clientWithConfig struct {
 	OSBClient    osb.Client
 	clientConfig *osb.ClientConfiguration
+	rateLimit    *v1beta1.BrokerRequestRateLimit
+	tlsConfig    *v1beta1.ServiceBrokerTLSConfig
+	configHash   string
+	created      time.Time
+}
+
+type brokerRateLimiter struct {
+	rateLimit v1beta1.BrokerRequestRateLimit
+	limiter   flowcontrol.RateLimiter
 }
@@ -17,10 +17,20 @@ limitations under the License.
 package controller_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/drycc-addons/service-catalog/pkg/controller"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestBrokerClientManager_CreateBrokerClient(t *testing.T) {
@@ -31,8 +41,8 @@ func TestBrokerClientManager_CreateBrokerClient(t *testing.T) {
 	manager := controller.NewBrokerClientManager(brokerClientFunc)
 
 	// WHEN
-	createdClient1, _ := manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"))
-	createdClient2, _ := manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"))
+	createdClient1, _ := manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"), nil, nil)
+	createdClient2, _ := manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"), nil, nil)
 	gotClient1, exists1 := manager.BrokerClient(controller.NewClusterServiceBrokerKey("broker1"))
 	gotClient2, exists2 := manager.BrokerClient(controller.NewServiceBrokerKey("prod", "broker1"))
 	_, exists3 := manager.BrokerClient(controller.NewServiceBrokerKey("stage", "broker1"))
@@ -71,8 +81,8 @@ func TestBrokerClientManager_RemoveBrokerClient(t *testing.T) {
 	manager := controller.NewBrokerClientManager(brokerClientFunc)
 
 	// WHEN
-	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"))
-	manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"))
+	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"), nil, nil)
+	manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"), nil, nil)
 	manager.RemoveBrokerClient(controller.NewClusterServiceBrokerKey("broker1"))
 	_, exists1 := manager.BrokerClient(controller.NewClusterServiceBrokerKey("broker1"))
 	_, exists2 := manager.BrokerClient(controller.NewServiceBrokerKey("prod", "broker1"))
@@ -108,11 +118,11 @@ func TestBrokerClientManager_UpdateBrokerClient(t *testing.T) {
 			Password: "password-changed",
 		},
 	}
-	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), osbCfg)
-	manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"))
+	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), osbCfg, nil, nil)
+	manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"), nil, nil)
 
 	// WHEN
-	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), osbCfgWithPasswordChange)
+	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), osbCfgWithPasswordChange, nil, nil)
 
 	// THEN
 	gotClient, exists := manager.BrokerClient(controller.NewClusterServiceBrokerKey("broker1"))
@@ -124,6 +134,251 @@ func TestBrokerClientManager_UpdateBrokerClient(t *testing.T) {
 	}
 }
 
+func TestBrokerClientManager_ServerIdentity(t *testing.T) {
+	// GIVEN
+	osbCl1, _ := osb.NewClient(testOsbConfig("osb-1"))
+	osbCl2, _ := osb.NewClient(testOsbConfig("osb-2"))
+	manager := controller.NewBrokerClientManager(clientFunc(osbCl1, osbCl2))
+	brokerKey := controller.NewClusterServiceBrokerKey("broker1")
+	meta := metav1.ObjectMeta{Name: "broker1"}
+	spec := &v1beta1.CommonServiceBrokerSpec{
+		URL: "https://broker.example.com",
+		TLSConfig: &v1beta1.ServiceBrokerTLSConfig{
+			ServerDNSNames: []string{"broker.example.com"},
+		},
+	}
+	newConfig := func() *osb.ClientConfiguration {
+		cfg, err := controller.NewClientConfigurationForBroker(meta, spec, nil, time.Minute, osb.LatestAPIVersion())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return cfg
+	}
+
+	// WHEN
+	client, _ := manager.UpdateBrokerClient(brokerKey, newConfig(), nil, spec.TLSConfig)
+	unchanged, _ := manager.UpdateBrokerClient(brokerKey, newConfig(), nil, spec.TLSConfig)
+	spec.TLSConfig = &v1beta1.ServiceBrokerTLSConfig{
+		ServerDNSNames: []string{"other.example.com"},
+	}
+	changed, _ := manager.UpdateBrokerClient(brokerKey, newConfig(), nil, spec.TLSConfig)
+
+	// THEN
+	if client != osbCl1 {
+		t.Fatal("Wrong client from broker1")
+	}
+	if unchanged != client {
+		t.Fatal("The client must be kept while the server identity of the broker is unchanged")
+	}
+	if changed != osbCl2 {
+		t.Fatal("The client must be recreated when the server identity of the broker changes")
+	}
+}
+
+func TestBrokerClientManager_RequestRateLimit(t *testing.T) {
+	// GIVEN
+	newFakeClient := func() osb.Client {
+		return fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+			CatalogReaction: &fakeosb.CatalogReaction{Response: &osb.CatalogResponse{}},
+		})
+	}
+	osbCl1, osbCl2, osbCl3 := newFakeClient(), newFakeClient(), newFakeClient()
+	manager := controller.NewBrokerClientManager(clientFunc(osbCl1, osbCl2, osbCl3))
+	brokerKey := controller.NewClusterServiceBrokerKey("broker1")
+	rateLimit := &v1beta1.BrokerRequestRateLimit{QPS: 10, Burst: 1}
+
+	// WHEN
+	client, _ := manager.UpdateBrokerClient(brokerKey, testOsbConfig("osb-1"), rateLimit, nil)
+	bindingClient, _ := manager.UpdateBrokerClient(brokerKey.ForBindings(), testOsbConfig("osb-1"), rateLimit, nil)
+	start := time.Now()
+	for _, c := range []osb.Client{client, bindingClient, client} {
+		if _, err := c.GetCatalog(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	// THEN
+	if client == osbCl1 || bindingClient == osbCl2 {
+		t.Fatal("The clients of a broker with a request rate limit must be rate limited")
+	}
+	if elapsed < 150*time.Millisecond {
+		t.Fatalf("The clients of a broker must share its rate limit: 3 requests took %v", elapsed)
+	}
+	if unchanged, _ := manager.UpdateBrokerClient(brokerKey, testOsbConfig("osb-1"), &v1beta1.BrokerRequestRateLimit{QPS: 10, Burst: 1}, nil); unchanged != client {
+		t.Fatal("The client must be kept while its rate limit is unchanged")
+	}
+	if unlimited, _ := manager.UpdateBrokerClient(brokerKey, testOsbConfig("osb-1"), nil, nil); unlimited != osbCl3 {
+		t.Fatal("The client must be recreated without a rate limit")
+	}
+}
+
+func TestBrokerClientManager_ServiceAccountToken(t *testing.T) {
+	// GIVEN
+	manager := controller.NewBrokerClientManager(clientFunc())
+	brokerKey := controller.NewServiceBrokerKey("prod", "broker1")
+	config := &v1beta1.ServiceAccountTokenAuthConfig{ServiceAccountName: "broker-client"}
+	var requests []*authenticationv1.TokenRequest
+	lifetime := time.Hour
+	requestToken := func(namespace, serviceAccountName string, request *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+		if namespace != "prod" || serviceAccountName != "broker-client" {
+			t.Fatalf("Unexpected token request for service account %s/%s", namespace, serviceAccountName)
+		}
+		requests = append(requests, request)
+		return &authenticationv1.TokenRequest{
+			Status: authenticationv1.TokenRequestStatus{
+				Token:               fmt.Sprintf("token-%d", len(requests)),
+				ExpirationTimestamp: metav1.NewTime(time.Now().Add(lifetime)),
+			},
+		}, nil
+	}
+
+	// WHEN
+	token1, err1 := manager.ServiceAccountToken(brokerKey, config, "http://example.com", requestToken)
+	token2, err2 := manager.ServiceAccountToken(brokerKey.ForBindings(), config, "http://example.com", requestToken)
+
+	// THEN
+	if err1 != nil || err2 != nil {
+		t.Fatalf("Unexpected errors: %v, %v", err1, err2)
+	}
+	if token1 != "token-1" || token2 != "token-1" {
+		t.Fatalf("The clients of a broker must share its token, got %q and %q", token1, token2)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 token request, got %d", len(requests))
+	}
+	if audiences := requests[0].Spec.Audiences; len(audiences) != 1 || audiences[0] != "http://example.com" {
+		t.Fatalf("The audience of the token must be the URL of the broker, got %v", audiences)
+	}
+	if expiration := requests[0].Spec.ExpirationSeconds; expiration == nil || *expiration != 3600 {
+		t.Fatalf("The token must be requested for 3600 seconds by default, got %v", expiration)
+	}
+
+	// WHEN the token is due for renewal
+	lifetime = -time.Minute
+	manager.RemoveBrokerClient(brokerKey)
+	manager.ServiceAccountToken(brokerKey, config, "http://example.com", requestToken)
+	token3, _ := manager.ServiceAccountToken(brokerKey, config, "http://example.com", requestToken)
+
+	// THEN
+	if token3 != "token-3" {
+		t.Fatalf("An expiring token must be renewed, got %q", token3)
+	}
+
+	// WHEN the audience changes
+	lifetime = time.Hour
+	manager.ServiceAccountToken(brokerKey, config, "http://example.com", requestToken)
+	token5, _ := manager.ServiceAccountToken(brokerKey, config, "http://other.example.com", requestToken)
+
+	// THEN
+	if token5 != "token-5" {
+		t.Fatalf("A token for a new audience must be requested, got %q", token5)
+	}
+}
+
+func TestBrokerClientManager_BrokerClients(t *testing.T) {
+	// GIVEN
+	osbCl1, _ := osb.NewClient(testOsbConfig("osb-1"))
+	osbCl2, _ := osb.NewClient(testOsbConfig("osb-2"))
+	osbCl3, _ := osb.NewClient(testOsbConfig("osb-3"))
+	brokerClientFunc := clientFunc(osbCl1, osbCl2, osbCl3)
+	manager := controller.NewBrokerClientManager(brokerClientFunc)
+
+	osbCfg := testOsbConfig("osb-1")
+	osbCfg.URL = "https://broker1.example.com"
+	manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-2"), nil, nil)
+	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), osbCfg, nil, nil)
+	before := manager.BrokerClients()
+
+	// WHEN
+	osbCfgWithPasswordChange := testOsbConfig("osb-1")
+	osbCfgWithPasswordChange.URL = "https://broker1.example.com"
+	osbCfgWithPasswordChange.AuthConfig = &osb.AuthConfig{
+		BasicAuthConfig: &osb.BasicAuthConfig{
+			Username: "user-1",
+			Password: "password-changed",
+		},
+	}
+	manager.UpdateBrokerClient(controller.NewClusterServiceBrokerKey("broker1"), osbCfgWithPasswordChange, nil, nil)
+	after := manager.BrokerClients()
+
+	// THEN
+	if len(after) != 2 {
+		t.Fatalf("Expected 2 broker clients, got %d", len(after))
+	}
+	if after[0].Broker != "broker1" || after[0].Namespace != "" || after[0].URL != "https://broker1.example.com" {
+		t.Fatalf("Unexpected first broker client: %+v", after[0])
+	}
+	if after[1].Broker != "broker1" || after[1].Namespace != "prod" {
+		t.Fatalf("Unexpected second broker client: %+v", after[1])
+	}
+	if after[0].ConfigHash == before[0].ConfigHash {
+		t.Fatal("The config hash must change with the credentials")
+	}
+	if after[0].Created.Before(before[0].Created) {
+		t.Fatal("The replaced client must have been created after the original one")
+	}
+	if after[1] != before[1] {
+		t.Fatalf("The unchanged broker client must be kept: expected %+v, got %+v", before[1], after[1])
+	}
+}
+
+func TestBrokerClientManager_ServeHTTP(t *testing.T) {
+	// GIVEN
+	osbCl1, _ := osb.NewClient(testOsbConfig("osb-1"))
+	manager := controller.NewBrokerClientManager(clientFunc(osbCl1))
+	manager.UpdateBrokerClient(controller.NewServiceBrokerKey("prod", "broker1"), testOsbConfig("osb-1"), nil, nil)
+
+	// WHEN
+	recorder := httptest.NewRecorder()
+	manager.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/brokerclients", nil))
+
+	// THEN
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code %d", recorder.Code)
+	}
+	var infos []controller.BrokerClientInfo
+	if err := json.Unmarshal(recorder.Body.Bytes(), &infos); err != nil {
+		t.Fatalf("Failed to unmarshal the response: %v", err)
+	}
+	if len(infos) != 1 || infos[0].Broker != "broker1" || infos[0].Namespace != "prod" || infos[0].ConfigHash == "" {
+		t.Fatalf("Unexpected broker clients: %+v", infos)
+	}
+}
+
+// TestBrokerClientManager_Concurrency exercises the manager from several
+// goroutines, so that the race detector can catch unguarded accesses.
+func TestBrokerClientManager_Concurrency(t *testing.T) {
+	// GIVEN
+	manager := controller.NewBrokerClientManager(func(cfg *osb.ClientConfiguration) (osb.Client, error) {
+		return osb.NewClient(cfg)
+	})
+
+	// WHEN
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := controller.NewClusterServiceBrokerKey(fmt.Sprintf("broker%d", i%3))
+			for j := 0; j < 50; j++ {
+				manager.UpdateBrokerClient(key, testOsbConfig(fmt.Sprintf("osb-%d", j%2)), nil, nil)
+				manager.BrokerClient(key)
+				manager.BrokerClients()
+				if j%10 == 0 {
+					manager.RemoveBrokerClient(key)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// THEN
+	if n := len(manager.BrokerClients()); n > 3 {
+		t.Fatalf("Expected at most 3 broker clients, got %d", n)
+	}
+}
+
 func clientFunc(clients ...osb.Client) osb.CreateFunc {
 	var i = 0
 	return func(_ *osb.ClientConfiguration) (osb.Client, error) {
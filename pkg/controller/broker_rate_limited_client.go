@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// rateLimitedClient is an OSB client which waits for the rate limiter of its
+// broker before sending each request.
+type rateLimitedClient struct {
+	osb.Client
+	limiter flowcontrol.RateLimiter
+}
+
+func (c *rateLimitedClient) GetCatalog() (*osb.CatalogResponse, error) {
+	c.limiter.Accept()
+	return c.Client.GetCatalog()
+}
+
+func (c *rateLimitedClient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.ProvisionResponse, error) {
+	c.limiter.Accept()
+	return c.Client.ProvisionInstance(r)
+}
+
+func (c *rateLimitedClient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateInstanceResponse, error) {
+	c.limiter.Accept()
+	return c.Client.UpdateInstance(r)
+}
+
+func (c *rateLimitedClient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.DeprovisionResponse, error) {
+	c.limiter.Accept()
+	return c.Client.DeprovisionInstance(r)
+}
+
+func (c *rateLimitedClient) GetInstance(r *osb.GetInstanceRequest) (*osb.GetInstanceResponse, error) {
+	c.limiter.Accept()
+	return c.Client.GetInstance(r)
+}
+
+func (c *rateLimitedClient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastOperationResponse, error) {
+	c.limiter.Accept()
+	return c.Client.PollLastOperation(r)
+}
+
+func (c *rateLimitedClient) PollBindingLastOperation(r *osb.BindingLastOperationRequest) (*osb.LastOperationResponse, error) {
+	c.limiter.Accept()
+	return c.Client.PollBindingLastOperation(r)
+}
+
+func (c *rateLimitedClient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
+	c.limiter.Accept()
+	return c.Client.Bind(r)
+}
+
+func (c *rateLimitedClient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error) {
+	c.limiter.Accept()
+	return c.Client.Unbind(r)
+}
+
+func (c *rateLimitedClient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingResponse, error) {
+	c.limiter.Accept()
+	return c.Client.GetBinding(r)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	// defaultServiceAccountTokenExpirationSeconds is the lifetime requested
+	// for the service account tokens of brokers which do not set one.
+	defaultServiceAccountTokenExpirationSeconds int64 = 3600
+
+	// serviceAccountTokenRefreshFraction is the fraction of the lifetime of
+	// a service account token after which a new token is requested, so that
+	// the requests sent to the broker never carry an expired token.
+	serviceAccountTokenRefreshFraction = 0.8
+)
+
+// ServiceAccountTokenRequester requests a token for a service account with
+// the TokenRequest API.
+type ServiceAccountTokenRequester func(namespace, serviceAccountName string, request *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error)
+
+// ServiceAccountToken returns a token of the service account of a broker
+// authenticating with one, with the URL of the broker as its audience. The
+// token is shared by the clients of the broker and is only requested again
+// once 80% of its lifetime has passed or the configuration has changed;
+// the client of the broker is then recreated with the new token by
+// UpdateBrokerClient.
+func (m *BrokerClientManager) ServiceAccountToken(brokerKey BrokerKey, config *v1beta1.ServiceAccountTokenAuthConfig, audience string, requestToken ServiceAccountTokenRequester) (string, error) {
+	m.tokensMu.Lock()
+	defer m.tokensMu.Unlock()
+
+	brokerKey = brokerKey.forBroker()
+	expirationSeconds := defaultServiceAccountTokenExpirationSeconds
+	if config.ExpirationSeconds != nil {
+		expirationSeconds = *config.ExpirationSeconds
+	}
+
+	now := time.Now()
+	existing, found := m.tokens[brokerKey]
+	if found && existing.serviceAccountName == config.ServiceAccountName &&
+		existing.audience == audience &&
+		existing.expirationSeconds == expirationSeconds &&
+		now.Before(existing.refreshAt) {
+		return existing.token, nil
+	}
+
+	klog.V(4).Infof("Requesting a token of service account %q for broker %q", config.ServiceAccountName, brokerKey.String())
+	response, err := requestToken(brokerKey.namespace, config.ServiceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{audience},
+			ExpirationSeconds: &expirationSeconds,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to request a token of service account %q: %v", config.ServiceAccountName, err)
+	}
+	if response.Status.Token == "" {
+		return "", fmt.Errorf("the token request of service account %q returned an empty token", config.ServiceAccountName)
+	}
+
+	// The API server may issue a token with a different lifetime than the
+	// requested one, so the refresh is based on the actual expiration.
+	lifetime := response.Status.ExpirationTimestamp.Sub(now)
+	m.tokens[brokerKey] = brokerServiceAccountToken{
+		serviceAccountName: config.ServiceAccountName,
+		audience:           audience,
+		expirationSeconds:  expirationSeconds,
+		token:              response.Status.Token,
+		refreshAt:          now.Add(time.Duration(float64(lifetime) * serviceAccountTokenRefreshFraction)),
+	}
+	return response.Status.Token, nil
+}
+
+type brokerServiceAccountToken struct {
+	serviceAccountName string
+	audience           string
+	expirationSeconds  int64
+	token              string
+	refreshAt          time.Time
+}
@@ -49,6 +49,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	kubeinformers "k8s.io/client-go/informers"
 	fakek8s "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
 )
@@ -142,15 +143,26 @@ func newControllerTest(t *testing.T) *controllerTest {
 		serviceCatalogSharedInformers.ServiceBindings(),
 		plansInformer,
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeinformers.NewSharedInformerFactory(k8sClient, 0).Core().V1().Namespaces(),
+		nil,
 		brokerClFunc,
 		24*time.Hour,
+		0,
+		0,
+		0,
+		0,
 		osb.LatestAPIVersion().HeaderValue(),
 		fakeRecorder,
 		7*24*time.Hour,
+		0,
 		7*24*time.Hour,
 		"DefaultClusterIDConfigMapName",
 		"DefaultClusterIDConfigMapNamespace",
 		60*time.Second,
+		30*time.Second,
+		nil,
+		false,
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
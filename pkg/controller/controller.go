@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -38,20 +40,28 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/catalogsource"
 	servicecatalogclientset "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
 	informers "github.com/drycc-addons/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
 	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
 	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
 	"github.com/drycc-addons/service-catalog/pkg/filter"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+	"github.com/drycc-addons/service-catalog/pkg/paramplugin"
 	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	"github.com/drycc-addons/service-catalog/pkg/secrettemplate"
+	"github.com/drycc-addons/service-catalog/pkg/util/tlsconfig"
 )
 
 const (
@@ -63,6 +73,9 @@ const (
 	maxRetries = 15
 	// pollingStartInterval is the initial interval to use when polling async OSB operations.
 	pollingStartInterval = 1 * time.Second
+	// allowContextUpdatesMetadataKey is the key of the service metadata
+	// that advertises that a broker accepts context-only updates.
+	allowContextUpdatesMetadataKey = "allow_context_updates"
 
 	// ContextProfilePlatformKubernetes is the platform name sent in the OSB
 	// ContextProfile for requests coming from Kubernetes.
@@ -85,40 +98,77 @@ func NewController(
 	bindingInformer informers.ServiceBindingInformer,
 	clusterServicePlanInformer informers.ClusterServicePlanInformer,
 	servicePlanInformer informers.ServicePlanInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
+	secretInformer coreinformers.SecretInformer,
 	brokerClientCreateFunc osb.CreateFunc,
 	brokerRelistInterval time.Duration,
+	brokerRelistJitterFactor float64,
+	brokerRelistConcurrency int,
+	maxConcurrentProvisions int,
+	instanceUpdateDebounce time.Duration,
 	osbAPIPreferredVersion string,
 	recorder record.EventRecorder,
 	reconciliationRetryDuration time.Duration,
+	reconciliationMaxAttempts int,
 	operationPollingMaximumBackoffDuration time.Duration,
 	clusterIDConfigMapName string,
 	clusterIDConfigMapNamespace string,
 	osbAPITimeOut time.Duration,
+	osbAPIBindingTimeOut time.Duration,
+	parametersPlugins paramplugin.Registry,
+	readOnly bool,
+	secretTemplates *secrettemplate.Templates,
 ) (Controller, error) {
+	terminating := newTerminatingNamespaces()
+	newPollingRateLimiter := func() workqueue.RateLimiter {
+		return newNamespaceRateLimiter(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), terminating)
+	}
 	controller := &controller{
 		kubeClient:                  kubeClient,
 		serviceCatalogClient:        serviceCatalogClient,
 		brokerRelistInterval:        brokerRelistInterval,
+		brokerRelistJitterFactor:    brokerRelistJitterFactor,
+		brokerRelists:               newBrokerRelistLimiter(brokerRelistConcurrency),
+		provisions:                  serviceInstanceProvisions{limit: maxConcurrentProvisions},
+		instanceUpdateDebounces:     serviceInstanceUpdateDebounces{window: instanceUpdateDebounce},
 		OSBAPIPreferredVersion:      osbAPIPreferredVersion,
 		OSBAPITimeOut:               osbAPITimeOut,
-		recorder:                    recorder,
+		OSBAPIBindingTimeOut:        osbAPIBindingTimeOut,
+		recorder:                    newCorrelatingEventRecorder(recorder),
 		reconciliationRetryDuration: reconciliationRetryDuration,
+		reconciliationMaxAttempts:   reconciliationMaxAttempts,
 		clusterServiceBrokerQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "cluster-service-broker"),
 		serviceBrokerQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "service-broker"),
 		clusterServiceClassQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-class"),
 		serviceClassQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-class"),
 		clusterServicePlanQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-plan"),
 		servicePlanQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-plan"),
-		instanceQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-instance"),
-		bindingQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-binding"),
-		instancePollingQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "instance-poller"),
-		bindingPollingQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "binding-poller"),
+		instanceQueue:               workqueue.NewNamedRateLimitingQueue(newNamespaceRateLimiter(workqueue.DefaultControllerRateLimiter(), terminating), "service-instance"),
+		bindingQueue:                workqueue.NewNamedRateLimitingQueue(newNamespaceRateLimiter(workqueue.DefaultControllerRateLimiter(), terminating), "service-binding"),
+		instancePollingQueue:        newPollingQueues("instance-poller", newPollingRateLimiter),
+		bindingPollingQueue:         newPollingQueues("binding-poller", newPollingRateLimiter),
+		bindingSecretDriftQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "binding-secret-drift"),
 		clusterIDConfigMapName:      clusterIDConfigMapName,
 		clusterIDConfigMapNamespace: clusterIDConfigMapNamespace,
 		brokerClientCreateFunc:      brokerClientCreateFunc,
+		parametersPlugins:           parametersPlugins,
+		identity:                    newControllerIdentity(),
+		terminatingNamespaces:       terminating,
+		readOnly:                    readOnly,
+		secretTemplates:             secretTemplates,
+		catalogSources:              catalogsource.NewLoader(kubeClient, &http.Client{Timeout: osbAPITimeOut}),
+
+		operationPollingMaximumBackoffDuration: operationPollingMaximumBackoffDuration,
 	}
 	controller.brokerClientManager = NewBrokerClientManager(brokerClientCreateFunc)
 
+	controller.namespaceCache = newNamespaceCache(namespaceInformer.Lister(), kubeClient)
+	namespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.namespaceAdd,
+		UpdateFunc: controller.namespaceUpdate,
+		DeleteFunc: controller.namespaceDelete,
+	})
+
 	controller.clusterServiceBrokerLister = clusterServiceBrokerInformer.Lister()
 	clusterServiceBrokerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    controller.clusterServiceBrokerAdd,
@@ -154,6 +204,15 @@ func NewController(
 		DeleteFunc: controller.bindingDelete,
 	})
 
+	if secretInformer != nil {
+		controller.secretLister = secretInformer.Lister()
+		secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    controller.bindingSecretAdd,
+			UpdateFunc: controller.bindingSecretUpdate,
+			DeleteFunc: controller.bindingSecretDelete,
+		})
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
 		controller.serviceBrokerLister = serviceBrokerInformer.Lister()
 		serviceBrokerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -174,8 +233,23 @@ func NewController(
 			DeleteFunc: controller.servicePlanDelete,
 		})
 	}
+	controller.catalogSynced = []cache.InformerSynced{
+		clusterServiceBrokerInformer.Informer().HasSynced,
+		clusterServiceClassInformer.Informer().HasSynced,
+		clusterServicePlanInformer.Informer().HasSynced,
+		instanceInformer.Informer().HasSynced,
+	}
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
+		controller.catalogSynced = append(controller.catalogSynced,
+			serviceBrokerInformer.Informer().HasSynced,
+			serviceClassInformer.Informer().HasSynced,
+			servicePlanInformer.Informer().HasSynced,
+		)
+	}
+
 	controller.instanceOperationRetryQueue.instances = make(map[string]backoffEntry)
 	controller.instanceOperationRetryQueue.rateLimiter = workqueue.NewItemExponentialFailureRateLimiter(minBrokerOperationRetryDelay, maxBrokerOperationRetryDelay)
+	controller.instanceOperationRetryQueue.brokerBusyRateLimiter = workqueue.NewItemExponentialFailureRateLimiter(minBrokerBusyRetryDelay, maxBrokerBusyRetryDelay)
 
 	return controller, nil
 }
@@ -187,35 +261,75 @@ type Controller interface {
 	// workers specifies the number of goroutines, per resource, processing work
 	// from the resource workqueues
 	Run(workers int, stopCh <-chan struct{})
+
+	// BrokerClientManager returns the manager of the OSB clients the
+	// controller holds for brokers.
+	BrokerClientManager() *BrokerClientManager
+
+	// EnableOperationCallbacks makes the controller accept the
+	// notifications of brokers with OperationCallbacks set that an
+	// operation completed, and returns the handler serving them at
+	// OperationCallbackPath. baseURL is the address at which brokers reach
+	// the handler, and key signs the tokens that authenticate them. It
+	// must be called before Run.
+	EnableOperationCallbacks(baseURL string, key []byte) http.Handler
+
+	// WatchTunables makes the controller apply the settings held by the
+	// ConfigMap with the given namespace and name while it runs, in place
+	// of those it was started with. It must be called before Run.
+	WatchTunables(namespace, name string)
+}
+
+// InstanceReconciler is implemented by the Controller NewController returns.
+// It lets tools that drive the controller without Run, such as the replay
+// harness, reconcile a ServiceInstance in the calling goroutine.
+type InstanceReconciler interface {
+	// SetClusterID sets the cluster ID the controller presents to brokers.
+	SetClusterID(id string)
+
+	// ReconcileServiceInstance runs one reconciliation of instance.
+	ReconcileServiceInstance(instance *v1beta1.ServiceInstance) error
 }
 
 // controller is a concrete Controller.
 type controller struct {
-	kubeClient                  kubernetes.Interface
-	serviceCatalogClient        servicecatalogclientset.ServicecatalogV1beta1Interface
-	clusterServiceBrokerLister  listers.ClusterServiceBrokerLister
-	serviceBrokerLister         listers.ServiceBrokerLister
-	clusterServiceClassLister   listers.ClusterServiceClassLister
-	serviceClassLister          listers.ServiceClassLister
-	instanceLister              listers.ServiceInstanceLister
-	bindingLister               listers.ServiceBindingLister
-	clusterServicePlanLister    listers.ClusterServicePlanLister
-	servicePlanLister           listers.ServicePlanLister
-	brokerRelistInterval        time.Duration
-	OSBAPIPreferredVersion      string
-	OSBAPITimeOut               time.Duration
-	recorder                    record.EventRecorder
-	reconciliationRetryDuration time.Duration
-	clusterServiceBrokerQueue   workqueue.RateLimitingInterface
-	serviceBrokerQueue          workqueue.RateLimitingInterface
-	clusterServiceClassQueue    workqueue.RateLimitingInterface
-	serviceClassQueue           workqueue.RateLimitingInterface
-	clusterServicePlanQueue     workqueue.RateLimitingInterface
-	servicePlanQueue            workqueue.RateLimitingInterface
-	instanceQueue               workqueue.RateLimitingInterface
-	bindingQueue                workqueue.RateLimitingInterface
-	instancePollingQueue        workqueue.RateLimitingInterface
-	bindingPollingQueue         workqueue.RateLimitingInterface
+	kubeClient                 kubernetes.Interface
+	serviceCatalogClient       servicecatalogclientset.ServicecatalogV1beta1Interface
+	clusterServiceBrokerLister listers.ClusterServiceBrokerLister
+	serviceBrokerLister        listers.ServiceBrokerLister
+	clusterServiceClassLister  listers.ClusterServiceClassLister
+	serviceClassLister         listers.ServiceClassLister
+	instanceLister             listers.ServiceInstanceLister
+	bindingLister              listers.ServiceBindingLister
+	clusterServicePlanLister   listers.ClusterServicePlanLister
+	servicePlanLister          listers.ServicePlanLister
+	brokerRelistInterval       time.Duration
+	OSBAPIPreferredVersion     string
+	OSBAPITimeOut              time.Duration
+	OSBAPIBindingTimeOut       time.Duration
+	recorder                   record.EventRecorder
+	clusterServiceBrokerQueue  workqueue.RateLimitingInterface
+	serviceBrokerQueue         workqueue.RateLimitingInterface
+	clusterServiceClassQueue   workqueue.RateLimitingInterface
+	serviceClassQueue          workqueue.RateLimitingInterface
+	clusterServicePlanQueue    workqueue.RateLimitingInterface
+	servicePlanQueue           workqueue.RateLimitingInterface
+	instanceQueue              workqueue.RateLimitingInterface
+	bindingQueue               workqueue.RateLimitingInterface
+	// instancePollingQueue and bindingPollingQueue hold a polling queue
+	// for each broker.
+	instancePollingQueue *pollingQueues
+	bindingPollingQueue  *pollingQueues
+	// bindingSecretDriftQueue holds the bindings whose Secrets changed, to
+	// check them for drift.
+	bindingSecretDriftQueue workqueue.RateLimitingInterface
+	// catalogSynced holds the HasSynced functions of the informers of the
+	// brokers, classes, plans and instances, which the instance and binding
+	// workers wait for.
+	catalogSynced []cache.InformerSynced
+	// secretLister lists the Secrets labeled BindingSecretLabel; nil unless
+	// the BindingSecretDriftRepair feature is enabled.
+	secretLister corelisters.SecretLister
 	// clusterIDConfigMapName is the k8s name that the clusterid
 	// configmap will have.
 	clusterIDConfigMapName string
@@ -234,10 +348,82 @@ type controller struct {
 	instanceOperationRetryQueue instanceOperationBackoff
 	// BrokerClientManager holds all OSB clients for brokers.
 	brokerClientManager *BrokerClientManager
+	// namespaceCache looks up the namespaces used to build the context sent
+	// to brokers.
+	namespaceCache *namespaceCache
+	// parametersPlugins resolves the parametersFrom sources that reference
+	// a parameters plugin; nil when no plugin directory is configured.
+	parametersPlugins paramplugin.Registry
+	// identity distinguishes this controller from others running at the
+	// same time when holding a ServiceInstance's operation lease.
+	identity string
+	// bindingOperations serializes the bind and unbind requests sent for
+	// the bindings of each ServiceInstance.
+	bindingOperations serviceInstanceBindingOperations
+	// terminatingNamespaces holds the namespaces being deleted, whose
+	// instances and bindings are retried without the usual backoff.
+	terminatingNamespaces *terminatingNamespaces
+	// reconciliationRetryDuration is how long the operation on a resource
+	// is retried before it fails.
+	reconciliationRetryDuration time.Duration
+	// reconciliationMaxAttempts is the number of requests sent to a broker
+	// for an operation before it fails; zero means no limit.
+	reconciliationMaxAttempts int
+	// brokerRelistJitterFactor is the largest fraction of a broker's relist
+	// interval added to it so that brokers are not relisted at the same time.
+	brokerRelistJitterFactor float64
+	// tunablesLock protects access to reconciliationRetryDuration,
+	// reconciliationMaxAttempts and brokerRelistJitterFactor between the
+	// monitor of the tunables ConfigMap and their readers.
+	tunablesLock sync.RWMutex
+	// tunablesConfigMapName and tunablesConfigMapNamespace locate the
+	// ConfigMap holding the tunables; empty unless WatchTunables is called.
+	tunablesConfigMapName      string
+	tunablesConfigMapNamespace string
+	// defaultTunables holds the settings the controller was started with,
+	// used for the tunables missing from the ConfigMap.
+	defaultTunables tunables
+	// brokerRelists limits the number of brokers relisted at the same time.
+	brokerRelists *brokerRelistLimiter
+	// provisions limits the number of instances whose provision is in
+	// flight at the same time.
+	provisions serviceInstanceProvisions
+	// instanceUpdateDebounces coalesces the generations of the instances
+	// edited in quick succession into a single update request.
+	instanceUpdateDebounces serviceInstanceUpdateDebounces
+	// readOnly makes the controller report drift instead of reconciling.
+	readOnly bool
+	// secretTemplates renders the objects through which the bindings with
+	// a SecretTemplate deliver their credentials; nil when no secret
+	// template directory is configured.
+	secretTemplates *secrettemplate.Templates
+	// catalogSources loads the catalogs of the brokers that set a
+	// CatalogSource.
+	catalogSources *catalogsource.Loader
+	// operationPollingMaximumBackoffDuration is the longest interval
+	// between two polls of the last operation of a resource.
+	operationPollingMaximumBackoffDuration time.Duration
+	// operationCallbacks accepts the notifications of brokers that an
+	// operation completed; nil until EnableOperationCallbacks is called.
+	operationCallbacks *operationCallbacks
+	// operationClocks tracks on the monotonic clock of the controller when
+	// it first saw each operation, to measure their retry window when the
+	// clocks of the controllers are skewed.
+	operationClocks operationClocks
 
 	brokerClientCreateFunc osb.CreateFunc
 }
 
+// newControllerIdentity returns an identity that is unique to this
+// controller process.
+func newControllerIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return hostname + "_" + string(uuid.NewUUID())
+}
+
 // Run runs the controller until the given stop channel can be read from.
 func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	defer runtimeutil.HandleCrash()
@@ -250,31 +436,62 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 		createWorker(c.clusterServiceBrokerQueue, "ClusterServiceBroker", maxRetries, true, c.reconcileClusterServiceBrokerKey, stopCh, &waitGroup)
 		createWorker(c.clusterServiceClassQueue, "ClusterServiceClass", maxRetries, true, c.reconcileClusterServiceClassKey, stopCh, &waitGroup)
 		createWorker(c.clusterServicePlanQueue, "ClusterServicePlan", maxRetries, true, c.reconcileClusterServicePlanKey, stopCh, &waitGroup)
-		createWorker(c.instanceQueue, "ServiceInstance", maxRetries, true, c.reconcileServiceInstanceKey, stopCh, &waitGroup)
-		createWorker(c.bindingQueue, "ServiceBinding", maxRetries, true, c.reconcileServiceBindingKey, stopCh, &waitGroup)
-		createWorker(c.instancePollingQueue, "InstancePoller", maxRetries, false, c.requeueServiceInstanceForPoll, stopCh, &waitGroup)
 
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
 			createWorker(c.serviceBrokerQueue, "ServiceBroker", maxRetries, true, c.reconcileServiceBrokerKey, stopCh, &waitGroup)
 			createWorker(c.serviceClassQueue, "ServiceClass", maxRetries, true, c.reconcileServiceClassKey, stopCh, &waitGroup)
 			createWorker(c.servicePlanQueue, "ServicePlan", maxRetries, true, c.reconcileServicePlanKey, stopCh, &waitGroup)
 		}
+	}
 
-		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
-			createWorker(c.bindingPollingQueue, "BindingPoller", maxRetries, false, c.requeueServiceBindingForPoll, stopCh, &waitGroup)
+	// The workers of the catalog start right away, those of the instances
+	// and bindings once the catalog is cached.
+	if c.waitForCatalogCaches(stopCh) {
+		for i := 0; i < workers; i++ {
+			createWorker(c.instanceQueue, "ServiceInstance", maxRetries, true, c.reconcileServiceInstanceKey, stopCh, &waitGroup)
+			createWorker(c.bindingQueue, "ServiceBinding", maxRetries, true, c.reconcileServiceBindingKey, stopCh, &waitGroup)
+
+			if c.secretLister != nil {
+				createWorker(c.bindingSecretDriftQueue, "BindingSecretDrift", maxRetries, true, c.reconcileServiceBindingSecretDriftKey, stopCh, &waitGroup)
+			}
 		}
 	}
 
-	// this creates a worker specifically for monitoring
-	// configmaps, as we don't have the watching polling queue
-	// infrastructure set up for one configmap. Instead this is a
-	// simple polling based worker
-	c.createConfigMapMonitorWorker(stopCh, &waitGroup)
+	c.instancePollingQueue.run(workers, "InstancePoller", c.pollServiceInstanceKey, &waitGroup)
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
+		c.bindingPollingQueue.run(workers, "BindingPoller", c.pollServiceBindingKey, &waitGroup)
+	}
+
+	if c.readOnly {
+		// create a task that runs periodically to report the number of
+		// resources whose state drifted from their spec
+		c.createReportReadOnlyDriftWorker(stopCh, &waitGroup)
+	} else {
+		// this creates a worker specifically for monitoring
+		// configmaps, as we don't have the watching polling queue
+		// infrastructure set up for one configmap. Instead this is a
+		// simple polling based worker
+		c.createConfigMapMonitorWorker(stopCh, &waitGroup)
+	}
+
+	if c.tunablesConfigMapName != "" {
+		// create a task that runs periodically to apply the settings
+		// of the tunables configmap
+		c.createTunablesMonitorWorker(stopCh, &waitGroup)
+	}
 
 	// create a task that runs periodically to purge expired
 	// instance operation retry entries
 	c.createPurgeExpiredRetryEntriesWorker(stopCh, &waitGroup)
 
+	// create a task that runs periodically to report the namespaces
+	// whose deletion is blocked on instances or bindings
+	c.createReportBlockedNamespacesWorker(stopCh, &waitGroup)
+
+	// create a task that runs periodically to report the age of the
+	// credentials of bindings
+	c.createReportBindingCredentialsAgeWorker(stopCh, &waitGroup)
+
 	<-stopCh
 	klog.Info("Shutting down service-catalog controller")
 
@@ -285,6 +502,7 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	c.bindingQueue.ShutDown()
 	c.instancePollingQueue.ShutDown()
 	c.bindingPollingQueue.ShutDown()
+	c.bindingSecretDriftQueue.ShutDown()
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
 		c.serviceBrokerQueue.ShutDown()
@@ -296,6 +514,12 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	klog.Info("Shutdown service-catalog controller")
 }
 
+// BrokerClientManager returns the manager of the OSB clients the controller
+// holds for brokers.
+func (c *controller) BrokerClientManager() *BrokerClientManager {
+	return c.brokerClientManager
+}
+
 // createWorker creates and runs a worker thread that just processes items in the
 // specified queue. The worker will run until stopCh is closed. The worker will be
 // added to the wait group when started and marked done when finished.
@@ -386,7 +610,9 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 				}
 				defer queue.Done(key)
 
+				start := time.Now()
 				err := reconciler(key.(string))
+				metrics.ReconcileDuration.WithLabelValues(resourceType, reconcileResult(err)).Observe(time.Since(start).Seconds())
 				if err == nil {
 					if forgetAfterSuccess {
 						queue.Forget(key)
@@ -409,6 +635,15 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 	}
 }
 
+// reconcileResult returns the result label of the reconcile duration metric
+// for the error a reconciler returned.
+func reconcileResult(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
 // operationError is a user-facing error that can be easily embedded in a
 // resource's Condition.
 type operationError struct {
@@ -652,7 +887,7 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
-		brokerClient, err = c.clusterServiceBrokerClient(broker)
+		brokerClient, err = c.clusterServiceBrokerBindingClient(broker)
 		if err != nil {
 			return nil, err
 		}
@@ -667,7 +902,7 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
-		brokerClient, err = c.serviceBrokerClient(broker)
+		brokerClient, err = c.serviceBrokerBindingClient(broker)
 		if err != nil {
 			return nil, err
 		}
@@ -750,10 +985,31 @@ func (c *controller) getAuthCredentialsFromServiceBroker(broker *v1beta1.Service
 		return &osb.AuthConfig{
 			BearerConfig: bearerConfig,
 		}, nil
+	} else if authInfo.ServiceAccountToken != nil && utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerServiceAccountTokenAuth) {
+		token, err := c.brokerClientManager.ServiceAccountToken(
+			NewServiceBrokerKey(broker.Namespace, broker.Name),
+			authInfo.ServiceAccountToken,
+			broker.Spec.URL,
+			c.requestServiceAccountToken,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &osb.AuthConfig{
+			BearerConfig: &osb.BearerConfig{
+				Token: token,
+			},
+		}, nil
 	}
 	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %v", authInfo)
 }
 
+// requestServiceAccountToken requests a token for a service account with
+// the TokenRequest API.
+func (c *controller) requestServiceAccountToken(namespace, serviceAccountName string, request *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+	return c.kubeClient.CoreV1().ServiceAccounts(namespace).CreateToken(context.TODO(), serviceAccountName, request, metav1.CreateOptions{})
+}
+
 func getBasicAuthConfig(secret *corev1.Secret) (*osb.BasicAuthConfig, error) {
 	usernameBytes, ok := secret.Data["username"]
 	if !ok {
@@ -788,15 +1044,13 @@ func getBearerConfig(secret *corev1.Secret) (*osb.BearerConfig, error) {
 // ServicePlans returned by this method are named in K8S with the OSB ID
 // filtered to adhere to K8S naming restrictions.
 func convertAndFilterCatalogToNamespacedTypes(namespace string, in *osb.CatalogResponse, restrictions *v1beta1.CatalogRestrictions, existingServiceClasses map[string]*v1beta1.ServiceClass, existingServicePlans map[string]*v1beta1.ServicePlan) ([]*v1beta1.ServiceClass, []*v1beta1.ServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
+	var classRestrictions []string
+	if restrictions != nil {
+		classRestrictions = restrictions.ServiceClass
+	}
+	predicate, err := filter.ServiceClassPredicate(classRestrictions)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	serviceClasses := []*v1beta1.ServiceClass(nil)
@@ -805,13 +1059,14 @@ func convertAndFilterCatalogToNamespacedTypes(namespace string, in *osb.CatalogR
 		serviceClass := &v1beta1.ServiceClass{
 			Spec: v1beta1.ServiceClassSpec{
 				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
-					Bindable:      svc.Bindable,
-					PlanUpdatable: svc.PlanUpdatable != nil && *svc.PlanUpdatable,
-					ExternalID:    svc.ID,
-					ExternalName:  svc.Name,
-					Tags:          svc.Tags,
-					Description:   svc.Description,
-					Requires:      svc.Requires,
+					Bindable:            svc.Bindable,
+					PlanUpdatable:       svc.PlanUpdatable != nil && *svc.PlanUpdatable,
+					AllowContextUpdates: serviceAllowsContextUpdates(svc),
+					ExternalID:          svc.ID,
+					ExternalName:        svc.Name,
+					Tags:                svc.Tags,
+					Description:         svc.Description,
+					Requires:            svc.Requires,
 				},
 			},
 		}
@@ -913,20 +1168,27 @@ func GenerateEscapedName(externalID string) string {
 	return escapedName
 }
 
+// serviceAllowsContextUpdates returns whether the broker accepts context-only
+// updates for instances of the service. The OSB client does not decode the
+// allow_context_updates field of a service offering, so brokers advertise it
+// under the same key in the metadata of the offering.
+func serviceAllowsContextUpdates(svc osb.Service) bool {
+	allow, ok := svc.Metadata[allowContextUpdatesMetadataKey].(bool)
+	return ok && allow
+}
+
 // convertAndFilterCatalog converts a service broker catalog into an array of
 // ClusterServiceClasses and an array of ClusterServicePlans and filters these
 // through the restrictions provided. The ClusterServiceClasses and
 // ClusterServicePlans returned by this method are named in K8S with the OSB ID.
 func convertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.CatalogRestrictions, existingServiceClasses map[string]*v1beta1.ClusterServiceClass, existingServicePlans map[string]*v1beta1.ClusterServicePlan) ([]*v1beta1.ClusterServiceClass, []*v1beta1.ClusterServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
+	var classRestrictions []string
+	if restrictions != nil {
+		classRestrictions = restrictions.ServiceClass
+	}
+	predicate, err := filter.ServiceClassPredicate(classRestrictions)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	serviceClasses := []*v1beta1.ClusterServiceClass(nil)
@@ -935,13 +1197,14 @@ func convertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.Cata
 		serviceClass := &v1beta1.ClusterServiceClass{
 			Spec: v1beta1.ClusterServiceClassSpec{
 				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
-					Bindable:      svc.Bindable,
-					PlanUpdatable: svc.PlanUpdatable != nil && *svc.PlanUpdatable,
-					ExternalID:    svc.ID,
-					ExternalName:  svc.Name,
-					Tags:          svc.Tags,
-					Description:   svc.Description,
-					Requires:      svc.Requires,
+					Bindable:            svc.Bindable,
+					PlanUpdatable:       svc.PlanUpdatable != nil && *svc.PlanUpdatable,
+					AllowContextUpdates: serviceAllowsContextUpdates(svc),
+					ExternalID:          svc.ID,
+					ExternalName:        svc.Name,
+					Tags:                svc.Tags,
+					Description:         svc.Description,
+					Requires:            svc.Requires,
 				},
 			},
 		}
@@ -991,15 +1254,13 @@ func convertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.Cata
 }
 
 func filterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ServicePlan) ([]*v1beta1.ServicePlan, []*v1beta1.ServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServicePlan) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServicePlan)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
+	var planRestrictions []string
+	if restrictions != nil {
+		planRestrictions = restrictions.ServicePlan
+	}
+	predicate, err := filter.ServicePlanPredicate(planRestrictions)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// If the predicate is empty, all plans will pass. No need to run through the list.
@@ -1022,15 +1283,13 @@ func filterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, ser
 }
 
 func filterServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ClusterServicePlan) ([]*v1beta1.ClusterServicePlan, []*v1beta1.ClusterServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServicePlan) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServicePlan)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
+	var planRestrictions []string
+	if restrictions != nil {
+		planRestrictions = restrictions.ServicePlan
+	}
+	predicate, err := filter.ServicePlanPredicate(planRestrictions)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// If the predicate is empty, all plans will pass. No need to run through the list.
@@ -1087,12 +1346,26 @@ func convertServicePlans(namespace string, plans []osb.Plan, serviceClassID stri
 	return servicePlans, nil
 }
 
+// convertMaintenanceInfo converts the maintenance info of a plan in a broker
+// catalog, if any.
+func convertMaintenanceInfo(in *osb.MaintenanceInfo) *v1beta1.MaintenanceInfo {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.MaintenanceInfo{
+		Version:     in.Version,
+		Description: in.Description,
+	}
+}
+
 func convertCommonServicePlan(plan osb.Plan, commonServicePlanSpec *v1beta1.CommonServicePlanSpec) error {
 	if plan.Bindable != nil {
 		b := plan.Bindable
 		commonServicePlanSpec.Bindable = b
 	}
 
+	commonServicePlanSpec.MaintenanceInfo = convertMaintenanceInfo(plan.MaintenanceInfo)
+
 	if plan.Metadata != nil {
 		metadata, err := json.Marshal(plan.Metadata)
 		if err != nil {
@@ -1171,6 +1444,8 @@ func convertClusterServicePlans(plans []osb.Plan, serviceClassID string, existin
 			servicePlans[i].Spec.Bindable = &b
 		}
 
+		servicePlans[i].Spec.MaintenanceInfo = convertMaintenanceInfo(plan.MaintenanceInfo)
+
 		if plan.Metadata != nil {
 			metadata, err := json.Marshal(plan.Metadata)
 			if err != nil {
@@ -1252,40 +1527,118 @@ func isServiceInstanceOrphanMitigation(instance *v1beta1.ServiceInstance) bool {
 
 // NewClientConfigurationForBroker creates a new ClientConfiguration for connecting
 // to the specified Broker
-func NewClientConfigurationForBroker(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, authConfig *osb.AuthConfig, osbAPITimeOut time.Duration) *osb.ClientConfiguration {
+func NewClientConfigurationForBroker(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, authConfig *osb.AuthConfig, osbAPITimeOut time.Duration, apiVersion osb.APIVersion) (*osb.ClientConfiguration, error) {
 	clientConfig := osb.DefaultClientConfiguration()
 	clientConfig.Name = meta.Name
 	clientConfig.URL = commonSpec.URL
+	clientConfig.APIVersion = apiVersion
 	clientConfig.AuthConfig = authConfig
 	clientConfig.EnableAlphaFeatures = true
 	clientConfig.Insecure = commonSpec.InsecureSkipTLSVerify
 	clientConfig.CAData = commonSpec.CABundle
 	clientConfig.TimeoutSeconds = int(osbAPITimeOut.Seconds())
-	return clientConfig
+	if commonSpec.TLSConfig != nil {
+		tlsConfig, err := tlsconfig.New(commonSpec.TLSConfig.MinVersion, commonSpec.TLSConfig.CipherSuites)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS configuration: %v", err)
+		}
+		if len(commonSpec.TLSConfig.ServerDNSNames) > 0 || len(commonSpec.TLSConfig.ServerSPIFFEIDs) > 0 {
+			tlsConfig.VerifyConnection = tlsconfig.VerifyServerIdentity(commonSpec.TLSConfig.ServerDNSNames, commonSpec.TLSConfig.ServerSPIFFEIDs)
+		}
+		clientConfig.TLSConfig = tlsConfig
+	}
+	return clientConfig, nil
+}
+
+// bindingRequestTimeout returns the timeout of the binding requests to a
+// broker: the one set in the broker spec, or the controller's binding request
+// timeout, which in turn falls back to the timeout of any request.
+func (c *controller) bindingRequestTimeout(commonSpec *v1beta1.CommonServiceBrokerSpec) time.Duration {
+	if commonSpec.BindingRequestTimeout != nil && commonSpec.BindingRequestTimeout.Duration > 0 {
+		return commonSpec.BindingRequestTimeout.Duration
+	}
+	if c.OSBAPIBindingTimeOut > 0 {
+		return c.OSBAPIBindingTimeOut
+	}
+	return c.OSBAPITimeOut
+}
+
+// getBrokerCatalog returns the catalog of a broker of the given namespace,
+// empty for a ClusterServiceBroker, from its CatalogSource if it sets one
+// and the BrokerCatalogSources feature is enabled, and from its /v2/catalog
+// endpoint otherwise.
+func (c *controller) getBrokerCatalog(brokerClient osb.Client, commonSpec *v1beta1.CommonServiceBrokerSpec, namespace string) (*osb.CatalogResponse, error) {
+	if commonSpec.CatalogSource != nil && utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCatalogSources) {
+		return c.catalogSources.Load(commonSpec.CatalogSource, namespace)
+	}
+	return brokerClient.GetCatalog()
+}
+
+// brokerAPIVersion returns the OSB API version to use when talking to a
+// broker. A version pinned in the broker spec takes precedence over the
+// controller's preferred version, which in turn falls back to the latest
+// version supported by the OSB client.
+func (c *controller) brokerAPIVersion(commonSpec *v1beta1.CommonServiceBrokerSpec) osb.APIVersion {
+	if version, ok := parseOSBAPIVersion(commonSpec.OSBAPIVersion); ok {
+		return version
+	}
+	if version, ok := parseOSBAPIVersion(c.OSBAPIPreferredVersion); ok {
+		return version
+	}
+	return osb.LatestAPIVersion()
+}
+
+// parseOSBAPIVersion returns the OSB API version matching the given header
+// value, and whether the OSB client supports it.
+func parseOSBAPIVersion(value string) (osb.APIVersion, bool) {
+	for _, version := range osb.APIVersions() {
+		if version.HeaderValue() == value {
+			return version, true
+		}
+	}
+	return osb.APIVersion{}, false
 }
 
-// reconciliationRetryDurationExceeded returns whether the given operation
-// start time has exceeded the controller's set reconciliation retry duration.
-func (c *controller) reconciliationRetryDurationExceeded(operationStartTime *metav1.Time) bool {
-	if operationStartTime == nil || time.Now().Before(operationStartTime.Time.Add(c.reconciliationRetryDuration)) {
+// reconciliationRetryDurationExceeded returns whether the operation of obj
+// that started at the given operation start time has exceeded its
+// reconciliation retry duration, which the retry policy of the broker of
+// an instance or binding may set. See operationElapsed for how the time
+// elapsed since it started is measured.
+func (c *controller) reconciliationRetryDurationExceeded(obj operationObject, operationStartTime *metav1.Time) bool {
+	if operationStartTime == nil {
 		return false
 	}
-	return true
+	return c.operationElapsed(obj, operationStartTime) >= c.reconciliationRetryDurationOf(obj)
 }
 
-// shouldStartOrphanMitigation returns whether an error with the given status
-// code indicates that orphan migitation should start.
-func shouldStartOrphanMitigation(statusCode int) bool {
-	is2XX := statusCode >= 200 && statusCode < 300
-	is5XX := statusCode >= 500 && statusCode < 600
+// maxAttempts returns the number of requests that may be sent
+// to the broker for an operation on obj: the value of its MaxAttempts
+// annotation or, when it is unset or invalid, the controller's setting. Zero
+// means no limit.
+func (c *controller) maxAttempts(obj metav1.Object) int {
+	value, ok := obj.GetAnnotations()[v1beta1.MaxAttemptsAnnotation]
+	if !ok {
+		return c.getReconciliationMaxAttempts()
+	}
+	maxAttempts, err := strconv.Atoi(value)
+	if err != nil || maxAttempts < 0 {
+		klog.Warningf("%s/%s: Ignoring invalid %s annotation %q", obj.GetNamespace(), obj.GetName(), v1beta1.MaxAttemptsAnnotation, value)
+		return c.getReconciliationMaxAttempts()
+	}
+	return maxAttempts
+}
 
-	return (is2XX && statusCode != http.StatusOK) || is5XX
+// reconciliationMaxAttemptsExceeded returns whether the given number of
+// requests sent to the broker has used up the budget of obj's operation.
+func (c *controller) reconciliationMaxAttemptsExceeded(obj metav1.Object, attempts int32) bool {
+	maxAttempts := c.maxAttempts(obj)
+	return maxAttempts > 0 && int(attempts) >= maxAttempts
 }
 
-// isRetriableHTTPStatus returns whether an error with the given HTTP status
-// code is retriable.
-func isRetriableHTTPStatus(statusCode int) bool {
-	return statusCode != http.StatusBadRequest
+// reconciliationMaxAttemptsMessage is the message of the Failed condition of
+// an operation that used up its budget of requests.
+func reconciliationMaxAttemptsMessage(attempts int32) string {
+	return fmt.Sprintf("Stopping reconciliation retries because %d requests were sent to the broker", attempts)
 }
 
 // ReconciliationAction represents a type of action the reconciler should take
@@ -1328,6 +1681,16 @@ func (c *controller) setClusterID(id string) {
 	c.clusterIDLock.Unlock()
 }
 
+// SetClusterID implements InstanceReconciler.
+func (c *controller) SetClusterID(id string) {
+	c.setClusterID(id)
+}
+
+// ReconcileServiceInstance implements InstanceReconciler.
+func (c *controller) ReconcileServiceInstance(instance *v1beta1.ServiceInstance) error {
+	return c.reconcileServiceInstance(instance)
+}
+
 // getServiceClassPlanAndServiceBrokerForServiceBinding is a sequence of operations that's
 // done to validate service plan, service class exist, and handles creating
 // a brokerclient to use for a given ServiceInstance.
@@ -1432,7 +1795,7 @@ func (c *controller) getServiceBrokerForServiceBinding(instance *v1beta1.Service
 // returns true unless the broker has a ready condition with status true and
 // the controller's broker relist interval has not elapsed since the broker's
 // ready condition became true, or if the broker's RelistBehavior is set to Manual.
-func shouldReconcileServiceBrokerCommon(pcb *pretty.ContextBuilder, brokerMeta *metav1.ObjectMeta, brokerSpec *v1beta1.CommonServiceBrokerSpec, brokerStatus *v1beta1.CommonServiceBrokerStatus, now time.Time, defaultRelistInterval time.Duration) bool {
+func shouldReconcileServiceBrokerCommon(pcb *pretty.ContextBuilder, brokerMeta *metav1.ObjectMeta, brokerSpec *v1beta1.CommonServiceBrokerSpec, brokerStatus *v1beta1.CommonServiceBrokerStatus, now time.Time, defaultRelistInterval time.Duration, relistJitterFactor float64) bool {
 	if brokerStatus.ReconciledGeneration != brokerMeta.Generation {
 		// If the spec has changed, we should reconcile the broker.
 		return true
@@ -1461,11 +1824,9 @@ func shouldReconcileServiceBrokerCommon(pcb *pretty.ContextBuilder, brokerMeta *
 				}
 
 				// By default, the broker should relist if it has been longer than the
-				// RelistDuration since the last time we fetched the Catalog
-				duration := defaultRelistInterval
-				if brokerSpec.RelistDuration != nil {
-					duration = brokerSpec.RelistDuration.Duration
-				}
+				// RelistDuration, plus the broker's jitter, since the last time we
+				// fetched the Catalog
+				duration := brokerRelistInterval(brokerMeta, brokerSpec, defaultRelistInterval, relistJitterFactor)
 
 				intervalPassed := true
 				if brokerStatus.LastCatalogRetrievalTime != nil {
@@ -20,12 +20,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"net"
 	"reflect"
+	"strings"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
 	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	"github.com/drycc-addons/service-catalog/pkg/secrettemplate"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	corev1 "k8s.io/api/core/v1"
@@ -51,6 +53,7 @@ const (
 	errorServiceBindingOrphanMitigation       string = "ServiceBindingNeedsOrphanMitigation"
 	errorFetchingBindingFailedReason          string = "FetchingBindingFailed"
 	errorAsyncOpTimeoutReason                 string = "AsyncOperationTimeout"
+	errorOSBAPIVersionUnsupportedReason       string = "ErrorOSBAPIVersionUnsupported"
 
 	successInjectedBindResultReason  string = "InjectedBindResult"
 	successInjectedBindResultMessage string = "Injected bind result"
@@ -63,6 +66,8 @@ const (
 	bindingInFlightMessage           string = "Binding request for ServiceBinding in-flight to Broker"
 	unbindingInFlightReason          string = "UnbindingRequestInFlight"
 	unbindingInFlightMessage         string = "Unbind request for ServiceBinding in-flight to Broker"
+	adoptedSecretReason              string = "AdoptedSecret"
+	overwroteSecretReason            string = "OverwroteSecret"
 )
 
 // bindingControllerKind contains the schema.GroupVersionKind for this controller type.
@@ -171,6 +176,11 @@ func (c *controller) reconcileServiceBinding(binding *v1beta1.ServiceBinding) er
 	pcb := pretty.NewBindingContextBuilder(binding)
 	klog.V(6).Info(pcb.Messagef(`beginning to process resourceVersion: %v`, binding.ResourceVersion))
 
+	if c.readOnly {
+		c.recordReadOnlyDrift(binding, pcb, serviceBindingDrift(binding))
+		return nil
+	}
+
 	reconciliationAction := getReconciliationActionForServiceBinding(binding)
 	switch reconciliationAction {
 	case reconcileAdd:
@@ -252,6 +262,11 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
+		if msg, reached := c.maxBindingsReached(binding, instance, servicePlan); reached {
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorMaxBindingsReachedReason, msg)
+			return c.processServiceBindingOperationError(binding, readyCond)
+		}
+
 		klog.V(4).Info(pcb.Message("Adding/Updating"))
 
 		request, inProgressProperties, err = c.prepareBindRequest(binding, instance)
@@ -289,6 +304,11 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
+		if msg, reached := c.maxBindingsReached(binding, instance, servicePlan); reached {
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorMaxBindingsReachedReason, msg)
+			return c.processServiceBindingOperationError(binding, readyCond)
+		}
+
 		klog.V(4).Info(pcb.Message("Adding/Updating"))
 
 		request, inProgressProperties, err = c.prepareBindRequest(binding, instance)
@@ -300,6 +320,14 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	}
 
 	if binding.Status.CurrentOperation == "" {
+		if c.isServiceBindingAdoption(binding) {
+			return c.adoptServiceBinding(binding)
+		}
+		if c.isServiceBindingRenewal(binding) {
+			if err := c.unbindServiceBindingForRenewal(binding, instance, brokerClient); err != nil {
+				return err
+			}
+		}
 		binding, err = c.recordStartOfServiceBindingOperation(binding, v1beta1.ServiceBindingOperationBind, inProgressProperties)
 		if err != nil {
 			// There has been an update to the binding. Start reconciliation
@@ -310,30 +338,57 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		return nil
 	}
 
+	if c.backoffServiceBindingIfRetrying(binding, "bind") {
+		return nil
+	}
+	if !c.acquireServiceBindingOperation(binding) {
+		return nil
+	}
+	binding.Status.OperationAttempts++
+	binding.Status.LastAttemptTime = newAttemptTime()
 	response, err := brokerClient.Bind(request)
+	if err != nil || !response.Async {
+		c.releaseServiceBindingOperation(binding)
+	}
 	if err != nil {
-		if httpErr, ok := osb.IsHTTPError(err); ok {
+		// Failed bind requests are not retried, but the broker error still
+		// decides whether orphan mitigation is required.
+		brokerErr := classifyBrokerError("bind", err)
+
+		if _, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf("ServiceBroker returned failure; bind operation will not be retried: %v", err.Error())
 			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorBindCallReason, msg)
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, "ServiceBindingReturnedFailure", msg)
-			return c.processBindFailure(binding, readyCond, failedCond, shouldStartOrphanMitigation(httpErr.StatusCode))
+			return c.processBindFailure(binding, readyCond, failedCond, requiresOrphanMitigation(brokerErr))
 		}
 
-		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		if isTimeoutError(err) {
 			msg := "Communication with the ServiceBroker timed out; Bind operation will not be retried: " + err.Error()
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorBindCallReason, msg)
-			return c.processBindFailure(binding, nil, failedCond, true)
+			return c.processBindFailure(binding, nil, failedCond, requiresOrphanMitigation(brokerErr))
+		}
+
+		if osb.IsAsyncBindingOperationsNotAllowedError(err) {
+			msg := fmt.Sprintf("The OSB API version negotiated with the ServiceBroker does not support asynchronous bindings; bind operation will not be retried: %v", err)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorOSBAPIVersionUnsupportedReason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorOSBAPIVersionUnsupportedReason, msg)
+			return c.processBindFailure(binding, readyCond, failedCond, false)
 		}
 
 		msg := fmt.Sprintf(`Error creating ServiceBinding for %s: %s`, prettyName, err)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorBindCallReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, false)
 		}
 
+		if c.reconciliationMaxAttemptsExceeded(binding, binding.Status.OperationAttempts) {
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationMaxAttemptsReason, reconciliationMaxAttemptsMessage(binding.Status.OperationAttempts))
+			return c.processBindFailure(binding, readyCond, failedCond, false)
+		}
+
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -352,7 +407,7 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		msg := fmt.Sprintf(`Error injecting bind result: %s`, err)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorInjectingBindResultReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, true)
@@ -406,6 +461,8 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 		if binding.Status.OperationStartTime == nil {
 			now := metav1.Now()
 			binding.Status.OperationStartTime = &now
+			binding.Status.OperationAttempts = 0
+			binding.Status.LastAttemptTime = nil
 		}
 	} else {
 		if binding.Status.CurrentOperation != v1beta1.ServiceBindingOperationUnbind {
@@ -482,19 +539,35 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 		return c.handleServiceBindingReconciliationError(binding, err)
 	}
 
+	if c.backoffServiceBindingIfRetrying(binding, "unbind") {
+		return nil
+	}
+	if !c.acquireServiceBindingOperation(binding) {
+		return nil
+	}
+	binding.Status.OperationAttempts++
+	binding.Status.LastAttemptTime = newAttemptTime()
 	response, err := brokerClient.Unbind(request)
+	if err != nil || !response.Async {
+		c.releaseServiceBindingOperation(binding)
+	}
 	if err != nil {
 		msg := fmt.Sprintf(
 			`Error unbinding from %s: %s`, prettyBrokerName, err,
 		)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
 			failedCond := newServiceBindingReadyCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processUnbindFailure(binding, readyCond, failedCond)
 		}
 
+		if c.reconciliationMaxAttemptsExceeded(binding, binding.Status.OperationAttempts) {
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationMaxAttemptsReason, reconciliationMaxAttemptsMessage(binding.Status.OperationAttempts))
+			return c.processUnbindFailure(binding, readyCond, failedCond)
+		}
+
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -536,70 +609,257 @@ func isServicePlanBindable(serviceClass *v1beta1.ServiceClass, plan *v1beta1.Ser
 }
 
 func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, credentials map[string]interface{}) error {
+	if binding.Spec.SecretTemplate != "" {
+		if err := c.injectServiceBindingSecretTemplate(binding, credentials); err != nil {
+			return err
+		}
+		now := metav1.Now()
+		binding.Status.LastCredentialsRotationTime = &now
+		return nil
+	}
+	written, err := c.injectServiceBindingSecret(binding, binding.Spec.SecretName, binding.Spec.SecretType, binding.Spec.SecretTransforms, credentials)
+	if err != nil {
+		return err
+	}
+	for _, target := range binding.Spec.AdditionalSecretTargets {
+		targetWritten, err := c.injectServiceBindingSecret(binding, target.SecretName, target.SecretType, target.SecretTransforms, credentials)
+		if err != nil {
+			return err
+		}
+		written = written || targetWritten
+	}
+	// the credentials are only rotated when one of the secrets was written
+	if written || binding.Status.LastCredentialsRotationTime == nil {
+		now := metav1.Now()
+		binding.Status.LastCredentialsRotationTime = &now
+	}
+	return nil
+}
+
+// injectServiceBindingSecret writes the binding's credentials, after
+// applying the given transforms to a copy of them, to the named secret of
+// the given type in the binding's namespace. An empty type leaves the type
+// of an existing secret alone, and creates an Opaque secret. An existing
+// secret of the binding that already holds the credentials is not written
+// again, so that reconciling the binding does not churn it; the returned
+// bool is whether the secret was written.
+func (c *controller) injectServiceBindingSecret(binding *v1beta1.ServiceBinding, secretName, secretType string, transforms []v1beta1.SecretTransform, brokerCredentials map[string]interface{}) (bool, error) {
 	pcb := pretty.NewBindingContextBuilder(binding)
 	klog.V(5).Info(pcb.Messagef(`Creating/updating Secret "%s/%s" with %d keys`,
-		binding.Namespace, binding.Spec.SecretName, len(credentials),
+		binding.Namespace, secretName, len(brokerCredentials),
 	))
 
-	if err := c.transformCredentials(binding.Spec.SecretTransforms, credentials); err != nil {
-		return fmt.Errorf(`Unexpected error while transforming credentials for ServiceBinding "%s/%s": %v`, binding.Namespace, binding.Name, err)
+	secretData, err := c.prepareServiceBindingSecretData(binding, transforms, brokerCredentials)
+	if err != nil {
+		return false, err
 	}
-
-	secretData := make(map[string][]byte)
-	for k, v := range credentials {
-		var err error
-		if secretData[k], err = serialize(v); err != nil {
-			return fmt.Errorf("Unable to serialize value for credential key %q (value is intentionally not logged): %s", k, err)
-		}
+	if err := validateServiceBindingSecretData(corev1.SecretType(secretType), secretData); err != nil {
+		return false, fmt.Errorf(`Credentials of ServiceBinding "%s/%s" cannot be written to Secret "%s/%s": %v`, binding.Namespace, binding.Name, binding.Namespace, secretName, err)
 	}
 
 	// Creating/updating the Secret
 	secretClient := c.kubeClient.CoreV1().Secrets(binding.Namespace)
-	existingSecret, err := secretClient.Get(context.Background(), binding.Spec.SecretName, metav1.GetOptions{})
+	existingSecret, err := secretClient.Get(context.Background(), secretName, metav1.GetOptions{})
 	if err == nil {
 		// Update existing secret
+		if secretType != "" && existingSecret.Type != corev1.SecretType(secretType) {
+			// The type of a secret is immutable.
+			return false, fmt.Errorf(`Secret "%s/%s" has type %q instead of %q, and must be deleted for it to be recreated`, binding.Namespace, secretName, existingSecret.Type, secretType)
+		}
+		if metav1.IsControlledBy(existingSecret, binding) && serviceBindingSecretUpToDate(existingSecret, secretData) {
+			klog.V(5).Info(pcb.Messagef(`Secret "%s/%s" already holds the credentials; skipping update`, binding.Namespace, secretName))
+			metrics.BindingSecretWritesSkippedCount.Inc()
+			return false, nil
+		}
+		var claimReason, claimMessage string
 		if !metav1.IsControlledBy(existingSecret, binding) {
-			controllerRef := metav1.GetControllerOf(existingSecret)
-			return fmt.Errorf(`Secret "%s/%s" is not owned by ServiceBinding, controllerRef: %v`, binding.Namespace, existingSecret.Name, controllerRef)
+			if claimReason, claimMessage, err = claimServiceBindingSecret(binding, existingSecret); err != nil {
+				return false, err
+			}
 		}
 		existingSecret.Data = secretData
+		markServiceBindingSecret(existingSecret)
 		if _, err = secretClient.Update(context.Background(), existingSecret, metav1.UpdateOptions{}); err != nil {
 			if apierrors.IsConflict(err) {
 				// Conflicting update detected, try again later
-				return fmt.Errorf(`Conflicting Secret "%s/%s" update detected`, binding.Namespace, existingSecret.Name)
+				return false, fmt.Errorf(`Conflicting Secret "%s/%s" update detected`, binding.Namespace, existingSecret.Name)
 			}
-			return fmt.Errorf(`Unexpected error updating Secret "%s/%s": %v`, binding.Namespace, existingSecret.Name, err)
+			return false, fmt.Errorf(`Unexpected error updating Secret "%s/%s": %v`, binding.Namespace, existingSecret.Name, err)
+		}
+		if claimReason != "" {
+			c.recorder.Event(binding, corev1.EventTypeNormal, claimReason, claimMessage)
 		}
 	} else {
 		if !apierrors.IsNotFound(err) {
 			// Terminal error
-			return fmt.Errorf(`Unexpected error getting Secret "%s/%s": %v`, binding.Namespace, existingSecret.Name, err)
+			return false, fmt.Errorf(`Unexpected error getting Secret "%s/%s": %v`, binding.Namespace, secretName, err)
 		}
 		err = nil
 		// Create new secret
 		secret := &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      binding.Spec.SecretName,
+				Name:      secretName,
 				Namespace: binding.Namespace,
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(binding, bindingControllerKind),
 				},
 			},
+			Type: corev1.SecretType(secretType),
 			Data: secretData,
 		}
+		markServiceBindingSecret(secret)
 
 		if _, err = secretClient.Create(context.Background(), secret, metav1.CreateOptions{}); err != nil {
 			if apierrors.IsAlreadyExists(err) {
 				// Concurrent controller has created secret under the same name,
 				// Update the secret at the next retry iteration
-				return fmt.Errorf(`Conflicting Secret "%s/%s" creation detected`, binding.Namespace, secret.Name)
+				return false, fmt.Errorf(`Conflicting Secret "%s/%s" creation detected`, binding.Namespace, secret.Name)
 			}
 			// Terminal error
-			return fmt.Errorf(`Unexpected error creating Secret "%s/%s": %v`, binding.Namespace, secret.Name, err)
+			return false, fmt.Errorf(`Unexpected error creating Secret "%s/%s": %v`, binding.Namespace, secret.Name, err)
 		}
 	}
 
-	return err
+	return true, nil
+}
+
+// serviceBindingSecretUpToDate returns whether writing the given data to an
+// existing secret of a binding would leave it unchanged: its data has the
+// same checksum, and it is already marked for drift repair if enabled.
+func serviceBindingSecretUpToDate(existing *corev1.Secret, data map[string][]byte) bool {
+	if serviceBindingSecretChecksum(existing.Data) != serviceBindingSecretChecksum(data) {
+		return false
+	}
+	updated := existing.DeepCopy()
+	updated.Data = data
+	markServiceBindingSecret(updated)
+	return reflect.DeepEqual(updated.Labels, existing.Labels) && reflect.DeepEqual(updated.Annotations, existing.Annotations)
+}
+
+// prepareServiceBindingSecretData applies the given transforms to a copy of
+// the binding's credentials, and serializes them as the data of a secret.
+func (c *controller) prepareServiceBindingSecretData(binding *v1beta1.ServiceBinding, transforms []v1beta1.SecretTransform, brokerCredentials map[string]interface{}) (map[string][]byte, error) {
+	// Every secret target transforms the broker's credentials independently.
+	credentials := make(map[string]interface{}, len(brokerCredentials))
+	for k, v := range brokerCredentials {
+		credentials[k] = v
+	}
+	if err := c.transformCredentials(transforms, credentials); err != nil {
+		return nil, fmt.Errorf(`Unexpected error while transforming credentials for ServiceBinding "%s/%s": %v`, binding.Namespace, binding.Name, err)
+	}
+
+	secretData := make(map[string][]byte)
+	for k, v := range credentials {
+		var err error
+		if secretData[k], err = serialize(v); err != nil {
+			return nil, fmt.Errorf("Unable to serialize value for credential key %q (value is intentionally not logged): %s", k, err)
+		}
+	}
+	return secretData, nil
+}
+
+// serviceBindingSecretTypeKeys are the keys the data of the secrets of the
+// well-known types must hold, at least one of each set.
+var serviceBindingSecretTypeKeys = map[corev1.SecretType][][]string{
+	corev1.SecretTypeBasicAuth:        {{corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey}},
+	corev1.SecretTypeSSHAuth:          {{corev1.SSHAuthPrivateKey}},
+	corev1.SecretTypeTLS:              {{corev1.TLSCertKey}, {corev1.TLSPrivateKeyKey}},
+	corev1.SecretTypeDockercfg:        {{corev1.DockerConfigKey}},
+	corev1.SecretTypeDockerConfigJson: {{corev1.DockerConfigJsonKey}},
+}
+
+// validateServiceBindingSecretData checks that the data of a secret holds
+// the keys its type requires, so that the credentials of a binding that
+// cannot be written to a secret of that type are reported along with the
+// keys to add through the secret transforms of the binding, rather than
+// with the validation error of the API server.
+func validateServiceBindingSecretData(secretType corev1.SecretType, data map[string][]byte) error {
+	for _, keys := range serviceBindingSecretTypeKeys[secretType] {
+		found := false
+		for _, key := range keys {
+			if _, ok := data[key]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("a secret of type %q requires the key %s", secretType, strings.Join(keys, " or "))
+		}
+	}
+	return nil
+}
+
+// injectServiceBindingSecretTemplate delivers the binding's credentials
+// through the objects rendered from its SecretTemplate.
+func (c *controller) injectServiceBindingSecretTemplate(binding *v1beta1.ServiceBinding, brokerCredentials map[string]interface{}) error {
+	if c.secretTemplates == nil {
+		return fmt.Errorf("Secret template %q is not configured in the controller", binding.Spec.SecretTemplate)
+	}
+	pcb := pretty.NewBindingContextBuilder(binding)
+	klog.V(5).Info(pcb.Messagef(`Applying secret template %q with %d keys`, binding.Spec.SecretTemplate, len(brokerCredentials)))
+
+	secretData, err := c.prepareServiceBindingSecretData(binding, binding.Spec.SecretTransforms, brokerCredentials)
+	if err != nil {
+		return err
+	}
+	data := serviceBindingSecretTemplateData(binding)
+	data.Credentials = make(map[string]string, len(secretData))
+	for k, v := range secretData {
+		data.Credentials[k] = string(v)
+	}
+	if err := c.secretTemplates.Apply(binding.Spec.SecretTemplate, data, *metav1.NewControllerRef(binding, bindingControllerKind)); err != nil {
+		return fmt.Errorf(`Unexpected error applying secret template %q for ServiceBinding "%s/%s": %v`, binding.Spec.SecretTemplate, binding.Namespace, binding.Name, err)
+	}
+	return nil
+}
+
+// serviceBindingSecretTemplateData returns the data the SecretTemplate of
+// binding is rendered with, without the credentials.
+func serviceBindingSecretTemplateData(binding *v1beta1.ServiceBinding) secrettemplate.Data {
+	return secrettemplate.Data{
+		Name:       binding.Name,
+		Namespace:  binding.Namespace,
+		SecretName: binding.Spec.SecretName,
+	}
+}
+
+// claimServiceBindingSecret applies the binding's SecretConflictPolicy to an
+// existing secret that the binding does not control. If the policy allows
+// it, the binding is made the controller of the secret and the reason and
+// message of the event to record once the secret has been updated are
+// returned. The secret is *not* updated in the registry.
+func claimServiceBindingSecret(binding *v1beta1.ServiceBinding, secret *corev1.Secret) (string, string, error) {
+	controllerRef := metav1.GetControllerOf(secret)
+
+	var reason, message string
+	switch binding.Spec.SecretConflictPolicy {
+	case v1beta1.SecretConflictPolicyAdopt:
+		if controllerRef != nil {
+			return "", "", fmt.Errorf(`Secret "%s/%s" is controlled by %s %q and cannot be adopted by ServiceBinding`, binding.Namespace, secret.Name, controllerRef.Kind, controllerRef.Name)
+		}
+		reason = adoptedSecretReason
+		message = fmt.Sprintf(`Adopted existing Secret "%s/%s"`, binding.Namespace, secret.Name)
+	case v1beta1.SecretConflictPolicyOverwrite:
+		reason = overwroteSecretReason
+		if controllerRef != nil {
+			message = fmt.Sprintf(`Overwrote existing Secret "%s/%s" controlled by %s %q`, binding.Namespace, secret.Name, controllerRef.Kind, controllerRef.Name)
+		} else {
+			message = fmt.Sprintf(`Overwrote existing Secret "%s/%s"`, binding.Namespace, secret.Name)
+		}
+	default:
+		return "", "", fmt.Errorf(`Secret "%s/%s" is not owned by ServiceBinding, controllerRef: %v`, binding.Namespace, secret.Name, controllerRef)
+	}
+
+	// An object can only have one controller, so any other controller
+	// reference is demoted to a plain owner reference.
+	ownerRefs := make([]metav1.OwnerReference, 0, len(secret.OwnerReferences)+1)
+	for _, ref := range secret.OwnerReferences {
+		ref.Controller = nil
+		ownerRefs = append(ownerRefs, ref)
+	}
+	secret.OwnerReferences = append(ownerRefs, *metav1.NewControllerRef(binding, bindingControllerKind))
+
+	return reason, message, nil
 }
 
 func (c *controller) transformCredentials(transforms []v1beta1.SecretTransform, credentials map[string]interface{}) error {
@@ -655,19 +915,42 @@ func evaluateJSONPath(jsonPath string, credentials map[string]interface{}) (stri
 }
 
 func (c *controller) ejectServiceBinding(binding *v1beta1.ServiceBinding) error {
-	var err error
+	if binding.Spec.SecretTemplate != "" {
+		return c.ejectServiceBindingSecretTemplate(binding)
+	}
+
+	secretNames := []string{binding.Spec.SecretName}
+	for _, target := range binding.Spec.AdditionalSecretTargets {
+		secretNames = append(secretNames, target.SecretName)
+	}
+
 	pcb := pretty.NewBindingContextBuilder(binding)
-	klog.V(5).Info(pcb.Messagef(`Deleting Secret "%s/%s"`,
-		binding.Namespace, binding.Spec.SecretName,
-	))
+	for _, secretName := range secretNames {
+		klog.V(5).Info(pcb.Messagef(`Deleting Secret "%s/%s"`,
+			binding.Namespace, secretName,
+		))
 
-	if err = c.kubeClient.CoreV1().Secrets(binding.Namespace).Delete(context.Background(), binding.Spec.SecretName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
-		return err
+		if err := c.kubeClient.CoreV1().Secrets(binding.Namespace).Delete(context.Background(), secretName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// ejectServiceBindingSecretTemplate deletes the objects rendered from the
+// binding's SecretTemplate. Without the template, they are left to be
+// garbage collected with the binding.
+func (c *controller) ejectServiceBindingSecretTemplate(binding *v1beta1.ServiceBinding) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+	if c.secretTemplates == nil {
+		klog.Warning(pcb.Messagef("Secret template %q is not configured in the controller, leaving its objects to be garbage collected", binding.Spec.SecretTemplate))
+		return nil
+	}
+	klog.V(5).Info(pcb.Messagef("Deleting the objects of secret template %q", binding.Spec.SecretTemplate))
+	return c.secretTemplates.Delete(binding.Spec.SecretTemplate, serviceBindingSecretTemplateData(binding), *metav1.NewControllerRef(binding, bindingControllerKind))
+}
+
 // setServiceBindingCondition sets a single condition on a ServiceBinding's
 // status: if the condition already exists in the status, it is mutated; if the
 // condition does not already exist in the status, it is added. Other
@@ -829,6 +1112,8 @@ func (c *controller) recordStartOfServiceBindingOperation(
 	toUpdate.Status.CurrentOperation = operation
 	now := metav1.Now()
 	toUpdate.Status.OperationStartTime = &now
+	toUpdate.Status.OperationAttempts = 0
+	toUpdate.Status.LastAttemptTime = nil
 	toUpdate.Status.InProgressProperties = inProgressProperties
 	reason := ""
 	message := ""
@@ -875,13 +1160,37 @@ func rollbackBindingReconciledGenerationOnDeletion(binding *v1beta1.ServiceBindi
 	}
 }
 
-func (c *controller) requeueServiceBindingForPoll(key string) error {
-	c.bindingQueue.Add(key)
+// pollServiceBindingKey polls the last operation of the binding of the
+// given key. It is called by the workers of the polling queue of the broker
+// of the binding. The bindings without an operation in progress are added to
+// the binding work queue instead, as are the bindings whose poll fails, to be
+// retried with the rate limiting of the binding work queue.
+func (c *controller) pollServiceBindingKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	pcb := pretty.NewContextBuilder(pretty.ServiceBinding, namespace, name, "")
+	binding, err := c.bindingLister.ServiceBindings(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.Info(pcb.Message("Not polling because the ServiceBinding has been deleted"))
+		c.bindingPollingQueue.Forget(key)
+		return nil
+	}
+	if err != nil || !binding.Status.AsyncOpInProgress {
+		c.bindingQueue.Add(key)
+		return nil
+	}
+
+	if err := c.reconcileServiceBinding(binding); err != nil {
+		klog.V(4).Info(pcb.Messagef("Error polling: %v", err))
+		c.bindingQueue.AddRateLimited(key)
+	}
 	return nil
 }
 
 // beginPollingServiceBinding does a rate-limited add of the key for the given
-// binding to the controller's binding polling queue.
+// binding to the binding polling queue of the broker of its instance.
 func (c *controller) beginPollingServiceBinding(binding *v1beta1.ServiceBinding) error {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(binding)
 	if err != nil {
@@ -889,7 +1198,7 @@ func (c *controller) beginPollingServiceBinding(binding *v1beta1.ServiceBinding)
 		return fmt.Errorf("Couldn't create a key for object %+v: %v", binding, err)
 	}
 
-	c.bindingPollingQueue.AddRateLimited(key)
+	c.bindingPollingQueue.AddRateLimited(c.pollingBrokerOfServiceBinding(binding), key)
 
 	return nil
 }
@@ -901,7 +1210,8 @@ func (c *controller) continuePollingServiceBinding(binding *v1beta1.ServiceBindi
 }
 
 // finishPollingServiceBinding removes the binding's key from the controller's
-// binding polling queue.
+// binding polling queue and lets the other bindings of the instance send their
+// requests.
 func (c *controller) finishPollingServiceBinding(binding *v1beta1.ServiceBinding) error {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(binding)
 	if err != nil {
@@ -910,6 +1220,7 @@ func (c *controller) finishPollingServiceBinding(binding *v1beta1.ServiceBinding
 	}
 
 	c.bindingPollingQueue.Forget(key)
+	c.releaseServiceBindingOperation(binding)
 
 	return nil
 }
@@ -965,7 +1276,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		klog.V(4).Info(pcb.Message(s))
 		c.recorder.Event(binding, corev1.EventTypeWarning, errorPollingLastOperationReason, s)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
@@ -980,7 +1291,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 
 	switch response.State {
 	case osb.StateInProgress:
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
@@ -1070,7 +1381,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		msg := "Unbind call failed: " + description
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, readyCond)
 		}
 
@@ -1093,7 +1404,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 	default:
 		klog.Warning(pcb.Messagef("Got invalid state in LastOperationResponse: %q", response.State))
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(binding, binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
@@ -1166,11 +1477,13 @@ func newServiceBindingFailedCondition(status v1beta1.ConditionStatus, reason, me
 }
 
 // setServiceBindingLastOperation sets the last operation key on the given
-// binding.
+// binding, and records it as the key of its last asynchronous operation.
 func setServiceBindingLastOperation(binding *v1beta1.ServiceBinding, operationKey *osb.OperationKey) {
+	binding.Status.LastOperationKey = ""
 	if operationKey != nil && *operationKey != "" {
 		key := string(*operationKey)
 		binding.Status.LastOperation = &key
+		binding.Status.LastOperationKey = key
 	}
 }
 
@@ -1229,7 +1542,7 @@ func (c *controller) prepareBindRequest(
 		scBindingRetrievable = serviceClass.Spec.BindingRetrievable
 	}
 
-	ns, err := c.kubeClient.CoreV1().Namespaces().Get(context.Background(), instance.Namespace, metav1.GetOptions{})
+	ns, err := c.namespaceCache.Get(instance.Namespace)
 	if err != nil {
 		return nil, nil, &operationError{
 			reason:  errorFindingNamespaceServiceInstanceReason,
@@ -1239,6 +1552,7 @@ func (c *controller) prepareBindRequest(
 
 	parameters, parametersChecksum, rawParametersWithRedaction, err := prepareInProgressPropertyParameters(
 		c.kubeClient,
+		c.parametersPlugins,
 		binding.Namespace,
 		binding.Spec.Parameters,
 		binding.Spec.ParametersFrom,
@@ -1257,14 +1571,7 @@ func (c *controller) prepareBindRequest(
 	}
 
 	appGUID := string(ns.UID)
-	clusterID := c.getClusterID()
-
-	requestContext := map[string]interface{}{
-		"platform":           ContextProfilePlatformKubernetes,
-		"namespace":          instance.Namespace,
-		clusterIdentifierKey: clusterID,
-		"instance_name":      instance.Name,
-	}
+	requestContext := serviceInstanceRequestContext(instance, c.getClusterID())
 
 	request := &osb.BindRequest{
 		BindingID:    binding.Spec.ExternalID,
@@ -1479,6 +1786,8 @@ func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCo
 		c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 
 		binding.Status.OrphanMitigationInProgress = true
+		binding.Status.OperationAttempts = 0
+		binding.Status.LastAttemptTime = nil
 		binding.Status.AsyncOpInProgress = false
 		binding.Status.OperationStartTime = nil
 	} else {
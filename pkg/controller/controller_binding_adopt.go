@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+)
+
+const (
+	successAdoptedBindingReason string = "AdoptedBinding"
+	errorAdoptingBindingReason  string = "ErrorAdoptingBinding"
+)
+
+// isServiceBindingAdoption returns whether binding is to be adopted instead
+// of being bound: it sets AdoptExisting, the BindingAdoption feature is
+// enabled, and it does not exist at the broker yet as far as the controller
+// knows. Renewing an adopted binding binds it at the broker as usual.
+func (c *controller) isServiceBindingAdoption(binding *v1beta1.ServiceBinding) bool {
+	return binding.Spec.AdoptExisting &&
+		utilfeature.DefaultFeatureGate.Enabled(scfeatures.BindingAdoption) &&
+		binding.Status.UnbindStatus != v1beta1.ServiceBindingUnbindStatusRequired
+}
+
+// adoptServiceBinding records binding as ready without sending a bind
+// request, for a binding created at the broker outside of the controller.
+// Its credentials must already be in the secret named by SecretName, which
+// binding becomes the controller of. Like the Adopt SecretConflictPolicy, a
+// secret controlled by another object is left untouched. From then on, the
+// binding is unbound from the broker when it is deleted.
+func (c *controller) adoptServiceBinding(binding *v1beta1.ServiceBinding) error {
+	secretClient := c.kubeClient.CoreV1().Secrets(binding.Namespace)
+	secret, err := secretClient.Get(context.Background(), binding.Spec.SecretName, metav1.GetOptions{})
+	if err != nil {
+		msg := fmt.Sprintf(`The existing binding cannot be adopted: error getting Secret "%s/%s": %v`, binding.Namespace, binding.Spec.SecretName, err)
+		if apierrors.IsNotFound(err) {
+			msg = fmt.Sprintf(`The existing binding cannot be adopted: Secret "%s/%s" holding its credentials does not exist`, binding.Namespace, binding.Spec.SecretName)
+		}
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorAdoptingBindingReason, msg)
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
+	if len(secret.Data) == 0 {
+		msg := fmt.Sprintf(`The existing binding cannot be adopted: Secret "%s/%s" holds no credentials`, binding.Namespace, secret.Name)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorAdoptingBindingReason, msg)
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
+
+	if !metav1.IsControlledBy(secret, binding) {
+		if controllerRef := metav1.GetControllerOf(secret); controllerRef != nil {
+			msg := fmt.Sprintf(`The existing binding cannot be adopted: Secret "%s/%s" is controlled by %s %q`, binding.Namespace, secret.Name, controllerRef.Kind, controllerRef.Name)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorAdoptingBindingReason, msg)
+			return c.processServiceBindingOperationError(binding, readyCond)
+		}
+		secret.OwnerReferences = append(secret.OwnerReferences, *metav1.NewControllerRef(binding, bindingControllerKind))
+	}
+	markServiceBindingSecret(secret)
+	if _, err := secretClient.Update(context.Background(), secret, metav1.UpdateOptions{}); err != nil {
+		msg := fmt.Sprintf(`The existing binding cannot be adopted: error updating Secret "%s/%s": %v`, binding.Namespace, secret.Name, err)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorAdoptingBindingReason, msg)
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
+
+	// The parameters the binding was created with at the broker are
+	// unknown, so only the user adopting it is recorded.
+	binding.Status.ExternalProperties = &v1beta1.ServiceBindingPropertiesState{
+		UserInfo: binding.Spec.UserInfo,
+	}
+	binding.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusRequired
+	msg := fmt.Sprintf(`Adopted the existing binding %q with the credentials of Secret "%s/%s"`, binding.Spec.ExternalID, binding.Namespace, secret.Name)
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, successAdoptedBindingReason, msg)
+	clearServiceBindingCurrentOperation(binding)
+
+	if _, err := c.updateServiceBindingStatus(binding); err != nil {
+		return err
+	}
+
+	c.recorder.Event(binding, corev1.EventTypeNormal, successAdoptedBindingReason, msg)
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+)
+
+// TestReconcileServiceBindingAdoption tests that a binding that sets
+// AdoptExisting is recorded as ready, and takes over the Secret holding its
+// credentials, without any request being sent to the broker.
+func TestReconcileServiceBindingAdoption(t *testing.T) {
+	err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BindingAdoption))
+	if err != nil {
+		t.Fatalf("Failed to enable the BindingAdoption feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingAdoption))
+
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretReaction(fakeKubeClient, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testServiceBindingSecretName,
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	binding := getTestServiceInactiveBinding()
+	binding.UID = "binding-uid"
+	binding.Spec.SecretName = testServiceBindingSecretName
+	binding.Spec.AdoptExisting = true
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	updateAction := kubeActions[len(kubeActions)-1]
+	assertActionEquals(t, updateAction, "update", "secrets")
+	actionSecret := updateAction.(clientgotesting.UpdateAction).GetObject().(*corev1.Secret)
+	if !metav1.IsControlledBy(actionSecret, binding) {
+		t.Fatalf("Secret is not controlled by the ServiceBinding: %v", metav1.GetControllerOf(actionSecret))
+	}
+	if e, a := "hunter2", string(actionSecret.Data["password"]); e != a {
+		t.Fatalf("Unexpected value of key 'password' in secret; %s", expectedGot(e, a))
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingReadyTrue(t, updatedServiceBinding)
+	assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, successAdoptedBindingReason)
+	assertServiceBindingCurrentOperationClear(t, updatedServiceBinding)
+	assertServiceBindingReconciledGeneration(t, updatedServiceBinding, binding.Generation)
+	assertServiceBindingUnbindStatus(t, updatedServiceBinding, v1beta1.ServiceBindingUnbindStatusRequired)
+
+	expectedEvent := normalEventBuilder(successAdoptedBindingReason).msg(`Adopted the existing binding "` + testServiceBindingGUID + `"`)
+	if err := checkEventPrefixes(getRecordedEvents(testController), expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceBindingAdoptionWithoutSecret tests that a binding
+// whose credentials are not in its Secret is not adopted, and that adopting
+// it is retried.
+func TestReconcileServiceBindingAdoptionWithoutSecret(t *testing.T) {
+	err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BindingAdoption))
+	if err != nil {
+		t.Fatalf("Failed to enable the BindingAdoption feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingAdoption))
+
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	binding := getTestServiceInactiveBinding()
+	binding.Spec.SecretName = testServiceBindingSecretName
+	binding.Spec.AdoptExisting = true
+
+	if err := reconcileServiceBinding(t, testController, binding); err == nil {
+		t.Fatal("Expected the adoption to be retried")
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, errorAdoptingBindingReason, binding)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+// bindingCredentialsAgeReportInterval is how often the age of the
+// credentials of bindings is reported.
+const bindingCredentialsAgeReportInterval = time.Minute
+
+// createReportBindingCredentialsAgeWorker creates a task that runs
+// periodically to report the age of the credentials of bindings
+func (c *controller) createReportBindingCredentialsAgeWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		wait.Until(c.reportBindingCredentialsAge, bindingCredentialsAgeReportInterval, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// reportBindingCredentialsAge sets the age of the credentials of every
+// binding whose credentials have been written. Bindings that no longer exist
+// are dropped from the metric.
+func (c *controller) reportBindingCredentialsAge() {
+	bindings, err := c.bindingLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Couldn't list ServiceBindings: %v", err)
+		return
+	}
+	metrics.BindingCredentialsAge.Reset()
+	now := time.Now()
+	for _, binding := range bindings {
+		if binding.Status.LastCredentialsRotationTime == nil {
+			continue
+		}
+		age := now.Sub(binding.Status.LastCredentialsRotationTime.Time)
+		metrics.BindingCredentialsAge.WithLabelValues(binding.Namespace, binding.Name).Set(age.Seconds())
+	}
+}
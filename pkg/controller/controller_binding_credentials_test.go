@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+// TestInjectServiceBindingRecordsRotationTime tests that writing the
+// credentials of a binding records when they were written.
+func TestInjectServiceBindingRecordsRotationTime(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	binding := getTestServiceBinding()
+	before := time.Now().Add(-time.Second)
+	if err := testController.injectServiceBinding(binding, map[string]interface{}{"a": "b"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rotated := binding.Status.LastCredentialsRotationTime
+	if rotated == nil {
+		t.Fatal("Expected the credentials rotation time to be recorded")
+	}
+	if rotated.Time.Before(before) {
+		t.Fatalf("Unexpected credentials rotation time %v", rotated.Time)
+	}
+}
+
+// TestInjectServiceBindingSkipsUnchangedSecret tests that a secret of the
+// binding that already holds the credentials is not written again, and that
+// the credentials rotation time is then kept.
+func TestInjectServiceBindingSkipsUnchangedSecret(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	binding := getTestServiceBinding()
+	binding.UID = "binding-uid"
+	binding.Spec.SecretName = testServiceBindingSecretName
+	rotated := metav1.NewTime(time.Now().Add(-time.Hour))
+	binding.Status.LastCredentialsRotationTime = &rotated
+	addGetSecretReaction(fakeKubeClient, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            testServiceBindingSecretName,
+			Namespace:       testNamespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+		},
+		Data: map[string][]byte{"a": []byte("b")},
+	})
+	skipped := testutil.ToFloat64(metrics.BindingSecretWritesSkippedCount)
+
+	if err := testController.injectServiceBinding(binding, map[string]interface{}{"a": "b"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 1)
+	assertActionEquals(t, kubeActions[0], "get", "secrets")
+	if e, a := skipped+1, testutil.ToFloat64(metrics.BindingSecretWritesSkippedCount); e != a {
+		t.Fatalf("Unexpected number of skipped secret writes; %s", expectedGot(e, a))
+	}
+	if !binding.Status.LastCredentialsRotationTime.Equal(&rotated) {
+		t.Fatalf("Unexpected credentials rotation time %v", binding.Status.LastCredentialsRotationTime)
+	}
+
+	if err := testController.injectServiceBinding(binding, map[string]interface{}{"a": "c"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kubeActions = fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 3)
+	assertActionEquals(t, kubeActions[2], "update", "secrets")
+	actionSecret := kubeActions[2].(clientgotesting.UpdateAction).GetObject().(*corev1.Secret)
+	if e, a := "c", string(actionSecret.Data["a"]); e != a {
+		t.Fatalf("Unexpected value of key 'a' in secret; %s", expectedGot(e, a))
+	}
+	if binding.Status.LastCredentialsRotationTime.Equal(&rotated) {
+		t.Fatal("Expected the credentials rotation time to be recorded once the secret was written")
+	}
+}
+
+// TestReportBindingCredentialsAge tests that the age of the credentials of
+// the bindings that have credentials is reported.
+func TestReportBindingCredentialsAge(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	rotated := getTestServiceBinding()
+	rotatedTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	rotated.Status.LastCredentialsRotationTime = &rotatedTime
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(rotated)
+
+	pending := getTestServiceBinding()
+	pending.Name = "pending-binding"
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(pending)
+
+	// A binding that no longer exists is dropped from the metric
+	metrics.BindingCredentialsAge.WithLabelValues(testNamespace, "deleted-binding").Set(1)
+
+	testController.reportBindingCredentialsAge()
+
+	if e, a := 1, testutil.CollectAndCount(metrics.BindingCredentialsAge); e != a {
+		t.Fatalf("Unexpected number of reported bindings; %s", expectedGot(e, a))
+	}
+	age := testutil.ToFloat64(metrics.BindingCredentialsAge.WithLabelValues(testNamespace, testServiceBindingName))
+	if age < time.Hour.Seconds() || age > (time.Hour+time.Minute).Seconds() {
+		t.Fatalf("Unexpected credentials age %v", age)
+	}
+}
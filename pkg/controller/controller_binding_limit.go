@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const errorMaxBindingsReachedReason string = "ErrorMaxBindingsReached"
+
+// maxBindingsPlan is a plan that may limit the number of bindings of each
+// of its instances.
+type maxBindingsPlan interface {
+	GetName() string
+	GetMaxBindings() (int, bool)
+}
+
+// maxBindingsReached returns whether binding has to wait before being bound
+// because the plan of instance limits the number of bindings of each
+// instance, and as many other bindings of the instance were created before
+// it, along with the reason to report. The bindings being deleted and those
+// that failed do not count. A binding that is already bound, or whose bind
+// is in progress, keeps its place even once the limit is lowered.
+func (c *controller) maxBindingsReached(binding *v1beta1.ServiceBinding, instance *v1beta1.ServiceInstance, plan maxBindingsPlan) (string, bool) {
+	maxBindings, ok := plan.GetMaxBindings()
+	if !ok || binding.Status.CurrentOperation != "" || c.isServiceBindingSucceeded(binding) {
+		return "", false
+	}
+
+	bindings, err := c.bindingLister.ServiceBindings(binding.Namespace).List(labels.Everything())
+	if err != nil {
+		// The broker enforces its own limits; do not hold up the binding
+		// because the others cannot be counted.
+		klog.Warning(pretty.NewBindingContextBuilder(binding).Messagef("Unable to count the bindings of %s: %v", pretty.ServiceInstanceName(instance), err))
+		return "", false
+	}
+	before := 0
+	for _, other := range bindings {
+		if other.Name == binding.Name || other.Spec.InstanceRef.Name != instance.Name ||
+			other.DeletionTimestamp != nil || isServiceBindingFailed(other) {
+			continue
+		}
+		if serviceBindingCreatedBefore(other, binding) {
+			before++
+		}
+	}
+	if before < maxBindings {
+		return "", false
+	}
+	return fmt.Sprintf("Binding cannot begin because %s already has %d bindings and its plan %q allows at most %d", pretty.ServiceInstanceName(instance), before, plan.GetName(), maxBindings), true
+}
+
+// serviceBindingCreatedBefore returns whether a was created before b, using
+// their names to order the bindings created at the same time.
+func serviceBindingCreatedBefore(a, b *v1beta1.ServiceBinding) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
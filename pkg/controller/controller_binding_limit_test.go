@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// getTestServiceBindingsForMaxBindings returns a binding to reconcile and
+// another binding of the same instance created before it.
+func getTestServiceBindingsForMaxBindings() (*v1beta1.ServiceBinding, *v1beta1.ServiceBinding) {
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              testServiceBindingName,
+			Namespace:         testNamespace,
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(time.Now()),
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:  testServiceBindingGUID,
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			UnbindStatus: v1beta1.ServiceBindingUnbindStatusNotRequired,
+		},
+	}
+	older := binding.DeepCopy()
+	older.Name = "older-binding"
+	older.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	setServiceBindingCondition(older, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, successInjectedBindResultReason, successInjectedBindResultMessage)
+	return binding, older
+}
+
+// TestReconcileServiceBindingMaxBindingsReached tests that a binding is not
+// bound while its instance already has as many bindings as its plan allows.
+func TestReconcileServiceBindingMaxBindingsReached(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	plan := getTestClusterServicePlan()
+	maxBindings := int32(1)
+	plan.Spec.MaxBindings = &maxBindings
+	binding, older := getTestServiceBindingsForMaxBindings()
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(older)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+	if err := reconcileServiceBinding(t, testController, binding); err == nil {
+		t.Fatal("Expected the binding to wait for a binding of the instance to be deleted")
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, errorMaxBindingsReachedReason, binding)
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+	expectedEvent := warningEventBuilder(errorMaxBindingsReachedReason).msgf(
+		"Binding cannot begin because ServiceInstance %q already has 1 bindings and its plan %q allows at most 1",
+		"test-ns/test-instance", testClusterServicePlanGUID,
+	)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceBindingMaxBindingsFailedBindingsIgnored tests that
+// the failed bindings of an instance do not count against the bindings its
+// plan allows.
+func TestReconcileServiceBindingMaxBindingsFailedBindingsIgnored(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	plan := getTestClusterServicePlan()
+	maxBindings := int32(1)
+	plan.Spec.MaxBindings = &maxBindings
+	binding, older := getTestServiceBindingsForMaxBindings()
+	setServiceBindingCondition(older, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, errorBindCallReason, "failed")
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(older)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	if e, a := v1beta1.ServiceBindingOperationBind, updatedServiceBinding.Status.CurrentOperation; e != a {
+		t.Fatalf("Unexpected current operation; %s", expectedGot(e, a))
+	}
+}
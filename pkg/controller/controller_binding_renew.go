@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	renewingBindingReason  string = "RenewingBinding"
+	renewingBindingMessage string = "Unbinding from the broker to renew the credentials"
+)
+
+// isServiceBindingRenewal returns whether the bind request about to be sent
+// for binding renews it: the binding is ready, and its generation changed
+// because its UpdateRequests were incremented.
+func (c *controller) isServiceBindingRenewal(binding *v1beta1.ServiceBinding) bool {
+	return c.isServiceBindingSucceeded(binding) && binding.Status.ReconciledGeneration != binding.Generation
+}
+
+// unbindServiceBindingForRenewal deletes the binding at the broker, so that
+// the bind request that follows returns new credentials. A binding that the
+// broker no longer has is considered deleted. The status of the binding is
+// left as it is, so a failed unbind request is sent again on the next retry.
+func (c *controller) unbindServiceBindingForRenewal(binding *v1beta1.ServiceBinding, instance *v1beta1.ServiceInstance, brokerClient osb.Client) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+
+	request, err := c.prepareUnbindRequest(binding, instance)
+	if err != nil {
+		return err
+	}
+	// The bind request must not be sent before the unbind has completed.
+	request.AcceptsIncomplete = false
+
+	klog.V(4).Info(pcb.Message(renewingBindingMessage))
+	c.recorder.Event(binding, corev1.EventTypeNormal, renewingBindingReason, renewingBindingMessage)
+	if _, err := brokerClient.Unbind(request); err != nil {
+		if httpErr, ok := osb.IsHTTPError(err); ok && httpErr.StatusCode == http.StatusGone {
+			return nil
+		}
+		msg := fmt.Sprintf("Error unbinding to renew the credentials: %v", err)
+		klog.Warning(pcb.Message(msg))
+		c.recorder.Event(binding, corev1.EventTypeWarning, errorUnbindCallReason, msg)
+		return errors.New(msg)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestReconcileServiceBindingRenewal tests that a ready binding whose
+// UpdateRequests were incremented is unbound at the broker, synchronously,
+// before it is bound again.
+func TestReconcileServiceBindingRenewal(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UnbindReaction: &fakeosb.UnbindReaction{
+			Response: &osb.UnbindResponse{},
+		},
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	binding := getTestServiceBinding()
+	binding.Generation = 2
+	binding.Spec.UpdateRequests = 1
+	binding.Status.ReconciledGeneration = 1
+	binding.Status.Conditions = []v1beta1.ServiceBindingCondition{{
+		Type:   v1beta1.ServiceBindingConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	request, ok := brokerActions[0].Request.(*osb.UnbindRequest)
+	if !ok {
+		t.Fatalf("Unexpected request type; expected %T, got %T", &osb.UnbindRequest{}, brokerActions[0].Request)
+	}
+	if request.AcceptsIncomplete {
+		t.Fatal("Expected the unbind request of a renewal not to accept an asynchronous operation")
+	}
+	if e, a := testServiceBindingGUID, request.BindingID; e != a {
+		t.Fatalf("Unexpected binding ID; %s", expectedGot(e, a))
+	}
+
+	assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+}
+
+// TestReconcileServiceBindingRenewalUnbindError tests that the binding is
+// not bound again when the unbind request of a renewal fails.
+func TestReconcileServiceBindingRenewalUnbindError(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UnbindReaction: &fakeosb.UnbindReaction{
+			Error: fakeosb.AsyncRequiredError(),
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	binding := getTestServiceBinding()
+	binding.Generation = 2
+	binding.Status.ReconciledGeneration = 1
+	binding.Status.Conditions = []v1beta1.ServiceBindingCondition{{
+		Type:   v1beta1.ServiceBindingConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+
+	if err := reconcileServiceBinding(t, testController, binding); err == nil {
+		t.Fatal("Expected the failed unbind request to be retried")
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 1)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	// BindingSecretLabel labels the Secrets the controller writes the
+	// credentials of ServiceBindings to when the BindingSecretDriftRepair
+	// feature is enabled, so that only those Secrets are watched.
+	BindingSecretLabel = "servicecatalog.k8s.io/binding-secret"
+
+	// bindingSecretChecksumAnnotation holds the checksum of the data the
+	// controller last wrote to a binding's Secret.
+	bindingSecretChecksumAnnotation = "servicecatalog.k8s.io/credentials-checksum"
+
+	secretDriftRepairedReason    string = "SecretDriftRepaired"
+	errorSecretDriftRepairReason string = "SecretDriftRepairFailed"
+)
+
+// serviceBindingSecretChecksum returns the checksum of the data of a
+// binding's Secret.
+func serviceBindingSecretChecksum(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(h, "%d:%s%d:", len(key), key, len(data[key]))
+		h.Write(data[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// markServiceBindingSecret labels secret as holding the credentials of a
+// binding and records the checksum of its data, so that changes made to it
+// outside of the controller can be detected. It does nothing unless the
+// BindingSecretDriftRepair feature is enabled.
+func markServiceBindingSecret(secret *corev1.Secret) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.BindingSecretDriftRepair) {
+		return
+	}
+	if secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	secret.Labels[BindingSecretLabel] = "true"
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[bindingSecretChecksumAnnotation] = serviceBindingSecretChecksum(secret.Data)
+}
+
+// bindingSecretAdd, bindingSecretUpdate and bindingSecretDelete queue the
+// ServiceBinding controlling a Secret to check it for drift.
+func (c *controller) bindingSecretAdd(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	ref := metav1.GetControllerOf(secret)
+	if ref == nil || ref.Kind != bindingControllerKind.Kind || ref.APIVersion != bindingControllerKind.GroupVersion().String() {
+		return
+	}
+	c.bindingSecretDriftQueue.Add(secret.Namespace + "/" + ref.Name)
+}
+
+func (c *controller) bindingSecretUpdate(oldObj, newObj interface{}) {
+	c.bindingSecretAdd(newObj)
+}
+
+func (c *controller) bindingSecretDelete(obj interface{}) {
+	c.bindingSecretAdd(obj)
+}
+
+func (c *controller) reconcileServiceBindingSecretDriftKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	binding, err := c.bindingLister.ServiceBindings(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return c.reconcileServiceBindingSecretDrift(binding)
+}
+
+// reconcileServiceBindingSecretDrift restores the Secrets of a ready binding
+// that were modified or deleted outside of the controller. The credentials
+// are retrieved from the broker when the binding is retrievable, and
+// otherwise obtained by sending the bind request again, which a broker
+// answers with the credentials of the existing binding.
+func (c *controller) reconcileServiceBindingSecretDrift(binding *v1beta1.ServiceBinding) error {
+	if c.readOnly || binding.DeletionTimestamp != nil || binding.Spec.SecretTemplate != "" ||
+		binding.Status.CurrentOperation != "" || !c.isServiceBindingSucceeded(binding) {
+		return nil
+	}
+	pcb := pretty.NewBindingContextBuilder(binding)
+
+	drifted, err := c.getDriftedServiceBindingSecrets(binding)
+	if err != nil || len(drifted) == 0 {
+		return err
+	}
+	klog.V(4).Info(pcb.Messagef("Secrets %s were modified or deleted outside of the controller", strings.Join(drifted, ", ")))
+
+	binding = binding.DeepCopy()
+	credentials, err := c.retrieveServiceBindingCredentials(binding)
+	if err == nil {
+		err = c.injectServiceBinding(binding, credentials)
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Error restoring Secrets %s: %v", strings.Join(drifted, ", "), err)
+		klog.Warning(pcb.Message(msg))
+		c.recorder.Event(binding, corev1.EventTypeWarning, errorSecretDriftRepairReason, msg)
+		return err
+	}
+	if _, err := c.updateServiceBindingStatus(binding); err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("Restored Secrets %s, which were modified or deleted outside of the controller", strings.Join(drifted, ", "))
+	klog.V(4).Info(pcb.Message(msg))
+	c.recorder.Event(binding, corev1.EventTypeNormal, secretDriftRepairedReason, msg)
+	return nil
+}
+
+// getDriftedServiceBindingSecrets returns the names of the Secrets of
+// binding that were deleted, or whose data no longer matches the checksum
+// recorded when the controller wrote it.
+func (c *controller) getDriftedServiceBindingSecrets(binding *v1beta1.ServiceBinding) ([]string, error) {
+	names := []string{binding.Spec.SecretName}
+	for _, target := range binding.Spec.AdditionalSecretTargets {
+		names = append(names, target.SecretName)
+	}
+
+	var drifted []string
+	for _, name := range names {
+		secret, err := c.secretLister.Secrets(binding.Namespace).Get(name)
+		if apierrors.IsNotFound(err) {
+			drifted = append(drifted, fmt.Sprintf("%q", name))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		checksum, ok := secret.Annotations[bindingSecretChecksumAnnotation]
+		if ok && checksum != serviceBindingSecretChecksum(secret.Data) {
+			drifted = append(drifted, fmt.Sprintf("%q", name))
+		}
+	}
+	return drifted, nil
+}
+
+// retrieveServiceBindingCredentials returns the credentials of binding from
+// its broker.
+func (c *controller) retrieveServiceBindingCredentials(binding *v1beta1.ServiceBinding) (map[string]interface{}, error) {
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	brokerClient, err := c.getBrokerClientForServiceBinding(instance, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	var retrievable bool
+	if instance.Spec.ClusterServiceClassSpecified() {
+		serviceClass, err := c.getClusterServiceClassForServiceBinding(instance, binding)
+		if err != nil {
+			return nil, err
+		}
+		retrievable = serviceClass.Spec.BindingRetrievable
+	} else {
+		serviceClass, err := c.getServiceClassForServiceBinding(instance, binding)
+		if err != nil {
+			return nil, err
+		}
+		retrievable = serviceClass.Spec.BindingRetrievable
+	}
+
+	if retrievable {
+		response, err := brokerClient.GetBinding(&osb.GetBindingRequest{
+			InstanceID: instance.Spec.ExternalID,
+			BindingID:  binding.Spec.ExternalID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Could not do a GET on binding resource: %v", err)
+		}
+		return response.Credentials, nil
+	}
+
+	request, _, err := c.prepareBindRequest(binding, instance)
+	if err != nil {
+		return nil, err
+	}
+	request.AcceptsIncomplete = false
+	response, err := brokerClient.Bind(request)
+	if err != nil {
+		return nil, fmt.Errorf("Error sending the bind request again: %v", err)
+	}
+	return response.Credentials, nil
+}
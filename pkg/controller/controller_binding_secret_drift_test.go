@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+// getTestReadyServiceBinding returns a ready binding whose secret was
+// written by the controller.
+func getTestReadyServiceBinding() *v1beta1.ServiceBinding {
+	binding := getTestServiceBinding()
+	binding.UID = "binding-uid"
+	binding.Status.Conditions = []v1beta1.ServiceBindingCondition{{
+		Type:   v1beta1.ServiceBindingConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+	return binding
+}
+
+// getTestServiceBindingSecret returns the secret of binding, holding data
+// and marked with the checksum of checksumData.
+func getTestServiceBindingSecret(binding *v1beta1.ServiceBinding, data, checksumData map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            binding.Spec.SecretName,
+			Namespace:       binding.Namespace,
+			Labels:          map[string]string{BindingSecretLabel: "true"},
+			Annotations:     map[string]string{bindingSecretChecksumAnnotation: serviceBindingSecretChecksum(checksumData)},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+		},
+		Data: data,
+	}
+}
+
+func TestReconcileServiceBindingSecretDrift(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BindingSecretDriftRepair)); err != nil {
+		t.Fatalf("Failed to enable the BindingSecretDriftRepair feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingSecretDriftRepair))
+
+	credentials := map[string][]byte{"password": []byte("s3cr3t")}
+	cases := []struct {
+		name                string
+		retrievable         bool
+		secret              func(binding *v1beta1.ServiceBinding) *corev1.Secret
+		expectedBrokerCalls int
+		expectedAction      fakeosb.ActionType
+		expectedKubeActions int
+	}{
+		{
+			name:                "deleted secret",
+			secret:              func(*v1beta1.ServiceBinding) *corev1.Secret { return nil },
+			expectedBrokerCalls: 1,
+			expectedAction:      fakeosb.Bind,
+			// the namespace is read to build the context of the request
+			expectedKubeActions: 3,
+		},
+		{
+			name:        "modified secret of a retrievable binding",
+			retrievable: true,
+			secret: func(binding *v1beta1.ServiceBinding) *corev1.Secret {
+				return getTestServiceBindingSecret(binding, map[string][]byte{"password": []byte("edited")}, credentials)
+			},
+			expectedBrokerCalls: 1,
+			expectedAction:      fakeosb.GetBinding,
+			expectedKubeActions: 2,
+		},
+		{
+			name: "unchanged secret",
+			secret: func(binding *v1beta1.ServiceBinding) *corev1.Secret {
+				return getTestServiceBindingSecret(binding, credentials, credentials)
+			},
+		},
+		{
+			name: "secret written before drift repair was enabled",
+			secret: func(binding *v1beta1.ServiceBinding) *corev1.Secret {
+				secret := getTestServiceBindingSecret(binding, map[string][]byte{"password": []byte("edited")}, credentials)
+				secret.Annotations = nil
+				return secret
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				BindReaction: &fakeosb.BindReaction{
+					Response: &osb.BindResponse{Credentials: map[string]interface{}{"password": "s3cr3t"}},
+				},
+				GetBindingReaction: &fakeosb.GetBindingReaction{
+					Response: &osb.GetBindingResponse{Credentials: map[string]interface{}{"password": "s3cr3t"}},
+				},
+			})
+			addGetSecretNotFoundReaction(fakeKubeClient)
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			if tc.retrievable {
+				sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestBindingRetrievableClusterServiceClass())
+			} else {
+				sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			}
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+			binding := getTestReadyServiceBinding()
+			secrets := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if secret := tc.secret(binding); secret != nil {
+				secrets.Add(secret)
+			}
+			testController.secretLister = corelisters.NewSecretLister(secrets)
+
+			if err := testController.reconcileServiceBindingSecretDrift(binding); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			brokerActions := fakeBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, tc.expectedBrokerCalls)
+			if tc.expectedBrokerCalls == 0 {
+				assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+				assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+				assertNumEvents(t, getRecordedEvents(testController), 0)
+				return
+			}
+			if e, a := tc.expectedAction, brokerActions[0].Type; e != a {
+				t.Fatalf("Unexpected broker action; %s", expectedGot(e, a))
+			}
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, tc.expectedKubeActions)
+			created := kubeActions[len(kubeActions)-1]
+			secret, ok := created.(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+			if !ok {
+				t.Fatalf("Unexpected object created; expected a Secret, got %v", created)
+			}
+			if e, a := serviceBindingSecretChecksum(secret.Data), secret.Annotations[bindingSecretChecksumAnnotation]; e != a {
+				t.Fatalf("Unexpected checksum of the restored secret; %s", expectedGot(e, a))
+			}
+			if secret.Labels[BindingSecretLabel] == "" {
+				t.Fatal("Expected the restored secret to be labeled")
+			}
+
+			assertNumberOfActions(t, fakeCatalogClient.Actions(), 1)
+			events := getRecordedEvents(testController)
+			assertNumEvents(t, events, 1)
+			if !strings.HasPrefix(events[0], corev1.EventTypeNormal+" "+secretDriftRepairedReason) {
+				t.Fatalf("Unexpected event; expected a %s event, got %q", secretDriftRepairedReason, events[0])
+			}
+		})
+	}
+}
+
+// TestBindingSecretAdd tests that only the secrets controlled by a binding
+// queue it.
+func TestBindingSecretAdd(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	binding := getTestReadyServiceBinding()
+
+	other := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNamespace}}
+	testController.bindingSecretAdd(other)
+	if e, a := 0, testController.bindingSecretDriftQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued bindings; %s", expectedGot(e, a))
+	}
+
+	secret := getTestServiceBindingSecret(binding, nil, nil)
+	testController.bindingSecretDelete(cache.DeletedFinalStateUnknown{Key: testNamespace + "/" + secret.Name, Obj: secret})
+	if e, a := 1, testController.bindingSecretDriftQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued bindings; %s", expectedGot(e, a))
+	}
+	key, _ := testController.bindingSecretDriftQueue.Get()
+	if e, a := testNamespace+"/"+testServiceBindingName, key; e != a {
+		t.Fatalf("Unexpected queued binding; %s", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/secrettemplate"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+const testExternalSecretTemplate = `apiVersion: example.com/v1
+kind: VaultSecret
+metadata:
+  name: {{ .SecretName }}
+spec:
+  path: {{ .Namespace }}/{{ .Name }}
+  data: {{ json .Credentials }}
+`
+
+var testVaultSecretResource = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "vaultsecrets"}
+
+func newTestSecretTemplates(t *testing.T) (*secrettemplate.Templates, *dynamicfake.FakeDynamicClient) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "VaultSecret"}, meta.RESTScopeNamespace)
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		testVaultSecretResource: "VaultSecretList",
+	})
+	templates, err := secrettemplate.New(map[string]string{"vault": testExternalSecretTemplate}, client, mapper)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return templates, client
+}
+
+// TestInjectServiceBindingSecretTemplate tests that the credentials of a
+// binding with a SecretTemplate are delivered through the objects of the
+// template instead of a Secret, and that ejecting the binding deletes them.
+func TestInjectServiceBindingSecretTemplate(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	templates, client := newTestSecretTemplates(t)
+	testController.secretTemplates = templates
+
+	binding := getTestServiceBinding()
+	binding.Spec.SecretTemplate = "vault"
+	binding.Spec.SecretTransforms = []v1beta1.SecretTransform{
+		{RenameKey: &v1beta1.RenameKeyTransform{From: "password", To: "PASSWORD"}},
+	}
+	if err := testController.injectServiceBinding(binding, map[string]interface{}{"password": "s3cr3t"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if binding.Status.LastCredentialsRotationTime == nil {
+		t.Fatal("Expected the time of the last credentials rotation to be set")
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	vaultSecret, err := client.Resource(testVaultSecretResource).Namespace(testNamespace).Get(context.Background(), binding.Spec.SecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if password, _, _ := unstructured.NestedString(vaultSecret.Object, "spec", "data", "PASSWORD"); password != "s3cr3t" {
+		t.Fatalf("Expected the transformed credentials to be rendered, got %v", vaultSecret.Object["spec"])
+	}
+	if !metav1.IsControlledBy(vaultSecret, binding) {
+		t.Fatal("Expected the rendered object to be controlled by the binding")
+	}
+
+	if err := testController.ejectServiceBinding(binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+	list, err := client.Resource(testVaultSecretResource).Namespace(testNamespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 0 {
+		t.Fatal("Expected the rendered object to be deleted")
+	}
+}
+
+// TestInjectServiceBindingSecretTemplateNotConfigured tests that the
+// credentials of a binding are not written to a Secret when its
+// SecretTemplate is not configured in the controller.
+func TestInjectServiceBindingSecretTemplateNotConfigured(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	binding := getTestServiceBinding()
+	binding.Spec.SecretTemplate = "vault"
+	if err := testController.injectServiceBinding(binding, map[string]interface{}{"password": "s3cr3t"}); err == nil {
+		t.Fatal("Expected an error for a secret template that is not configured")
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// serviceBindingOperationRetryDelay is how long a ServiceBinding waits
+// before trying again to send its request when another binding of the same
+// instance has one in flight. Bindings are also requeued as soon as the
+// other binding's request completes; the delay only bounds the wait if that
+// never happens, e.g. because the other binding was deleted.
+const serviceBindingOperationRetryDelay = 10 * time.Second
+
+// serviceInstanceBindingOperations tracks, per ServiceInstance, the
+// ServiceBinding whose bind or unbind request is in flight, and the bindings
+// waiting to send theirs.
+type serviceInstanceBindingOperations struct {
+	mutex sync.Mutex
+	// holders maps the key of a ServiceInstance to the key of the binding
+	// with a request in flight.
+	holders map[string]string
+	// waiters maps the key of a ServiceInstance to the keys of the bindings
+	// waiting to send a request.
+	waiters map[string]sets.String
+}
+
+// acquireServiceBindingOperation returns whether binding may send a bind or
+// unbind request to the broker. When the SerializeBindingOperations feature
+// is enabled, only one binding of an instance may have a request in flight,
+// including an asynchronous operation being polled; the other bindings are
+// requeued until it completes.
+func (c *controller) acquireServiceBindingOperation(binding *v1beta1.ServiceBinding) bool {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.SerializeBindingOperations) {
+		return true
+	}
+	pcb := pretty.NewBindingContextBuilder(binding)
+	instanceKey := binding.Namespace + "/" + binding.Spec.InstanceRef.Name
+	bindingKey, err := cache.MetaNamespaceKeyFunc(binding)
+	if err != nil {
+		klog.Errorf("Couldn't create a key for object %+v: %v", binding, err)
+		return true
+	}
+
+	ops := &c.bindingOperations
+	ops.mutex.Lock()
+	defer ops.mutex.Unlock()
+
+	if holder, ok := ops.holders[instanceKey]; ok && holder != bindingKey && c.isServiceBindingOperationInFlight(holder) {
+		klog.V(4).Info(pcb.Messagef("Waiting for the request of ServiceBinding %q to the same instance to complete", holder))
+		if ops.waiters == nil {
+			ops.waiters = make(map[string]sets.String)
+		}
+		if ops.waiters[instanceKey] == nil {
+			ops.waiters[instanceKey] = sets.NewString()
+		}
+		ops.waiters[instanceKey].Insert(bindingKey)
+		c.bindingQueue.AddAfter(bindingKey, serviceBindingOperationRetryDelay)
+		return false
+	}
+
+	if ops.holders == nil {
+		ops.holders = make(map[string]string)
+	}
+	ops.holders[instanceKey] = bindingKey
+	if waiters := ops.waiters[instanceKey]; waiters != nil {
+		waiters.Delete(bindingKey)
+	}
+	return true
+}
+
+// releaseServiceBindingOperation records that the request of binding has
+// completed, and requeues the bindings of the same instance waiting to send
+// theirs.
+func (c *controller) releaseServiceBindingOperation(binding *v1beta1.ServiceBinding) {
+	instanceKey := binding.Namespace + "/" + binding.Spec.InstanceRef.Name
+	bindingKey, err := cache.MetaNamespaceKeyFunc(binding)
+	if err != nil {
+		return
+	}
+
+	ops := &c.bindingOperations
+	ops.mutex.Lock()
+	defer ops.mutex.Unlock()
+
+	if ops.holders[instanceKey] != bindingKey {
+		return
+	}
+	delete(ops.holders, instanceKey)
+	for _, waiter := range ops.waiters[instanceKey].List() {
+		c.bindingQueue.Add(waiter)
+	}
+	delete(ops.waiters, instanceKey)
+}
+
+// isServiceBindingOperationInFlight returns whether the binding with the
+// given key may still have a request in flight. It guards against holders
+// that were deleted or whose operation ended without releasing the instance.
+func (c *controller) isServiceBindingOperationInFlight(bindingKey string) bool {
+	namespace, name, err := cache.SplitMetaNamespaceKey(bindingKey)
+	if err != nil {
+		return false
+	}
+	binding, err := c.bindingLister.ServiceBindings(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return binding.Status.CurrentOperation != "" || binding.Status.AsyncOpInProgress || binding.Status.OrphanMitigationInProgress
+}
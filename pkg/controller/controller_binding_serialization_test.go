@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+)
+
+// TestReconcileServiceBindingSerializedPerInstance tests that a bind request
+// is not sent while another binding of the same instance has one in flight,
+// and that the binding is requeued once the other request completes.
+func TestReconcileServiceBindingSerializedPerInstance(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.SerializeBindingOperations)); err != nil {
+		t.Fatalf("Failed to enable SerializeBindingOperations feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.SerializeBindingOperations))
+
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+
+	other := getTestServiceBinding()
+	other.Name = "other-binding"
+	other.Status.CurrentOperation = v1beta1.ServiceBindingOperationBind
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(other)
+	if !testController.acquireServiceBindingOperation(other) {
+		t.Fatal("Expected the first binding of the instance to send its request")
+	}
+
+	binding := getTestServiceBinding()
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding = assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	testController.releaseServiceBindingOperation(other)
+	if e, a := 1, testController.bindingQueue.Len(); e != a {
+		t.Fatalf("Expected the waiting binding to be requeued; %s", expectedGot(e, a))
+	}
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	brokerActions := fakeBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	if _, ok := brokerActions[0].Request.(*osb.BindRequest); !ok {
+		t.Fatalf("Unexpected request type; expected %T, got %T", &osb.BindRequest{}, brokerActions[0].Request)
+	}
+}
+
+// TestAcquireServiceBindingOperationStaleHolder tests that a binding does
+// not wait for a binding that no longer has a request in flight.
+func TestAcquireServiceBindingOperationStaleHolder(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.SerializeBindingOperations)); err != nil {
+		t.Fatalf("Failed to enable SerializeBindingOperations feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.SerializeBindingOperations))
+
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	other := getTestServiceBinding()
+	other.Name = "other-binding"
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(other)
+	if !testController.acquireServiceBindingOperation(other) {
+		t.Fatal("Expected the first binding of the instance to send its request")
+	}
+
+	if !testController.acquireServiceBindingOperation(getTestServiceBinding()) {
+		t.Fatal("Expected the binding not to wait for a binding without an operation in progress")
+	}
+}
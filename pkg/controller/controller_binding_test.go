@@ -582,6 +582,244 @@ func TestReconcileServiceBindingWithParameters(t *testing.T) {
 	}
 }
 
+// TestInjectServiceBindingSecretConflictPolicy tests that an existing secret
+// not owned by the binding is only written to when the binding's
+// SecretConflictPolicy allows it.
+func TestInjectServiceBindingSecretConflictPolicy(t *testing.T) {
+	otherControllerRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "other",
+		UID:        "other-uid",
+		Controller: truePtr(),
+	}
+
+	cases := []struct {
+		name           string
+		policy         v1beta1.SecretConflictPolicy
+		ownerRefs      []metav1.OwnerReference
+		success        bool
+		expectedEvents []string
+	}{
+		{
+			name:    "default policy fails",
+			success: false,
+		},
+		{
+			name:    "fail",
+			policy:  v1beta1.SecretConflictPolicyFail,
+			success: false,
+		},
+		{
+			name:           "adopt unowned secret",
+			policy:         v1beta1.SecretConflictPolicyAdopt,
+			success:        true,
+			expectedEvents: normalEventBuilder(adoptedSecretReason).stringArr(),
+		},
+		{
+			name:      "adopt secret controlled by another object",
+			policy:    v1beta1.SecretConflictPolicyAdopt,
+			ownerRefs: []metav1.OwnerReference{otherControllerRef},
+			success:   false,
+		},
+		{
+			name:           "overwrite secret controlled by another object",
+			policy:         v1beta1.SecretConflictPolicyOverwrite,
+			ownerRefs:      []metav1.OwnerReference{otherControllerRef},
+			success:        true,
+			expectedEvents: normalEventBuilder(overwroteSecretReason).stringArr(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+			addGetSecretReaction(fakeKubeClient, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            testServiceBindingSecretName,
+					Namespace:       testNamespace,
+					OwnerReferences: tc.ownerRefs,
+				},
+			})
+
+			binding := getTestServiceBinding()
+			binding.UID = "binding-uid"
+			binding.Spec.SecretConflictPolicy = tc.policy
+
+			err := testController.injectServiceBinding(binding, map[string]interface{}{"a": "b"})
+			if !tc.success {
+				if err == nil {
+					t.Fatal("expected the credentials injection to fail")
+				}
+				kubeActions := fakeKubeClient.Actions()
+				assertNumberOfActions(t, kubeActions, 1)
+				assertActionEquals(t, kubeActions[0], "get", "secrets")
+				assertNumEvents(t, getRecordedEvents(testController), 0)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, 2)
+			assertActionEquals(t, kubeActions[1], "update", "secrets")
+			actionSecret := kubeActions[1].(clientgotesting.UpdateAction).GetObject().(*corev1.Secret)
+			if !metav1.IsControlledBy(actionSecret, binding) {
+				t.Fatalf("Secret is not controlled by the ServiceBinding: %v", metav1.GetControllerOf(actionSecret))
+			}
+			if e, a := len(tc.ownerRefs)+1, len(actionSecret.OwnerReferences); e != a {
+				t.Fatalf("Unexpected number of owner references; %s", expectedGot(e, a))
+			}
+			if e, a := "b", string(actionSecret.Data["a"]); e != a {
+				t.Fatalf("Unexpected value of key 'a' in secret; %s", expectedGot(e, a))
+			}
+
+			if err := checkEventPrefixes(getRecordedEvents(testController), tc.expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestInjectServiceBindingAdditionalSecretTargets tests that the credentials
+// are written to every additional secret target, each with its own
+// transforms applied.
+func TestInjectServiceBindingAdditionalSecretTargets(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	binding := getTestServiceBinding()
+	binding.Spec.SecretTransforms = []v1beta1.SecretTransform{
+		{RemoveKey: &v1beta1.RemoveKeyTransform{Key: "password"}},
+	}
+	binding.Spec.AdditionalSecretTargets = []v1beta1.SecretTarget{
+		{SecretName: "env-secret"},
+		{
+			SecretName: "renamed-secret",
+			SecretTransforms: []v1beta1.SecretTransform{
+				{RenameKey: &v1beta1.RenameKeyTransform{From: "user", To: "DB_USER"}},
+			},
+		},
+	}
+
+	credentials := map[string]interface{}{"user": "foo", "password": "bar"}
+	if err := testController.injectServiceBinding(binding, credentials); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 6)
+
+	expected := []struct {
+		name string
+		data map[string]string
+	}{
+		{name: testServiceBindingSecretName, data: map[string]string{"user": "foo"}},
+		{name: "env-secret", data: map[string]string{"user": "foo", "password": "bar"}},
+		{name: "renamed-secret", data: map[string]string{"DB_USER": "foo", "password": "bar"}},
+	}
+	for i, e := range expected {
+		assertActionEquals(t, kubeActions[2*i], "get", "secrets")
+		assertActionEquals(t, kubeActions[2*i+1], "create", "secrets")
+		secret := kubeActions[2*i+1].(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+		if secret.Name != e.name {
+			t.Fatalf("Unexpected secret name; %s", expectedGot(e.name, secret.Name))
+		}
+		if !metav1.IsControlledBy(secret, binding) {
+			t.Fatalf("Secret %q is not controlled by the ServiceBinding", secret.Name)
+		}
+		if len(secret.Data) != len(e.data) {
+			t.Fatalf("Unexpected number of keys in secret %q; %s", secret.Name, expectedGot(len(e.data), len(secret.Data)))
+		}
+		for k, v := range e.data {
+			if a := string(secret.Data[k]); a != v {
+				t.Fatalf("Unexpected value of key %q in secret %q; %s", k, secret.Name, expectedGot(v, a))
+			}
+		}
+	}
+
+	if len(credentials) != 2 {
+		t.Fatalf("broker credentials were modified: %v", credentials)
+	}
+}
+
+// TestInjectServiceBindingSecretType tests that the secrets of a binding are
+// created with its secret type, as long as the credentials hold the keys the
+// type requires and an existing secret has the same type.
+func TestInjectServiceBindingSecretType(t *testing.T) {
+	cases := []struct {
+		name           string
+		secretType     string
+		existingSecret *corev1.Secret
+		success        bool
+		numActions     int
+	}{
+		{
+			name:       "basic-auth",
+			secretType: string(corev1.SecretTypeBasicAuth),
+			success:    true,
+			numActions: 2,
+		},
+		{
+			name:       "custom",
+			secretType: "example.com/database",
+			success:    true,
+			numActions: 2,
+		},
+		{
+			name:       "tls without the required keys",
+			secretType: string(corev1.SecretTypeTLS),
+			success:    false,
+			numActions: 0,
+		},
+		{
+			name:       "existing secret of another type",
+			secretType: string(corev1.SecretTypeBasicAuth),
+			existingSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: testServiceBindingSecretName, Namespace: testNamespace},
+				Type:       corev1.SecretTypeOpaque,
+			},
+			success:    false,
+			numActions: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+			if tc.existingSecret != nil {
+				addGetSecretReaction(fakeKubeClient, tc.existingSecret)
+			} else {
+				addGetSecretNotFoundReaction(fakeKubeClient)
+			}
+
+			binding := getTestServiceBinding()
+			binding.Spec.SecretType = tc.secretType
+
+			err := testController.injectServiceBinding(binding, map[string]interface{}{"username": "foo", "password": "bar"})
+			if tc.success && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.success && err == nil {
+				t.Fatal("expected the credentials injection to fail")
+			}
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, tc.numActions)
+			if !tc.success {
+				return
+			}
+			assertActionEquals(t, kubeActions[1], "create", "secrets")
+			secret := kubeActions[1].(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+			if e, a := corev1.SecretType(tc.secretType), secret.Type; e != a {
+				t.Fatalf("Unexpected secret type; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
 // TestReconcileServiceBindingWithSecretTransform tests reconcileBinding to ensure a
 // binding with secretTransforms performs the specified transformations.
 func TestReconcileServiceBindingWithSecretTransform(t *testing.T) {
@@ -3575,6 +3813,10 @@ func TestPollServiceBinding(t *testing.T) {
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationBind, originalBinding)
+				// The operation key is kept for correlation with the logs
+				// of the broker.
+				assertServiceBindingLastOperation(t, updatedBinding, "")
+				assertServiceBindingLastOperationKey(t, updatedBinding, testOperation)
 			},
 			shouldFinishPolling: true,
 			expectedEvents:      []string{corev1.EventTypeNormal + " " + successInjectedBindResultReason + " " + successInjectedBindResultMessage},
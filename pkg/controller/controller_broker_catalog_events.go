@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	classAddedReason   string = "ClassAdded"
+	classChangedReason string = "ClassChanged"
+	classRemovedReason string = "ClassRemoved"
+	planAddedReason    string = "PlanAdded"
+	planChangedReason  string = "PlanChanged"
+	planRemovedReason  string = "PlanRemoved"
+
+	// maxCatalogChangeEvents is the number of events recorded for each
+	// reason on a relist of the catalog of a broker. The remaining changes
+	// of the reason are batched into a single event, so that a large
+	// catalog change does not flood the events of the broker.
+	maxCatalogChangeEvents = 5
+)
+
+// catalogChangeReasons are the reasons of the events of catalog changes, in
+// the order their events are recorded.
+var catalogChangeReasons = []string{
+	classAddedReason,
+	classChangedReason,
+	classRemovedReason,
+	planAddedReason,
+	planChangedReason,
+	planRemovedReason,
+}
+
+// catalogChange is the verb of the messages of the events of a reason.
+var catalogChange = map[string]string{
+	classAddedReason:   "added to",
+	classChangedReason: "changed in",
+	classRemovedReason: "removed from",
+	planAddedReason:    "added to",
+	planChangedReason:  "changed in",
+	planRemovedReason:  "removed from",
+}
+
+// catalogChanges records the classes and plans added, changed or removed by
+// a relist of the catalog of a broker, by the reason of their events. The
+// entries may be synced in parallel, so the records are guarded by a mutex.
+type catalogChanges struct {
+	mutex sync.Mutex
+	// classExternalNames are the external names of the classes of the
+	// broker by name, to name the plans after their class.
+	classExternalNames map[string]string
+	names              map[string][]string
+}
+
+func newCatalogChanges() *catalogChanges {
+	return &catalogChanges{
+		classExternalNames: map[string]string{},
+		names:              map[string][]string{},
+	}
+}
+
+// recordChange records the change of an entry of the catalog, if catalog
+// change events are enabled. Plans are named after the external names of
+// their class and of the plan, such as "mysql/small".
+func (m *catalogMaterializer) recordChange(entries catalogEntries, entry metav1.Object, classReason, planReason string) {
+	if m.changes == nil {
+		return
+	}
+	m.changes.mutex.Lock()
+	defer m.changes.mutex.Unlock()
+
+	if entries == m.classes {
+		m.changes.names[classReason] = append(m.changes.names[classReason], entries.externalName(entry))
+		return
+	}
+	name := m.changes.classExternalNames[entries.className(entry)] + "/" + entries.externalName(entry)
+	m.changes.names[planReason] = append(m.changes.names[planReason], name)
+}
+
+// recordChangeEvents records an event on the broker for each recorded
+// change of its catalog, up to maxCatalogChangeEvents for each reason, and
+// a single event listing the remaining changes of the reason.
+func (m *catalogMaterializer) recordChangeEvents() {
+	if m.changes == nil {
+		return
+	}
+	m.changes.mutex.Lock()
+	defer m.changes.mutex.Unlock()
+
+	for _, reason := range catalogChangeReasons {
+		names := m.changes.names[reason]
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		kind, kinds := m.classes.kind(), m.classes.kind()+"es"
+		if strings.HasPrefix(reason, "Plan") {
+			kind, kinds = m.plans.kind(), m.plans.kind()+"s"
+		}
+
+		for i, name := range names {
+			if i == maxCatalogChangeEvents {
+				break
+			}
+			m.recorder.Eventf(m.broker, corev1.EventTypeNormal, reason, "%s %q was %s the broker catalog", kind, name, catalogChange[reason])
+		}
+		if len(names) <= maxCatalogChangeEvents {
+			continue
+		}
+
+		batched := names[maxCatalogChangeEvents:]
+		listed := batched
+		if len(listed) > maxCatalogSummaryChanges {
+			listed = listed[:maxCatalogSummaryChanges]
+		}
+		s := fmt.Sprintf("%d more %s were %s the broker catalog: %s", len(batched), kinds, catalogChange[reason], strings.Join(listed, ", "))
+		if len(batched) > len(listed) {
+			s += ", ..."
+		}
+		m.recorder.Event(m.broker, corev1.EventTypeNormal, reason, s)
+	}
+	m.changes.names = map[string][]string{}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// getMaterializerEvents returns the events recorded by a test materializer.
+func getMaterializerEvents(m *catalogMaterializer) []string {
+	source := m.recorder.(*record.FakeRecorder).Events
+	var events []string
+	for {
+		select {
+		case event := <-source:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+// TestCatalogMaterializerRecordsChangeEvents tests that the classes added,
+// changed, restored and removed by a catalog are recorded as events on the
+// broker, and that the unchanged ones are not.
+func TestCatalogMaterializerRecordsChangeEvents(t *testing.T) {
+	m, _ := newTestCatalogMaterializer(newFakeCatalogEntries(), newFakeCatalogEntries(), false)
+	m.changes = newCatalogChanges()
+
+	changed := newTestCatalogEntry("changed", false)
+	changed.Spec.Description = "new description"
+	payload := []metav1.Object{
+		newTestCatalogEntry("unchanged", false),
+		changed,
+		newTestCatalogEntry("restored", false),
+		newTestCatalogEntry("added", false),
+	}
+	existing := map[string]metav1.Object{
+		"unchanged": newTestCatalogEntry("unchanged", false),
+		"changed":   newTestCatalogEntry("changed", false),
+		"restored":  newTestCatalogEntry("restored", true),
+		"removed":   newTestCatalogEntry("removed", false),
+		"gone":      newTestCatalogEntry("gone", true),
+	}
+	if err := m.materialize(payload, nil, existing, map[string]metav1.Object{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedEvents := []string{
+		normalEventBuilder(classAddedReason).msg(`ClusterServiceClass "added-name" was added to the broker catalog`).String(),
+		normalEventBuilder(classAddedReason).msg(`ClusterServiceClass "restored-name" was added to the broker catalog`).String(),
+		normalEventBuilder(classChangedReason).msg(`ClusterServiceClass "changed-name" was changed in the broker catalog`).String(),
+		normalEventBuilder(classRemovedReason).msg(`ClusterServiceClass "removed-name" was removed from the broker catalog`).String(),
+	}
+	if err := checkEvents(getMaterializerEvents(m), expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCatalogMaterializerBatchesChangeEvents tests that the changes of a
+// reason beyond maxCatalogChangeEvents are batched into a single event.
+func TestCatalogMaterializerBatchesChangeEvents(t *testing.T) {
+	m, _ := newTestCatalogMaterializer(newFakeCatalogEntries(), newFakeCatalogEntries(), true)
+	m.changes = newCatalogChanges()
+
+	var payload []metav1.Object
+	for i := 0; i < maxCatalogChangeEvents+maxCatalogSummaryChanges+2; i++ {
+		payload = append(payload, newTestCatalogEntry(fmt.Sprintf("class-%02d", i), false))
+	}
+	if err := m.materialize(payload, nil, map[string]metav1.Object{}, map[string]metav1.Object{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var expectedEvents []string
+	for i := 0; i < maxCatalogChangeEvents; i++ {
+		expectedEvents = append(expectedEvents, normalEventBuilder(classAddedReason).msgf(`ClusterServiceClass "class-%02d-name" was added to the broker catalog`, i).String())
+	}
+	expectedEvents = append(expectedEvents, normalEventBuilder(classAddedReason).msg(
+		"12 more ClusterServiceClasses were added to the broker catalog: "+
+			"class-05-name, class-06-name, class-07-name, class-08-name, class-09-name, "+
+			"class-10-name, class-11-name, class-12-name, class-13-name, class-14-name, ...").String())
+	if err := checkEvents(getMaterializerEvents(m), expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// maxCatalogSummaryChanges is the number of added and of removed classes
+// and plans listed in the catalog summary of a broker.
+const maxCatalogSummaryChanges = 10
+
+// summarize returns the summary of the catalog of the broker, given the
+// classes and plans of its catalog payload and the existing ones, to record
+// in the status of the broker. previous is the summary recorded by the last
+// catalog fetch: its added and removed entries are kept when the catalog
+// payload adds or removes none, so that the last change stays auditable.
+func (m *catalogMaterializer) summarize(catalog *osb.CatalogResponse, payloadClasses, payloadPlans []metav1.Object, existingClasses, existingPlans map[string]metav1.Object, previous *v1beta1.CatalogSummary) *v1beta1.CatalogSummary {
+	checksum, err := catalogChecksum(catalog)
+	if err != nil {
+		klog.Warning(m.pcb.Messagef("Unable to compute the checksum of the catalog: %v", err))
+		return previous
+	}
+
+	// plans are named after their class, whose external name is looked up
+	// by the name of the class
+	classExternalNames := make(map[string]string)
+	for _, class := range existingClasses {
+		classExternalNames[class.GetName()] = m.classes.externalName(class)
+	}
+	for _, class := range payloadClasses {
+		classExternalNames[class.GetName()] = m.classes.externalName(class)
+	}
+	planName := func(plan metav1.Object) string {
+		return classExternalNames[m.plans.className(plan)] + "/" + m.plans.externalName(plan)
+	}
+
+	offered := sets.NewString()
+	for _, class := range payloadClasses {
+		offered.Insert(m.classes.externalName(class))
+	}
+	for _, plan := range payloadPlans {
+		offered.Insert(planName(plan))
+	}
+	offeredBefore := sets.NewString()
+	for _, class := range existingClasses {
+		if m.classes.managed(class) && !m.classes.removedFromBrokerCatalog(class) {
+			offeredBefore.Insert(m.classes.externalName(class))
+		}
+	}
+	for _, plan := range existingPlans {
+		if m.plans.managed(plan) && !m.plans.removedFromBrokerCatalog(plan) {
+			offeredBefore.Insert(planName(plan))
+		}
+	}
+
+	summary := &v1beta1.CatalogSummary{
+		Checksum:   checksum,
+		ClassCount: int32(len(payloadClasses)),
+		PlanCount:  int32(len(payloadPlans)),
+		Added:      firstCatalogSummaryChanges(offered.Difference(offeredBefore)),
+		Removed:    firstCatalogSummaryChanges(offeredBefore.Difference(offered)),
+	}
+	if len(summary.Added) == 0 && len(summary.Removed) == 0 && previous != nil {
+		summary.Added = previous.Added
+		summary.Removed = previous.Removed
+	}
+	return summary
+}
+
+// catalogChecksum returns the checksum of the catalog of a broker recorded in
+// its catalog summary.
+func catalogChecksum(catalog *osb.CatalogResponse) (string, error) {
+	data, err := json.Marshal(catalog)
+	if err != nil {
+		return "", err
+	}
+	checksum := sha256.Sum256(data)
+	return hex.EncodeToString(checksum[:]), nil
+}
+
+// catalogUnchanged returns whether catalog is the catalog the last relist of
+// a broker materialized, so that materializing it again would write no class
+// or plan: its checksum is the one of the catalog summary, the broker is
+// ready and its spec has not changed since, and the broker still offers as
+// many classes and plans as the summary counts, so none was deleted.
+func catalogUnchanged(catalog *osb.CatalogResponse, generation int64, status *v1beta1.CommonServiceBrokerStatus, offeredClasses, offeredPlans int) bool {
+	summary := status.CatalogSummary
+	if summary == nil || status.ReconciledGeneration != generation {
+		return false
+	}
+	if int(summary.ClassCount) != offeredClasses || int(summary.PlanCount) != offeredPlans {
+		return false
+	}
+	ready := false
+	for _, condition := range status.Conditions {
+		if condition.Type == v1beta1.ServiceBrokerConditionReady {
+			ready = condition.Status == v1beta1.ConditionTrue
+		}
+	}
+	if !ready {
+		return false
+	}
+	checksum, err := catalogChecksum(catalog)
+	return err == nil && checksum == summary.Checksum
+}
+
+// firstCatalogSummaryChanges returns the first names of changes in sorted
+// order, up to maxCatalogSummaryChanges.
+func firstCatalogSummaryChanges(changes sets.String) []string {
+	if changes.Len() == 0 {
+		return nil
+	}
+	names := changes.List()
+	if len(names) > maxCatalogSummaryChanges {
+		names = names[:maxCatalogSummaryChanges]
+	}
+	return names
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func newTestSummaryClass(name string, removed bool) *v1beta1.ClusterServiceClass {
+	class := &v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name + "-id"}}
+	class.Spec.ExternalName = name
+	class.Status.RemovedFromBrokerCatalog = removed
+	markAsServiceCatalogManagedResource(class, getTestClusterServiceBroker())
+	return class
+}
+
+func newTestSummaryPlan(class, name string, removed bool) *v1beta1.ClusterServicePlan {
+	plan := &v1beta1.ClusterServicePlan{ObjectMeta: metav1.ObjectMeta{Name: class + "-" + name + "-id"}}
+	plan.Spec.ExternalName = name
+	plan.Spec.ClusterServiceClassRef = v1beta1.ClusterObjectReference{Name: class + "-id"}
+	plan.Status.RemovedFromBrokerCatalog = removed
+	markAsServiceCatalogManagedResource(plan, getTestClusterServiceBroker())
+	return plan
+}
+
+func newTestSummaryMaterializer() *catalogMaterializer {
+	m, _ := newTestCatalogMaterializer(&clusterServiceClassEntries{}, &clusterServicePlanEntries{}, false)
+	return m
+}
+
+// TestCatalogMaterializerSummarize tests that the summary of a catalog
+// counts the classes and plans of the payload and lists the ones added and
+// removed since the last catalog fetch.
+func TestCatalogMaterializerSummarize(t *testing.T) {
+	m := newTestSummaryMaterializer()
+	catalog := &osb.CatalogResponse{Services: []osb.Service{{ID: "mysql-id", Name: "mysql"}}}
+
+	payloadClasses := []metav1.Object{newTestSummaryClass("mysql", false), newTestSummaryClass("redis", false)}
+	payloadPlans := []metav1.Object{newTestSummaryPlan("mysql", "small", false), newTestSummaryPlan("redis", "large", false)}
+	existingClasses := map[string]metav1.Object{
+		"mysql-id":    newTestSummaryClass("mysql", false),
+		"postgres-id": newTestSummaryClass("postgres", false),
+		"mongo-id":    newTestSummaryClass("mongo", true),
+	}
+	existingPlans := map[string]metav1.Object{
+		"mysql-small-id":    newTestSummaryPlan("mysql", "small", false),
+		"postgres-tiny-id":  newTestSummaryPlan("postgres", "tiny", false),
+		"mongo-standard-id": newTestSummaryPlan("mongo", "standard", true),
+	}
+
+	summary := m.summarize(catalog, payloadClasses, payloadPlans, existingClasses, existingPlans, nil)
+	if e, a := int32(2), summary.ClassCount; e != a {
+		t.Fatalf("Unexpected number of classes; %s", expectedGot(e, a))
+	}
+	if e, a := int32(2), summary.PlanCount; e != a {
+		t.Fatalf("Unexpected number of plans; %s", expectedGot(e, a))
+	}
+	if e, a := []string{"redis", "redis/large"}, summary.Added; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected added entries; %s", expectedGot(e, a))
+	}
+	if e, a := []string{"postgres", "postgres/tiny"}, summary.Removed; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected removed entries; %s", expectedGot(e, a))
+	}
+
+	// the checksum changes with the catalog
+	other := m.summarize(&osb.CatalogResponse{}, payloadClasses, payloadPlans, existingClasses, existingPlans, nil)
+	if summary.Checksum == "" || summary.Checksum == other.Checksum {
+		t.Fatalf("Expected the checksums of different catalogs to differ, got %q and %q", summary.Checksum, other.Checksum)
+	}
+}
+
+// TestCatalogMaterializerSummarizeKeepsLastChanges tests that the changes
+// of the last catalog fetch that made any are kept.
+func TestCatalogMaterializerSummarizeKeepsLastChanges(t *testing.T) {
+	m := newTestSummaryMaterializer()
+	previous := &v1beta1.CatalogSummary{Checksum: "old", Added: []string{"mysql"}}
+
+	payloadClasses := []metav1.Object{newTestSummaryClass("mysql", false)}
+	existingClasses := map[string]metav1.Object{"mysql-id": newTestSummaryClass("mysql", false)}
+	summary := m.summarize(&osb.CatalogResponse{}, payloadClasses, nil, existingClasses, map[string]metav1.Object{}, previous)
+	if e, a := []string{"mysql"}, summary.Added; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected added entries; %s", expectedGot(e, a))
+	}
+	if summary.Checksum == previous.Checksum {
+		t.Fatal("Expected the checksum to be updated")
+	}
+}
+
+// TestCatalogMaterializerSummarizeLimitsChanges tests that only the first
+// changes are listed.
+func TestCatalogMaterializerSummarizeLimitsChanges(t *testing.T) {
+	m := newTestSummaryMaterializer()
+
+	var payloadClasses []metav1.Object
+	for i := 0; i < 2*maxCatalogSummaryChanges; i++ {
+		payloadClasses = append(payloadClasses, newTestSummaryClass(fmt.Sprintf("class-%02d", i), false))
+	}
+	summary := m.summarize(&osb.CatalogResponse{}, payloadClasses, nil, map[string]metav1.Object{}, map[string]metav1.Object{}, nil)
+	if e, a := maxCatalogSummaryChanges, len(summary.Added); e != a {
+		t.Fatalf("Unexpected number of added entries; %s", expectedGot(e, a))
+	}
+	if e, a := "class-00", summary.Added[0]; e != a {
+		t.Fatalf("Unexpected first added entry; %s", expectedGot(e, a))
+	}
+	if e, a := int32(2*maxCatalogSummaryChanges), summary.ClassCount; e != a {
+		t.Fatalf("Unexpected number of classes; %s", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	"github.com/drycc-addons/service-catalog/pkg/util/cron"
+)
+
+const maintenanceDeferredReason string = "MaintenanceDeferred"
+
+// activeMaintenanceWindowEnd returns whether one of the broker's maintenance
+// windows that defers operation is active at now and, if so, when the last
+// of the active windows ends.
+func activeMaintenanceWindowEnd(spec *v1beta1.CommonServiceBrokerSpec, operation v1beta1.MaintenanceOperation, now time.Time) (time.Time, bool) {
+	var end time.Time
+	active := false
+	for _, window := range spec.MaintenanceWindows {
+		if !maintenanceWindowDefers(&window, operation) {
+			continue
+		}
+		schedule, err := cron.Parse(window.Schedule)
+		if err != nil {
+			// The schedule is validated when the broker is admitted.
+			klog.Warningf("Ignoring maintenance window with invalid schedule %q: %v", window.Schedule, err)
+			continue
+		}
+		if windowEnd, ok := schedule.ActiveUntil(now.UTC(), window.Duration.Duration); ok && windowEnd.After(end) {
+			end, active = windowEnd, true
+		}
+	}
+	return end, active
+}
+
+// maintenanceWindowDefers returns whether operation is deferred while window
+// is active.
+func maintenanceWindowDefers(window *v1beta1.MaintenanceWindow, operation v1beta1.MaintenanceOperation) bool {
+	if len(window.DeferredOperations) == 0 {
+		return true
+	}
+	for _, deferred := range window.DeferredOperations {
+		if deferred == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// deferBrokerRelistForMaintenance returns whether the relist of a broker must
+// be deferred because one of its maintenance windows is active. If it must,
+// an event is recorded on the broker and the broker is added back to queue
+// once the window ends.
+func (c *controller) deferBrokerRelistForMaintenance(pcb *pretty.ContextBuilder, broker runtime.Object, spec *v1beta1.CommonServiceBrokerSpec, queue workqueue.RateLimitingInterface) bool {
+	now := time.Now()
+	end, active := activeMaintenanceWindowEnd(spec, v1beta1.MaintenanceOperationRelist, now)
+	if !active {
+		return false
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(broker)
+	if err != nil {
+		klog.Errorf("Couldn't get key for object %+v: %v", broker, err)
+		return false
+	}
+
+	s := fmt.Sprintf("Deferring relist until the maintenance window ends at %v", end)
+	klog.V(4).Info(pcb.Message(s))
+	c.recorder.Event(broker, corev1.EventTypeNormal, maintenanceDeferredReason, s)
+	queue.AddAfter(key, end.Sub(now))
+	return true
+}
+
+// deferServiceInstanceForMaintenance returns whether operation must be
+// deferred for instance because one of the maintenance windows of the
+// instance's broker is active. If it must, an event is recorded on the
+// instance and the instance is added back to the queue once the window ends.
+// Errors getting the broker are left for the operation itself to report.
+func (c *controller) deferServiceInstanceForMaintenance(instance *v1beta1.ServiceInstance, operation v1beta1.MaintenanceOperation) bool {
+	brokerName, spec := c.getServiceInstanceBrokerSpec(instance)
+	if spec == nil {
+		return false
+	}
+
+	now := time.Now()
+	end, active := activeMaintenanceWindowEnd(spec, operation, now)
+	if !active {
+		return false
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf("Deferring %s until the maintenance window of broker %q ends at %v", strings.ToLower(string(operation)), brokerName, end)
+	klog.V(4).Info(pcb.Message(s))
+	c.recorder.Event(instance, corev1.EventTypeNormal, maintenanceDeferredReason, s)
+	c.enqueueInstanceAfter(instance, end.Sub(now))
+	return true
+}
+
+// getServiceInstanceBrokerSpec returns the name and the common spec of the
+// broker offering the resolved class of instance, or nil if it cannot be
+// found.
+func (c *controller) getServiceInstanceBrokerSpec(instance *v1beta1.ServiceInstance) (string, *v1beta1.CommonServiceBrokerSpec) {
+	if instance.Spec.ClusterServiceClassRef != nil {
+		serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return "", nil
+		}
+		broker, err := c.clusterServiceBrokerLister.Get(serviceClass.Spec.ClusterServiceBrokerName)
+		if err != nil {
+			return "", nil
+		}
+		return broker.Name, &broker.Spec.CommonServiceBrokerSpec
+	}
+	if instance.Spec.ServiceClassRef != nil {
+		serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return "", nil
+		}
+		broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(serviceClass.Spec.ServiceBrokerName)
+		if err != nil {
+			return "", nil
+		}
+		return broker.Name, &broker.Spec.CommonServiceBrokerSpec
+	}
+	return "", nil
+}
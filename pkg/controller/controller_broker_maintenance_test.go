@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestActiveMaintenanceWindowEnd(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 2, 30, 0, 0, time.UTC)
+	nightly := v1beta1.MaintenanceWindow{
+		Schedule: "0 2 * * *",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+	nightlyProvisions := v1beta1.MaintenanceWindow{
+		Schedule:           "0 2 * * *",
+		Duration:           metav1.Duration{Duration: 2 * time.Hour},
+		DeferredOperations: []v1beta1.MaintenanceOperation{v1beta1.MaintenanceOperationProvision},
+	}
+	weekly := v1beta1.MaintenanceWindow{
+		Schedule: "0 2 * * 1",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+
+	cases := []struct {
+		name      string
+		windows   []v1beta1.MaintenanceWindow
+		operation v1beta1.MaintenanceOperation
+		active    bool
+		end       time.Time
+	}{
+		{
+			name:      "no windows",
+			operation: v1beta1.MaintenanceOperationRelist,
+		},
+		{
+			name:      "inactive window",
+			windows:   []v1beta1.MaintenanceWindow{weekly},
+			operation: v1beta1.MaintenanceOperationRelist,
+		},
+		{
+			name:      "active window defers all operations by default",
+			windows:   []v1beta1.MaintenanceWindow{nightly},
+			operation: v1beta1.MaintenanceOperationUpdate,
+			active:    true,
+			end:       now.Add(30 * time.Minute),
+		},
+		{
+			name:      "active window not deferring the operation",
+			windows:   []v1beta1.MaintenanceWindow{nightlyProvisions},
+			operation: v1beta1.MaintenanceOperationRelist,
+		},
+		{
+			name:      "last of the active windows ends",
+			windows:   []v1beta1.MaintenanceWindow{nightly, nightlyProvisions, weekly},
+			operation: v1beta1.MaintenanceOperationProvision,
+			active:    true,
+			end:       now.Add(90 * time.Minute),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &v1beta1.CommonServiceBrokerSpec{MaintenanceWindows: tc.windows}
+			end, active := activeMaintenanceWindowEnd(spec, tc.operation, now)
+			if e, a := tc.active, active; e != a {
+				t.Fatalf("Unexpected active state; %s", expectedGot(e, a))
+			}
+			if e, a := tc.end, end; !e.Equal(a) {
+				t.Fatalf("Unexpected window end; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerDeferredForMaintenance tests that the
+// catalog of a broker is not fetched during its maintenance window.
+func TestReconcileClusterServiceBrokerDeferredForMaintenance(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.MaintenanceWindows = []v1beta1.MaintenanceWindow{
+		{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+
+	if err := testController.reconcileClusterServiceBroker(broker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	expectedEvents := normalEventBuilder(maintenanceDeferredReason).stringArr()
+	if err := checkEventPrefixes(getRecordedEvents(testController), expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceDeferredForMaintenance tests that a provision
+// request is not sent during a maintenance window of the instance's broker
+// that defers provisions.
+func TestReconcileServiceInstanceDeferredForMaintenance(t *testing.T) {
+	cases := []struct {
+		name               string
+		deferredOperations []v1beta1.MaintenanceOperation
+		expectDeferred     bool
+	}{
+		{
+			name:           "provisions deferred",
+			expectDeferred: true,
+		},
+		{
+			name:               "only relists deferred",
+			deferredOperations: []v1beta1.MaintenanceOperation{v1beta1.MaintenanceOperationRelist},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				ProvisionReaction: &fakeosb.ProvisionReaction{
+					Response: &osb.ProvisionResponse{},
+				},
+			})
+
+			addGetNamespaceReaction(fakeKubeClient)
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.MaintenanceWindows = []v1beta1.MaintenanceWindow{
+				{
+					Schedule:           "* * * * *",
+					Duration:           metav1.Duration{Duration: time.Hour},
+					DeferredOperations: tc.deferredOperations,
+				},
+			}
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !tc.expectDeferred {
+				assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+				return
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+			// Only the printable status is updated; no operation is started.
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+			if e, a := v1beta1.ServiceInstanceOperation(""), updatedServiceInstance.Status.CurrentOperation; e != a {
+				t.Fatalf("Unexpected current operation; %s", expectedGot(e, a))
+			}
+
+			expectedEvents := normalEventBuilder(maintenanceDeferredReason).stringArr()
+			if err := checkEventPrefixes(getRecordedEvents(testController), expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"hash/fnv"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+// brokerRelistLimitRetryDelay is about how long a broker waits before trying
+// again to relist its catalog when too many brokers are being relisted.
+const brokerRelistLimitRetryDelay = 10 * time.Second
+
+// brokerRelistJitter returns the delay added to the relist interval of the
+// broker so that brokers with the same interval are not relisted at the same
+// time. It is a fraction, at most jitterFactor, of interval that only
+// depends on the broker's name, so that it stays the same across relists.
+func brokerRelistJitter(brokerMeta *metav1.ObjectMeta, interval time.Duration, jitterFactor float64) time.Duration {
+	if jitterFactor <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(brokerMeta.Namespace + "/" + brokerMeta.Name))
+	fraction := float64(h.Sum64()) / float64(math.MaxUint64)
+	return time.Duration(fraction * jitterFactor * float64(interval))
+}
+
+// brokerRelistInterval returns how long after the last retrieval of its
+// catalog the broker is relisted: its RelistDuration or, when unset, the
+// controller's default, plus the broker's jitter.
+func brokerRelistInterval(brokerMeta *metav1.ObjectMeta, brokerSpec *v1beta1.CommonServiceBrokerSpec, defaultRelistInterval time.Duration, jitterFactor float64) time.Duration {
+	interval := defaultRelistInterval
+	if brokerSpec.RelistDuration != nil {
+		interval = brokerSpec.RelistDuration.Duration
+	}
+	return interval + brokerRelistJitter(brokerMeta, interval, jitterFactor)
+}
+
+// brokerRelistLimiter limits the number of brokers whose catalog is relisted
+// at the same time.
+type brokerRelistLimiter struct {
+	slots chan struct{}
+}
+
+// newBrokerRelistLimiter returns a limiter allowing concurrency relists at
+// the same time, or any number of them when concurrency is not positive.
+func newBrokerRelistLimiter(concurrency int) *brokerRelistLimiter {
+	if concurrency <= 0 {
+		return &brokerRelistLimiter{}
+	}
+	return &brokerRelistLimiter{slots: make(chan struct{}, concurrency)}
+}
+
+// tryAcquire returns whether a relist may start now. A relist that may start
+// must call release once it is done.
+func (l *brokerRelistLimiter) tryAcquire() bool {
+	if l.slots == nil {
+		return true
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// release ends a relist started after tryAcquire returned true.
+func (l *brokerRelistLimiter) release() {
+	if l.slots == nil {
+		return
+	}
+	<-l.slots
+}
+
+// acquireBrokerRelist returns whether the relist of a broker may start now.
+// If it may not, because too many brokers are being relisted, the broker is
+// added back to queue after a jittered delay. A relist that may start must
+// call c.brokerRelists.release once it is done.
+func (c *controller) acquireBrokerRelist(pcb *pretty.ContextBuilder, broker runtime.Object, queue workqueue.RateLimitingInterface) bool {
+	if c.brokerRelists.tryAcquire() {
+		return true
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(broker)
+	if err != nil {
+		klog.Errorf("Couldn't get key for object %+v: %v", broker, err)
+		return false
+	}
+
+	klog.V(4).Info(pcb.Message("Deferring relist because too many brokers are being relisted"))
+	queue.AddAfter(key, wait.Jitter(brokerRelistLimitRetryDelay, 1.0))
+	return false
+}
+
+// requeueBrokerForRelist adds a ready broker back to queue when its next
+// relist is due, so that it does not wait for the next resync of the
+// informers.
+func (c *controller) requeueBrokerForRelist(broker runtime.Object, brokerMeta *metav1.ObjectMeta, brokerSpec *v1beta1.CommonServiceBrokerSpec, brokerStatus *v1beta1.CommonServiceBrokerStatus, queue workqueue.RateLimitingInterface) {
+	if brokerSpec.RelistBehavior == v1beta1.ServiceBrokerRelistBehaviorManual || brokerStatus.LastCatalogRetrievalTime == nil {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(broker)
+	if err != nil {
+		klog.Errorf("Couldn't get key for object %+v: %v", broker, err)
+		return
+	}
+
+	next := brokerStatus.LastCatalogRetrievalTime.Add(brokerRelistInterval(brokerMeta, brokerSpec, c.brokerRelistInterval, c.getBrokerRelistJitterFactor()))
+	if delay := time.Until(next); delay > 0 {
+		queue.AddAfter(key, delay)
+	}
+}
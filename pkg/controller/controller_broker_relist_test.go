@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestBrokerRelistJitter(t *testing.T) {
+	interval := 24 * time.Hour
+	broker := getTestClusterServiceBroker()
+
+	if e, a := time.Duration(0), brokerRelistJitter(&broker.ObjectMeta, interval, 0); e != a {
+		t.Fatalf("Unexpected jitter without a jitter factor; %s", expectedGot(e, a))
+	}
+
+	jitter := brokerRelistJitter(&broker.ObjectMeta, interval, 0.1)
+	if jitter < 0 || jitter > interval/10 {
+		t.Fatalf("Expected a jitter of at most %v, got %v", interval/10, jitter)
+	}
+	if e, a := jitter, brokerRelistJitter(&broker.ObjectMeta, interval, 0.1); e != a {
+		t.Fatalf("Expected the jitter of a broker not to change; %s", expectedGot(e, a))
+	}
+
+	other := getTestClusterServiceBroker()
+	other.Name = "other-broker"
+	if jitter == brokerRelistJitter(&other.ObjectMeta, interval, 0.1) {
+		t.Fatal("Expected brokers with different names to have different jitters")
+	}
+}
+
+// TestShouldReconcileClusterServiceBrokerWithJitter tests that a broker is
+// relisted once its relist interval and its jitter have elapsed.
+func TestShouldReconcileClusterServiceBrokerWithJitter(t *testing.T) {
+	lastRelistTime := metav1.NewTime(time.Now().Add(-16 * time.Minute))
+	broker := getTestClusterServiceBrokerWithStatusAndTime(v1beta1.ConditionTrue, lastRelistTime, lastRelistTime)
+	jitter := brokerRelistJitter(&broker.ObjectMeta, broker.Spec.RelistDuration.Duration, 1)
+
+	relistTime := lastRelistTime.Add(broker.Spec.RelistDuration.Duration + jitter)
+	if shouldReconcileClusterServiceBroker(broker, relistTime.Add(-time.Second), 24*time.Hour, 1) {
+		t.Fatal("Expected the broker not to be relisted before its jitter has elapsed")
+	}
+	if !shouldReconcileClusterServiceBroker(broker, relistTime.Add(time.Second), 24*time.Hour, 1) {
+		t.Fatal("Expected the broker to be relisted once its jitter has elapsed")
+	}
+}
+
+func TestBrokerRelistLimiter(t *testing.T) {
+	limiter := newBrokerRelistLimiter(2)
+	if !limiter.tryAcquire() || !limiter.tryAcquire() {
+		t.Fatal("Expected two relists to start")
+	}
+	if limiter.tryAcquire() {
+		t.Fatal("Expected a third relist to wait")
+	}
+	limiter.release()
+	if !limiter.tryAcquire() {
+		t.Fatal("Expected a relist to start once another one is done")
+	}
+
+	unlimited := newBrokerRelistLimiter(0)
+	for i := 0; i < 10; i++ {
+		if !unlimited.tryAcquire() {
+			t.Fatal("Expected relists not to be limited")
+		}
+	}
+}
+
+// TestReconcileClusterServiceBrokerRelistLimit tests that a broker is not
+// relisted while too many brokers are, and that it is requeued instead.
+func TestReconcileClusterServiceBrokerRelistLimit(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+	testController.brokerRelists = newBrokerRelistLimiter(1)
+	if !testController.brokerRelists.tryAcquire() {
+		t.Fatal("Expected a relist to start")
+	}
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	testController.brokerRelists.release()
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+}
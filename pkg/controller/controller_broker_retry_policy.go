@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+// serviceInstanceRetryPolicy returns the retry policy of the broker of
+// instance, or nil if it sets none or cannot be resolved.
+func (c *controller) serviceInstanceRetryPolicy(instance *v1beta1.ServiceInstance) *v1beta1.BrokerRetryPolicy {
+	_, spec := c.getServiceInstanceBrokerSpec(instance)
+	if spec == nil {
+		return nil
+	}
+	return spec.RetryPolicy
+}
+
+// serviceBindingRetryPolicy returns the retry policy of the broker of the
+// instance of binding, or nil if it sets none or cannot be resolved.
+func (c *controller) serviceBindingRetryPolicy(binding *v1beta1.ServiceBinding) *v1beta1.BrokerRetryPolicy {
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	if err != nil {
+		return nil
+	}
+	return c.serviceInstanceRetryPolicy(instance)
+}
+
+// reconciliationRetryDurationOf returns how long the operation of obj is
+// retried: the maximum elapsed time of the retry policy of the broker of
+// an instance or binding, or the controller's reconciliation retry
+// duration.
+func (c *controller) reconciliationRetryDurationOf(obj operationObject) time.Duration {
+	var policy *v1beta1.BrokerRetryPolicy
+	switch o := obj.(type) {
+	case *v1beta1.ServiceInstance:
+		policy = c.serviceInstanceRetryPolicy(o)
+	case *v1beta1.ServiceBinding:
+		policy = c.serviceBindingRetryPolicy(o)
+	}
+	if policy != nil && policy.MaxElapsedTime != nil {
+		return policy.MaxElapsedTime.Duration
+	}
+	return c.getReconciliationRetryDuration()
+}
+
+// retryPolicyDelay returns the delay before the retry of an operation that
+// failed the given number of times before, doubling the initial interval of
+// policy on each failure up to its maximum interval. It returns false when
+// policy sets neither interval, so that the default backoff applies.
+func retryPolicyDelay(policy *v1beta1.BrokerRetryPolicy, failures int) (time.Duration, bool) {
+	if policy == nil || (policy.InitialInterval == nil && policy.MaxInterval == nil) {
+		return 0, false
+	}
+	initial, maxDelay := minBrokerOperationRetryDelay, maxBrokerOperationRetryDelay
+	if policy.InitialInterval != nil {
+		initial = policy.InitialInterval.Duration
+	}
+	if policy.MaxInterval != nil {
+		maxDelay = policy.MaxInterval.Duration
+	}
+	if failures < 0 {
+		failures = 0
+	}
+
+	delay := float64(initial) * math.Pow(2, float64(failures))
+	if delay > float64(maxDelay) {
+		return maxDelay, true
+	}
+	return time.Duration(delay), true
+}
+
+// backoffServiceBindingIfRetrying returns true, after adding binding back
+// to the queue for when it may be retried, when the broker of its instance
+// sets the intervals of a retry policy and the last failed request of its
+// current operation was sent too recently to send another one yet.
+func (c *controller) backoffServiceBindingIfRetrying(binding *v1beta1.ServiceBinding, operation string) bool {
+	if binding.Status.OperationAttempts == 0 || binding.Status.LastAttemptTime == nil {
+		return false
+	}
+	delay, ok := retryPolicyDelay(c.serviceBindingRetryPolicy(binding), int(binding.Status.OperationAttempts)-1)
+	if !ok {
+		return false
+	}
+	retryTime := binding.Status.LastAttemptTime.Add(delay)
+	remaining := time.Until(retryTime)
+	if remaining <= 0 {
+		return false
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(binding)
+	if err != nil {
+		return false
+	}
+	pcb := pretty.NewBindingContextBuilder(binding)
+	msg := fmt.Sprintf("Delaying %s retry after the retry policy of the broker, next attempt will be after %s", operation, retryTime)
+	c.recorder.Event(binding, corev1.EventTypeWarning, "RetryBackoff", msg)
+	klog.V(2).Info(pcb.Message(msg))
+	c.bindingQueue.AddAfter(key, remaining)
+	return true
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   *v1beta1.BrokerRetryPolicy
+		failures int
+		delay    time.Duration
+		ok       bool
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:   "no intervals",
+			policy: &v1beta1.BrokerRetryPolicy{MaxElapsedTime: &metav1.Duration{Duration: time.Hour}},
+		},
+		{
+			name:   "first retry",
+			policy: &v1beta1.BrokerRetryPolicy{InitialInterval: &metav1.Duration{Duration: 30 * time.Second}},
+			delay:  30 * time.Second,
+			ok:     true,
+		},
+		{
+			name:     "doubled on each failure",
+			policy:   &v1beta1.BrokerRetryPolicy{InitialInterval: &metav1.Duration{Duration: 30 * time.Second}},
+			failures: 3,
+			delay:    4 * time.Minute,
+			ok:       true,
+		},
+		{
+			name: "capped at the maximum interval",
+			policy: &v1beta1.BrokerRetryPolicy{
+				InitialInterval: &metav1.Duration{Duration: 30 * time.Second},
+				MaxInterval:     &metav1.Duration{Duration: 3 * time.Minute},
+			},
+			failures: 3,
+			delay:    3 * time.Minute,
+			ok:       true,
+		},
+		{
+			name:     "default initial interval",
+			policy:   &v1beta1.BrokerRetryPolicy{MaxInterval: &metav1.Duration{Duration: time.Hour}},
+			failures: 2,
+			delay:    4 * minBrokerOperationRetryDelay,
+			ok:       true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			delay, ok := retryPolicyDelay(tc.policy, tc.failures)
+			if e, a := tc.ok, ok; e != a {
+				t.Fatalf("Unexpected policy application; %s", expectedGot(e, a))
+			}
+			if e, a := tc.delay, delay; e != a {
+				t.Fatalf("Unexpected delay; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconciliationRetryDurationExceededRetryPolicy tests that the
+// operations of the instances and bindings of a broker with a retry policy
+// are retried for its maximum elapsed time.
+func TestReconciliationRetryDurationExceededRetryPolicy(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.reconciliationRetryDuration = time.Hour
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.RetryPolicy = &v1beta1.BrokerRetryPolicy{MaxElapsedTime: &metav1.Duration{Duration: 3 * time.Hour}}
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	instance := getTestServiceInstanceWithClusterRefs()
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	binding := getTestServiceBinding()
+
+	started := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	if testController.reconciliationRetryDurationExceeded(instance, &started) {
+		t.Fatal("Expected an instance operation started two hours ago to be within the retry policy of its broker")
+	}
+	if testController.reconciliationRetryDurationExceeded(binding, &started) {
+		t.Fatal("Expected a binding operation started two hours ago to be within the retry policy of its broker")
+	}
+	started = metav1.NewTime(time.Now().Add(-4 * time.Hour))
+	if !testController.reconciliationRetryDurationExceeded(instance, &started) {
+		t.Fatal("Expected an instance operation started four hours ago to exceed the retry policy of its broker")
+	}
+}
+
+// TestBackoffServiceBindingIfRetrying tests that a failed request of a
+// binding is only sent again once the delay of the retry policy of its
+// broker has elapsed since the last one.
+func TestBackoffServiceBindingIfRetrying(t *testing.T) {
+	cases := []struct {
+		name        string
+		policy      *v1beta1.BrokerRetryPolicy
+		attempts    int32
+		lastAttempt time.Duration
+		backoff     bool
+	}{
+		{
+			name:        "no retry policy",
+			attempts:    1,
+			lastAttempt: time.Second,
+		},
+		{
+			name:        "no attempt yet",
+			policy:      &v1beta1.BrokerRetryPolicy{InitialInterval: &metav1.Duration{Duration: 10 * time.Second}},
+			lastAttempt: time.Second,
+		},
+		{
+			name:        "within the delay",
+			policy:      &v1beta1.BrokerRetryPolicy{InitialInterval: &metav1.Duration{Duration: 10 * time.Second}},
+			attempts:    2,
+			lastAttempt: 15 * time.Second,
+			backoff:     true,
+		},
+		{
+			name:        "after the delay",
+			policy:      &v1beta1.BrokerRetryPolicy{InitialInterval: &metav1.Duration{Duration: 10 * time.Second}},
+			attempts:    2,
+			lastAttempt: 25 * time.Second,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.RetryPolicy = tc.policy
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithClusterRefs())
+
+			binding := getTestServiceBinding()
+			binding.Status.OperationAttempts = tc.attempts
+			binding.Status.LastAttemptTime = &metav1.Time{Time: time.Now().Add(-tc.lastAttempt)}
+
+			if e, a := tc.backoff, testController.backoffServiceBindingIfRetrying(binding, "bind"); e != a {
+				t.Fatalf("Unexpected backoff; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
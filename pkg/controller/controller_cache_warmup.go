@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+const (
+	// catalogCacheSyncPollInterval is how often the caches of the catalog
+	// are checked while the instance and binding workers wait for them.
+	catalogCacheSyncPollInterval = 100 * time.Millisecond
+	// catalogCacheWarmupLogInterval is how often the progress of the sync
+	// of the caches of the catalog is logged.
+	catalogCacheWarmupLogInterval = 10 * time.Second
+)
+
+// waitForCatalogCaches waits for the caches of the brokers, classes, plans
+// and instances to sync, logging their progress, and returns false if stopCh
+// is closed first. The instances and bindings refer to the classes and plans
+// of the catalog, so they are only reconciled once it is cached, lest the
+// classes and plans not cached yet be reported as missing.
+func (c *controller) waitForCatalogCaches(stopCh <-chan struct{}) bool {
+	start := time.Now()
+	lastLog := start
+	metrics.CatalogCacheSynced.Set(0)
+	err := wait.PollUntilContextCancel(wait.ContextForChannel(stopCh), catalogCacheSyncPollInterval, true, func(context.Context) (bool, error) {
+		synced := c.catalogCachesSynced()
+		classes, plans := c.countCachedCatalog()
+		metrics.CatalogCacheObjects.WithLabelValues("class").Set(float64(classes))
+		metrics.CatalogCacheObjects.WithLabelValues("plan").Set(float64(plans))
+		metrics.CatalogCacheWarmupSeconds.Set(time.Since(start).Seconds())
+		if !synced && time.Since(lastLog) >= catalogCacheWarmupLogInterval {
+			lastLog = time.Now()
+			klog.Infof("Waiting for the caches of the catalog to sync before reconciling instances and bindings; %d classes and %d plans cached after %v", classes, plans, time.Since(start).Round(time.Second))
+		}
+		return synced, nil
+	})
+	if err != nil {
+		klog.Info("Stopped waiting for the caches of the catalog to sync")
+		return false
+	}
+
+	metrics.CatalogCacheSynced.Set(1)
+	classes, plans := c.countCachedCatalog()
+	klog.Infof("Caches of the catalog synced with %d classes and %d plans in %v; reconciling instances and bindings", classes, plans, time.Since(start).Round(time.Millisecond))
+	return true
+}
+
+// catalogCachesSynced returns whether the informers of the catalog and of
+// the instances have all synced.
+func (c *controller) catalogCachesSynced() bool {
+	for _, synced := range c.catalogSynced {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+// countCachedCatalog returns the number of classes and plans, cluster-scoped
+// and namespaced, in the caches of the controller.
+func (c *controller) countCachedCatalog() (int, int) {
+	var classes, plans int
+	if list, err := c.clusterServiceClassLister.List(labels.Everything()); err == nil {
+		classes += len(list)
+	}
+	if list, err := c.clusterServicePlanLister.List(labels.Everything()); err == nil {
+		plans += len(list)
+	}
+	if c.serviceClassLister != nil {
+		if list, err := c.serviceClassLister.List(labels.Everything()); err == nil {
+			classes += len(list)
+		}
+	}
+	if c.servicePlanLister != nil {
+		if list, err := c.servicePlanLister.List(labels.Everything()); err == nil {
+			plans += len(list)
+		}
+	}
+	return classes, plans
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+// TestWaitForCatalogCaches tests that the instance and binding workers wait
+// for all the caches of the catalog to sync, and that the number of classes
+// and plans cached is exposed meanwhile.
+func TestWaitForCatalogCaches(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	var classesSynced atomic.Bool
+	testController.catalogSynced = []cache.InformerSynced{
+		func() bool { return true },
+		classesSynced.Load,
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	done := make(chan bool)
+	go func() {
+		done <- testController.waitForCatalogCaches(stopCh)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Expected to wait for the cache of the classes to sync")
+	case <-time.After(3 * catalogCacheSyncPollInterval):
+	}
+	if e, a := 0.0, testutil.ToFloat64(metrics.CatalogCacheSynced); e != a {
+		t.Fatalf("Unexpected synced metric; %s", expectedGot(e, a))
+	}
+	if e, a := 1.0, testutil.ToFloat64(metrics.CatalogCacheObjects.WithLabelValues("class")); e != a {
+		t.Fatalf("Unexpected number of cached classes; %s", expectedGot(e, a))
+	}
+	if e, a := 1.0, testutil.ToFloat64(metrics.CatalogCacheObjects.WithLabelValues("plan")); e != a {
+		t.Fatalf("Unexpected number of cached plans; %s", expectedGot(e, a))
+	}
+
+	classesSynced.Store(true)
+	select {
+	case synced := <-done:
+		if !synced {
+			t.Fatal("Expected the caches to be reported synced")
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("Timed out waiting for the caches to sync")
+	}
+	if e, a := 1.0, testutil.ToFloat64(metrics.CatalogCacheSynced); e != a {
+		t.Fatalf("Unexpected synced metric; %s", expectedGot(e, a))
+	}
+}
+
+// TestWaitForCatalogCachesStopped tests that waiting for the caches of the
+// catalog ends when the controller stops.
+func TestWaitForCatalogCachesStopped(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.catalogSynced = []cache.InformerSynced{
+		func() bool { return false },
+	}
+
+	stopCh := make(chan struct{})
+	close(stopCh)
+	if testController.waitForCatalogCaches(stopCh) {
+		t.Fatal("Expected the caches not to be reported synced")
+	}
+}
@@ -0,0 +1,486 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	// catalogFieldManager is the field manager of the classes and plans
+	// written with server-side apply.
+	catalogFieldManager = "service-catalog-controller-manager"
+	// catalogApplyWorkers is the number of classes or plans written at a
+	// time with server-side apply.
+	catalogApplyWorkers = 8
+)
+
+// catalogUpdateManagers are the field managers of the classes and plans
+// created or updated by the controller manager rather than applied: the
+// default field manager of its client, derived from its user agent, and
+// catalogFieldManager.
+var catalogUpdateManagers = sets.New(
+	strings.Split(rest.DefaultKubernetesUserAgent(), "/")[0],
+	catalogFieldManager,
+)
+
+// catalogEntries reads and writes the classes or the plans of a broker, so
+// that cluster-scoped and namespaced catalogs are materialized alike.
+type catalogEntries interface {
+	// kind returns the kind of the entries, such as ClusterServiceClass.
+	kind() string
+	// prettyName returns the name of the entry used in logs and events.
+	prettyName(entry metav1.Object) string
+	externalID(entry metav1.Object) string
+	externalName(entry metav1.Object) string
+	brokerName(entry metav1.Object) string
+	// className returns the name of the class of a plan, and the name of a
+	// class itself.
+	className(entry metav1.Object) string
+	removedFromBrokerCatalog(entry metav1.Object) bool
+	// managed returns whether the entry was created from a broker catalog
+	// rather than by a user.
+	managed(entry metav1.Object) bool
+
+	// prepare sets the broker of an entry of the catalog payload.
+	prepare(payload metav1.Object)
+	// get returns the entry with the given name from the informer cache.
+	get(name string) (metav1.Object, error)
+	// claim handles an entry of the same name that belongs to another
+	// broker. It returns a catalogConflictError when the payload entry must
+	// be skipped.
+	claim(other metav1.Object) error
+	create(payload metav1.Object) (metav1.Object, error)
+	// update projects the payload entry onto the existing one.
+	update(existing, payload metav1.Object) (metav1.Object, error)
+	// upToDate returns whether the existing entry is managed and projecting
+	// the payload entry onto it would leave it unchanged.
+	upToDate(existing, payload metav1.Object) bool
+	// apply writes the payload entry with server-side apply.
+	apply(payload []byte, name string) (metav1.Object, error)
+	// patchManagedFields sends a JSON patch of the managed fields of the
+	// entry with the given name.
+	patchManagedFields(name string, patch []byte) error
+	// setRemovedFromBrokerCatalog updates the RemovedFromBrokerCatalog
+	// status of the entry.
+	setRemovedFromBrokerCatalog(entry metav1.Object, removed bool) error
+}
+
+// catalogMaterializer turns the catalog of a broker into classes and plans.
+type catalogMaterializer struct {
+	pcb        *pretty.ContextBuilder
+	recorder   record.EventRecorder
+	broker     runtime.Object
+	brokerName string
+	classes    catalogEntries
+	plans      catalogEntries
+	// syncFailed sets the Ready condition of the broker to false after an
+	// entry could not be synced.
+	syncFailed func(message string) error
+	// classesSynced, if set, is called with the names of the conflicting
+	// classes once the classes are synced.
+	classesSynced func(conflicting sets.String) error
+	// plansSynced, if set, is called with the names of the conflicting
+	// plans, and of the plans of the conflicting classes, once the plans
+	// are synced.
+	plansSynced func(conflicting sets.String) error
+	// serverSideApply writes the entries with server-side apply,
+	// catalogApplyWorkers at a time, instead of one by one in catalog order.
+	serverSideApply bool
+	// deltaSync skips the writes of the existing entries that the catalog
+	// leaves unchanged.
+	deltaSync bool
+	// changes, if set, records the entries added, changed or removed by
+	// the catalog, which are recorded as events on the broker.
+	changes *catalogChanges
+}
+
+func (c *controller) newCatalogMaterializer(pcb *pretty.ContextBuilder, broker runtime.Object, brokerName string, classes, plans catalogEntries, syncFailed func(string) error) *catalogMaterializer {
+	m := &catalogMaterializer{
+		pcb:             pcb,
+		recorder:        c.recorder,
+		broker:          broker,
+		brokerName:      brokerName,
+		classes:         classes,
+		plans:           plans,
+		syncFailed:      syncFailed,
+		serverSideApply: utilfeature.DefaultFeatureGate.Enabled(scfeatures.CatalogServerSideApply),
+		deltaSync:       utilfeature.DefaultFeatureGate.Enabled(scfeatures.CatalogDeltaSync),
+	}
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCatalogEvents) {
+		m.changes = newCatalogChanges()
+	}
+	return m
+}
+
+// materialize creates or updates the classes and plans of the catalog
+// payload, and marks the existing ones that are no longer in it as removed
+// from the broker catalog. The existing entries are keyed by name. The
+// changes made to the catalog, if recorded, are recorded as events on the
+// broker, even when materializing fails halfway.
+func (m *catalogMaterializer) materialize(payloadClasses, payloadPlans []metav1.Object, existingClasses, existingPlans map[string]metav1.Object) error {
+	if m.changes != nil {
+		for _, class := range existingClasses {
+			m.changes.classExternalNames[class.GetName()] = m.classes.externalName(class)
+		}
+		for _, class := range payloadClasses {
+			m.changes.classExternalNames[class.GetName()] = m.classes.externalName(class)
+		}
+		defer m.recordChangeEvents()
+	}
+
+	conflicting := sets.NewString()
+	if err := m.syncEntries(m.classes, payloadClasses, existingClasses, conflicting); err != nil {
+		return err
+	}
+	if err := m.markRemoved(m.classes, existingClasses); err != nil {
+		return err
+	}
+	if m.classesSynced != nil {
+		if err := m.classesSynced(conflicting); err != nil {
+			klog.Warning(m.pcb.Message(err.Error()))
+			return err
+		}
+	}
+
+	plans := make([]metav1.Object, 0, len(payloadPlans))
+	conflictingPlans := sets.NewString()
+	for _, plan := range payloadPlans {
+		if conflicting.Has(m.plans.className(plan)) {
+			conflictingPlans.Insert(plan.GetName())
+			continue
+		}
+		plans = append(plans, plan)
+	}
+	if err := m.syncEntries(m.plans, plans, existingPlans, conflictingPlans); err != nil {
+		return err
+	}
+	if err := m.markRemoved(m.plans, existingPlans); err != nil {
+		return err
+	}
+	if m.plansSynced != nil {
+		if err := m.plansSynced(conflictingPlans); err != nil {
+			klog.Warning(m.pcb.Message(err.Error()))
+			return err
+		}
+	}
+	return nil
+}
+
+// syncEntries syncs the payload entries with the existing ones, which are
+// removed from the existing map. The names of the payload entries skipped
+// because of a conflict are added to conflicting, if set.
+func (m *catalogMaterializer) syncEntries(entries catalogEntries, payloads []metav1.Object, existing map[string]metav1.Object, conflicting sets.String) error {
+	matches := make([]metav1.Object, len(payloads))
+	for i, payload := range payloads {
+		matches[i] = takeExistingCatalogEntry(existing, payload.GetName(), entries.externalID(payload))
+	}
+
+	errs := make([]error, len(payloads))
+	if m.serverSideApply {
+		workqueue.ParallelizeUntil(context.Background(), catalogApplyWorkers, len(payloads), func(i int) {
+			errs[i] = m.syncEntry(entries, payloads[i], matches[i])
+		})
+	}
+	for i, payload := range payloads {
+		if !m.serverSideApply {
+			klog.V(4).Info(m.pcb.Messagef("Reconciling %s", entries.prettyName(payload)))
+			errs[i] = m.syncEntry(entries, payload, matches[i])
+		}
+		err := errs[i]
+		if err == nil {
+			klog.V(5).Info(m.pcb.Messagef("Reconciled %s", entries.prettyName(payload)))
+			continue
+		}
+		if isCatalogConflictError(err) {
+			klog.Warning(m.pcb.Message(err.Error()))
+			m.recorder.Event(m.broker, corev1.EventTypeWarning, catalogConflictReason, err.Error())
+			if conflicting != nil {
+				conflicting.Insert(payload.GetName())
+			}
+			continue
+		}
+		s := fmt.Sprintf("Error reconciling %s (broker %q): %s", entries.prettyName(payload), m.brokerName, err)
+		klog.Warning(m.pcb.Message(s))
+		m.recorder.Eventf(m.broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+		if err := m.syncFailed(s); err != nil {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// takeExistingCatalogEntry removes the existing entry of the given name from
+// existing and returns it. Entries created before classes and plans were
+// named after their external ID are found by their external ID.
+func takeExistingCatalogEntry(existing map[string]metav1.Object, name, externalID string) metav1.Object {
+	if entry, ok := existing[name]; ok {
+		delete(existing, name)
+		return entry
+	}
+	if entry, ok := existing[externalID]; ok {
+		delete(existing, externalID)
+		return entry
+	}
+	return nil
+}
+
+// syncEntry creates or updates the entry of the catalog payload. The
+// existing parameter is the entry of the broker with the same name, if any.
+func (m *catalogMaterializer) syncEntry(entries catalogEntries, payload, existing metav1.Object) error {
+	entries.prepare(payload)
+
+	if existing == nil {
+		other, err := entries.get(payload.GetName())
+		if err != nil {
+			// we expect _not_ to find an entry this way, so a not-found
+			// error is expected and legitimate.
+			if !errors.IsNotFound(err) {
+				return err
+			}
+		} else if entries.brokerName(other) != m.brokerName {
+			if err := entries.claim(other); err != nil {
+				return err
+			}
+		}
+
+		if m.serverSideApply {
+			if _, err := m.apply(entries, payload); err != nil {
+				return err
+			}
+		} else {
+			klog.V(5).Info(m.pcb.Messagef("Fresh %s; creating", entries.prettyName(payload)))
+			if _, err := entries.create(payload); err != nil {
+				klog.Error(m.pcb.Messagef("Error creating %s: %v", entries.prettyName(payload), err))
+				return err
+			}
+		}
+		m.recordChange(entries, payload, classAddedReason, planAddedReason)
+		return nil
+	}
+
+	if entries.externalID(existing) != entries.externalID(payload) {
+		errMsg := fmt.Sprintf(
+			"%s already exists with OSB guid %q, received different guid %q",
+			entries.prettyName(payload), entries.externalID(existing), entries.externalID(payload),
+		)
+		klog.Error(m.pcb.Message(errMsg))
+		return fmt.Errorf("%s", errMsg)
+	}
+
+	if m.serverSideApply {
+		m.upgradeManagedFields(entries, existing)
+	}
+	// the entries restored to the catalog are recorded as added, and the
+	// other ones as changed unless the catalog leaves them unchanged
+	restored := entries.removedFromBrokerCatalog(existing)
+	upToDate := !restored && (m.deltaSync || m.changes != nil) && entries.upToDate(existing, payload)
+	if m.deltaSync && upToDate {
+		klog.V(5).Info(m.pcb.Messagef("Found existing %s; unchanged", entries.prettyName(payload)))
+		metrics.CatalogEntriesUnchangedCount.WithLabelValues(entries.kind()).Inc()
+		return nil
+	}
+
+	klog.V(5).Info(m.pcb.Messagef("Found existing %s; updating", entries.prettyName(payload)))
+
+	var updated metav1.Object
+	var err error
+	if m.serverSideApply {
+		updated, err = m.apply(entries, payload)
+	} else {
+		updated, err = entries.update(existing, payload)
+	}
+	if err != nil {
+		klog.Error(m.pcb.Messagef("Error updating %s: %v", entries.prettyName(payload), err))
+		return err
+	}
+
+	if entries.removedFromBrokerCatalog(updated) {
+		klog.V(4).Info(m.pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", entries.prettyName(updated)))
+		if err := entries.setRemovedFromBrokerCatalog(updated, false); err != nil {
+			return fmt.Errorf("error updating status of %s: %v", entries.prettyName(updated), err)
+		}
+	}
+	if restored {
+		m.recordChange(entries, payload, classAddedReason, planAddedReason)
+	} else if !upToDate {
+		m.recordChange(entries, payload, classChangedReason, planChangedReason)
+	}
+	return nil
+}
+
+// apply writes the payload entry with server-side apply. Only the fields
+// set from the catalog are applied, so that the fields set by users, such
+// as default provision parameters, are kept.
+func (m *catalogMaterializer) apply(entries catalogEntries, payload metav1.Object) (metav1.Object, error) {
+	data, err := catalogApplyConfiguration(payload, entries.kind())
+	if err != nil {
+		return nil, err
+	}
+	return entries.apply(data, payload.GetName())
+}
+
+// upgradeManagedFields hands the fields of an existing entry owned by the
+// updates of the controller manager over to catalogFieldManager, so that
+// the fields dropped from the catalog are removed by the next apply rather
+// than kept by a former update. The fields of the other managers, such as
+// the visibility or labels set by admins, keep their owner. A failure is
+// only logged, as the upgrade is tried again on the next relist.
+func (m *catalogMaterializer) upgradeManagedFields(entries catalogEntries, existing metav1.Object) {
+	obj, ok := existing.(runtime.Object)
+	if !ok {
+		return
+	}
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(obj, catalogUpdateManagers, catalogFieldManager)
+	if err == nil && patch != nil {
+		err = entries.patchManagedFields(existing.GetName(), patch)
+	}
+	if err != nil {
+		klog.V(4).Info(m.pcb.Messagef("Error upgrading the managed fields of %s: %v", entries.prettyName(existing), err))
+	}
+}
+
+// catalogApplyConfiguration returns the apply configuration of a class or
+// plan of a catalog payload: its name, labels, owner and spec.
+func catalogApplyConfiguration(payload metav1.Object, kind string) ([]byte, error) {
+	obj, ok := payload.(runtime.Object)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a runtime object", payload)
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]interface{}{"name": payload.GetName()}
+	if namespace := payload.GetNamespace(); namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	if labels := payload.GetLabels(); len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if refs := payload.GetOwnerReferences(); len(refs) > 0 {
+		metadata["ownerReferences"] = refs
+	}
+	return json.Marshal(map[string]interface{}{
+		"apiVersion": v1beta1.SchemeGroupVersion.String(),
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       content["spec"],
+	})
+}
+
+// catalogApplyOptions are the options of the server-side applies of classes
+// and plans. Conflicts are forced as the controller owns the fields set
+// from the catalog.
+func catalogApplyOptions() metav1.PatchOptions {
+	force := true
+	return metav1.PatchOptions{FieldManager: catalogFieldManager, Force: &force}
+}
+
+// markRemoved marks the remaining existing entries, which are no longer in
+// the catalog payload, as removed from the broker catalog.
+func (m *catalogMaterializer) markRemoved(entries catalogEntries, existing map[string]metav1.Object) error {
+	for _, entry := range existing {
+		if entries.removedFromBrokerCatalog(entry) {
+			continue
+		}
+		// Do not remove user-defined entries
+		if !entries.managed(entry) {
+			continue
+		}
+
+		klog.V(4).Info(m.pcb.Messagef("%s has been removed from broker's catalog; marking", entries.prettyName(entry)))
+		if err := entries.setRemovedFromBrokerCatalog(entry, true); err != nil {
+			s := fmt.Sprintf("Error updating status of %s: %v", entries.prettyName(entry), err)
+			klog.Warning(m.pcb.Message(s))
+			m.recorder.Eventf(m.broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
+			if err := m.syncFailed(s); err != nil {
+				return err
+			}
+			return err
+		}
+		m.recordChange(entries, entry, classRemovedReason, planRemovedReason)
+	}
+	return nil
+}
+
+// projectServiceClassSpec projects the fields of a class that are set from
+// the catalog onto an existing class.
+func projectServiceClassSpec(existing *v1beta1.CommonServiceClassSpec, payload *v1beta1.CommonServiceClassSpec) {
+	existing.BindingRetrievable = payload.BindingRetrievable
+	existing.Bindable = payload.Bindable
+	existing.PlanUpdatable = payload.PlanUpdatable
+	existing.AllowContextUpdates = payload.AllowContextUpdates
+	existing.Tags = payload.Tags
+	existing.Description = payload.Description
+	existing.Requires = payload.Requires
+	existing.ExternalName = payload.ExternalName
+	existing.ExternalMetadata = payload.ExternalMetadata
+}
+
+// serviceClassSpecUpToDate returns whether projecting the payload spec of a
+// class onto the existing one would leave it unchanged.
+func serviceClassSpecUpToDate(existing *v1beta1.CommonServiceClassSpec, payload *v1beta1.CommonServiceClassSpec) bool {
+	projected := existing.DeepCopy()
+	projectServiceClassSpec(projected, payload)
+	return equality.Semantic.DeepEqual(projected, existing)
+}
+
+// servicePlanSpecUpToDate returns whether projecting the payload spec of a
+// plan onto the existing one would leave it unchanged.
+func servicePlanSpecUpToDate(existing *v1beta1.CommonServicePlanSpec, payload *v1beta1.CommonServicePlanSpec) bool {
+	projected := existing.DeepCopy()
+	projectServicePlanSpec(projected, payload)
+	return equality.Semantic.DeepEqual(projected, existing)
+}
+
+// projectServicePlanSpec projects the fields of a plan that are set from
+// the catalog onto an existing plan.
+func projectServicePlanSpec(existing *v1beta1.CommonServicePlanSpec, payload *v1beta1.CommonServicePlanSpec) {
+	existing.Description = payload.Description
+	existing.Bindable = payload.Bindable
+	existing.Free = payload.Free
+	existing.ExternalName = payload.ExternalName
+	existing.ExternalMetadata = payload.ExternalMetadata
+	existing.InstanceCreateParameterSchema = payload.InstanceCreateParameterSchema
+	existing.InstanceUpdateParameterSchema = payload.InstanceUpdateParameterSchema
+	existing.ServiceBindingCreateParameterSchema = payload.ServiceBindingCreateParameterSchema
+	existing.MaintenanceInfo = payload.MaintenanceInfo
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+// fakeCatalogEntries are catalog entries held in memory, which record the
+// writes of the materializer.
+type fakeCatalogEntries struct {
+	mutex    sync.Mutex
+	latency  time.Duration
+	failName string
+	created  []string
+	updated  []string
+	applied  map[string][]byte
+	patched  map[string][]byte
+	statuses map[string]bool
+}
+
+func newFakeCatalogEntries() *fakeCatalogEntries {
+	return &fakeCatalogEntries{
+		applied:  map[string][]byte{},
+		patched:  map[string][]byte{},
+		statuses: map[string]bool{},
+	}
+}
+
+func (e *fakeCatalogEntries) write(name string, record func()) error {
+	time.Sleep(e.latency)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if name == e.failName {
+		return errors.New("oops")
+	}
+	record()
+	return nil
+}
+
+func (e *fakeCatalogEntries) kind() string {
+	return "ClusterServiceClass"
+}
+
+func (e *fakeCatalogEntries) prettyName(entry metav1.Object) string {
+	return pretty.ClusterServiceClassName(entry.(*v1beta1.ClusterServiceClass))
+}
+
+func (e *fakeCatalogEntries) externalID(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServiceClass).Spec.ExternalID
+}
+
+func (e *fakeCatalogEntries) externalName(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServiceClass).Spec.ExternalName
+}
+
+func (e *fakeCatalogEntries) brokerName(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServiceClass).Spec.ClusterServiceBrokerName
+}
+
+func (e *fakeCatalogEntries) className(entry metav1.Object) string {
+	return entry.GetName()
+}
+
+func (e *fakeCatalogEntries) removedFromBrokerCatalog(entry metav1.Object) bool {
+	return entry.(*v1beta1.ClusterServiceClass).Status.RemovedFromBrokerCatalog
+}
+
+func (e *fakeCatalogEntries) managed(entry metav1.Object) bool {
+	return true
+}
+
+func (e *fakeCatalogEntries) prepare(payload metav1.Object) {
+	payload.(*v1beta1.ClusterServiceClass).Spec.ClusterServiceBrokerName = testClusterServiceBrokerName
+}
+
+func (e *fakeCatalogEntries) get(name string) (metav1.Object, error) {
+	return nil, apierrors.NewNotFound(v1beta1.Resource("clusterserviceclass"), name)
+}
+
+func (e *fakeCatalogEntries) claim(other metav1.Object) error {
+	return fmt.Errorf("unexpected claim of %s", other.GetName())
+}
+
+func (e *fakeCatalogEntries) create(payload metav1.Object) (metav1.Object, error) {
+	return payload, e.write(payload.GetName(), func() { e.created = append(e.created, payload.GetName()) })
+}
+
+func (e *fakeCatalogEntries) update(existing, payload metav1.Object) (metav1.Object, error) {
+	return existing, e.write(payload.GetName(), func() { e.updated = append(e.updated, payload.GetName()) })
+}
+
+func (e *fakeCatalogEntries) upToDate(existing, payload metav1.Object) bool {
+	return serviceClassSpecUpToDate(&existing.(*v1beta1.ClusterServiceClass).Spec.CommonServiceClassSpec, &payload.(*v1beta1.ClusterServiceClass).Spec.CommonServiceClassSpec)
+}
+
+func (e *fakeCatalogEntries) apply(payload []byte, name string) (metav1.Object, error) {
+	class := &v1beta1.ClusterServiceClass{}
+	if err := json.Unmarshal(payload, class); err != nil {
+		return nil, err
+	}
+	return class, e.write(name, func() { e.applied[name] = payload })
+}
+
+func (e *fakeCatalogEntries) patchManagedFields(name string, patch []byte) error {
+	return e.write(name, func() { e.patched[name] = patch })
+}
+
+func (e *fakeCatalogEntries) setRemovedFromBrokerCatalog(entry metav1.Object, removed bool) error {
+	return e.write(entry.GetName(), func() { e.statuses[entry.GetName()] = removed })
+}
+
+func newTestCatalogMaterializer(classes, plans catalogEntries, serverSideApply bool) (*catalogMaterializer, *[]string) {
+	broker := getTestClusterServiceBroker()
+	var failures []string
+	return &catalogMaterializer{
+		pcb:        pretty.NewClusterServiceBrokerContextBuilder(broker),
+		recorder:   record.NewFakeRecorder(100),
+		broker:     broker,
+		brokerName: broker.Name,
+		classes:    classes,
+		plans:      plans,
+		syncFailed: func(message string) error {
+			failures = append(failures, message)
+			return nil
+		},
+		serverSideApply: serverSideApply,
+	}, &failures
+}
+
+func newTestCatalogEntry(name string, removed bool) *v1beta1.ClusterServiceClass {
+	class := &v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	class.Spec.ExternalID = name
+	class.Spec.ExternalName = name + "-name"
+	class.Spec.ClusterServiceBrokerName = testClusterServiceBrokerName
+	class.Status.RemovedFromBrokerCatalog = removed
+	return class
+}
+
+func TestCatalogMaterializerSyncsEntries(t *testing.T) {
+	classes := newFakeCatalogEntries()
+	m, failures := newTestCatalogMaterializer(classes, newFakeCatalogEntries(), false)
+
+	payload := []metav1.Object{newTestCatalogEntry("a", false), newTestCatalogEntry("b", false)}
+	existing := map[string]metav1.Object{
+		"b": newTestCatalogEntry("b", true),
+		"c": newTestCatalogEntry("c", false),
+	}
+	if err := m.materialize(payload, nil, existing, map[string]metav1.Object{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := []string{"a"}, classes.created; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected created entries; %s", expectedGot(e, a))
+	}
+	if e, a := []string{"b"}, classes.updated; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected updated entries; %s", expectedGot(e, a))
+	}
+	if e, a := map[string]bool{"b": false, "c": true}, classes.statuses; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected RemovedFromBrokerCatalog statuses; %s", expectedGot(e, a))
+	}
+	if len(*failures) != 0 {
+		t.Fatalf("Unexpected failures: %v", *failures)
+	}
+}
+
+func TestCatalogMaterializerDeltaSync(t *testing.T) {
+	classes := newFakeCatalogEntries()
+	m, failures := newTestCatalogMaterializer(classes, newFakeCatalogEntries(), false)
+	m.deltaSync = true
+
+	changed := newTestCatalogEntry("changed", false)
+	changed.Spec.Description = "new description"
+	payload := []metav1.Object{
+		newTestCatalogEntry("unchanged", false),
+		changed,
+		newTestCatalogEntry("restored", false),
+		newTestCatalogEntry("added", false),
+	}
+	existing := map[string]metav1.Object{
+		"unchanged": newTestCatalogEntry("unchanged", false),
+		"changed":   newTestCatalogEntry("changed", false),
+		"restored":  newTestCatalogEntry("restored", true),
+	}
+	if err := m.materialize(payload, nil, existing, map[string]metav1.Object{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := []string{"added"}, classes.created; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected created entries; %s", expectedGot(e, a))
+	}
+	if e, a := []string{"changed", "restored"}, classes.updated; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected updated entries; %s", expectedGot(e, a))
+	}
+	if e, a := map[string]bool{"restored": false}, classes.statuses; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected RemovedFromBrokerCatalog statuses; %s", expectedGot(e, a))
+	}
+	if len(*failures) != 0 {
+		t.Fatalf("Unexpected failures: %v", *failures)
+	}
+}
+
+func TestCatalogMaterializerServerSideApply(t *testing.T) {
+	classes := newFakeCatalogEntries()
+	m, _ := newTestCatalogMaterializer(classes, newFakeCatalogEntries(), true)
+
+	var payload []metav1.Object
+	var names []string
+	for i := 0; i < 3*catalogApplyWorkers; i++ {
+		name := fmt.Sprintf("class-%02d", i)
+		payload = append(payload, newTestCatalogEntry(name, false))
+		names = append(names, name)
+	}
+	if err := m.materialize(payload, nil, map[string]metav1.Object{}, map[string]metav1.Object{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(classes.created) != 0 || len(classes.updated) != 0 {
+		t.Fatalf("Expected no creates or updates, got %v and %v", classes.created, classes.updated)
+	}
+	var applied []string
+	for name := range classes.applied {
+		applied = append(applied, name)
+	}
+	sort.Strings(applied)
+	if e, a := names, applied; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected applied entries; %s", expectedGot(e, a))
+	}
+
+	configuration := map[string]interface{}{}
+	if err := json.Unmarshal(classes.applied["class-00"], &configuration); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "ClusterServiceClass", configuration["kind"]; e != a {
+		t.Fatalf("Unexpected kind; %s", expectedGot(e, a))
+	}
+	if e, a := v1beta1.SchemeGroupVersion.String(), configuration["apiVersion"]; e != a {
+		t.Fatalf("Unexpected apiVersion; %s", expectedGot(e, a))
+	}
+	if _, ok := configuration["status"]; ok {
+		t.Fatal("Expected the apply configuration not to contain a status")
+	}
+}
+
+func TestCatalogMaterializerServerSideApplyError(t *testing.T) {
+	classes := newFakeCatalogEntries()
+	classes.failName = "b"
+	m, failures := newTestCatalogMaterializer(classes, newFakeCatalogEntries(), true)
+
+	payload := []metav1.Object{newTestCatalogEntry("a", false), newTestCatalogEntry("b", false), newTestCatalogEntry("c", false)}
+	if err := m.materialize(payload, nil, map[string]metav1.Object{}, map[string]metav1.Object{}); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if e, a := 1, len(*failures); e != a {
+		t.Fatalf("Unexpected number of failures; %s", expectedGot(e, a))
+	}
+	if e, a := 2, len(classes.applied); e != a {
+		t.Fatalf("Expected the other entries to be applied; %s", expectedGot(e, a))
+	}
+}
+
+func TestCatalogMaterializerUpgradesManagedFields(t *testing.T) {
+	classes := newFakeCatalogEntries()
+	m, _ := newTestCatalogMaterializer(classes, newFakeCatalogEntries(), true)
+
+	updateManager := strings.Split(rest.DefaultKubernetesUserAgent(), "/")[0]
+	managedFields := func(entries ...metav1.ManagedFieldsEntry) []metav1.ManagedFieldsEntry {
+		for i := range entries {
+			entries[i].APIVersion = v1beta1.SchemeGroupVersion.String()
+			entries[i].FieldsType = "FieldsV1"
+		}
+		return entries
+	}
+	updated := newTestCatalogEntry("updated", false)
+	updated.ResourceVersion = "1"
+	updated.ManagedFields = managedFields(
+		metav1.ManagedFieldsEntry{
+			Manager:   updateManager,
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:description":{}}}`)},
+		},
+		metav1.ManagedFieldsEntry{
+			Manager:   "kubectl-edit",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:catalogVisibility":{}}}`)},
+		},
+	)
+	applied := newTestCatalogEntry("applied", false)
+	applied.ManagedFields = managedFields(metav1.ManagedFieldsEntry{
+		Manager:   catalogFieldManager,
+		Operation: metav1.ManagedFieldsOperationApply,
+		FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:description":{}}}`)},
+	})
+
+	payload := []metav1.Object{newTestCatalogEntry("updated", false), newTestCatalogEntry("applied", false)}
+	existing := map[string]metav1.Object{"updated": updated, "applied": applied}
+	if err := m.materialize(payload, nil, existing, map[string]metav1.Object{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := classes.patched["applied"]; ok {
+		t.Fatal("Expected the managed fields of an applied entry not to be patched")
+	}
+	var patch []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(classes.patched["updated"], &patch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var upgraded []metav1.ManagedFieldsEntry
+	for _, op := range patch {
+		if op.Path == "/metadata/managedFields" {
+			if err := json.Unmarshal(op.Value, &upgraded); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+	managers := map[string]metav1.ManagedFieldsOperationType{}
+	for _, entry := range upgraded {
+		managers[entry.Manager] = entry.Operation
+	}
+	expected := map[string]metav1.ManagedFieldsOperationType{
+		catalogFieldManager: metav1.ManagedFieldsOperationApply,
+		"kubectl-edit":      metav1.ManagedFieldsOperationUpdate,
+	}
+	if !reflect.DeepEqual(expected, managers) {
+		t.Fatalf("Unexpected managed fields; %s", expectedGot(expected, managers))
+	}
+	if e, a := 2, len(classes.applied); e != a {
+		t.Fatalf("Expected both entries to be applied; %s", expectedGot(e, a))
+	}
+}
+
+// BenchmarkCatalogMaterializer materializes a catalog of 200 classes, each
+// write taking 100µs, one by one and with server-side apply.
+func BenchmarkCatalogMaterializer(b *testing.B) {
+	for _, serverSideApply := range []bool{false, true} {
+		b.Run(fmt.Sprintf("serverSideApply=%v", serverSideApply), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				classes := newFakeCatalogEntries()
+				classes.latency = 100 * time.Microsecond
+				m, _ := newTestCatalogMaterializer(classes, newFakeCatalogEntries(), serverSideApply)
+				payload := make([]metav1.Object, 0, 200)
+				for j := 0; j < 200; j++ {
+					payload = append(payload, newTestCatalogEntry(fmt.Sprintf("class-%d", j), false))
+				}
+				if err := m.materialize(payload, nil, map[string]metav1.Object{}, map[string]metav1.Object{}); err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -28,11 +28,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/cache"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
 	"github.com/drycc-addons/service-catalog/pkg/metrics"
 	"github.com/drycc-addons/service-catalog/pkg/pretty"
 	"github.com/drycc-addons/service-catalog/pkg/util"
@@ -62,6 +65,7 @@ const (
 	successFetchedCatalogReason           string = "FetchedCatalog"
 	successFetchedCatalogMessage          string = "Successfully fetched catalog entries from broker."
 	errorReconciliationRetryTimeoutReason string = "ErrorReconciliationRetryTimeout"
+	errorReconciliationMaxAttemptsReason  string = "ErrorReconciliationMaxAttempts"
 )
 
 func (c *controller) clusterServiceBrokerAdd(obj interface{}) {
@@ -96,7 +100,7 @@ func (c *controller) clusterServiceBrokerDelete(obj interface{}) {
 // returns true unless the broker has a ready condition with status true and
 // the controller's broker relist interval has not elapsed since the broker's
 // ready condition became true, or if the broker's RelistBehavior is set to Manual.
-func shouldReconcileClusterServiceBroker(broker *v1beta1.ClusterServiceBroker, now time.Time, defaultRelistInterval time.Duration) bool {
+func shouldReconcileClusterServiceBroker(broker *v1beta1.ClusterServiceBroker, now time.Time, defaultRelistInterval time.Duration, relistJitterFactor float64) bool {
 	return shouldReconcileServiceBrokerCommon(
 		pretty.NewClusterServiceBrokerContextBuilder(broker),
 		&broker.ObjectMeta,
@@ -104,6 +108,7 @@ func shouldReconcileClusterServiceBroker(broker *v1beta1.ClusterServiceBroker, n
 		&broker.Status.CommonServiceBrokerStatus,
 		now,
 		defaultRelistInterval,
+		relistJitterFactor,
 	)
 }
 
@@ -116,6 +121,7 @@ func (c *controller) reconcileClusterServiceBrokerKey(key string) error {
 	if errors.IsNotFound(err) {
 		klog.Info(pcb.Message("Not doing work because it has been deleted"))
 		c.brokerClientManager.RemoveBrokerClient(NewClusterServiceBrokerKey(key))
+		c.removePollingQueues(key)
 		return nil
 	}
 	if err != nil {
@@ -127,6 +133,20 @@ func (c *controller) reconcileClusterServiceBrokerKey(key string) error {
 }
 
 func (c *controller) clusterServiceBrokerClient(broker *v1beta1.ClusterServiceBroker) (osb.Client, error) {
+	return c.updateClusterServiceBrokerClient(broker, NewClusterServiceBrokerKey(broker.Name), c.OSBAPITimeOut)
+}
+
+// clusterServiceBrokerBindingClient returns the client used for the bind,
+// unbind and binding last operation requests of the broker, whose timeout is
+// the binding request timeout of the broker.
+func (c *controller) clusterServiceBrokerBindingClient(broker *v1beta1.ClusterServiceBroker) (osb.Client, error) {
+	brokerKey := NewClusterServiceBrokerKey(broker.Name)
+	return c.updateClusterServiceBrokerClient(broker, brokerKey.ForBindings(), c.bindingRequestTimeout(&broker.Spec.CommonServiceBrokerSpec))
+}
+
+// updateClusterServiceBrokerClient creates or updates the client of the
+// broker stored under brokerKey, with the given request timeout.
+func (c *controller) updateClusterServiceBrokerClient(broker *v1beta1.ClusterServiceBroker, brokerKey BrokerKey, timeout time.Duration) (osb.Client, error) {
 	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
 	klog.V(4).Info(pcb.Message("Updating broker client"))
 	authConfig, err := c.getAuthCredentialsFromClusterServiceBroker(broker)
@@ -139,8 +159,11 @@ func (c *controller) clusterServiceBrokerClient(broker *v1beta1.ClusterServiceBr
 		}
 		return nil, err
 	}
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig, c.OSBAPITimeOut)
-	brokerClient, err := c.brokerClientManager.UpdateBrokerClient(NewClusterServiceBrokerKey(broker.Name), clientConfig)
+	clientConfig, err := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig, timeout, c.brokerAPIVersion(&broker.Spec.CommonServiceBrokerSpec))
+	var brokerClient osb.Client
+	if err == nil {
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClient(brokerKey, clientConfig, broker.Spec.RequestRateLimit, broker.Spec.TLSConfig)
+	}
 	if err != nil {
 		s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 		klog.Info(pcb.Message(s))
@@ -160,15 +183,29 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
 	klog.V(4).Infof(pcb.Message("Processing"))
 
+	if c.readOnly {
+		c.recordReadOnlyDrift(broker, pcb, serviceBrokerDrift(&broker.ObjectMeta, &broker.Status.CommonServiceBrokerStatus))
+		return nil
+	}
+
 	// * If the broker's ready condition is true and the RelistBehavior has been
 	// set to Manual, do not reconcile it.
 	// * If the broker's ready condition is true and the relist interval has not
 	// elapsed, do not reconcile it.
-	if !shouldReconcileClusterServiceBroker(broker, time.Now(), c.brokerRelistInterval) {
+	if !shouldReconcileClusterServiceBroker(broker, time.Now(), c.brokerRelistInterval, c.getBrokerRelistJitterFactor()) {
+		c.requeueBrokerForRelist(broker, &broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, &broker.Status.CommonServiceBrokerStatus, c.clusterServiceBrokerQueue)
 		return nil
 	}
 
 	if broker.DeletionTimestamp == nil { // Add or update
+		if c.deferBrokerRelistForMaintenance(pcb, broker, &broker.Spec.CommonServiceBrokerSpec, c.clusterServiceBrokerQueue) {
+			return nil
+		}
+		if !c.acquireBrokerRelist(pcb, broker, c.clusterServiceBrokerQueue) {
+			return nil
+		}
+		defer c.brokerRelists.release()
+
 		klog.V(4).Info(pcb.Message("Processing adding/update event"))
 
 		brokerClient, err := c.clusterServiceBrokerClient(broker)
@@ -178,7 +215,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 		// get the broker's catalog
 		now := metav1.Now()
-		brokerCatalog, err := brokerClient.GetCatalog()
+		brokerCatalog, err := c.getBrokerCatalog(brokerClient, &broker.Spec.CommonServiceBrokerSpec, "")
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			klog.Warning(pcb.Message(s))
@@ -193,7 +230,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 					klog.Error(pcb.Messagef("Error updating operation start time: %v", err))
 					return err
 				}
-			} else if !time.Now().Before(broker.Status.OperationStartTime.Time.Add(c.reconciliationRetryDuration)) {
+			} else if c.reconciliationRetryDurationExceeded(broker, broker.Status.OperationStartTime) {
 				s := "Stopping reconciliation retries because too much time has elapsed"
 				klog.Info(pcb.Message(s))
 				c.recorder.Event(broker, corev1.EventTypeWarning, errorReconciliationRetryTimeoutReason, s)
@@ -223,6 +260,28 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			broker = updated
 		}
 
+		// with CatalogDeltaSync, a catalog left unchanged since the last
+		// relist is not materialized again, which spares listing the classes
+		// and plans of the broker and comparing each of them with the catalog
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.CatalogDeltaSync) {
+			offeredClasses, offeredPlans, err := c.countOfferedClusterServiceClassesAndPlans(broker)
+			if err != nil {
+				return err
+			}
+			if catalogUnchanged(brokerCatalog, broker.Generation, &broker.Status.CommonServiceBrokerStatus, offeredClasses, offeredPlans) {
+				klog.V(4).Info(pcb.Message("Catalog unchanged since the last relist; skipping its classes and plans"))
+				metrics.CatalogEntriesUnchangedCount.WithLabelValues("ClusterServiceClass").Add(float64(offeredClasses))
+				metrics.CatalogEntriesUnchangedCount.WithLabelValues("ClusterServicePlan").Add(float64(offeredPlans))
+				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+					return err
+				}
+				c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successFetchedCatalogMessage)
+				metrics.BrokerServiceClassCount.WithLabelValues(broker.Name, "").Set(float64(offeredClasses))
+				metrics.BrokerServicePlanCount.WithLabelValues(broker.Name, "").Set(float64(offeredPlans))
+				return nil
+			}
+		}
+
 		// get the existing services and plans for this broker so that we can
 		// detect when services and plans are removed from the broker's
 		// catalog
@@ -248,126 +307,22 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		}
 		klog.V(5).Info(pcb.Message("Successfully converted catalog payload from to service-catalog API"))
 
-		// reconcile the serviceClasses that were part of the broker's catalog
-		// payload
-		for _, payloadServiceClass := range payloadServiceClasses {
-			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
-			delete(existingServiceClassMap, payloadServiceClass.Name)
-			if existingServiceClass == nil {
-				existingServiceClass, _ = existingServiceClassMap[payloadServiceClass.Spec.ExternalID]
-				delete(existingServiceClassMap, payloadServiceClass.Spec.ExternalID)
-			}
-
-			klog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ClusterServiceClassName(payloadServiceClass)))
-			if err := c.reconcileClusterServiceClassFromClusterServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
-				s := fmt.Sprintf(
-					"Error reconciling %s (broker %q): %s",
-					pretty.ClusterServiceClassName(payloadServiceClass), broker.Name, err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
-
-			klog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ClusterServiceClassName(payloadServiceClass)))
-		}
-
-		// handle the serviceClasses that were not in the broker's payload;
-		// mark these as having been removed from the broker's catalog
-		for _, existingServiceClass := range existingServiceClassMap {
-			if existingServiceClass.Status.RemovedFromBrokerCatalog {
-				continue
-			}
-
-			// Do not delete user-defined classes
-			if !isServiceCatalogManagedResource(existingServiceClass) {
-				continue
-			}
-
-			klog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ClusterServiceClassName(existingServiceClass)))
-			existingServiceClass.Status.RemovedFromBrokerCatalog = true
-			_, err := c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(context.Background(), existingServiceClass, metav1.UpdateOptions{})
-			if err != nil {
-				s := fmt.Sprintf(
-					"Error updating status of %s: %v",
-					pretty.ClusterServiceClassName(existingServiceClass), err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
-		}
-
-		// reconcile the plans that were part of the broker's catalog payload
-		for _, payloadServicePlan := range payloadServicePlans {
-			existingServicePlan, _ := existingServicePlanMap[payloadServicePlan.Name]
-			delete(existingServicePlanMap, payloadServicePlan.Name)
-			if existingServicePlan == nil {
-				existingServicePlan, _ = existingServicePlanMap[payloadServicePlan.Spec.ExternalID]
-				delete(existingServicePlanMap, payloadServicePlan.Spec.ExternalID)
-			}
-
-			klog.V(4).Infof(
-				"ClusterServiceBroker %q: reconciling %s",
-				broker.Name, pretty.ClusterServicePlanName(payloadServicePlan),
-			)
-			if err := c.reconcileClusterServicePlanFromClusterServiceBrokerCatalog(broker, payloadServicePlan, existingServicePlan); err != nil {
-				s := fmt.Sprintf(
-					"Error reconciling %s: %s",
-					pretty.ClusterServicePlanName(payloadServicePlan), err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s)
-				return err
-			}
-			klog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ClusterServicePlanName(payloadServicePlan)))
-
-		}
-
-		// handle the servicePlans that were not in the broker's payload;
-		// mark these as deleted
-		for _, existingServicePlan := range existingServicePlanMap {
-			if existingServicePlan.Status.RemovedFromBrokerCatalog {
-				continue
-			}
-
-			// Do not delete user-defined plans
-			if !isServiceCatalogManagedResource(existingServicePlan) {
-				continue
-			}
-
-			klog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ClusterServicePlanName(existingServicePlan)))
-			existingServicePlan.Status.RemovedFromBrokerCatalog = true
-			_, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(context.Background(), existingServicePlan, metav1.UpdateOptions{})
-			if err != nil {
-				s := fmt.Sprintf(
-					"Error updating status of %s: %v",
-					pretty.ClusterServicePlanName(existingServicePlan),
-					err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
+		// create or update the classes and plans of the broker's catalog
+		// payload, and mark the ones that are no longer in it as removed
+		payloadClasses, payloadPlans, existingClasses, existingPlans := clusterCatalogEntries(payloadServiceClasses, payloadServicePlans, existingServiceClassMap, existingServicePlanMap)
+		m := c.newClusterServiceBrokerCatalogMaterializer(broker)
+		// the summary is computed first, as materializing takes the existing
+		// entries that are still in the catalog out of their maps
+		summary := m.summarize(brokerCatalog, payloadClasses, payloadPlans, existingClasses, existingPlans, broker.Status.CatalogSummary)
+		if err := m.materialize(payloadClasses, payloadPlans, existingClasses, existingPlans); err != nil {
+			return err
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// everything worked correctly; record the summary of the catalog and
+		// update the broker's ready condition to status true
+		toUpdate := broker.DeepCopy()
+		toUpdate.Status.CatalogSummary = summary
+		if err := c.updateClusterServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
@@ -431,6 +386,15 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
+		if err := c.clearClusterServiceClassConflicts(broker.Name, sets.NewString()); err != nil {
+			klog.Warning(pcb.Message(err.Error()))
+			return err
+		}
+		if err := c.clearClusterServicePlanConflicts(broker.Name, sets.NewString()); err != nil {
+			klog.Warning(pcb.Message(err.Error()))
+			return err
+		}
+
 		if err := c.updateClusterServiceBrokerCondition(
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
@@ -456,184 +420,230 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 	return nil
 }
 
-// reconcileClusterServiceClassFromClusterServiceBrokerCatalog reconciles a
-// ClusterServiceClass after the ClusterServiceBroker's catalog has been re-
-// listed. The serviceClass parameter is the serviceClass from the broker's
-// catalog payload. The existingServiceClass parameter is the serviceClass
-// that already exists for the given broker with this serviceClass' k8s name.
-func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog(broker *v1beta1.ClusterServiceBroker, serviceClass, existingServiceClass *v1beta1.ClusterServiceClass) error {
-	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
-	serviceClass.Spec.ClusterServiceBrokerName = broker.Name
+// reconcileClusterServicePlanFromClusterServiceBrokerCatalog reconciles a
+// ServicePlan after the ServiceClass's catalog has been re-listed.
+func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(broker *v1beta1.ClusterServiceBroker, servicePlan, existingServicePlan *v1beta1.ClusterServicePlan) error {
+	m := c.newClusterServiceBrokerCatalogMaterializer(broker)
+	var existing metav1.Object
+	if existingServicePlan != nil {
+		existing = existingServicePlan
+	}
+	return m.syncEntry(m.plans, servicePlan, existing)
+}
 
-	if existingServiceClass == nil {
-		otherServiceClass, err := c.clusterServiceClassLister.Get(serviceClass.Name)
-		if err != nil {
-			// we expect _not_ to find a service class this way, so a not-
-			// found error is expected and legitimate.
-			if !errors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			// we do not expect to find an existing service class if we were
-			// not already passed one; the following if statement will almost
-			// certainly evaluate to true.
-			if otherServiceClass.Spec.ClusterServiceBrokerName != broker.Name {
-				errMsg := fmt.Sprintf("%s already exists for Broker %q",
-					pretty.ClusterServiceClassName(serviceClass), otherServiceClass.Spec.ClusterServiceBrokerName,
-				)
-				klog.Error(pcb.Message(errMsg))
-				return fmt.Errorf(errMsg)
-			}
-		}
+// newClusterServiceBrokerCatalogMaterializer returns the materializer of the
+// catalog of a ClusterServiceBroker.
+func (c *controller) newClusterServiceBrokerCatalogMaterializer(broker *v1beta1.ClusterServiceBroker) *catalogMaterializer {
+	m := c.newCatalogMaterializer(
+		pretty.NewClusterServiceBrokerContextBuilder(broker),
+		broker,
+		broker.Name,
+		&clusterServiceClassEntries{c: c, broker: broker},
+		&clusterServicePlanEntries{c: c, broker: broker},
+		func(message string) error {
+			return c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				errorSyncingCatalogMessage+message)
+		},
+	)
+	// forget the conflicts recorded for classes this broker no longer
+	// offers
+	m.classesSynced = func(conflicting sets.String) error {
+		return c.clearClusterServiceClassConflicts(broker.Name, conflicting)
+	}
+	m.plansSynced = func(conflicting sets.String) error {
+		return c.clearClusterServicePlanConflicts(broker.Name, conflicting)
+	}
+	return m
+}
 
-		markAsServiceCatalogManagedResource(serviceClass, broker)
+// clusterCatalogEntries returns the classes and plans of a catalog payload
+// and the existing ones as catalog entries.
+func clusterCatalogEntries(payloadClasses []*v1beta1.ClusterServiceClass, payloadPlans []*v1beta1.ClusterServicePlan, existingClasses map[string]*v1beta1.ClusterServiceClass, existingPlans map[string]*v1beta1.ClusterServicePlan) ([]metav1.Object, []metav1.Object, map[string]metav1.Object, map[string]metav1.Object) {
+	classes := make([]metav1.Object, 0, len(payloadClasses))
+	for _, class := range payloadClasses {
+		classes = append(classes, class)
+	}
+	plans := make([]metav1.Object, 0, len(payloadPlans))
+	for _, plan := range payloadPlans {
+		plans = append(plans, plan)
+	}
+	existingClassEntries := make(map[string]metav1.Object, len(existingClasses))
+	for name, class := range existingClasses {
+		existingClassEntries[name] = class
+	}
+	existingPlanEntries := make(map[string]metav1.Object, len(existingPlans))
+	for name, plan := range existingPlans {
+		existingPlanEntries[name] = plan
+	}
+	return classes, plans, existingClassEntries, existingPlanEntries
+}
 
-		klog.V(5).Info(pcb.Messagef("Fresh %s; creating", pretty.ClusterServiceClassName(serviceClass)))
-		if _, err := c.serviceCatalogClient.ClusterServiceClasses().Create(context.Background(), serviceClass, metav1.CreateOptions{}); err != nil {
-			klog.Error(pcb.Messagef("Error creating %s: %v", pretty.ClusterServiceClassName(serviceClass), err))
-			return err
-		}
+// clusterServiceClassEntries are the ClusterServiceClasses of a
+// ClusterServiceBroker.
+type clusterServiceClassEntries struct {
+	c      *controller
+	broker *v1beta1.ClusterServiceBroker
+}
 
-		return nil
-	}
+func (e *clusterServiceClassEntries) kind() string {
+	return "ClusterServiceClass"
+}
 
-	if existingServiceClass.Spec.ExternalID != serviceClass.Spec.ExternalID {
-		errMsg := fmt.Sprintf(
-			"%s already exists with OSB guid %q, received different guid %q",
-			pretty.ClusterServiceClassName(serviceClass), existingServiceClass.Name, serviceClass.Name,
-		)
-		klog.Error(pcb.Message(errMsg))
-		return fmt.Errorf(errMsg)
-	}
+func (e *clusterServiceClassEntries) prettyName(entry metav1.Object) string {
+	return pretty.ClusterServiceClassName(entry.(*v1beta1.ClusterServiceClass))
+}
 
-	klog.V(5).Info(pcb.Messagef("Found existing %s; updating", pretty.ClusterServiceClassName(serviceClass)))
+func (e *clusterServiceClassEntries) externalID(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServiceClass).Spec.ExternalID
+}
 
-	// There was an existing service class -- project the update onto it and
-	// update it.
-	toUpdate := existingServiceClass.DeepCopy()
-	toUpdate.Spec.BindingRetrievable = serviceClass.Spec.BindingRetrievable
-	toUpdate.Spec.Bindable = serviceClass.Spec.Bindable
-	toUpdate.Spec.PlanUpdatable = serviceClass.Spec.PlanUpdatable
-	toUpdate.Spec.Tags = serviceClass.Spec.Tags
-	toUpdate.Spec.Description = serviceClass.Spec.Description
-	toUpdate.Spec.Requires = serviceClass.Spec.Requires
-	toUpdate.Spec.ExternalName = serviceClass.Spec.ExternalName
-	toUpdate.Spec.ExternalMetadata = serviceClass.Spec.ExternalMetadata
+func (e *clusterServiceClassEntries) externalName(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServiceClass).Spec.ExternalName
+}
 
-	markAsServiceCatalogManagedResource(toUpdate, broker)
+func (e *clusterServiceClassEntries) brokerName(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServiceClass).Spec.ClusterServiceBrokerName
+}
 
-	updatedServiceClass, err := c.serviceCatalogClient.ClusterServiceClasses().Update(context.Background(), toUpdate, metav1.UpdateOptions{})
-	if err != nil {
-		klog.Error(pcb.Messagef("Error updating %s: %v", pretty.ClusterServiceClassName(serviceClass), err))
-		return err
-	}
+func (e *clusterServiceClassEntries) className(entry metav1.Object) string {
+	return entry.GetName()
+}
 
-	if updatedServiceClass.Status.RemovedFromBrokerCatalog {
-		klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ClusterServiceClassName(serviceClass)))
-		updatedServiceClass.Status.RemovedFromBrokerCatalog = false
-		_, err := c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(context.Background(), updatedServiceClass, metav1.UpdateOptions{})
-		if err != nil {
-			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ClusterServiceClassName(updatedServiceClass), err)
-			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
-				return err
-			}
-			return err
-		}
-	}
+func (e *clusterServiceClassEntries) removedFromBrokerCatalog(entry metav1.Object) bool {
+	return entry.(*v1beta1.ClusterServiceClass).Status.RemovedFromBrokerCatalog
+}
 
-	return nil
+func (e *clusterServiceClassEntries) managed(entry metav1.Object) bool {
+	return isServiceCatalogManagedResource(entry)
 }
 
-// reconcileClusterServicePlanFromClusterServiceBrokerCatalog reconciles a
-// ServicePlan after the ServiceClass's catalog has been re-listed.
-func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(broker *v1beta1.ClusterServiceBroker, servicePlan, existingServicePlan *v1beta1.ClusterServicePlan) error {
-	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
-	servicePlan.Spec.ClusterServiceBrokerName = broker.Name
+func (e *clusterServiceClassEntries) prepare(payload metav1.Object) {
+	payload.(*v1beta1.ClusterServiceClass).Spec.ClusterServiceBrokerName = e.broker.Name
+	markAsServiceCatalogManagedResource(payload, e.broker)
+}
 
-	if existingServicePlan == nil {
-		otherServicePlan, err := c.clusterServicePlanLister.Get(servicePlan.Name)
-		if err != nil {
-			// we expect _not_ to find a service class this way, so a not-
-			// found error is expected and legitimate.
-			if !errors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			// we do not expect to find an existing service class if we were
-			// not already passed one; the following if statement will almost
-			// certainly evaluate to true.
-			if otherServicePlan.Spec.ClusterServiceBrokerName != broker.Name {
-				errMsg := fmt.Sprintf(
-					"%s already exists for Broker %q",
-					pretty.ClusterServicePlanName(servicePlan), otherServicePlan.Spec.ClusterServiceBrokerName,
-				)
-				klog.Error(pcb.Message(errMsg))
-				return fmt.Errorf(errMsg)
-			}
-		}
+func (e *clusterServiceClassEntries) get(name string) (metav1.Object, error) {
+	return e.c.clusterServiceClassLister.Get(name)
+}
 
-		markAsServiceCatalogManagedResource(servicePlan, broker)
+func (e *clusterServiceClassEntries) claim(other metav1.Object) error {
+	return e.c.takeOverClusterServiceClass(e.broker, other.(*v1beta1.ClusterServiceClass))
+}
 
-		// An error returned from a lister Get call means that the object does
-		// not exist.  Create a new ClusterServicePlan.
-		if _, err := c.serviceCatalogClient.ClusterServicePlans().Create(context.Background(), servicePlan, metav1.CreateOptions{}); err != nil {
-			klog.Error(pcb.Messagef("Error creating %s: %v", pretty.ClusterServicePlanName(servicePlan), err))
-			return err
-		}
+func (e *clusterServiceClassEntries) create(payload metav1.Object) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ClusterServiceClasses().Create(context.Background(), payload.(*v1beta1.ClusterServiceClass), metav1.CreateOptions{FieldManager: catalogFieldManager})
+}
 
-		return nil
-	}
+func (e *clusterServiceClassEntries) update(existing, payload metav1.Object) (metav1.Object, error) {
+	toUpdate := existing.(*v1beta1.ClusterServiceClass).DeepCopy()
+	projectServiceClassSpec(&toUpdate.Spec.CommonServiceClassSpec, &payload.(*v1beta1.ClusterServiceClass).Spec.CommonServiceClassSpec)
+	markAsServiceCatalogManagedResource(toUpdate, e.broker)
+	return e.c.serviceCatalogClient.ClusterServiceClasses().Update(context.Background(), toUpdate, metav1.UpdateOptions{FieldManager: catalogFieldManager})
+}
 
-	if existingServicePlan.Spec.ExternalID != servicePlan.Spec.ExternalID {
-		errMsg := fmt.Sprintf(
-			"%s already exists with OSB guid %q, received different guid %q",
-			pretty.ClusterServicePlanName(servicePlan), existingServicePlan.Spec.ExternalID, servicePlan.Spec.ExternalID,
-		)
-		klog.Error(pcb.Message(errMsg))
-		return fmt.Errorf(errMsg)
-	}
+func (e *clusterServiceClassEntries) upToDate(existing, payload metav1.Object) bool {
+	return isServiceCatalogManagedResource(existing) &&
+		serviceClassSpecUpToDate(&existing.(*v1beta1.ClusterServiceClass).Spec.CommonServiceClassSpec, &payload.(*v1beta1.ClusterServiceClass).Spec.CommonServiceClassSpec)
+}
 
-	klog.V(5).Info(pcb.Messagef("Found existing %s; updating", pretty.ClusterServicePlanName(servicePlan)))
+func (e *clusterServiceClassEntries) apply(payload []byte, name string) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ClusterServiceClasses().Patch(context.Background(), name, types.ApplyPatchType, payload, catalogApplyOptions())
+}
 
-	// There was an existing service plan -- project the update onto it and
-	// update it.
-	toUpdate := existingServicePlan.DeepCopy()
-	toUpdate.Spec.Description = servicePlan.Spec.Description
-	toUpdate.Spec.Bindable = servicePlan.Spec.Bindable
-	toUpdate.Spec.Free = servicePlan.Spec.Free
-	toUpdate.Spec.ExternalName = servicePlan.Spec.ExternalName
-	toUpdate.Spec.ExternalMetadata = servicePlan.Spec.ExternalMetadata
-	toUpdate.Spec.InstanceCreateParameterSchema = servicePlan.Spec.InstanceCreateParameterSchema
-	toUpdate.Spec.InstanceUpdateParameterSchema = servicePlan.Spec.InstanceUpdateParameterSchema
-	toUpdate.Spec.ServiceBindingCreateParameterSchema = servicePlan.Spec.ServiceBindingCreateParameterSchema
+func (e *clusterServiceClassEntries) patchManagedFields(name string, patch []byte) error {
+	_, err := e.c.serviceCatalogClient.ClusterServiceClasses().Patch(context.Background(), name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
 
-	markAsServiceCatalogManagedResource(toUpdate, broker)
+func (e *clusterServiceClassEntries) setRemovedFromBrokerCatalog(entry metav1.Object, removed bool) error {
+	class := entry.(*v1beta1.ClusterServiceClass)
+	class.Status.RemovedFromBrokerCatalog = removed
+	_, err := e.c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(context.Background(), class, metav1.UpdateOptions{})
+	return err
+}
 
-	updatedPlan, err := c.serviceCatalogClient.ClusterServicePlans().Update(context.Background(), toUpdate, metav1.UpdateOptions{})
-	if err != nil {
-		klog.Error(pcb.Messagef("Error updating %s: %v", pretty.ClusterServicePlanName(servicePlan), err))
-		return err
-	}
+// clusterServicePlanEntries are the ClusterServicePlans of a
+// ClusterServiceBroker.
+type clusterServicePlanEntries struct {
+	c      *controller
+	broker *v1beta1.ClusterServiceBroker
+}
 
-	if updatedPlan.Status.RemovedFromBrokerCatalog {
-		updatedPlan.Status.RemovedFromBrokerCatalog = false
-		klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ClusterServicePlanName(updatedPlan)))
+func (e *clusterServicePlanEntries) kind() string {
+	return "ClusterServicePlan"
+}
 
-		_, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(context.Background(), updatedPlan, metav1.UpdateOptions{})
-		if err != nil {
-			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ClusterServicePlanName(updatedPlan), err)
-			klog.Error(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
-				return err
-			}
-			return err
-		}
-	}
+func (e *clusterServicePlanEntries) prettyName(entry metav1.Object) string {
+	return pretty.ClusterServicePlanName(entry.(*v1beta1.ClusterServicePlan))
+}
 
-	return nil
+func (e *clusterServicePlanEntries) externalID(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServicePlan).Spec.ExternalID
+}
+
+func (e *clusterServicePlanEntries) externalName(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServicePlan).Spec.ExternalName
+}
+
+func (e *clusterServicePlanEntries) brokerName(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServicePlan).Spec.ClusterServiceBrokerName
+}
+
+func (e *clusterServicePlanEntries) className(entry metav1.Object) string {
+	return entry.(*v1beta1.ClusterServicePlan).Spec.ClusterServiceClassRef.Name
+}
+
+func (e *clusterServicePlanEntries) removedFromBrokerCatalog(entry metav1.Object) bool {
+	return entry.(*v1beta1.ClusterServicePlan).Status.RemovedFromBrokerCatalog
+}
+
+func (e *clusterServicePlanEntries) managed(entry metav1.Object) bool {
+	return isServiceCatalogManagedResource(entry)
+}
+
+func (e *clusterServicePlanEntries) prepare(payload metav1.Object) {
+	payload.(*v1beta1.ClusterServicePlan).Spec.ClusterServiceBrokerName = e.broker.Name
+	markAsServiceCatalogManagedResource(payload, e.broker)
+}
+
+func (e *clusterServicePlanEntries) get(name string) (metav1.Object, error) {
+	return e.c.clusterServicePlanLister.Get(name)
+}
+
+func (e *clusterServicePlanEntries) claim(other metav1.Object) error {
+	return e.c.takeOverClusterServicePlan(e.broker, other.(*v1beta1.ClusterServicePlan))
+}
+
+func (e *clusterServicePlanEntries) create(payload metav1.Object) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ClusterServicePlans().Create(context.Background(), payload.(*v1beta1.ClusterServicePlan), metav1.CreateOptions{FieldManager: catalogFieldManager})
+}
+
+func (e *clusterServicePlanEntries) update(existing, payload metav1.Object) (metav1.Object, error) {
+	toUpdate := existing.(*v1beta1.ClusterServicePlan).DeepCopy()
+	projectServicePlanSpec(&toUpdate.Spec.CommonServicePlanSpec, &payload.(*v1beta1.ClusterServicePlan).Spec.CommonServicePlanSpec)
+	markAsServiceCatalogManagedResource(toUpdate, e.broker)
+	return e.c.serviceCatalogClient.ClusterServicePlans().Update(context.Background(), toUpdate, metav1.UpdateOptions{FieldManager: catalogFieldManager})
+}
+
+func (e *clusterServicePlanEntries) upToDate(existing, payload metav1.Object) bool {
+	return isServiceCatalogManagedResource(existing) &&
+		servicePlanSpecUpToDate(&existing.(*v1beta1.ClusterServicePlan).Spec.CommonServicePlanSpec, &payload.(*v1beta1.ClusterServicePlan).Spec.CommonServicePlanSpec)
+}
+
+func (e *clusterServicePlanEntries) apply(payload []byte, name string) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ClusterServicePlans().Patch(context.Background(), name, types.ApplyPatchType, payload, catalogApplyOptions())
+}
+
+func (e *clusterServicePlanEntries) patchManagedFields(name string, patch []byte) error {
+	_, err := e.c.serviceCatalogClient.ClusterServicePlans().Patch(context.Background(), name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+func (e *clusterServicePlanEntries) setRemovedFromBrokerCatalog(entry metav1.Object, removed bool) error {
+	plan := entry.(*v1beta1.ClusterServicePlan)
+	plan.Status.RemovedFromBrokerCatalog = removed
+	_, err := e.c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(context.Background(), plan, metav1.UpdateOptions{})
+	return err
 }
 
 // updateClusterServiceBrokerCondition updates the ready condition for the given Broker
@@ -673,12 +683,15 @@ func (c *controller) updateClusterServiceBrokerCondition(broker *v1beta1.Cluster
 		}
 	}
 
-	// Set status.ReconciledGeneration && status.LastCatalogRetrievalTime if updating ready condition to true
+	// Set status.ReconciledGeneration, status.LastCatalogRetrievalTime and
+	// the OSB API version the catalog was fetched with if updating ready
+	// condition to true
 
 	if conditionType == v1beta1.ServiceBrokerConditionReady && status == v1beta1.ConditionTrue {
 		toUpdate.Status.ReconciledGeneration = toUpdate.Generation
 		now := metav1.NewTime(t)
 		toUpdate.Status.LastCatalogRetrievalTime = &now
+		toUpdate.Status.OSBAPIVersion = c.brokerAPIVersion(&toUpdate.Spec.CommonServiceBrokerSpec).HeaderValue()
 	}
 	toUpdate.RecalculatePrinterColumnStatusFields()
 
@@ -720,6 +733,32 @@ func (c *controller) updateClusterServiceBrokerFinalizers(
 	return err
 }
 
+// countOfferedClusterServiceClassesAndPlans returns the number of classes and
+// plans of broker held by the listers that are not marked as removed from its
+// catalog.
+func (c *controller) countOfferedClusterServiceClassesAndPlans(broker *v1beta1.ClusterServiceBroker) (int, int, error) {
+	serviceClasses, err := c.clusterServiceClassLister.List(labels.Everything())
+	if err != nil {
+		return 0, 0, err
+	}
+	servicePlans, err := c.clusterServicePlanLister.List(labels.Everything())
+	if err != nil {
+		return 0, 0, err
+	}
+	var offeredClasses, offeredPlans int
+	for _, serviceClass := range serviceClasses {
+		if serviceClass.Spec.ClusterServiceBrokerName == broker.Name && !serviceClass.Status.RemovedFromBrokerCatalog {
+			offeredClasses++
+		}
+	}
+	for _, servicePlan := range servicePlans {
+		if servicePlan.Spec.ClusterServiceBrokerName == broker.Name && !servicePlan.Status.RemovedFromBrokerCatalog {
+			offeredPlans++
+		}
+	}
+	return offeredClasses, offeredPlans, nil
+}
+
 func (c *controller) getCurrentServiceClassesAndPlansForBroker(broker *v1beta1.ClusterServiceBroker) ([]v1beta1.ClusterServiceClass, []v1beta1.ClusterServicePlan, error) {
 	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
 
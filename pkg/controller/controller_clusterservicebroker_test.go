@@ -27,6 +27,7 @@ import (
 	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
 	"github.com/drycc-addons/service-catalog/pkg/util"
 	"github.com/drycc-addons/service-catalog/test/fake"
 
@@ -35,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"strings"
 
@@ -225,7 +227,7 @@ func TestShouldReconcileClusterServiceBroker(t *testing.T) {
 				t.Logf("broker.Spec.RelistDuration set to nil")
 			}
 
-			actual := shouldReconcileClusterServiceBroker(tc.broker, tc.now, 24*time.Hour)
+			actual := shouldReconcileClusterServiceBroker(tc.broker, tc.now, 24*time.Hour, 0)
 
 			if e, a := tc.reconcile, actual; e != a {
 				t.Errorf("unexpected result: %s", expectedGot(e, a))
@@ -249,6 +251,60 @@ func TestReconcileClusterServiceBrokerSetOSBTimeOut(t *testing.T) {
 	}
 }
 
+// TestClusterServiceBrokerBindingClientTimeOut verifies that the binding
+// requests to a broker use a client of their own, whose timeout is the binding
+// request timeout of the broker or, when it is unset, the controller's.
+func TestClusterServiceBrokerBindingClientTimeOut(t *testing.T) {
+	cases := []struct {
+		name                  string
+		bindingRequestTimeout *metav1.Duration
+		expected              time.Duration
+	}{
+		{
+			name:     "default",
+			expected: 20 * time.Second,
+		},
+		{
+			name:                  "set by the broker",
+			bindingRequestTimeout: &metav1.Duration{Duration: 5 * time.Second},
+			expected:              5 * time.Second,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			testController.OSBAPITimeOut = 80 * time.Second
+			testController.OSBAPIBindingTimeOut = 20 * time.Second
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.BindingRequestTimeout = tc.bindingRequestTimeout
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+
+			if _, err := testController.getBrokerClientForServiceBinding(getTestServiceInstanceWithClusterRefs(), getTestServiceBinding()); err != nil {
+				t.Fatalf("Unexpected error getting the binding client: %v", err)
+			}
+
+			brokerKey := NewClusterServiceBrokerKey(broker.Name)
+			createdClient, ok := testController.brokerClientManager.clients[brokerKey.ForBindings()]
+			if !ok {
+				t.Fatal("Expected a binding client to be created for the broker")
+			}
+			if e, a := int(tc.expected.Seconds()), createdClient.clientConfig.TimeoutSeconds; e != a {
+				t.Fatalf("Unexpected binding request timeout: %s", expectedGot(e, a))
+			}
+			if _, ok := testController.brokerClientManager.clients[brokerKey]; !ok {
+				t.Fatal("Expected the client of the broker to be kept")
+			}
+
+			testController.brokerClientManager.RemoveBrokerClient(brokerKey)
+			if _, ok := testController.brokerClientManager.BrokerClient(brokerKey.ForBindings()); ok {
+				t.Fatal("Expected the binding client to be removed with the broker")
+			}
+		})
+	}
+}
+
 // TestReconcileClusterServiceBrokerExistingServiceClassAndServicePlan
 // verifies a simple, successful run of reconcileClusterServiceBroker() when a
 // ClusterServiceClass and plan already exist.  This test will cause
@@ -365,6 +421,164 @@ func TestReconcileClusterServiceBrokerRemovedClusterServiceClass(t *testing.T) {
 	assertNumberOfActions(t, kubeActions, 0)
 }
 
+// TestReconcileClusterServiceBrokerCatalogSummary validates that a relist
+// that changes the catalog records, in the status of the broker, the classes
+// and plans that were added and removed, and not the ones that are still
+// offered.
+func TestReconcileClusterServiceBrokerCatalogSummary(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
+
+	testClusterServiceClass := getTestClusterServiceClass()
+	testRemovedClusterServiceClass := getTestRemovedClusterServiceClass()
+	testClusterServicePlan := getTestClusterServicePlan()
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(testClusterServiceClass)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(testRemovedClusterServiceClass)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(testClusterServicePlan)
+
+	fakeCatalogClient.AddReactor("list", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServiceClassList{
+			Items: []v1beta1.ClusterServiceClass{
+				*testClusterServiceClass,
+				*testRemovedClusterServiceClass,
+			},
+		}, nil
+	})
+	fakeCatalogClient.AddReactor("list", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServicePlanList{
+			Items: []v1beta1.ClusterServicePlan{
+				*testClusterServicePlan,
+			},
+		}, nil
+	})
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	if len(actions) == 0 {
+		t.Fatal("expected the status of the broker to be updated")
+	}
+	updatedClusterServiceBroker, ok := assertUpdateStatus(t, actions[len(actions)-1], getTestClusterServiceBroker()).(*v1beta1.ClusterServiceBroker)
+	if !ok {
+		t.Fatal("couldn't convert to a ClusterServiceBroker")
+	}
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+	summary := updatedClusterServiceBroker.Status.CatalogSummary
+	if summary == nil {
+		t.Fatal("expected a catalog summary in the status of the broker")
+	}
+	if e, a := int32(1), summary.ClassCount; e != a {
+		t.Fatalf("unexpected class count: %v", expectedGot(e, a))
+	}
+	if e, a := int32(2), summary.PlanCount; e != a {
+		t.Fatalf("unexpected plan count: %v", expectedGot(e, a))
+	}
+	expectedAdded := []string{testClusterServiceClassName + "/" + testNonbindableClusterServicePlanName}
+	if e, a := expectedAdded, summary.Added; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected added entries: %v", expectedGot(e, a))
+	}
+	expectedRemoved := []string{testRemovedClusterServiceClassName}
+	if e, a := expectedRemoved, summary.Removed; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected removed entries: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileClusterServiceBrokerUnchangedCatalog validates that, with
+// CatalogDeltaSync, a relist that fetches the catalog recorded by the catalog
+// summary of the broker neither lists nor writes its classes and plans.
+func TestReconcileClusterServiceBrokerUnchangedCatalog(t *testing.T) {
+	err := utilfeature.DefaultMutableFeatureGate.Set(string(scfeatures.CatalogDeltaSync) + "=true")
+	if err != nil {
+		t.Fatalf("Failed to enable catalog delta sync feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(string(scfeatures.CatalogDeltaSync) + "=false")
+
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
+
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	nonbindablePlan := getTestClusterServicePlanNonbindable()
+	nonbindablePlan.Spec.ClusterServiceBrokerName = testClusterServiceBrokerName
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(nonbindablePlan)
+
+	checksum, err := catalogChecksum(getTestCatalog())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	lastRelistTime := metav1.NewTime(time.Now().Add(-1 * time.Hour))
+	broker := getTestClusterServiceBrokerWithStatusAndTime(v1beta1.ConditionTrue, lastRelistTime, lastRelistTime)
+	broker.Status.ReconciledGeneration = broker.Generation
+	broker.Status.CatalogSummary = &v1beta1.CatalogSummary{
+		Checksum:   checksum,
+		ClassCount: 1,
+		PlanCount:  2,
+	}
+
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertGetCatalog(t, brokerActions[0])
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedClusterServiceBroker, ok := assertUpdateStatus(t, actions[0], broker).(*v1beta1.ClusterServiceBroker)
+	if !ok {
+		t.Fatal("couldn't convert to a ClusterServiceBroker")
+	}
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+	if e, a := broker.Status.CatalogSummary, updatedClusterServiceBroker.Status.CatalogSummary; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected catalog summary: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileClusterServiceBrokerRecordsOSBAPIVersion tests that the OSB
+// API version the catalog was fetched with is recorded in the status of the
+// broker by the update of its ready condition.
+func TestReconcileClusterServiceBrokerRecordsOSBAPIVersion(t *testing.T) {
+	cases := []struct {
+		name     string
+		pinned   string
+		expected string
+	}{
+		{
+			name:     "preferred version",
+			expected: osb.LatestAPIVersion().HeaderValue(),
+		},
+		{
+			name:     "pinned version",
+			pinned:   "2.13",
+			expected: "2.13",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestCatalogConfig())
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.OSBAPIVersion = tc.pinned
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			updatedClusterServiceBroker, ok := assertUpdateStatus(t, actions[len(actions)-1], broker).(*v1beta1.ClusterServiceBroker)
+			if !ok {
+				t.Fatal("couldn't convert to a ClusterServiceBroker")
+			}
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+			if e, a := tc.expected, updatedClusterServiceBroker.Status.OSBAPIVersion; e != a {
+				t.Fatalf("unexpected OSB API version: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
+
 // TestReconcileClusterServiceBrokerRemovedAndRestoredClusterServiceClass
 // validates where Service Catalog has a class and plan that is marked as
 // RemovedFromBrokerCatalog but then the ServiceBroker adds the class and plan
@@ -496,7 +710,8 @@ func TestReconcileClusterServiceBrokerRemovedClusterServicePlan(t *testing.T) {
 
 // TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker simulates catalog
 // refresh where broker lists a service which matches an existing, already
-// cataloged service but the service points to a different ClusterServiceBroker.  Results in an error.
+// cataloged service but the service points to a different ClusterServiceBroker
+// that no longer exists. The broker takes the unused class over.
 func TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker(t *testing.T) {
 	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
 
@@ -505,8 +720,8 @@ func TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker
 
 	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(testClusterServiceClass)
 
-	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err == nil {
-		t.Fatal("The same service class should not belong to two different brokers.")
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
 	}
 
 	brokerActions := fakeClusterServiceBrokerClient.Actions()
@@ -514,7 +729,7 @@ func TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker
 	assertGetCatalog(t, brokerActions[0])
 
 	actions := fakeCatalogClient.Actions()
-	assertNumberOfActions(t, actions, 3)
+	assertNumberOfActions(t, actions, 8)
 
 	listRestrictions := clientgotesting.ListRestrictions{
 		Labels: labels.SelectorFromSet(labels.Set{
@@ -524,8 +739,18 @@ func TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker
 	}
 	assertList(t, actions[0], &v1beta1.ClusterServiceClass{}, listRestrictions)
 	assertList(t, actions[1], &v1beta1.ClusterServicePlan{}, listRestrictions)
-	updatedClusterServiceBroker := assertUpdateStatus(t, actions[2], getTestClusterServiceBroker())
-	assertClusterServiceBrokerReadyFalse(t, updatedClusterServiceBroker)
+	assertList(t, actions[2], &v1beta1.ServiceInstance{}, clientgotesting.ListRestrictions{
+		Labels: labels.SelectorFromSet(labels.Set{
+			v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceClassRefName: util.GenerateSHA(testClusterServiceClassGUID),
+		}),
+		Fields: fields.Everything(),
+	})
+	assertDelete(t, actions[3], testClusterServiceClass)
+	assertCreate(t, actions[4], getTestClusterServiceClass())
+	assertCreate(t, actions[5], getTestClusterServicePlan())
+	assertCreate(t, actions[6], getTestClusterServicePlanNonbindable())
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[7], getTestClusterServiceBroker())
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
 
 	// verify no kube resources created
 	kubeActions := fakeKubeClient.Actions()
@@ -533,21 +758,109 @@ func TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorSyncingCatalogReason).msgf(
-		"Error reconciling ClusterServiceClass (K8S: %q ExternalName: %q) (broker %q):",
-		testClusterServiceClassGUID, testClusterServiceClassName, testClusterServiceBrokerName,
-	).msgf(
-		"ClusterServiceClass (K8S: %q ExternalName: %q) already exists for Broker %q",
+	expectedEvent := normalEventBuilder(tookOverClusterServiceClassReason).msgf(
+		"Took over ClusterServiceClass (K8S: %q ExternalName: %q) from ClusterServiceBroker %q",
 		testClusterServiceClassGUID, testClusterServiceClassName, "notTheSame",
 	)
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+	expectedEvents := []string{
+		expectedEvent.String(),
+		normalEventBuilder(successFetchedCatalogReason).msg(successFetchedCatalogMessage).String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// TestReconcileClusterServiceBrokerClusterServiceClassConflict simulates
+// catalog refresh where broker lists a service which matches an existing
+// service belonging to a different ClusterServiceBroker that cannot be taken
+// over. The service and its plans are skipped and the conflict is recorded
+// on the existing service.
+func TestReconcileClusterServiceBrokerClusterServiceClassConflict(t *testing.T) {
+	cases := []struct {
+		name        string
+		ownerBroker *v1beta1.ClusterServiceBroker
+		instance    *v1beta1.ServiceInstance
+		// actions is the number of actions on the catalog client
+		actions int
+	}{
+		{
+			name: "owner takes precedence",
+			ownerBroker: func() *v1beta1.ClusterServiceBroker {
+				b := getTestClusterServiceBroker()
+				b.Name = "notTheSame"
+				b.Spec.Priority = 10
+				return b
+			}(),
+			actions: 4,
+		},
+		{
+			name:     "class in use",
+			instance: getTestServiceInstanceWithClusterRefs(),
+			actions:  5,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
+
+			testClusterServiceClass := getTestClusterServiceClass()
+			testClusterServiceClass.Spec.ClusterServiceBrokerName = "notTheSame"
+
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(testClusterServiceClass)
+			if tc.ownerBroker != nil {
+				sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(tc.ownerBroker)
+			}
+			if tc.instance != nil {
+				// the instance is not in the lister, as when it does not
+				// match the watch label selector of the controller
+				fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+					return true, &v1beta1.ServiceInstanceList{
+						Items: []v1beta1.ServiceInstance{*tc.instance},
+					}, nil
+				})
+			}
+
+			if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, tc.actions)
+
+			updatedClusterServiceClass, ok := assertUpdateStatus(t, actions[len(actions)-2], testClusterServiceClass).(*v1beta1.ClusterServiceClass)
+			if !ok {
+				t.Fatalf("couldn't convert to *v1beta1.ClusterServiceClass")
+			}
+			if e, a := []string{testClusterServiceBrokerName}, updatedClusterServiceClass.Status.ConflictingBrokers; !reflect.DeepEqual(e, a) {
+				t.Fatalf("unexpected conflicting brokers; %s", expectedGot(e, a))
+			}
+			conditions := updatedClusterServiceClass.Status.Conditions
+			if len(conditions) != 1 || conditions[0].Type != v1beta1.ServiceClassConditionConflict || conditions[0].Status != v1beta1.ConditionTrue {
+				t.Fatalf("expected a true Conflict condition, got %v", conditions)
+			}
+
+			updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], getTestClusterServiceBroker())
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+			events := getRecordedEvents(testController)
+			expectedEvents := []string{
+				warningEventBuilder(catalogConflictReason).String(),
+				normalEventBuilder(successFetchedCatalogReason).msg(successFetchedCatalogMessage).String(),
+			}
+			if err := checkEventPrefixes(events, expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass simulates catalog
 // refresh where broker lists a service plan which matches an existing, already
-// cataloged service plan but the plan points to a different ClusterServiceClass.  Results in an error.
+// cataloged service plan but the plan points to a different ClusterServiceClass
+// and a ClusterServiceBroker that no longer exists. The broker takes the unused
+// plan over.
 func TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass(t *testing.T) {
 	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
 
@@ -559,8 +872,8 @@ func TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass(t
 
 	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(testClusterServicePlan)
 
-	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err == nil {
-		t.Fatal("The same service class should not belong to two different brokers.")
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
 	}
 
 	brokerActions := fakeClusterServiceBrokerClient.Actions()
@@ -568,7 +881,7 @@ func TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass(t
 	assertGetCatalog(t, brokerActions[0])
 
 	actions := fakeCatalogClient.Actions()
-	assertNumberOfActions(t, actions, 4)
+	assertNumberOfActions(t, actions, 8)
 
 	listRestrictions := clientgotesting.ListRestrictions{
 		Labels: labels.SelectorFromSet(labels.Set{
@@ -579,8 +892,17 @@ func TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass(t
 	assertList(t, actions[0], &v1beta1.ClusterServiceClass{}, listRestrictions)
 	assertList(t, actions[1], &v1beta1.ClusterServicePlan{}, listRestrictions)
 	assertCreate(t, actions[2], getTestClusterServiceClass())
-	updatedClusterServiceBroker := assertUpdateStatus(t, actions[3], getTestClusterServiceBroker())
-	assertClusterServiceBrokerReadyFalse(t, updatedClusterServiceBroker)
+	assertList(t, actions[3], &v1beta1.ServiceInstance{}, clientgotesting.ListRestrictions{
+		Labels: labels.SelectorFromSet(labels.Set{
+			v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServicePlanRefName: util.GenerateSHA(testClusterServicePlanGUID),
+		}),
+		Fields: fields.Everything(),
+	})
+	assertDelete(t, actions[4], testClusterServicePlan)
+	assertCreate(t, actions[5], getTestClusterServicePlan())
+	assertCreate(t, actions[6], getTestClusterServicePlanNonbindable())
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[7], getTestClusterServiceBroker())
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
 
 	// verify no kube resources created
 	kubeActions := fakeKubeClient.Actions()
@@ -588,18 +910,127 @@ func TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass(t
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorSyncingCatalogReason).msgf(
-		"Error reconciling ClusterServicePlan (K8S: %q ExternalName: %q):",
-		testClusterServicePlanGUID, testClusterServicePlanName,
-	).msgf(
-		"ClusterServicePlan (K8S: %q ExternalName: %q) already exists for Broker %q",
+	expectedEvent := normalEventBuilder(tookOverClusterServicePlanReason).msgf(
+		"Took over ClusterServicePlan (K8S: %q ExternalName: %q) from ClusterServiceBroker %q",
 		testClusterServicePlanGUID, testClusterServicePlanName, "notTheSame",
 	)
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+	expectedEvents := []string{
+		expectedEvent.String(),
+		normalEventBuilder(successFetchedCatalogReason).msg(successFetchedCatalogMessage).String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// TestReconcileClusterServiceBrokerClusterServicePlanConflict simulates
+// catalog refresh where broker lists a plan which matches an existing plan
+// belonging to a different, existing ClusterServiceBroker. The plan is taken
+// over when the broker takes precedence and the plan is unused; otherwise it
+// is skipped and the conflict is recorded on the existing plan.
+func TestReconcileClusterServiceBrokerClusterServicePlanConflict(t *testing.T) {
+	cases := []struct {
+		name          string
+		ownerPriority int32
+		instance      *v1beta1.ServiceInstance
+		// actions is the number of actions on the catalog client
+		actions  int
+		takeOver bool
+	}{
+		{
+			name:          "broker takes precedence",
+			ownerPriority: -10,
+			actions:       8,
+			takeOver:      true,
+		},
+		{
+			name:          "owner takes precedence",
+			ownerPriority: 10,
+			actions:       6,
+		},
+		{
+			name:          "plan in use",
+			ownerPriority: -10,
+			instance:      getTestServiceInstanceWithClusterRefs(),
+			actions:       7,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
+
+			ownerBroker := getTestClusterServiceBroker()
+			ownerBroker.Name = "notTheSame"
+			ownerBroker.Spec.Priority = tc.ownerPriority
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(ownerBroker)
+
+			testClusterServicePlan := getTestClusterServicePlan()
+			testClusterServicePlan.Spec.ClusterServiceBrokerName = ownerBroker.Name
+			testClusterServicePlan.Spec.ClusterServiceClassRef = v1beta1.ClusterObjectReference{
+				Name: "notTheSameClass",
+			}
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(testClusterServicePlan)
+
+			if tc.instance != nil {
+				fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+					return true, &v1beta1.ServiceInstanceList{
+						Items: []v1beta1.ServiceInstance{*tc.instance},
+					}, nil
+				})
+			}
+
+			if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, tc.actions)
+
+			updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], getTestClusterServiceBroker())
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+			events := getRecordedEvents(testController)
+			if tc.takeOver {
+				assertDelete(t, actions[len(actions)-4], testClusterServicePlan)
+				assertCreate(t, actions[len(actions)-3], getTestClusterServicePlan())
+
+				expectedEvents := []string{
+					normalEventBuilder(tookOverClusterServicePlanReason).msgf(
+						"Took over ClusterServicePlan (K8S: %q ExternalName: %q) from ClusterServiceBroker %q",
+						testClusterServicePlanGUID, testClusterServicePlanName, ownerBroker.Name,
+					).String(),
+					normalEventBuilder(successFetchedCatalogReason).msg(successFetchedCatalogMessage).String(),
+				}
+				if err := checkEvents(events, expectedEvents); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			updatedClusterServicePlan, ok := assertUpdateStatus(t, actions[len(actions)-3], testClusterServicePlan).(*v1beta1.ClusterServicePlan)
+			if !ok {
+				t.Fatalf("couldn't convert to *v1beta1.ClusterServicePlan")
+			}
+			if e, a := []string{testClusterServiceBrokerName}, updatedClusterServicePlan.Status.ConflictingBrokers; !reflect.DeepEqual(e, a) {
+				t.Fatalf("unexpected conflicting brokers; %s", expectedGot(e, a))
+			}
+			conditions := updatedClusterServicePlan.Status.Conditions
+			if len(conditions) != 1 || conditions[0].Type != v1beta1.ServicePlanConditionConflict || conditions[0].Status != v1beta1.ConditionTrue {
+				t.Fatalf("expected a true Conflict condition, got %v", conditions)
+			}
+
+			expectedEvents := []string{
+				warningEventBuilder(catalogConflictReason).String(),
+				normalEventBuilder(successFetchedCatalogReason).msg(successFetchedCatalogMessage).String(),
+			}
+			if err := checkEventPrefixes(events, expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 func getClusterServiceBrokerReactor(broker *v1beta1.ClusterServiceBroker) (string, string, clientgotesting.ReactionFunc) {
 	return "get", "clusterservicebrokers", func(action clientgotesting.Action) (bool, runtime.Object, error) {
 		return true, broker, nil
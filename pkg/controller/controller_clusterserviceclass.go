@@ -32,6 +32,20 @@ import (
 // Service class handlers and control-loop
 
 func (c *controller) clusterServiceClassAdd(obj interface{}) {
+	c.enqueueClusterServiceClass(obj)
+
+	// Instances that were created before the ClusterServiceClass appeared in the
+	// broker's catalog may be waiting on it to resolve their references.
+	if clusterServiceClass, ok := obj.(*v1beta1.ClusterServiceClass); ok {
+		c.enqueueInstancesReferencingClusterServiceClass(clusterServiceClass)
+	}
+}
+
+func (c *controller) clusterServiceClassUpdate(oldObj, newObj interface{}) {
+	c.enqueueClusterServiceClass(newObj)
+}
+
+func (c *controller) enqueueClusterServiceClass(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("Couldn't get key for object %+v: %v", obj, err)
@@ -40,10 +54,6 @@ func (c *controller) clusterServiceClassAdd(obj interface{}) {
 	c.clusterServiceClassQueue.Add(key)
 }
 
-func (c *controller) clusterServiceClassUpdate(oldObj, newObj interface{}) {
-	c.clusterServiceClassAdd(newObj)
-}
-
 func (c *controller) clusterServiceClassDelete(obj interface{}) {
 	serviceClass, ok := obj.(*v1beta1.ClusterServiceClass)
 	if serviceClass == nil || !ok {
@@ -74,6 +84,10 @@ func (c *controller) reconcileClusterServiceClassKey(key string) error {
 func (c *controller) reconcileClusterServiceClass(serviceClass *v1beta1.ClusterServiceClass) error {
 	klog.Infof("ClusterServiceClass %q (ExternalName: %q): processing", serviceClass.Name, serviceClass.Spec.ExternalName)
 
+	if c.readOnly {
+		return nil
+	}
+
 	if !serviceClass.Status.RemovedFromBrokerCatalog {
 		return nil
 	}
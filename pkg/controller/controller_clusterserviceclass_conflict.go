@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	catalogConflictReason             string = "CatalogConflict"
+	noCatalogConflictReason           string = "NoCatalogConflict"
+	noCatalogConflictMessage          string = "No other broker offers a class with the same external ID"
+	noPlanCatalogConflictMessage      string = "No other broker offers a plan with the same external ID"
+	tookOverClusterServiceClassReason string = "TookOverClusterServiceClass"
+	tookOverClusterServicePlanReason  string = "TookOverClusterServicePlan"
+
+	catalogConflictPrecedenceExplanation string = "that broker takes precedence"
+	catalogConflictInUseExplanation      string = "it is in use by ServiceInstances"
+)
+
+// catalogConflictError is returned when a class or plan in a
+// ClusterServiceBroker's catalog cannot be reconciled because an object with
+// the same name belongs to another broker.
+type catalogConflictError struct {
+	message string
+}
+
+func (e *catalogConflictError) Error() string {
+	return e.message
+}
+
+// isCatalogConflictError returns whether err is a catalogConflictError.
+func isCatalogConflictError(err error) bool {
+	_, ok := err.(*catalogConflictError)
+	return ok
+}
+
+// clusterServiceBrokerTakesPrecedence returns whether broker a takes
+// precedence over broker b for classes and plans offered by both: the broker
+// with the highest priority wins and ties go to the broker whose name sorts
+// first, so that the outcome does not depend on the order the brokers are
+// relisted in.
+func clusterServiceBrokerTakesPrecedence(a, b *v1beta1.ClusterServiceBroker) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	return a.Name < b.Name
+}
+
+// clusterServiceBrokerPrecedesOwner returns whether broker takes precedence
+// over the named broker currently owning a class or plan. An owner that no
+// longer exists never takes precedence.
+func (c *controller) clusterServiceBrokerPrecedesOwner(broker *v1beta1.ClusterServiceBroker, ownerName string) (bool, error) {
+	owner, err := c.clusterServiceBrokerLister.Get(ownerName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return clusterServiceBrokerTakesPrecedence(broker, owner), nil
+}
+
+// takeOverClusterServiceClass resolves the conflict between broker and the
+// broker owning serviceClass, which broker's catalog also offers. If broker
+// takes precedence and no ServiceInstance uses the class, the class is
+// deleted so that broker can create its own. Otherwise broker is recorded
+// as conflicting on the class and a catalogConflictError is returned.
+func (c *controller) takeOverClusterServiceClass(broker *v1beta1.ClusterServiceBroker, serviceClass *v1beta1.ClusterServiceClass) error {
+	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
+	ownerName := serviceClass.Spec.ClusterServiceBrokerName
+
+	precedes, err := c.clusterServiceBrokerPrecedesOwner(broker, ownerName)
+	if err != nil {
+		return err
+	}
+	explanation := catalogConflictPrecedenceExplanation
+	if precedes {
+		inUse, err := c.clusterServiceClassInUse(serviceClass)
+		if err != nil {
+			return err
+		}
+		if !inUse {
+			klog.V(4).Info(pcb.Messagef("Taking over %s from ClusterServiceBroker %q", pretty.ClusterServiceClassName(serviceClass), ownerName))
+			if err := c.serviceCatalogClient.ClusterServiceClasses().Delete(context.Background(), serviceClass.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			c.recorder.Eventf(broker, corev1.EventTypeNormal, tookOverClusterServiceClassReason,
+				"Took over %s from ClusterServiceBroker %q", pretty.ClusterServiceClassName(serviceClass), ownerName)
+			return nil
+		}
+		explanation = catalogConflictInUseExplanation
+	}
+
+	if err := c.addClusterServiceClassConflict(serviceClass, broker.Name); err != nil {
+		return err
+	}
+	return &catalogConflictError{
+		message: fmt.Sprintf("%s belongs to ClusterServiceBroker %q and %s; skipping it",
+			pretty.ClusterServiceClassName(serviceClass), ownerName, explanation),
+	}
+}
+
+// takeOverClusterServicePlan is takeOverClusterServiceClass for plans.
+func (c *controller) takeOverClusterServicePlan(broker *v1beta1.ClusterServiceBroker, servicePlan *v1beta1.ClusterServicePlan) error {
+	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
+	ownerName := servicePlan.Spec.ClusterServiceBrokerName
+
+	precedes, err := c.clusterServiceBrokerPrecedesOwner(broker, ownerName)
+	if err != nil {
+		return err
+	}
+	explanation := catalogConflictPrecedenceExplanation
+	if precedes {
+		inUse, err := c.clusterServicePlanInUse(servicePlan)
+		if err != nil {
+			return err
+		}
+		if !inUse {
+			klog.V(4).Info(pcb.Messagef("Taking over %s from ClusterServiceBroker %q", pretty.ClusterServicePlanName(servicePlan), ownerName))
+			if err := c.serviceCatalogClient.ClusterServicePlans().Delete(context.Background(), servicePlan.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			c.recorder.Eventf(broker, corev1.EventTypeNormal, tookOverClusterServicePlanReason,
+				"Took over %s from ClusterServiceBroker %q", pretty.ClusterServicePlanName(servicePlan), ownerName)
+			return nil
+		}
+		explanation = catalogConflictInUseExplanation
+	}
+
+	if err := c.addClusterServicePlanConflict(servicePlan, broker.Name); err != nil {
+		return err
+	}
+	return &catalogConflictError{
+		message: fmt.Sprintf("%s belongs to ClusterServiceBroker %q and %s; skipping it",
+			pretty.ClusterServicePlanName(servicePlan), ownerName, explanation),
+	}
+}
+
+// clusterServiceClassInUse returns whether any ServiceInstance refers to
+// serviceClass. The instances are listed from the API server rather than the
+// instance lister, which only holds the instances matching the watch label
+// selector of the controller: an instance reconciled by another controller
+// still uses the class.
+func (c *controller) clusterServiceClassInUse(serviceClass *v1beta1.ClusterServiceClass) (bool, error) {
+	instances, err := c.findServiceInstancesOnClusterServiceClass(serviceClass)
+	if err != nil {
+		return false, err
+	}
+	return len(instances.Items) > 0, nil
+}
+
+// clusterServicePlanInUse returns whether any ServiceInstance refers to
+// servicePlan, listing the instances from the API server like
+// clusterServiceClassInUse.
+func (c *controller) clusterServicePlanInUse(servicePlan *v1beta1.ClusterServicePlan) (bool, error) {
+	instances, err := c.findServiceInstancesOnClusterServicePlan(servicePlan)
+	if err != nil {
+		return false, err
+	}
+	return len(instances.Items) > 0, nil
+}
+
+// addClusterServiceClassConflict records that brokerName also offers
+// serviceClass.
+func (c *controller) addClusterServiceClassConflict(serviceClass *v1beta1.ClusterServiceClass, brokerName string) error {
+	brokers := sets.NewString(serviceClass.Status.ConflictingBrokers...)
+	if brokers.Has(brokerName) {
+		return nil
+	}
+	return c.updateClusterServiceClassConflicts(serviceClass, brokers.Insert(brokerName))
+}
+
+// clearClusterServiceClassConflicts removes brokerName from the conflicting
+// brokers of every ClusterServiceClass except those named in keep, once the
+// broker no longer offers them.
+func (c *controller) clearClusterServiceClassConflicts(brokerName string, keep sets.String) error {
+	serviceClasses, err := c.clusterServiceClassLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, serviceClass := range serviceClasses {
+		if keep.Has(serviceClass.Name) {
+			continue
+		}
+		brokers := sets.NewString(serviceClass.Status.ConflictingBrokers...)
+		if !brokers.Has(brokerName) {
+			continue
+		}
+		if err := c.updateClusterServiceClassConflicts(serviceClass, brokers.Delete(brokerName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateClusterServiceClassConflicts records brokers as the conflicting
+// brokers of serviceClass and sets its Conflict condition accordingly.
+func (c *controller) updateClusterServiceClassConflicts(serviceClass *v1beta1.ClusterServiceClass, brokers sets.String) error {
+	toUpdate := serviceClass.DeepCopy()
+	toUpdate.Status.ConflictingBrokers = brokers.List()
+	if brokers.Len() > 0 {
+		setServiceClassCondition(&toUpdate.Status.CommonServiceClassStatus, v1beta1.ServiceClassConditionConflict, v1beta1.ConditionTrue, catalogConflictReason,
+			fmt.Sprintf("A class with the same external ID is also offered by ClusterServiceBroker(s) %s", strings.Join(toUpdate.Status.ConflictingBrokers, ", ")))
+	} else {
+		setServiceClassCondition(&toUpdate.Status.CommonServiceClassStatus, v1beta1.ServiceClassConditionConflict, v1beta1.ConditionFalse, noCatalogConflictReason, noCatalogConflictMessage)
+	}
+
+	if _, err := c.serviceCatalogClient.ClusterServiceClasses().UpdateStatus(context.Background(), toUpdate, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating status of %s: %v", pretty.ClusterServiceClassName(serviceClass), err)
+	}
+	return nil
+}
+
+// addClusterServicePlanConflict records that brokerName also offers
+// servicePlan.
+func (c *controller) addClusterServicePlanConflict(servicePlan *v1beta1.ClusterServicePlan, brokerName string) error {
+	brokers := sets.NewString(servicePlan.Status.ConflictingBrokers...)
+	if brokers.Has(brokerName) {
+		return nil
+	}
+	return c.updateClusterServicePlanConflicts(servicePlan, brokers.Insert(brokerName))
+}
+
+// clearClusterServicePlanConflicts is clearClusterServiceClassConflicts for
+// plans.
+func (c *controller) clearClusterServicePlanConflicts(brokerName string, keep sets.String) error {
+	servicePlans, err := c.clusterServicePlanLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, servicePlan := range servicePlans {
+		if keep.Has(servicePlan.Name) {
+			continue
+		}
+		brokers := sets.NewString(servicePlan.Status.ConflictingBrokers...)
+		if !brokers.Has(brokerName) {
+			continue
+		}
+		if err := c.updateClusterServicePlanConflicts(servicePlan, brokers.Delete(brokerName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateClusterServicePlanConflicts records brokers as the conflicting
+// brokers of servicePlan and sets its Conflict condition accordingly.
+func (c *controller) updateClusterServicePlanConflicts(servicePlan *v1beta1.ClusterServicePlan, brokers sets.String) error {
+	toUpdate := servicePlan.DeepCopy()
+	toUpdate.Status.ConflictingBrokers = brokers.List()
+	if brokers.Len() > 0 {
+		setServicePlanCondition(&toUpdate.Status.CommonServicePlanStatus, v1beta1.ServicePlanConditionConflict, v1beta1.ConditionTrue, catalogConflictReason,
+			fmt.Sprintf("A plan with the same external ID is also offered by ClusterServiceBroker(s) %s", strings.Join(toUpdate.Status.ConflictingBrokers, ", ")))
+	} else {
+		setServicePlanCondition(&toUpdate.Status.CommonServicePlanStatus, v1beta1.ServicePlanConditionConflict, v1beta1.ConditionFalse, noCatalogConflictReason, noPlanCatalogConflictMessage)
+	}
+
+	if _, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(context.Background(), toUpdate, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating status of %s: %v", pretty.ClusterServicePlanName(servicePlan), err)
+	}
+	return nil
+}
+
+// setServiceClassCondition sets a single condition on a class's status. The
+// LastTransitionTime is only updated when the condition's status changes.
+func setServiceClassCondition(status *v1beta1.CommonServiceClassStatus, conditionType v1beta1.ServiceClassConditionType, conditionStatus v1beta1.ConditionStatus, reason, message string) {
+	newCondition := v1beta1.ServiceClassCondition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+	for i, cond := range status.Conditions {
+		if cond.Type != conditionType {
+			continue
+		}
+		if cond.Status == conditionStatus {
+			newCondition.LastTransitionTime = cond.LastTransitionTime
+		}
+		status.Conditions[i] = newCondition
+		return
+	}
+	status.Conditions = append(status.Conditions, newCondition)
+}
+
+// setServicePlanCondition is setServiceClassCondition for plans.
+func setServicePlanCondition(status *v1beta1.CommonServicePlanStatus, conditionType v1beta1.ServicePlanConditionType, conditionStatus v1beta1.ConditionStatus, reason, message string) {
+	newCondition := v1beta1.ServicePlanCondition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+	for i, cond := range status.Conditions {
+		if cond.Type != conditionType {
+			continue
+		}
+		if cond.Status == conditionStatus {
+			newCondition.LastTransitionTime = cond.LastTransitionTime
+		}
+		status.Conditions[i] = newCondition
+		return
+	}
+	status.Conditions = append(status.Conditions, newCondition)
+}
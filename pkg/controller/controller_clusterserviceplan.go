@@ -32,6 +32,20 @@ import (
 // Cluster service plan handlers and control-loop
 
 func (c *controller) clusterServicePlanAdd(obj interface{}) {
+	c.enqueueClusterServicePlan(obj)
+
+	// Instances that were created before the ClusterServicePlan appeared in the
+	// broker's catalog may be waiting on it to resolve their references.
+	if clusterServicePlan, ok := obj.(*v1beta1.ClusterServicePlan); ok {
+		c.enqueueInstancesReferencingClusterServicePlan(clusterServicePlan)
+	}
+}
+
+func (c *controller) clusterServicePlanUpdate(oldObj, newObj interface{}) {
+	c.enqueueClusterServicePlan(newObj)
+}
+
+func (c *controller) enqueueClusterServicePlan(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("ClusterServicePlan: Couldn't get key for object %+v: %v", obj, err)
@@ -40,10 +54,6 @@ func (c *controller) clusterServicePlanAdd(obj interface{}) {
 	c.clusterServicePlanQueue.Add(key)
 }
 
-func (c *controller) clusterServicePlanUpdate(oldObj, newObj interface{}) {
-	c.clusterServicePlanAdd(newObj)
-}
-
 func (c *controller) clusterServicePlanDelete(obj interface{}) {
 	clusterServicePlan, ok := obj.(*v1beta1.ClusterServicePlan)
 	if clusterServicePlan == nil || !ok {
@@ -75,10 +85,14 @@ func (c *controller) reconcileClusterServicePlanKey(key string) error {
 func (c *controller) reconcileClusterServicePlan(clusterServicePlan *v1beta1.ClusterServicePlan) error {
 	klog.Infof("ClusterServicePlan %q (ExternalName: %q): processing", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
 
-	if !clusterServicePlan.Status.RemovedFromBrokerCatalog {
+	if c.readOnly {
 		return nil
 	}
 
+	if !clusterServicePlan.Status.RemovedFromBrokerCatalog {
+		return c.syncClusterServicePlanParameters(clusterServicePlan)
+	}
+
 	klog.Infof("ClusterServicePlan %q (ExternalName: %q): has been removed from broker catalog; determining whether there are instances remaining", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
 
 	serviceInstances, err := c.findServiceInstancesOnClusterServicePlan(clusterServicePlan)
@@ -155,3 +155,44 @@ func reconcileClusterServicePlan(t *testing.T, testController *controller, clust
 	}
 	return err
 }
+
+func TestClusterServicePlanAddEnqueuesUnresolvedInstances(t *testing.T) {
+	cases := []struct {
+		name            string
+		instance        *v1beta1.ServiceInstance
+		expectedEnqueue bool
+	}{
+		{
+			name:            "unresolved instance referencing the plan",
+			instance:        getTestServiceInstance(),
+			expectedEnqueue: true,
+		},
+		{
+			name:            "resolved instance referencing the plan",
+			instance:        getTestServiceInstanceWithClusterRefs(),
+			expectedEnqueue: false,
+		},
+		{
+			name: "unresolved instance referencing another plan",
+			instance: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstance()
+				instance.Spec.ClusterServicePlanExternalName = "other-plan"
+				return instance
+			}(),
+			expectedEnqueue: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(tc.instance)
+
+			testController.clusterServicePlanAdd(getTestClusterServicePlan())
+
+			if e, a := tc.expectedEnqueue, testController.instanceQueue.Len() == 1; e != a {
+				t.Fatalf("expected instance to be enqueued: %v, got %v", e, a)
+			}
+		})
+	}
+}
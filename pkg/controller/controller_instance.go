@@ -20,7 +20,6 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
-	"net/url"
 	"reflect"
 	"sync"
 	"time"
@@ -28,8 +27,10 @@ import (
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/planschema"
 	"github.com/drycc-addons/service-catalog/pkg/pretty"
 	"github.com/drycc-addons/service-catalog/pkg/util"
+	scparameters "github.com/drycc-addons/service-catalog/pkg/util/parameters"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -78,6 +79,7 @@ const (
 	errorFindingNamespaceServiceInstanceReason string = "ErrorFindingNamespaceForInstance"
 	errorOrphanMitigationFailedReason          string = "OrphanMitigationFailed"
 	errorInvalidDeprovisionStatusReason        string = "InvalidDeprovisionStatus"
+	errorMaintenanceInfoConflictReason         string = "MaintenanceInfoConflict"
 
 	errorAmbiguousPlanReferenceScope string = "couldn't determine if the instance refers to a Cluster or Namespaced ServiceClass/Plan"
 
@@ -110,6 +112,7 @@ type backoffEntry struct {
 	generation          int64
 	calculatedRetryTime time.Time // earliest time we should retry
 	dirty               bool      // true indicates new backoff should be calculated
+	brokerBusy          bool      // true indicates the broker answered it was busy
 }
 
 type instanceOperationBackoff struct {
@@ -117,6 +120,9 @@ type instanceOperationBackoff struct {
 	mutex       sync.RWMutex
 	instances   map[string]backoffEntry // Key is K8s metadata UID
 	rateLimiter workqueue.RateLimiter   // used to calculate next retry time, key is UID
+	// brokerBusyRateLimiter calculates the next retry time after the broker
+	// answered it was busy, key is UID
+	brokerBusyRateLimiter workqueue.RateLimiter
 }
 
 // ServiceInstance handlers and control-loop
@@ -142,6 +148,81 @@ func (c *controller) enqueueInstanceAfter(obj interface{}, d time.Duration) {
 	c.instanceQueue.AddAfter(key, d)
 }
 
+// enqueueInstancesReferencingClusterServiceClass adds to the work queue the
+// instances whose class and plan references are not yet resolved and that
+// refer to the given ClusterServiceClass.
+func (c *controller) enqueueInstancesReferencingClusterServiceClass(serviceClass *v1beta1.ClusterServiceClass) {
+	names := sets.NewString(serviceClass.Name, serviceClass.Spec.ExternalName, serviceClass.Spec.ExternalID)
+	c.enqueueInstancesWithUnresolvedReferences(metav1.NamespaceAll, func(instance *v1beta1.ServiceInstance) bool {
+		return instance.Spec.ClusterServiceClassSpecified() &&
+			names.Has(instance.Spec.GetSpecifiedClusterServiceClass())
+	})
+}
+
+// enqueueInstancesReferencingClusterServicePlan adds to the work queue the
+// instances whose class and plan references are not yet resolved and that
+// refer to the given ClusterServicePlan.
+func (c *controller) enqueueInstancesReferencingClusterServicePlan(servicePlan *v1beta1.ClusterServicePlan) {
+	names := sets.NewString(servicePlan.Name, servicePlan.Spec.ExternalName, servicePlan.Spec.ExternalID)
+	c.enqueueInstancesWithUnresolvedReferences(metav1.NamespaceAll, func(instance *v1beta1.ServiceInstance) bool {
+		return instance.Spec.ClusterServicePlanSpecified() &&
+			names.Has(instance.Spec.GetSpecifiedClusterServicePlan())
+	})
+}
+
+// enqueueInstancesReferencingServiceClass adds to the work queue the
+// instances in the class's namespace whose class and plan references are not
+// yet resolved and that refer to the given ServiceClass.
+func (c *controller) enqueueInstancesReferencingServiceClass(serviceClass *v1beta1.ServiceClass) {
+	names := sets.NewString(serviceClass.Name, serviceClass.Spec.ExternalName, serviceClass.Spec.ExternalID)
+	c.enqueueInstancesWithUnresolvedReferences(serviceClass.Namespace, func(instance *v1beta1.ServiceInstance) bool {
+		return instance.Spec.ServiceClassSpecified() &&
+			names.Has(instance.Spec.GetSpecifiedServiceClass())
+	})
+}
+
+// enqueueInstancesReferencingServicePlan adds to the work queue the instances
+// in the plan's namespace whose class and plan references are not yet
+// resolved and that refer to the given ServicePlan.
+func (c *controller) enqueueInstancesReferencingServicePlan(servicePlan *v1beta1.ServicePlan) {
+	names := sets.NewString(servicePlan.Name, servicePlan.Spec.ExternalName, servicePlan.Spec.ExternalID)
+	c.enqueueInstancesWithUnresolvedReferences(servicePlan.Namespace, func(instance *v1beta1.ServiceInstance) bool {
+		return instance.Spec.ServicePlanSpecified() &&
+			names.Has(instance.Spec.GetSpecifiedServicePlan())
+	})
+}
+
+// enqueueInstancesWithUnresolvedReferences adds to the work queue every
+// instance in the given namespace that is missing a class or plan reference
+// and matches the given predicate.
+func (c *controller) enqueueInstancesWithUnresolvedReferences(namespace string, matches func(*v1beta1.ServiceInstance) bool) {
+	instances, err := c.instanceLister.ServiceInstances(namespace).List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Couldn't list ServiceInstances in namespace %q: %v", namespace, err)
+		return
+	}
+	for _, instance := range instances {
+		if instance.DeletionTimestamp != nil || !hasUnresolvedReferences(instance) || !matches(instance) {
+			continue
+		}
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.V(4).Info(pcb.Message("Requeueing to resolve class and plan references"))
+		c.enqueueInstance(instance)
+	}
+}
+
+// hasUnresolvedReferences returns whether the instance is still waiting for
+// its class or plan reference to be resolved.
+func hasUnresolvedReferences(instance *v1beta1.ServiceInstance) bool {
+	if instance.Spec.ClusterServiceClassSpecified() {
+		return instance.Spec.ClusterServiceClassRef == nil || instance.Spec.ClusterServicePlanRef == nil
+	}
+	if instance.Spec.ServiceClassSpecified() {
+		return instance.Spec.ServiceClassRef == nil || instance.Spec.ServicePlanRef == nil
+	}
+	return false
+}
+
 // instanceAdd handles the ServiceInstance ADDED watch event
 func (c *controller) instanceAdd(obj interface{}) {
 	if klog.V(eventHandlerLogLevel).Enabled() {
@@ -180,6 +261,8 @@ func (c *controller) instanceDelete(obj interface{}) {
 		return
 	}
 
+	c.forgetServiceInstanceUpdateDebounce(instance)
+
 	if klog.V(eventHandlerLogLevel).Enabled() {
 		pcb := pretty.NewInstanceContextBuilder(instance)
 		klog.Info(pcb.Messagef("Received DELETE event: %v", toJSON(instance)))
@@ -188,37 +271,59 @@ func (c *controller) instanceDelete(obj interface{}) {
 }
 
 // Async operations on instances have a somewhat convoluted flow in order to
-// ensure that only a single goroutine works on an instance at any given time.
-// The flow is:
+// ensure that the polls of the operations of a slow broker never hold up
+// those of the other brokers. The flow is:
 //
 // 1.  When the controller wants to begin polling the state of an operation on
 //     an instance, it calls its beginPollingServiceInstance method (or
 //     calls continuePollingServiceInstance, an alias of that method)
 // 2.  begin/continuePollingServiceInstance do a rate-limited add to the polling queue
-// 3.  the instancePollingQueue calls requeueServiceInstanceForPoll, which adds the instance's
-//     key to the instance work queue
-// 4.  the worker servicing the instance polling queue forgets the instances key,
-//     requiring the controller to call continuePollingServiceInstance if additional
-//     work is needed.
-// 5.  the instance work queue is the single work queue that actually services
-//     instances by calling reconcileServiceInstance
+//     of the instance's broker
+// 3.  the workers of the polling queue of the broker call pollServiceInstanceKey,
+//     which polls the last operation of the instance. The instance work queue
+//     does not get the instance meanwhile, as the updates of instances with an
+//     operation in progress are not enqueued.
+// 4.  the worker servicing the instance polling queue does not forget the instance's
+//     key, so that the polls back off, requiring the controller to call
+//     continuePollingServiceInstance if additional work is needed.
+// 5.  the instances that are no longer polled, or whose poll fails, are handed
+//     to the instance work queue, which services them by calling
+//     reconcileServiceInstance
 // 6.  when an asynchronous operation is completed, the controller calls
 //     finishPollingServiceInstance to forget the instance from the polling queue
 
-// requeueServiceInstanceForPoll adds the given instance key to the controller's work
-// queue for instances.  It is used to trigger polling for the status of an
-// async operation on and instance and is called by the worker servicing the
-// instance polling queue.  After requeueServiceInstanceForPoll exits, the worker
-// forgets the key from the polling queue, so the controller must call
-// continuePollingServiceInstance if the instance requires additional polling.
-func (c *controller) requeueServiceInstanceForPoll(key string) error {
-	c.instanceQueue.Add(key)
+// pollServiceInstanceKey polls the last operation of the instance of the
+// given key. It is called by the workers of the polling queue of the broker
+// of the instance. The instances without an operation in progress are added
+// to the instance work queue instead, as are the instances whose poll fails,
+// to be retried with the rate limiting of the instance work queue.
+func (c *controller) pollServiceInstanceKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	pcb := pretty.NewContextBuilder(pretty.ServiceInstance, namespace, name, "")
+	instance, err := c.instanceLister.ServiceInstances(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.Info(pcb.Messagef("Not polling %v because it has been deleted", key))
+		c.instancePollingQueue.Forget(key)
+		return nil
+	}
+	if err != nil || !instance.Status.AsyncOpInProgress {
+		c.instanceQueue.Add(key)
+		return nil
+	}
 
+	if err := c.reconcileServiceInstance(instance); err != nil {
+		klog.V(4).Info(pcb.Messagef("Error polling %v: %v", key, err))
+		c.instanceQueue.AddRateLimited(key)
+	}
 	return nil
 }
 
 // beginPollingServiceInstance does a rate-limited add of the key for the given
-// instance to the controller's instance polling queue.
+// instance to the instance polling queue of its broker, or a delayed add when
+// the broker of the instance uses operation callbacks.
 func (c *controller) beginPollingServiceInstance(instance *v1beta1.ServiceInstance) error {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(instance)
 	if err != nil {
@@ -228,7 +333,15 @@ func (c *controller) beginPollingServiceInstance(instance *v1beta1.ServiceInstan
 		return fmt.Errorf(s)
 	}
 
-	c.instancePollingQueue.AddRateLimited(key)
+	// The broker notifies the controller when the operation completes, so
+	// the instance is only polled at the longest interval in case a
+	// notification is lost.
+	if c.serviceInstanceUsesOperationCallbacks(instance) {
+		c.instancePollingQueue.AddAfter(c.pollingBrokerOfServiceInstance(instance), key, c.operationPollingMaximumBackoffDuration)
+		return nil
+	}
+
+	c.instancePollingQueue.AddRateLimited(c.pollingBrokerOfServiceInstance(instance), key)
 
 	return nil
 }
@@ -309,6 +422,11 @@ func (c *controller) reconcileServiceInstanceKey(key string) error {
 // error is returned to indicate that the instance has not been fully
 // processed and should be resubmitted at a later time.
 func (c *controller) reconcileServiceInstance(instance *v1beta1.ServiceInstance) error {
+	if c.readOnly {
+		c.recordReadOnlyDrift(instance, pretty.NewInstanceContextBuilder(instance), serviceInstanceDrift(instance))
+		return nil
+	}
+
 	updated, err := c.initObservedGeneration(instance)
 	if err != nil {
 		return err
@@ -412,9 +530,11 @@ func (c *controller) setRetryBackoffRequired(instance *v1beta1.ServiceInstance)
 		// reset the backoff as the generation changed
 		if found {
 			c.instanceOperationRetryQueue.rateLimiter.Forget(key)
+			c.instanceOperationRetryQueue.brokerBusyRateLimiter.Forget(key)
 		}
 	}
 	retryEntry.dirty = true
+	retryEntry.brokerBusy = false
 	c.instanceOperationRetryQueue.instances[key] = retryEntry
 	klog.V(4).Info(pcb.Messagef("BrokerOpRetry: added %v (%v/%v) generation %v to backoffBeforeRetrying map", key, instance.GetNamespace(), instance.GetName(), instance.Generation))
 }
@@ -439,11 +559,17 @@ func (c *controller) backoffAndRequeueIfRetrying(instance *v1beta1.ServiceInstan
 			// cleanup and no delay
 			delete(c.instanceOperationRetryQueue.instances, key)
 			c.instanceOperationRetryQueue.rateLimiter.Forget(key)
+			c.instanceOperationRetryQueue.brokerBusyRateLimiter.Forget(key)
 			return false
 		}
 		if retryEntry.dirty {
-			// calculate earliest retry time with exponential backoff
-			retryEntry.calculatedRetryTime = time.Now().Add(c.instanceOperationRetryQueue.rateLimiter.When(key))
+			// calculate earliest retry time with exponential backoff, after
+			// the retry policy of the broker if it sets one
+			retryDelay := c.instanceOperationRetryQueue.rateLimiter.When(key)
+			if policyDelay, ok := retryPolicyDelay(c.serviceInstanceRetryPolicy(instance), c.instanceOperationRetryQueue.rateLimiter.NumRequeues(key)-1); ok {
+				retryDelay = policyDelay
+			}
+			retryEntry.calculatedRetryTime = time.Now().Add(retryDelay)
 			retryEntry.dirty = false
 			c.instanceOperationRetryQueue.instances[key] = retryEntry
 			klog.V(4).Infof(pcb.Messagef("BrokerOpRetry: generation %v retryTime calculated as %v", instance.Generation, retryEntry.calculatedRetryTime))
@@ -484,6 +610,7 @@ func (c *controller) purgeExpiredRetryEntries() {
 			klog.V(5).Infof("BrokerOpRetry: removing %s from instanceOperationRetryQueue which had retry time of %v", k, v.calculatedRetryTime)
 			delete(c.instanceOperationRetryQueue.instances, k)
 			c.instanceOperationRetryQueue.rateLimiter.Forget(k)
+			c.instanceOperationRetryQueue.brokerBusyRateLimiter.Forget(k)
 			purgedEntries++
 		}
 	}
@@ -499,6 +626,7 @@ func (c *controller) removeInstanceFromRetryMap(instance *v1beta1.ServiceInstanc
 	defer c.instanceOperationRetryQueue.mutex.Unlock()
 	delete(c.instanceOperationRetryQueue.instances, key)
 	c.instanceOperationRetryQueue.rateLimiter.Forget(key)
+	c.instanceOperationRetryQueue.brokerBusyRateLimiter.Forget(key)
 	klog.V(4).Infof(pcb.Message("BrokerOpRetry: removed %v from instanceOperationRetryQueue"), key)
 }
 
@@ -552,7 +680,8 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		instance.ResourceVersion = updatedInstance.ResourceVersion
 	}
 
-	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) {
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) ||
+		utilfeature.DefaultFeatureGate.Enabled(scfeatures.PlanSchemaDefaults) {
 		// Apply default provisioning parameters, this must be done after we've resolved the class and plan
 		modified, err = c.applyDefaultProvisioningParameters(instance)
 		if err != nil {
@@ -564,6 +693,14 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		}
 	}
 
+	if c.deferServiceInstanceForMaintenance(instance, v1beta1.MaintenanceOperationProvision) {
+		return nil
+	}
+
+	if !c.acquireServiceInstanceProvision(instance) {
+		return nil
+	}
+
 	klog.V(4).Info(pcb.Message("Processing adding event"))
 
 	request, inProgressProperties, err := c.prepareProvisionRequest(instance)
@@ -615,22 +752,54 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		prettyClass, brokerName,
 	))
 
+	instance, err = c.acquireServiceInstanceOperationLease(instance)
+	if err != nil {
+		return err
+	}
+
 	c.setRetryBackoffRequired(instance)
+	c.recordServiceInstanceBroker(instance, brokerName)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseRequestSent, fmt.Sprintf("Provision request sent to broker %q", brokerName))
+	instance.Status.OperationAttempts++
+	instance.Status.LastAttemptTime = newAttemptTime()
+	c.recordServiceInstanceRequestSnapshot(instance, v1beta1.ServiceInstanceOperationProvision, request)
 	response, err := brokerClient.ProvisionInstance(request)
 	if err != nil {
+		brokerErr := classifyBrokerError("provision", err)
+		// Depending on the specific response, we may need to initiate orphan mitigation.
+		shouldMitigateOrphan := requiresOrphanMitigation(brokerErr)
+
+		if !isTerminalError(brokerErr) && c.reconciliationMaxAttemptsExceeded(instance, instance.Status.OperationAttempts) {
+			msg := fmt.Sprintf("The provision call failed: Error communicating with broker for provisioning: %v", err)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorReconciliationMaxAttemptsReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationMaxAttemptsReason, reconciliationMaxAttemptsMessage(instance.Status.OperationAttempts))
+			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, shouldMitigateOrphan)
+		}
+
+		if code, ok := isBrokerBusyError(brokerErr); ok && !c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
+			return c.processServiceInstanceBrokerBusy(instance, "provision", code, err)
+		}
+
+		if isMaintenanceInfoConflictError(brokerErr) {
+			msg := fmt.Sprintf(
+				"The ServiceBroker %q rejected the provisioning of the ServiceInstance of %s because of a conflict with the maintenance info of its catalog; provision will not be retried: %v",
+				brokerName, prettyClass, err,
+			)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorMaintenanceInfoConflictReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason, msg)
+			setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionMaintenanceInfoConflict, v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason, msg)
+			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, shouldMitigateOrphan)
+		}
+
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf(
 				"Error provisioning ServiceInstance of %s at ClusterServiceBroker %q: %s",
 				prettyClass, brokerName, httpErr,
 			)
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorProvisionCallFailedReason, msg)
-			// Depending on the specific response, we may need to initiate orphan mitigation.
-			shouldMitigateOrphan := shouldStartOrphanMitigation(httpErr.StatusCode)
-			if isRetriableHTTPStatus(httpErr.StatusCode) {
+			if isRetriableError(brokerErr) {
 				return c.processTemporaryProvisionFailure(instance, readyCond, shouldMitigateOrphan)
 			}
-			// A failure with a given HTTP response code is treated as a terminal
-			// failure.
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, "ClusterServiceBrokerReturnedFailure", msg)
 			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, shouldMitigateOrphan)
 		}
@@ -639,10 +808,10 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 
 		// A timeout error is considered a retriable error, but we
 		// should initiate orphan mitigation.
-		if urlErr, ok := err.(*url.Error); ok && urlErr.Timeout() {
-			msg := fmt.Sprintf("Communication with the ClusterServiceBroker timed out; operation will be retried: %v", urlErr)
+		if isTimeoutError(err) {
+			msg := fmt.Sprintf("Communication with the ClusterServiceBroker timed out; operation will be retried: %v", err)
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
-			return c.processTemporaryProvisionFailure(instance, readyCond, true)
+			return c.processTemporaryProvisionFailure(instance, readyCond, shouldMitigateOrphan)
 		}
 
 		// All other errors should be retried, unless the
@@ -650,12 +819,13 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		msg := fmt.Sprintf("The provision call failed and will be retried: Error communicating with broker for provisioning: %v", err)
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries because too much time has elapsed"
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
 		}
 
+		c.releaseServiceInstanceProvision(instance)
 		return c.processServiceInstanceOperationError(instance, readyCond)
 	}
 
@@ -672,8 +842,11 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 	pcb := pretty.NewInstanceContextBuilder(instance)
 
 	if isServiceInstanceProcessedAlready(instance) {
-		klog.V(4).Info(pcb.Message("Not processing event because status showed there is no work to do"))
-		return nil
+		if !c.isServiceInstanceContextUpdateRequired(instance) {
+			klog.V(4).Info(pcb.Message("Not processing event because status showed there is no work to do"))
+			return c.verifyServiceInstanceBinding(instance)
+		}
+		klog.V(4).Info(pcb.Message("Processing event because the context of the instance has changed"))
 	}
 
 	// don't DOS the broker.  If we already did an update attempt that ended with a non-terminal
@@ -682,6 +855,14 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 		return nil
 	}
 
+	if c.debounceServiceInstanceUpdate(instance) {
+		return nil
+	}
+
+	if held, err := c.holdServiceInstanceUpdate(instance); held {
+		return err
+	}
+
 	instance = instance.DeepCopy()
 	// Any status updates from this point should have an updated observed generation
 	if instance.Status.ObservedGeneration != instance.Generation {
@@ -698,19 +879,29 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 		return nil
 	}
 
+	if c.deferServiceInstanceForMaintenance(instance, v1beta1.MaintenanceOperationUpdate) {
+		return nil
+	}
+
+	// The update is no longer held, if it was; the condition is removed
+	// along with the next update of the status.
+	removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionUpdatesHeld)
+
 	klog.V(4).Info(pcb.Message("Processing updating event"))
 
 	var brokerClient osb.Client
+	var brokerName string
 	var request *osb.UpdateInstanceRequest
 
 	if instance.Spec.ClusterServiceClassSpecified() {
 
-		serviceClass, servicePlan, brokerName, bClient, err := c.getClusterServiceClassPlanAndClusterServiceBroker(instance)
+		serviceClass, servicePlan, bName, bClient, err := c.getClusterServiceClassPlanAndClusterServiceBroker(instance)
 		if err != nil {
 			return c.handleServiceInstanceReconciliationError(instance, err)
 		}
 
 		brokerClient = bClient
+		brokerName = bName
 
 		// Check if the ServiceClass or ServicePlan has been deleted. If so, do
 		// not allow plan upgrades, but do allow parameter changes.
@@ -745,12 +936,13 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 
 	} else if instance.Spec.ServiceClassSpecified() {
 
-		serviceClass, servicePlan, brokerName, bClient, err := c.getServiceClassPlanAndServiceBroker(instance)
+		serviceClass, servicePlan, bName, bClient, err := c.getServiceClassPlanAndServiceBroker(instance)
 		if err != nil {
 			return c.handleServiceInstanceReconciliationError(instance, err)
 		}
 
 		brokerClient = bClient
+		brokerName = bName
 
 		// Check if the ServiceClass or ServicePlan has been deleted. If so, do
 		// not allow plan upgrades, but do allow parameter changes.
@@ -793,17 +985,45 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 		instance.ResourceVersion = updatedInstance.ResourceVersion
 	}
 
+	instance, err = c.acquireServiceInstanceOperationLease(instance)
+	if err != nil {
+		return err
+	}
+
 	c.setRetryBackoffRequired(instance)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseRequestSent, fmt.Sprintf("Update request sent to broker %q", brokerName))
+	instance.Status.OperationAttempts++
+	instance.Status.LastAttemptTime = newAttemptTime()
+	c.recordServiceInstanceRequestSnapshot(instance, v1beta1.ServiceInstanceOperationUpdate, request)
 	response, err := brokerClient.UpdateInstance(request)
 	if err != nil {
+		brokerErr := classifyBrokerError("update", err)
+
+		if !isTerminalError(brokerErr) && c.reconciliationMaxAttemptsExceeded(instance, instance.Status.OperationAttempts) {
+			msg := fmt.Sprintf("The update call failed: Error communicating with broker for updating: %s", err)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorReconciliationMaxAttemptsReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationMaxAttemptsReason, reconciliationMaxAttemptsMessage(instance.Status.OperationAttempts))
+			return c.processTerminalUpdateServiceInstanceFailure(instance, readyCond, failedCond)
+		}
+
+		if code, ok := isBrokerBusyError(brokerErr); ok && !c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
+			return c.processServiceInstanceBrokerBusy(instance, "update", code, err)
+		}
+
+		if isMaintenanceInfoConflictError(brokerErr) {
+			msg := fmt.Sprintf("ServiceBroker rejected the update call because of a conflict with the maintenance info of its catalog; update will not be retried: %v", err)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorMaintenanceInfoConflictReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason, msg)
+			setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionMaintenanceInfoConflict, v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason, msg)
+			return c.processTerminalUpdateServiceInstanceFailure(instance, readyCond, failedCond)
+		}
+
 		if httpErr, ok := osb.IsHTTPError(err); ok {
-			if isRetriableHTTPStatus(httpErr.StatusCode) {
+			if isRetriableError(brokerErr) {
 				msg := fmt.Sprintf("ServiceBroker returned a failure for update call; update will be retried: %v", httpErr)
 				readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorUpdateInstanceCallFailedReason, msg)
 				return c.processTemporaryUpdateServiceInstanceFailure(instance, readyCond)
 			}
-			// A failure with a given HTTP response code is treated as a terminal
-			// failure.
 			msg := fmt.Sprintf("ServiceBroker returned a failure for update call; update will not be retried: %v", httpErr)
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorUpdateInstanceCallFailedReason, msg)
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorUpdateInstanceCallFailedReason, msg)
@@ -812,15 +1032,15 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 
 		reason := errorErrorCallingUpdateInstanceReason
 
-		if urlErr, ok := err.(*url.Error); ok && urlErr.Timeout() {
-			msg := fmt.Sprintf("Communication with the ServiceBroker timed out; update will be retried: %v", urlErr)
+		if isTimeoutError(err) {
+			msg := fmt.Sprintf("Communication with the ServiceBroker timed out; update will be retried: %v", err)
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
 			return c.processTemporaryUpdateServiceInstanceFailure(instance, readyCond)
 		}
 
 		msg := fmt.Sprintf("The update call failed and will be retried: Error communicating with broker for updating: %s", err)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 			// log and record the real error, but process as a
 			// failure with reconciliation retry timeout
 			klog.Info(pcb.Message(msg))
@@ -954,6 +1174,8 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 			// if mitigating an orphan, set the operation start time if unset
 			now := metav1.Now()
 			instance.Status.OperationStartTime = &now
+			instance.Status.OperationAttempts = 0
+			instance.Status.LastAttemptTime = nil
 		}
 	} else {
 		if instance.Status.CurrentOperation != v1beta1.ServiceInstanceOperationDeprovision {
@@ -977,9 +1199,27 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 		}
 	}
 
+	if c.backoffAndRequeueIfBrokerBusy(instance, "deprovision") {
+		return nil
+	}
+
+	instance, err = c.acquireServiceInstanceOperationLease(instance)
+	if err != nil {
+		return err
+	}
+
 	klog.V(4).Info(pcb.Message("Sending deprovision request to broker"))
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseRequestSent, fmt.Sprintf("Deprovision request sent to broker %q", brokerName))
+	instance.Status.OperationAttempts++
+	instance.Status.LastAttemptTime = newAttemptTime()
 	response, err := brokerClient.DeprovisionInstance(request)
 	if err != nil {
+		if code, ok := isBrokerBusyError(classifyBrokerError("deprovision", err)); ok &&
+			!c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) &&
+			!c.reconciliationMaxAttemptsExceeded(instance, instance.Status.OperationAttempts) {
+			return c.processServiceInstanceBrokerBusy(instance, "deprovision", code, err)
+		}
+
 		msg := fmt.Sprintf(
 			`Error deprovisioning, %s at ClusterServiceBroker %q: %v`,
 			prettyName, brokerName, err,
@@ -1001,12 +1241,17 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 func (c *controller) processDeprovisionError(instance *v1beta1.ServiceInstance, msg string) error {
 	readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCallFailedReason, msg)
 
-	if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+	if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 		msg := "Stopping reconciliation retries because too much time has elapsed"
 		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 		return c.processDeprovisionFailure(instance, readyCond, failedCond)
 	}
 
+	if c.reconciliationMaxAttemptsExceeded(instance, instance.Status.OperationAttempts) {
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationMaxAttemptsReason, reconciliationMaxAttemptsMessage(instance.Status.OperationAttempts))
+		return c.processDeprovisionFailure(instance, readyCond, failedCond)
+	}
+
 	return c.processServiceInstanceOperationError(instance, readyCond)
 }
 
@@ -1057,21 +1302,16 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		klog.V(4).Info(pcb.Message(message))
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
 
-		if httpErr, ok := osb.IsHTTPError(err); ok {
-			if isRetriableHTTPStatus(httpErr.StatusCode) {
-				return c.processServiceInstancePollingTemporaryFailure(instance, readyCond)
-			}
-			// A failure with a given HTTP response code is treated as a terminal
-			// failure.
+		if isTerminalError(classifyBrokerError("poll", err)) {
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, reason, message)
 			return c.processServiceInstancePollingTerminalFailure(instance, readyCond, failedCond)
 		}
 
-		// Unknown error: update status and continue polling
+		// Any other error: update status and continue polling
 		return c.processServiceInstancePollingTemporaryFailure(instance, readyCond)
 	}
 
@@ -1102,7 +1342,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		}
 
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
 
@@ -1140,7 +1380,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 			msg := "Deprovision call failed: " + description
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCallFailedReason, msg)
 
-			if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+			if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 				return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 			}
 
@@ -1169,7 +1409,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 	default:
 		message := pcb.Messagef("Got invalid state in LastOperationResponse: %q", response.State)
 		klog.Warning(message)
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorPollingLastOperationReason, message)
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
@@ -1640,6 +1880,10 @@ func (c *controller) applyDefaultProvisioningParameters(instance *v1beta1.Servic
 		return false, nil
 	}
 
+	if c.skipServiceInstanceStep(instance, v1beta1.SkipDefaultsAnnotation, "default provisioning parameters") {
+		return false, nil
+	}
+
 	defaultParams, err := c.getDefaultProvisioningParameters(instance)
 	if err != nil {
 		return false, err
@@ -1674,7 +1918,7 @@ func (c *controller) applyDefaultProvisioningParameters(instance *v1beta1.Servic
 }
 
 func (c *controller) getDefaultProvisioningParameters(instance *v1beta1.ServiceInstance) (*runtime.RawExtension, error) {
-	var classDefaults, planDefaults *runtime.RawExtension
+	var classDefaults, planDefaults, planSchema *runtime.RawExtension
 
 	if instance.Spec.ClusterServiceClassSpecified() {
 		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
@@ -1698,17 +1942,37 @@ func (c *controller) getDefaultProvisioningParameters(instance *v1beta1.ServiceI
 			return nil, err
 		}
 		planDefaults = plan.Spec.DefaultProvisionParameters
+		planSchema = plan.Spec.InstanceCreateParameterSchema
 	} else if instance.Spec.ServicePlanSpecified() {
 		plan, err := c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
 		if err != nil {
 			return nil, err
 		}
 		planDefaults = plan.Spec.DefaultProvisionParameters
+		planSchema = plan.Spec.InstanceCreateParameterSchema
 	} else {
 		return nil, fmt.Errorf("invalid plan reference %v", instance.Spec.PlanReference)
 	}
 
-	return mergeParameters(planDefaults, classDefaults)
+	var defaults *runtime.RawExtension
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) {
+		var err error
+		defaults, err = mergeParameters(planDefaults, classDefaults)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.PlanSchemaDefaults) {
+		return defaults, nil
+	}
+	// The defaults of the schema have the lowest precedence, so that the
+	// defaults of the class and plan override them.
+	schemaDefaults, err := planschema.Defaults(planSchema)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the defaults of the parameter schema of the plan: %v", err)
+	}
+	return mergeParameters(defaults, schemaDefaults)
 }
 
 func (c *controller) prepareProvisionRequest(instance *v1beta1.ServiceInstance) (*osb.ProvisionRequest, *v1beta1.ServiceInstancePropertiesState, error) {
@@ -1981,6 +2245,8 @@ func (c *controller) updateServiceInstanceStatusWithRetries(
 
 	if err != nil {
 		klog.Errorf(pcb.Messagef("Failed to update status: %v", err))
+	} else {
+		c.publishServiceInstanceReadiness(updatedInstance)
 	}
 
 	return updatedInstance, err
@@ -2060,6 +2326,11 @@ func isServiceInstancePropertiesStateEqual(s1 *v1beta1.ServiceInstanceProperties
 	if s1.ParameterChecksum != s2.ParameterChecksum {
 		return false
 	}
+	// Operations started before the context checksum was recorded have
+	// none; do not restart them just because it is missing.
+	if s1.ContextChecksum != "" && s2.ContextChecksum != "" && s1.ContextChecksum != s2.ContextChecksum {
+		return false
+	}
 	if s1.UserInfo != nil || s2.UserInfo != nil {
 		u1 := s1.UserInfo
 		u2 := s2.UserInfo
@@ -2092,7 +2363,12 @@ func (c *controller) recordStartOfServiceInstanceOperation(toUpdate *v1beta1.Ser
 	toUpdate.Status.CurrentOperation = operation
 	now := metav1.Now()
 	toUpdate.Status.OperationStartTime = &now
+	toUpdate.Status.OperationAttempts = 0
+	toUpdate.Status.LastAttemptTime = nil
 	toUpdate.Status.InProgressProperties = inProgressProperties
+	// a new operation invalidates any earlier binding verification
+	removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionVerified)
+	toUpdate.Status.OperationTimeline = nil
 	reason := ""
 	message := ""
 	switch operation {
@@ -2107,6 +2383,7 @@ func (c *controller) recordStartOfServiceInstanceOperation(toUpdate *v1beta1.Ser
 		reason = deprovisioningInFlightReason
 		message = deprovisioningInFlightMessage
 	}
+	appendServiceInstanceOperationTimeline(toUpdate, v1beta1.ServiceInstanceOperationPhaseValidated, fmt.Sprintf("%s request validated", operation))
 	setServiceInstanceCondition(
 		toUpdate,
 		v1beta1.ServiceInstanceConditionReady,
@@ -2206,6 +2483,39 @@ func clearServiceInstanceCurrentOperation(toUpdate *v1beta1.ServiceInstance) {
 	toUpdate.Status.AsyncOpInProgress = false
 	toUpdate.Status.LastOperation = nil
 	toUpdate.Status.InProgressProperties = nil
+	toUpdate.Status.OperationLease = nil
+}
+
+// recordServiceInstanceBroker records the name and the current URL of the
+// broker that the provision request of instance is sent to. The Status is
+// *not* recorded in the registry.
+func (c *controller) recordServiceInstanceBroker(instance *v1beta1.ServiceInstance, brokerName string) {
+	instance.Status.BrokerName = brokerName
+	instance.Status.BrokerURL = ""
+	if instance.Spec.ClusterServiceClassSpecified() {
+		if broker, err := c.clusterServiceBrokerLister.Get(brokerName); err == nil {
+			instance.Status.BrokerURL = broker.Spec.URL
+		}
+	} else if c.serviceBrokerLister != nil {
+		if broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(brokerName); err == nil {
+			instance.Status.BrokerURL = broker.Spec.URL
+		}
+	}
+}
+
+// appendServiceInstanceOperationTimeline records that the instance's current
+// operation entered the given phase, keeping only the latest
+// ServiceInstanceOperationTimelineMaxLength entries. The Status is *not*
+// recorded in the registry.
+func appendServiceInstanceOperationTimeline(toUpdate *v1beta1.ServiceInstance, phase v1beta1.ServiceInstanceOperationPhase, message string) {
+	toUpdate.Status.OperationTimeline = append(toUpdate.Status.OperationTimeline, v1beta1.ServiceInstanceOperationTimelineEntry{
+		Phase:   phase,
+		Time:    metav1.Now(),
+		Message: message,
+	})
+	if n := len(toUpdate.Status.OperationTimeline); n > v1beta1.ServiceInstanceOperationTimelineMaxLength {
+		toUpdate.Status.OperationTimeline = toUpdate.Status.OperationTimeline[n-v1beta1.ServiceInstanceOperationTimelineMaxLength:]
+	}
 }
 
 // checkServiceInstanceHasExistingBindings returns true if there are any existing
@@ -2295,7 +2605,7 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 	}
 
 	// Only prepare namespace, parameters, and context for provision/update
-	ns, err := c.kubeClient.CoreV1().Namespaces().Get(context.Background(), instance.Namespace, metav1.GetOptions{})
+	ns, err := c.namespaceCache.Get(instance.Namespace)
 	if err != nil {
 		return nil, &operationError{
 			reason:  errorFindingNamespaceServiceInstanceReason,
@@ -2304,9 +2614,17 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 	}
 	rh.ns = ns
 
+	// osb client handles whether or not to really send this based
+	// on the version of the client.
+	rh.requestContext = serviceInstanceRequestContext(instance, c.getClusterID())
+	if c.skipServiceInstanceStep(instance, v1beta1.SkipContextAnnotation, "OSB context") {
+		rh.requestContext = nil
+	}
+
 	if setInProgressProperties {
 		parameters, parametersChecksum, rawParametersWithRedaction, err := prepareInProgressPropertyParameters(
 			c.kubeClient,
+			c.parametersPlugins,
 			instance.Namespace,
 			instance.Spec.Parameters,
 			instance.Spec.ParametersFrom,
@@ -2319,9 +2637,18 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 		}
 		rh.parameters = parameters
 
+		contextChecksum, err := scparameters.Checksum(rh.requestContext)
+		if err != nil {
+			return nil, &operationError{
+				reason:  errorWithParametersReason,
+				message: fmt.Sprintf("Failed to generate the context checksum to store in Status: %v", err),
+			}
+		}
+
 		rh.inProgressProperties = &v1beta1.ServiceInstancePropertiesState{
 			Parameters:        rawParametersWithRedaction,
 			ParameterChecksum: parametersChecksum,
+			ContextChecksum:   contextChecksum,
 			UserInfo:          instance.Spec.UserInfo,
 		}
 
@@ -2334,16 +2661,80 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 		}
 	}
 
-	// osb client handles whether or not to really send this based
-	// on the version of the client.
-	id := c.getClusterID()
-	rh.requestContext = map[string]interface{}{
+	c.addOperationCallbackContext(instance, rh.requestContext)
+
+	return rh, nil
+}
+
+// serviceInstanceRequestContext returns the OSB context sent with requests
+// concerning instance. Besides the fields of the Kubernetes context profile,
+// it carries the instance's description and the labels listed in its
+// ContextLabels, when there are any.
+func serviceInstanceRequestContext(instance *v1beta1.ServiceInstance, clusterID string) map[string]interface{} {
+	requestContext := map[string]interface{}{
 		"platform":           ContextProfilePlatformKubernetes,
-		"namespace":          instance.Namespace,
-		clusterIdentifierKey: id,
+		"namespace":          serviceInstanceContextNamespace(instance),
+		clusterIdentifierKey: clusterID,
 		"instance_name":      instance.Name,
 	}
-	return rh, nil
+	if instance.Spec.Description != "" {
+		requestContext["instance_description"] = instance.Spec.Description
+	}
+	instanceLabels := map[string]interface{}{}
+	for _, key := range instance.Spec.ContextLabels {
+		if value, ok := instance.Labels[key]; ok {
+			instanceLabels[key] = value
+		}
+	}
+	if len(instanceLabels) > 0 {
+		requestContext["instance_labels"] = instanceLabels
+	}
+	return requestContext
+}
+
+// serviceInstanceContextNamespace returns the namespace presented to the
+// broker in the OSB context of instance: its ContextNamespaceOverride when
+// the ContextNamespaceOverride feature is enabled, or its own namespace.
+func serviceInstanceContextNamespace(instance *v1beta1.ServiceInstance) string {
+	if instance.Spec.ContextNamespaceOverride != "" && utilfeature.DefaultFeatureGate.Enabled(scfeatures.ContextNamespaceOverride) {
+		return instance.Spec.ContextNamespaceOverride
+	}
+	return instance.Namespace
+}
+
+// isServiceInstanceContextUpdateRequired returns whether the context of a
+// provisioned instance has changed since it was last sent to the broker, and
+// the broker accepts update requests that change only the context.
+func (c *controller) isServiceInstanceContextUpdateRequired(instance *v1beta1.ServiceInstance) bool {
+	// Instances provisioned before the checksum of their context was recorded
+	// are left alone until their next update.
+	if !isServiceInstanceReady(instance) || instance.Status.ExternalProperties == nil || instance.Status.ExternalProperties.ContextChecksum == "" {
+		return false
+	}
+	if !c.serviceInstanceAllowsContextUpdates(instance) || serviceInstanceSkipsStep(instance, v1beta1.SkipContextAnnotation) {
+		return false
+	}
+	checksum, err := scparameters.Checksum(serviceInstanceRequestContext(instance, c.getClusterID()))
+	if err != nil {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.Warning(pcb.Messagef("Failed to generate the context checksum: %v", err))
+		return false
+	}
+	return checksum != instance.Status.ExternalProperties.ContextChecksum
+}
+
+// serviceInstanceAllowsContextUpdates returns whether the class of the
+// instance advertises support for updates that change only the context.
+func (c *controller) serviceInstanceAllowsContextUpdates(instance *v1beta1.ServiceInstance) bool {
+	if instance.Spec.ClusterServiceClassRef != nil {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		return err == nil && class.Spec.AllowContextUpdates
+	}
+	if instance.Spec.ServiceClassRef != nil {
+		class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		return err == nil && class.Spec.AllowContextUpdates
+	}
+	return false
 }
 
 // innerPrepareProvisionRequest creates a provision request object to be passed to
@@ -2693,8 +3084,11 @@ func (c *controller) processServiceInstanceOperationError(instance *v1beta1.Serv
 // processProvisionSuccess handles the logging and updating of a
 // ServiceInstance that has successfully been provisioned at the broker.
 func (c *controller) processProvisionSuccess(instance *v1beta1.ServiceInstance, dashboardURL *string) error {
+	c.releaseServiceInstanceProvision(instance)
 	setServiceInstanceDashboardURL(instance, dashboardURL)
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseSucceeded, successProvisionMessage)
+	c.setServiceInstanceMaintenanceInfo(instance, true)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
@@ -2730,6 +3124,7 @@ func (c *controller) processTemporaryProvisionFailure(instance *v1beta1.ServiceI
 // ServiceInstance that hit a temporary or a terminal failure during provision
 // reconciliation.
 func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance, readyCond, failedCond *v1beta1.ServiceInstanceCondition, shouldMitigateOrphan bool) error {
+	c.releaseServiceInstanceProvision(instance)
 	c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
 
@@ -2737,6 +3132,7 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 	if failedCond != nil {
 		c.recorder.Event(instance, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseFailed, failedCond.Message)
 		errorMessage = fmt.Errorf(failedCond.Message)
 	} else {
 		errorMessage = fmt.Errorf(readyCond.Message)
@@ -2754,6 +3150,8 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 			startingInstanceOrphanMitigationMessage)
 
 		instance.Status.OrphanMitigationInProgress = true
+		instance.Status.OperationAttempts = 0
+		instance.Status.LastAttemptTime = nil
 	} else {
 		// Deprovisioning is not required for provisioning that has failed with an
 		// error that doesn't require orphan mitigation
@@ -2791,6 +3189,7 @@ func (c *controller) processProvisionAsyncResponse(instance *v1beta1.ServiceInst
 	setServiceInstanceDashboardURL(instance, response.DashboardURL)
 	setServiceInstanceLastOperation(instance, response.OperationKey)
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncProvisioningReason, asyncProvisioningMessage)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhasePolling, asyncProvisioningMessage)
 	instance.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
@@ -2805,6 +3204,8 @@ func (c *controller) processProvisionAsyncResponse(instance *v1beta1.ServiceInst
 // ServiceInstance that has successfully been updated at the broker.
 func (c *controller) processUpdateServiceInstanceSuccess(instance *v1beta1.ServiceInstance) error {
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successUpdateInstanceReason, successUpdateInstanceMessage)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseSucceeded, successUpdateInstanceMessage)
+	c.setServiceInstanceMaintenanceInfo(instance, false)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
@@ -2842,6 +3243,7 @@ func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.Servi
 
 	if failedCond != nil {
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseFailed, failedCond.Message)
 		// Reset the current operation if there was a terminal error
 		clearServiceInstanceCurrentOperation(instance)
 	} else {
@@ -2871,6 +3273,7 @@ func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.Servi
 func (c *controller) processUpdateServiceInstanceAsyncResponse(instance *v1beta1.ServiceInstance, response *osb.UpdateInstanceResponse) error {
 	setServiceInstanceLastOperation(instance, response.OperationKey)
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncUpdatingInstanceReason, asyncUpdatingInstanceMessage)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhasePolling, asyncUpdatingInstanceMessage)
 	instance.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
@@ -2896,6 +3299,7 @@ func (c *controller) processDeprovisionSuccess(instance *v1beta1.ServiceInstance
 	}
 
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, reason, msg)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseSucceeded, msg)
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ExternalProperties = nil
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusNotProvisioned
@@ -2953,6 +3357,7 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 		c.recorder.Event(instance, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
 	}
 
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhaseFailed, failedCond.Message)
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusFailed
 
@@ -2969,6 +3374,7 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 func (c *controller) processDeprovisionAsyncResponse(instance *v1beta1.ServiceInstance, response *osb.DeprovisionResponse) error {
 	setServiceInstanceLastOperation(instance, response.OperationKey)
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncDeprovisioningReason, asyncDeprovisioningMessage)
+	appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhasePolling, asyncDeprovisioningMessage)
 	instance.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
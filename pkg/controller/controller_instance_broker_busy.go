@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// errorBrokerOperationInProgressReason is the reason of the Ready
+	// condition of an instance whose request the broker rejected with a
+	// ConcurrencyError because another operation on it is in progress.
+	errorBrokerOperationInProgressReason string = "BrokerOperationInProgress"
+	// errorBrokerAsyncRequiredReason is the reason of the Ready condition
+	// of an instance whose request the broker rejected with an
+	// AsyncRequired error.
+	errorBrokerAsyncRequiredReason string = "BrokerAsyncRequired"
+
+	// minBrokerBusyRetryDelay and maxBrokerBusyRetryDelay bound the
+	// exponential backoff between the requests for an instance while its
+	// broker answers it is busy.
+	minBrokerBusyRetryDelay time.Duration = time.Second * 5
+	maxBrokerBusyRetryDelay time.Duration = time.Minute * 5
+)
+
+// setBrokerBusyBackoff records that the broker of the instance answered it
+// was busy, and returns how long to wait before sending the request again.
+// The backoff replaces the one of failed provisions and updates until the
+// next request is sent.
+func (c *controller) setBrokerBusyBackoff(instance *v1beta1.ServiceInstance) time.Duration {
+	key := string(instance.GetUID())
+	c.instanceOperationRetryQueue.mutex.Lock()
+	defer c.instanceOperationRetryQueue.mutex.Unlock()
+
+	delay := c.instanceOperationRetryQueue.brokerBusyRateLimiter.When(key)
+	c.instanceOperationRetryQueue.instances[key] = backoffEntry{
+		generation:          instance.Generation,
+		calculatedRetryTime: time.Now().Add(delay),
+		brokerBusy:          true,
+	}
+	return delay
+}
+
+// backoffAndRequeueIfBrokerBusy returns true if the broker of the instance
+// answered it was busy and the backoff has not elapsed yet, in which case the
+// instance is requeued for when it does. Unlike backoffAndRequeueIfRetrying,
+// it ignores the backoff of failed provisions and updates, so that it can
+// guard deprovision requests.
+func (c *controller) backoffAndRequeueIfBrokerBusy(instance *v1beta1.ServiceInstance, operation string) bool {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	key := string(instance.GetUID())
+	c.instanceOperationRetryQueue.mutex.RLock()
+	retryEntry, exists := c.instanceOperationRetryQueue.instances[key]
+	c.instanceOperationRetryQueue.mutex.RUnlock()
+	if !exists || !retryEntry.brokerBusy || retryEntry.generation != instance.Generation {
+		return false
+	}
+
+	delay := time.Until(retryEntry.calculatedRetryTime)
+	if delay <= 0 {
+		return false
+	}
+	msg := fmt.Sprintf("Delaying %s retry while the broker is busy, next attempt will be after %s", operation, retryEntry.calculatedRetryTime)
+	c.recorder.Event(instance, corev1.EventTypeWarning, "RetryBackoff", msg)
+	klog.V(2).Info(pcb.Messagef("BrokerOpRetry: %s", msg))
+	c.enqueueInstanceAfter(instance, delay)
+	return true
+}
+
+// processServiceInstanceBrokerBusy handles the logging and updating of a
+// ServiceInstance whose provision, update or deprovision request the broker
+// rejected because it is busy with the given error code. The current
+// operation is kept, and the request is sent again after a backoff bounded by
+// maxBrokerBusyRetryDelay.
+func (c *controller) processServiceInstanceBrokerBusy(instance *v1beta1.ServiceInstance, operation, code string, err error) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	delay := c.setBrokerBusyBackoff(instance)
+
+	reason := errorBrokerOperationInProgressReason
+	msg := fmt.Sprintf("The broker rejected the %s request because another operation on the instance is in progress; it will be retried in %s: %v", operation, delay, err)
+	if code == brokerErrorAsyncRequired {
+		reason = errorBrokerAsyncRequiredReason
+		msg = fmt.Sprintf("The broker rejected the %s request because it requires an asynchronous operation; it will be retried in %s: %v", operation, delay, err)
+	}
+	klog.V(4).Info(pcb.Message(msg))
+
+	status := v1beta1.ConditionFalse
+	if operation == "deprovision" {
+		status = v1beta1.ConditionUnknown
+	} else if operation == "provision" {
+		c.releaseServiceInstanceProvision(instance)
+	}
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, status, reason, msg)
+	clearServiceInstanceAsyncOsbOperation(instance)
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+	c.recorder.Event(instance, corev1.EventTypeWarning, reason, msg)
+
+	c.enqueueInstanceAfter(instance, delay)
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestReconcileServiceInstanceBrokerBusy tests that a provision request the
+// broker rejects with a 422 ConcurrencyError or AsyncRequired error keeps the
+// provision in progress with a dedicated reason, and is not sent again before
+// the backoff elapses.
+func TestReconcileServiceInstanceBrokerBusy(t *testing.T) {
+	cases := []struct {
+		code   string
+		reason string
+	}{
+		{
+			code:   brokerErrorConcurrency,
+			reason: errorBrokerOperationInProgressReason,
+		},
+		{
+			code:   brokerErrorAsyncRequired,
+			reason: errorBrokerAsyncRequiredReason,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.code, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				ProvisionReaction: &fakeosb.ProvisionReaction{
+					Error: osb.HTTPStatusCodeError{
+						StatusCode:   http.StatusUnprocessableEntity,
+						ErrorMessage: strPtr(tc.code),
+					},
+				},
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("Unexpected error preparing the provision: %v", err)
+			}
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 2)
+			instance = assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+			fakeCatalogClient.ClearActions()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("Unexpected error handling the busy broker: %v", err)
+			}
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+			actions = fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertServiceInstanceReadyCondition(t, updatedServiceInstance, v1beta1.ConditionFalse, tc.reason)
+			assertServiceInstanceCurrentOperation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision)
+			for _, condition := range updatedServiceInstance.(*v1beta1.ServiceInstance).Status.Conditions {
+				if condition.Type == v1beta1.ServiceInstanceConditionFailed {
+					t.Fatalf("Unexpected Failed condition: %+v", condition)
+				}
+			}
+
+			instance = updatedServiceInstance.(*v1beta1.ServiceInstance)
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("Unexpected error during the backoff: %v", err)
+			}
+			// The provision request must not have been sent again.
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scparameters "github.com/drycc-addons/service-catalog/pkg/util/parameters"
+)
+
+// getTestServiceInstanceWithStaleContext returns a ready instance whose
+// context has changed since it was last sent to the broker.
+func getTestServiceInstanceWithStaleContext() *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Generation = 1
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	instance.Status.Conditions = []v1beta1.ServiceInstanceCondition{{
+		Type:   v1beta1.ServiceInstanceConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+		ContextChecksum:                "stale-checksum",
+	}
+	return instance
+}
+
+// TestReconcileServiceInstanceUpdateContext tests that a context-only update
+// is sent to a broker that allows context updates when the context of a
+// ready instance has changed.
+func TestReconcileServiceInstanceUpdateContext(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Response: &osb.UpdateInstanceResponse{},
+		},
+	})
+
+	serviceClass := getTestClusterServiceClass()
+	serviceClass.Spec.AllowContextUpdates = true
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(serviceClass)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithStaleContext()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := v1beta1.ServiceInstanceOperationUpdate, instance.Status.CurrentOperation; e != a {
+		t.Fatalf("Unexpected current operation; %s", expectedGot(e, a))
+	}
+	expectedChecksum, err := scparameters.Checksum(testContext)
+	if err != nil {
+		t.Fatalf("Failed to generate the context checksum: %v", err)
+	}
+	if e, a := expectedChecksum, instance.Status.InProgressProperties.ContextChecksum; e != a {
+		t.Fatalf("Unexpected in-progress context checksum; %s", expectedGot(e, a))
+	}
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertUpdateInstance(t, brokerActions[0], &osb.UpdateInstanceRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            nil, // no change to the plan
+		Context:           testContext,
+		Parameters:        nil, // no change to parameters
+		PreviousValues:    &osb.PreviousValues{PlanID: testClusterServicePlanGUID, ServiceID: testClusterServiceClassGUID},
+	})
+
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := expectedChecksum, instance.Status.ExternalProperties.ContextChecksum; e != a {
+		t.Fatalf("Unexpected external context checksum; %s", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceInstanceUpdateContextNotAllowed tests that no request
+// is sent when the context of an instance has changed but the broker does not
+// allow context updates.
+func TestReconcileServiceInstanceUpdateContextNotAllowed(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	if err := reconcileServiceInstance(t, testController, getTestServiceInstanceWithStaleContext()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const debugStepSkippedReason string = "DebugStepSkipped"
+
+// serviceInstanceSkipsStep returns whether instance is annotated with
+// annotation to skip a step of preparing its requests. The annotations are
+// only honored when the DebugAnnotations feature is enabled.
+func serviceInstanceSkipsStep(instance *v1beta1.ServiceInstance, annotation string) bool {
+	return utilfeature.DefaultFeatureGate.Enabled(scfeatures.DebugAnnotations) && instance.Annotations[annotation] == "true"
+}
+
+// skipServiceInstanceStep returns whether instance is annotated with
+// annotation to skip the given step, and if so records that the step was
+// skipped, so that the users debugging a broker can tell from the events of
+// the instance which steps its requests went through.
+func (c *controller) skipServiceInstanceStep(instance *v1beta1.ServiceInstance, annotation string, step string) bool {
+	if !serviceInstanceSkipsStep(instance, annotation) {
+		return false
+	}
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	s := fmt.Sprintf("Skipping the %s as requested by the %s annotation", step, annotation)
+	klog.V(4).Info(pcb.Message(s))
+	c.recorder.Event(instance, corev1.EventTypeNormal, debugStepSkippedReason, s)
+	return true
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+)
+
+// TestPrepareRequestHelperSkipContext tests that the requests of an instance
+// annotated to skip the context are prepared without one, only when the
+// DebugAnnotations feature is enabled.
+func TestPrepareRequestHelperSkipContext(t *testing.T) {
+	cases := []struct {
+		name           string
+		enabled        bool
+		expectsContext bool
+	}{
+		{name: "feature enabled", enabled: true, expectsContext: false},
+		{name: "feature disabled", enabled: false, expectsContext: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.DebugAnnotations, tc.enabled)); err != nil {
+				t.Fatalf("Failed to set DebugAnnotations feature: %v", err)
+			}
+			defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.DebugAnnotations))
+
+			fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+			addGetNamespaceReaction(fakeKubeClient)
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.Annotations = map[string]string{v1beta1.SkipContextAnnotation: "true"}
+
+			rh, err := testController.prepareRequestHelper(instance, testClusterServicePlanName, testClusterServicePlanGUID, true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if e, a := tc.expectsContext, rh.requestContext != nil; e != a {
+				t.Fatalf("Unexpected context; %s", expectedGot(e, a))
+			}
+
+			events := getRecordedEvents(testController)
+			if tc.expectsContext {
+				assertNumEvents(t, events, 0)
+				return
+			}
+			assertNumEvents(t, events, 1)
+			expectedEvent := normalEventBuilder(debugStepSkippedReason).msgf("Skipping the OSB context as requested by the %s annotation", v1beta1.SkipContextAnnotation)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestApplyDefaultProvisioningParametersSkipDefaults tests that the default
+// provisioning parameters are not applied to an instance annotated to skip
+// them.
+func TestApplyDefaultProvisioningParametersSkipDefaults(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.DebugAnnotations)); err != nil {
+		t.Fatalf("Failed to enable DebugAnnotations feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.DebugAnnotations))
+
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	class := getTestClusterServiceClass()
+	class.Spec.DefaultProvisionParameters = &runtime.RawExtension{Raw: []byte(`{"secure": true}`)}
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(class)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Annotations = map[string]string{v1beta1.SkipDefaultsAnnotation: "true"}
+
+	modified, err := testController.applyDefaultProvisioningParameters(instance)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if modified {
+		t.Fatal("Expected the default provisioning parameters to be skipped")
+	}
+	if instance.Spec.Parameters != nil {
+		t.Fatalf("Expected the parameters of the instance to be left alone, got %s", instance.Spec.Parameters.Raw)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const updatesHeldReason string = "UpdatesHeld"
+
+// holdServiceInstanceUpdate returns whether the update request of instance
+// must be held because the instance is annotated with HoldUpdatesAnnotation.
+// If it must, the UpdatesHeld condition of the instance is set, once for each
+// generation held. The instance is reconciled again when the annotation is
+// removed, as removing it updates the instance.
+func (c *controller) holdServiceInstanceUpdate(instance *v1beta1.ServiceInstance) (bool, error) {
+	if instance.Annotations[v1beta1.HoldUpdatesAnnotation] != "true" {
+		return false, nil
+	}
+
+	s := fmt.Sprintf("Holding the update of generation %d until the %s annotation is removed", instance.Generation, v1beta1.HoldUpdatesAnnotation)
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionUpdatesHeld && cond.Status == v1beta1.ConditionTrue && cond.Message == s {
+			return true, nil
+		}
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	klog.V(4).Info(pcb.Message(s))
+	toUpdate := instance.DeepCopy()
+	setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionUpdatesHeld, v1beta1.ConditionTrue, updatesHeldReason, s)
+	if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+		return true, err
+	}
+	c.recorder.Event(instance, corev1.EventTypeNormal, updatesHeldReason, s)
+	return true, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+)
+
+// serviceInstanceOperationLeaseDuration is how long a controller may send
+// requests for a ServiceInstance's current operation before another
+// controller can take the lease over. It comfortably exceeds the time a
+// single broker request can take.
+const serviceInstanceOperationLeaseDuration = 5 * time.Minute
+
+// operationLeaseHeldError is returned when another controller holds the
+// operation lease of a ServiceInstance.
+type operationLeaseHeldError struct {
+	holderIdentity string
+	expireTime     metav1.Time
+}
+
+func (e *operationLeaseHeldError) Error() string {
+	return fmt.Sprintf("the operation lease is held by %q until %v", e.holderIdentity, e.expireTime)
+}
+
+// acquireServiceInstanceOperationLease records in the status of instance
+// that this controller is about to send a request to the broker for the
+// instance's current operation. The status is updated without retrying on
+// conflicts: a conflict means that another worker updated the instance first,
+// and the request must not be sent from a stale copy. An
+// operationLeaseHeldError is returned while another controller holds an
+// unexpired lease.
+//
+// The lease is released when the current operation is cleared. When the
+// OperationLease feature is disabled, instance is returned unchanged.
+func (c *controller) acquireServiceInstanceOperationLease(instance *v1beta1.ServiceInstance) (*v1beta1.ServiceInstance, error) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.OperationLease) {
+		return instance, nil
+	}
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	now := time.Now()
+	if lease := instance.Status.OperationLease; lease != nil && lease.HolderIdentity != c.identity && now.Before(lease.ExpireTime.Time) {
+		return nil, &operationLeaseHeldError{
+			holderIdentity: lease.HolderIdentity,
+			expireTime:     lease.ExpireTime,
+		}
+	}
+
+	toUpdate := instance.DeepCopy()
+	toUpdate.Status.OperationLease = &v1beta1.ServiceInstanceOperationLease{
+		HolderIdentity: c.identity,
+		ExpireTime:     metav1.NewTime(now.Add(serviceInstanceOperationLeaseDuration)),
+	}
+	klog.V(4).Info(pcb.Messagef("Acquiring the operation lease until %v", toUpdate.Status.OperationLease.ExpireTime))
+	updated, err := c.serviceCatalogClient.ServiceInstances(toUpdate.Namespace).UpdateStatus(context.Background(), toUpdate, metav1.UpdateOptions{})
+	if err != nil {
+		klog.V(4).Info(pcb.Messagef("Unable to acquire the operation lease: %v", err))
+		return nil, err
+	}
+	return updated, nil
+}
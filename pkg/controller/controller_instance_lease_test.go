@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+)
+
+// TestReconcileServiceInstanceOperationLease tests that a provision request
+// is only sent once the controller holds the instance's operation lease, and
+// that the lease is released when the operation succeeds.
+func TestReconcileServiceInstanceOperationLease(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.OperationLease)); err != nil {
+		t.Fatalf("Failed to enable OperationLease feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.OperationLease))
+
+	cases := []struct {
+		name            string
+		lease           *v1beta1.ServiceInstanceOperationLease
+		expectProvision bool
+	}{
+		{
+			name:            "no lease",
+			expectProvision: true,
+		},
+		{
+			name: "lease held by another controller",
+			lease: &v1beta1.ServiceInstanceOperationLease{
+				HolderIdentity: "other-controller",
+				ExpireTime:     metav1.NewTime(time.Now().Add(time.Minute)),
+			},
+		},
+		{
+			name: "expired lease held by another controller",
+			lease: &v1beta1.ServiceInstanceOperationLease{
+				HolderIdentity: "other-controller",
+				ExpireTime:     metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			expectProvision: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				ProvisionReaction: &fakeosb.ProvisionReaction{
+					Response: &osb.ProvisionResponse{},
+				},
+			})
+
+			addGetNamespaceReaction(fakeKubeClient)
+			// The operation continues with the instance returned when the
+			// lease is acquired.
+			fakeCatalogClient.AddReactor(updateObjectReactor("serviceinstances"))
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+			instance.Status.OperationLease = tc.lease
+			fakeCatalogClient.ClearActions()
+
+			err := reconcileServiceInstance(t, testController, instance)
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			actions := fakeCatalogClient.Actions()
+			if !tc.expectProvision {
+				if _, ok := err.(*operationLeaseHeldError); !ok {
+					t.Fatalf("expected an operationLeaseHeldError, got %v", err)
+				}
+				assertNumberOfBrokerActions(t, brokerActions, 0)
+				assertNumberOfActions(t, actions, 0)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, brokerActions, 1)
+			assertNumberOfActions(t, actions, 2)
+
+			leased := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+			if leased.Status.OperationLease == nil || leased.Status.OperationLease.HolderIdentity != testController.identity {
+				t.Fatalf("expected the lease to be held by %q, got %+v", testController.identity, leased.Status.OperationLease)
+			}
+			succeeded := assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+			if succeeded.Status.OperationLease != nil {
+				t.Fatalf("expected the lease to be released, got %+v", succeeded.Status.OperationLease)
+			}
+		})
+	}
+}
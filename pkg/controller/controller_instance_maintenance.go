@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	"k8s.io/klog/v2"
+)
+
+// getServiceInstancePlanMaintenanceInfo returns the maintenance info the
+// plan of instance publishes, or nil if it publishes none or cannot be found.
+func (c *controller) getServiceInstancePlanMaintenanceInfo(instance *v1beta1.ServiceInstance) *v1beta1.MaintenanceInfo {
+	var (
+		spec *v1beta1.CommonServicePlanSpec
+		err  error
+	)
+	switch {
+	case instance.Spec.ClusterServicePlanRef != nil:
+		var plan *v1beta1.ClusterServicePlan
+		plan, err = c.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
+		if err == nil {
+			spec = &plan.Spec.CommonServicePlanSpec
+		}
+	case instance.Spec.ServicePlanRef != nil:
+		var plan *v1beta1.ServicePlan
+		plan, err = c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
+		if err == nil {
+			spec = &plan.Spec.CommonServicePlanSpec
+		}
+	}
+	if err != nil {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.Warning(pcb.Messagef("Unable to get the maintenance info of the plan: %v", err))
+	}
+	if spec == nil || spec.MaintenanceInfo == nil {
+		return nil
+	}
+	return spec.MaintenanceInfo.DeepCopy()
+}
+
+// setServiceInstanceMaintenanceInfo records the maintenance info of the plan
+// of instance once the broker provisioned it or moved it to another plan,
+// and clears the MaintenanceInfoConflict condition left by a rejected
+// request. An update that keeps the plan keeps the recorded maintenance info:
+// the OSB client sends no maintenance info, so the broker does not upgrade
+// the instance to the plan's version.
+func (c *controller) setServiceInstanceMaintenanceInfo(instance *v1beta1.ServiceInstance, provisioned bool) {
+	removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionMaintenanceInfoConflict)
+	if !provisioned && !serviceInstancePlanChanged(instance) {
+		return
+	}
+	instance.Status.MaintenanceInfo = c.getServiceInstancePlanMaintenanceInfo(instance)
+}
+
+// serviceInstancePlanChanged returns whether the operation in progress on
+// instance moves it to a plan other than the one the broker knows about.
+func serviceInstancePlanChanged(instance *v1beta1.ServiceInstance) bool {
+	external, inProgress := instance.Status.ExternalProperties, instance.Status.InProgressProperties
+	if external == nil || inProgress == nil {
+		return true
+	}
+	return external.ClusterServicePlanExternalID != inProgress.ClusterServicePlanExternalID ||
+		external.ServicePlanExternalID != inProgress.ServicePlanExternalID
+}
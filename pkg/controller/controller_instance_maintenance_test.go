@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestSetServiceInstanceMaintenanceInfo tests that the maintenance info of
+// the plan is recorded once an instance is provisioned or moved to another
+// plan, and kept when an update keeps the plan.
+func TestSetServiceInstanceMaintenanceInfo(t *testing.T) {
+	recorded := &v1beta1.MaintenanceInfo{Version: "1.0.0"}
+	published := &v1beta1.MaintenanceInfo{Version: "2.0.0", Description: "OS upgrade"}
+
+	cases := []struct {
+		name        string
+		provisioned bool
+		externalID  string
+		expected    *v1beta1.MaintenanceInfo
+	}{
+		{
+			name:        "provisioned",
+			provisioned: true,
+			externalID:  testClusterServicePlanGUID,
+			expected:    published,
+		},
+		{
+			name:       "plan changed",
+			externalID: "old-plan-id",
+			expected:   published,
+		},
+		{
+			name:       "plan kept",
+			externalID: testClusterServicePlanGUID,
+			expected:   recorded,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			plan := getTestClusterServicePlan()
+			plan.Spec.MaintenanceInfo = published
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.Status.MaintenanceInfo = recorded
+			instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+				ClusterServicePlanExternalID: tc.externalID,
+			}
+			instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
+				ClusterServicePlanExternalID: testClusterServicePlanGUID,
+			}
+			setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionMaintenanceInfoConflict, v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason, "conflict")
+
+			testController.setServiceInstanceMaintenanceInfo(instance, tc.provisioned)
+
+			if e, a := tc.expected, instance.Status.MaintenanceInfo; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Unexpected maintenance info: %v", expectedGot(e, a))
+			}
+			for _, cond := range instance.Status.Conditions {
+				if cond.Type == v1beta1.ServiceInstanceConditionMaintenanceInfoConflict {
+					t.Fatalf("Expected the %s condition to be removed", v1beta1.ServiceInstanceConditionMaintenanceInfoConflict)
+				}
+			}
+		})
+	}
+}
+
+// TestReconcileServiceInstanceUpdateMaintenanceInfoConflict tests that an
+// update rejected because of a conflict with the maintenance info of the
+// catalog sets the MaintenanceInfoConflict condition and is not retried.
+func TestReconcileServiceInstanceUpdateMaintenanceInfoConflict(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Error: osb.HTTPStatusCodeError{
+				StatusCode:   http.StatusUnprocessableEntity,
+				ErrorMessage: strPtr(brokerErrorMaintenanceInfoConflict),
+			},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceUpdatingPlan()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceUpdateInProgressIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionMaintenanceInfoConflict, v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, errorMaintenanceInfoConflictReason)
+	if a := updatedServiceInstance.(*v1beta1.ServiceInstance).Status.MaintenanceInfo; a != nil {
+		t.Fatalf("Expected no maintenance info to be recorded, but got: %+v", a)
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// provisionLimitRetryDelay is about how long a ServiceInstance waits before
+// trying again to send its provision request when too many provisions are
+// in flight. The first waiting instances are also requeued as soon as a
+// provision completes.
+const provisionLimitRetryDelay = 10 * time.Second
+
+// provisionSlotGracePeriod is how long an instance holds its provision slot
+// after acquiring it before the controller checks that the provision is
+// still in flight. It covers the synchronous provision request and the lag
+// of the informers.
+const provisionSlotGracePeriod = 5 * time.Minute
+
+// serviceInstanceProvisions limits the number of ServiceInstances whose
+// provision is in flight at the same time, including asynchronous
+// provisions being polled. The instances that may not send their provision
+// request yet wait in line, in the order they first tried to.
+type serviceInstanceProvisions struct {
+	// limit is the number of provisions that may be in flight; zero or less
+	// means no limit. It may change while the controller runs, so it is
+	// read with the mutex held.
+	limit int
+
+	mutex sync.Mutex
+	// seeded is whether the provisions already in flight when the
+	// controller started were added to holders.
+	seeded bool
+	// holders maps the key of each instance whose provision is in flight to
+	// when it acquired its slot.
+	holders map[string]time.Time
+	// waiters holds the keys of the instances waiting to send their
+	// provision request, first in line first.
+	waiters []string
+}
+
+// acquireServiceInstanceProvision returns whether instance may send its
+// provision request to the broker. When too many provisions are in flight,
+// the position of instance in line is recorded in its status and it is
+// requeued. An instance that may send its request must call
+// releaseServiceInstanceProvision once its provision completes.
+func (c *controller) acquireServiceInstanceProvision(instance *v1beta1.ServiceInstance) bool {
+	p := &c.provisions
+	if !p.limited() {
+		return true
+	}
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	key, err := cache.MetaNamespaceKeyFunc(instance)
+	if err != nil {
+		klog.Errorf("Couldn't create a key for object %+v: %v", instance, err)
+		return true
+	}
+
+	position := c.acquireServiceInstanceProvisionSlot(key)
+	if position == 0 {
+		instance.Status.ProvisionQueuePosition = 0
+		return true
+	}
+
+	klog.V(4).Info(pcb.Messagef("Deferring provision because too many provisions are in flight; position in line: %d", position))
+	if instance.Status.ProvisionQueuePosition != int32(position) {
+		instance.Status.ProvisionQueuePosition = int32(position)
+		if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+			klog.Warning(pcb.Messagef("Unable to record the position in line of the provision: %v", err))
+		}
+	}
+	c.instanceQueue.AddAfter(key, wait.Jitter(provisionLimitRetryDelay, 1.0))
+	return false
+}
+
+// acquireServiceInstanceProvisionSlot gives a provision slot to the instance
+// with the given key if one is free and no instance is ahead in line, and
+// returns 0. Otherwise it returns the position of the instance in line,
+// starting at 1.
+func (c *controller) acquireServiceInstanceProvisionSlot(key string) int {
+	p := &c.provisions
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	defer p.updateMetrics()
+
+	if p.limit <= 0 {
+		return 0
+	}
+	if p.holders == nil {
+		p.holders = make(map[string]time.Time)
+	}
+	if !p.seeded {
+		for _, held := range c.serviceInstanceProvisionsInFlight() {
+			p.holders[held] = time.Now()
+		}
+		p.seeded = true
+	}
+	if _, ok := p.holders[key]; ok {
+		return 0
+	}
+
+	for held, acquired := range p.holders {
+		if time.Since(acquired) > provisionSlotGracePeriod && !c.isServiceInstanceProvisionInFlight(held) {
+			delete(p.holders, held)
+		}
+	}
+
+	// Drop the waiters that no longer need to provision, so that they do
+	// not hold up those behind them.
+	position := -1
+	waiters := p.waiters[:0]
+	for _, waiter := range p.waiters {
+		if waiter != key && !c.isServiceInstanceProvisionPending(waiter) {
+			continue
+		}
+		if waiter == key {
+			position = len(waiters)
+		}
+		waiters = append(waiters, waiter)
+	}
+	p.waiters = waiters
+	if position < 0 {
+		position = len(p.waiters)
+		p.waiters = append(p.waiters, key)
+	}
+
+	free := p.limit - len(p.holders)
+	if position < free {
+		p.holders[key] = time.Now()
+		p.waiters = append(p.waiters[:position], p.waiters[position+1:]...)
+		return 0
+	}
+	if free < 0 {
+		free = 0
+	}
+	return position - free + 1
+}
+
+// releaseServiceInstanceProvision records that the provision of instance
+// has completed, and requeues the instances first in line for the slots
+// now free.
+func (c *controller) releaseServiceInstanceProvision(instance *v1beta1.ServiceInstance) {
+	p := &c.provisions
+	if !p.limited() {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(instance)
+	if err != nil {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	defer p.updateMetrics()
+
+	if _, ok := p.holders[key]; !ok {
+		return
+	}
+	delete(p.holders, key)
+	for i := 0; i < len(p.waiters) && i < p.limit-len(p.holders); i++ {
+		c.instanceQueue.Add(p.waiters[i])
+	}
+}
+
+// limited returns whether the number of provisions in flight is limited.
+func (p *serviceInstanceProvisions) limited() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.limit > 0
+}
+
+// setServiceInstanceProvisionLimit changes the number of provisions that
+// may be in flight, and requeues the instances in line for the slots it
+// frees.
+func (c *controller) setServiceInstanceProvisionLimit(limit int) {
+	p := &c.provisions
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	defer p.updateMetrics()
+
+	if limit == p.limit {
+		return
+	}
+	if p.limit <= 0 || limit <= 0 {
+		// The provisions in flight are not tracked without a limit, so
+		// they are seeded again from the instances once there is one.
+		p.seeded = false
+		p.holders = nil
+	}
+	p.limit = limit
+	for i := 0; i < len(p.waiters) && (limit <= 0 || i < limit-len(p.holders)); i++ {
+		c.instanceQueue.Add(p.waiters[i])
+	}
+	if limit <= 0 {
+		p.waiters = nil
+	}
+}
+
+// updateMetrics exposes the number of provisions in flight and waiting. It
+// must be called with the mutex held.
+func (p *serviceInstanceProvisions) updateMetrics() {
+	metrics.ProvisionsInFlight.Set(float64(len(p.holders)))
+	metrics.ProvisionsWaiting.Set(float64(len(p.waiters)))
+}
+
+// serviceInstanceProvisionsInFlight returns the keys of the instances with
+// an asynchronous provision in flight, which the controller may have
+// started before it last restarted.
+func (c *controller) serviceInstanceProvisionsInFlight() []string {
+	instances, err := c.instanceLister.List(labels.Everything())
+	if err != nil {
+		klog.Warningf("Unable to list the provisions in flight: %v", err)
+		return nil
+	}
+	var keys []string
+	for _, instance := range instances {
+		if instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationProvision && instance.Status.AsyncOpInProgress {
+			if key, err := cache.MetaNamespaceKeyFunc(instance); err == nil {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// isServiceInstanceProvisionInFlight returns whether the instance with the
+// given key may still have a provision in flight. It guards against holders
+// that were deleted or whose provision ended without releasing their slot.
+func (c *controller) isServiceInstanceProvisionInFlight(key string) bool {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return false
+	}
+	instance, err := c.instanceLister.ServiceInstances(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationProvision && instance.Status.AsyncOpInProgress
+}
+
+// isServiceInstanceProvisionPending returns whether the instance with the
+// given key may still need to send its provision request. It guards against
+// waiters that were deleted or provisioned while waiting.
+func (c *controller) isServiceInstanceProvisionPending(key string) bool {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return false
+	}
+	instance, err := c.instanceLister.ServiceInstances(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return instance.DeletionTimestamp == nil && instance.Status.ProvisionStatus != v1beta1.ServiceInstanceProvisionStatusProvisioned
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func getTestServiceInstanceNamed(name string) *v1beta1.ServiceInstance {
+	instance := getTestServiceInstance()
+	instance.Name = name
+	return instance
+}
+
+// TestAcquireServiceInstanceProvision tests that only as many provisions as
+// allowed are in flight, that the other instances wait in line with their
+// position in their status, and that releasing a slot requeues the first
+// instance in line.
+func TestAcquireServiceInstanceProvision(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.provisions.limit = 1
+
+	first := getTestServiceInstanceNamed("first")
+	second := getTestServiceInstanceNamed("second")
+	third := getTestServiceInstanceNamed("third")
+	for _, instance := range []*v1beta1.ServiceInstance{first, second, third} {
+		sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	}
+
+	if !testController.acquireServiceInstanceProvision(first) {
+		t.Fatal("Expected the first provision to be allowed")
+	}
+	if !testController.acquireServiceInstanceProvision(first) {
+		t.Fatal("Expected the instance holding the slot to keep it")
+	}
+	if testController.acquireServiceInstanceProvision(second) {
+		t.Fatal("Expected the second provision to wait")
+	}
+	if testController.acquireServiceInstanceProvision(third) {
+		t.Fatal("Expected the third provision to wait")
+	}
+	if e, a := int32(1), second.Status.ProvisionQueuePosition; e != a {
+		t.Fatalf("Unexpected position of the second instance; %s", expectedGot(e, a))
+	}
+	if e, a := int32(2), third.Status.ProvisionQueuePosition; e != a {
+		t.Fatalf("Unexpected position of the third instance; %s", expectedGot(e, a))
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 2)
+
+	// The third instance may not jump the line when the slot is freed.
+	testController.releaseServiceInstanceProvision(first)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued instances; %s", expectedGot(e, a))
+	}
+	if testController.acquireServiceInstanceProvision(third) {
+		t.Fatal("Expected the third provision to keep waiting")
+	}
+	if !testController.acquireServiceInstanceProvision(second) {
+		t.Fatal("Expected the second provision to be allowed once the slot is free")
+	}
+	if e, a := int32(0), second.Status.ProvisionQueuePosition; e != a {
+		t.Fatalf("Unexpected position of the second instance; %s", expectedGot(e, a))
+	}
+}
+
+// TestAcquireServiceInstanceProvisionDropsDeletedWaiters tests that the
+// instances deleted while waiting in line do not hold up the others.
+func TestAcquireServiceInstanceProvisionDropsDeletedWaiters(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.provisions.limit = 1
+
+	first := getTestServiceInstanceNamed("first")
+	second := getTestServiceInstanceNamed("second")
+	third := getTestServiceInstanceNamed("third")
+	for _, instance := range []*v1beta1.ServiceInstance{first, second, third} {
+		sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	}
+
+	testController.acquireServiceInstanceProvision(first)
+	testController.acquireServiceInstanceProvision(second)
+	sharedInformers.ServiceInstances().Informer().GetStore().Delete(second)
+	testController.releaseServiceInstanceProvision(first)
+
+	if !testController.acquireServiceInstanceProvision(third) {
+		t.Fatal("Expected the provision to be allowed once the instance ahead in line is deleted")
+	}
+}
+
+// TestAcquireServiceInstanceProvisionNoLimit tests that provisions are not
+// limited by default.
+func TestAcquireServiceInstanceProvisionNoLimit(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+	for _, name := range []string{"first", "second"} {
+		if !testController.acquireServiceInstanceProvision(getTestServiceInstanceNamed(name)) {
+			t.Fatalf("Expected the provision of %q to be allowed", name)
+		}
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
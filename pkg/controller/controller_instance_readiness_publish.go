@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	// readinessPublishLabel labels the ConfigMaps the readiness of a
+	// ServiceInstance is published to.
+	readinessPublishLabel = v1beta1.GroupName + "/readiness-publish"
+
+	errorReadinessPublishReason string = "ReadinessPublishFailed"
+)
+
+// serviceInstanceReadinessData returns the data of the ConfigMap the
+// readiness of instance is published to. It only holds what the instance
+// status already shows to anyone allowed to read it, none of which is
+// secret.
+func serviceInstanceReadinessData(instance *v1beta1.ServiceInstance) map[string]string {
+	data := map[string]string{
+		"ready":              strconv.FormatBool(isServiceInstanceReady(instance)),
+		"failed":             strconv.FormatBool(isServiceInstanceFailed(instance)),
+		"status":             string(instance.Status.PrintableStatus),
+		"class":              instance.Status.UserSpecifiedClassName,
+		"plan":               instance.Status.UserSpecifiedPlanName,
+		"observedGeneration": strconv.FormatInt(instance.Status.ObservedGeneration, 10),
+	}
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == v1beta1.ServiceInstanceConditionReady {
+			data["reason"] = condition.Reason
+			data["lastTransitionTime"] = condition.LastTransitionTime.UTC().Format(time.RFC3339)
+		}
+	}
+	if instance.Status.DashboardURL != nil {
+		data["dashboardURL"] = *instance.Status.DashboardURL
+	}
+	return data
+}
+
+// publishServiceInstanceReadiness mirrors the readiness of instance into the
+// ConfigMap named by its readinessPublish, when the InstanceReadinessPublish
+// feature is enabled. The ConfigMap is deleted along with the instance. A
+// ConfigMap of that name that the instance does not control is left alone.
+// Failing to publish the readiness does not hold up the reconciliation of
+// the instance; it is published again on its next status update.
+func (c *controller) publishServiceInstanceReadiness(instance *v1beta1.ServiceInstance) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.InstanceReadinessPublish) ||
+		instance.Spec.ReadinessPublish == nil || instance.DeletionTimestamp != nil {
+		return
+	}
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	name := instance.Spec.ReadinessPublish.ConfigMapName
+	data := serviceInstanceReadinessData(instance)
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(instance.Namespace)
+	existing, err := configMaps.Get(context.Background(), name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		ownerRef := *metav1.NewControllerRef(instance, v1beta1.SchemeGroupVersion.WithKind("ServiceInstance"))
+		ownerRef.BlockOwnerDeletion = nil
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       instance.Namespace,
+				Labels:          map[string]string{readinessPublishLabel: "true"},
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Data: data,
+		}
+		_, err = configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+	case err == nil:
+		if !metav1.IsControlledBy(existing, instance) {
+			msg := fmt.Sprintf("ConfigMap %s/%s is not controlled by the instance, its readiness is not published", instance.Namespace, name)
+			klog.Warning(pcb.Message(msg))
+			c.recorder.Event(instance, corev1.EventTypeWarning, errorReadinessPublishReason, msg)
+			return
+		}
+		if reflect.DeepEqual(existing.Data, data) {
+			return
+		}
+		configMap := existing.DeepCopy()
+		configMap.Data = data
+		_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.Warning(pcb.Messagef("Unable to publish the readiness to ConfigMap %s/%s: %v", instance.Namespace, name, err))
+		return
+	}
+	klog.V(4).Info(pcb.Messagef("Published the readiness to ConfigMap %s/%s", instance.Namespace, name))
+}
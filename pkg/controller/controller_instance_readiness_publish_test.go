@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+)
+
+const testReadinessConfigMapName = "test-instance-ready"
+
+// TestPublishServiceInstanceReadiness tests that the readiness of an instance
+// is written to the ConfigMap named by its readinessPublish, and kept up to
+// date as the instance becomes ready.
+func TestPublishServiceInstanceReadiness(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.InstanceReadinessPublish)); err != nil {
+		t.Fatalf("Failed to enable InstanceReadinessPublish feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.InstanceReadinessPublish))
+
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	fakeKubeClient := clientgofake.NewSimpleClientset()
+	testController.kubeClient = fakeKubeClient
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.ReadinessPublish = &v1beta1.ServiceInstanceReadinessPublish{ConfigMapName: testReadinessConfigMapName}
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, provisioningInFlightReason, "provisioning")
+	testController.publishServiceInstanceReadiness(instance)
+
+	configMap, err := fakeKubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), testReadinessConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected the readiness ConfigMap to be created: %v", err)
+	}
+	if e, a := "false", configMap.Data["ready"]; e != a {
+		t.Fatalf("Unexpected readiness; %s", expectedGot(e, a))
+	}
+	if e, a := provisioningInFlightReason, configMap.Data["reason"]; e != a {
+		t.Fatalf("Unexpected reason; %s", expectedGot(e, a))
+	}
+	if !metav1.IsControlledBy(configMap, instance) {
+		t.Fatalf("Expected the ConfigMap to be controlled by the instance, got %+v", configMap.OwnerReferences)
+	}
+
+	dashboardURL := "https://dashboard.example.com"
+	instance.Status.DashboardURL = &dashboardURL
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
+	testController.publishServiceInstanceReadiness(instance)
+
+	configMap, err = fakeKubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), testReadinessConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error getting the readiness ConfigMap: %v", err)
+	}
+	if e, a := "true", configMap.Data["ready"]; e != a {
+		t.Fatalf("Unexpected readiness; %s", expectedGot(e, a))
+	}
+	if e, a := dashboardURL, configMap.Data["dashboardURL"]; e != a {
+		t.Fatalf("Unexpected dashboard URL; %s", expectedGot(e, a))
+	}
+}
+
+// TestPublishServiceInstanceReadinessForeignConfigMap tests that a ConfigMap
+// the instance does not control is not overwritten.
+func TestPublishServiceInstanceReadinessForeignConfigMap(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.InstanceReadinessPublish)); err != nil {
+		t.Fatalf("Failed to enable InstanceReadinessPublish feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.InstanceReadinessPublish))
+
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	fakeKubeClient := clientgofake.NewSimpleClientset()
+	testController.kubeClient = fakeKubeClient
+	foreign := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testReadinessConfigMapName, Namespace: testNamespace},
+		Data:       map[string]string{"app": "settings"},
+	}
+	if _, err := fakeKubeClient.CoreV1().ConfigMaps(testNamespace).Create(context.Background(), foreign, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Unexpected error creating the ConfigMap: %v", err)
+	}
+	fakeKubeClient.ClearActions()
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.ReadinessPublish = &v1beta1.ServiceInstanceReadinessPublish{ConfigMapName: testReadinessConfigMapName}
+	testController.publishServiceInstanceReadiness(instance)
+
+	actions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	if e, a := "get", actions[0].GetVerb(); e != a {
+		t.Fatalf("Unexpected action; %s", expectedGot(e, a))
+	}
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+	if e, a := corev1.EventTypeWarning+" "+errorReadinessPublishReason, events[0]; !strings.HasPrefix(a, e) {
+		t.Fatalf("Received unexpected event; %s", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	// requestSnapshotLabel labels the ConfigMaps holding the last request
+	// sent for a ServiceInstance, so that tooling can find them.
+	requestSnapshotLabel = v1beta1.GroupName + "/last-request"
+
+	// redactedValue replaces the values left out of request snapshots.
+	redactedValue = "<redacted>"
+)
+
+// requestSnapshotConfigMapName returns the name of the ConfigMap holding the
+// last request sent for the instance.
+func requestSnapshotConfigMapName(instance *v1beta1.ServiceInstance) string {
+	return "serviceinstance-" + instance.Name + "-last-request"
+}
+
+// recordServiceInstanceRequestSnapshot writes a redacted snapshot of the
+// provision or update request about to be sent for instance to its
+// ConfigMap, when the InstanceRequestSnapshots feature is enabled and the
+// instance has the CaptureRequestAnnotation. The snapshot only helps
+// debugging, so failing to write it does not hold up the request.
+func (c *controller) recordServiceInstanceRequestSnapshot(instance *v1beta1.ServiceInstance, operation v1beta1.ServiceInstanceOperation, request interface{}) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.InstanceRequestSnapshots) || instance.Annotations[v1beta1.CaptureRequestAnnotation] != "true" {
+		return
+	}
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	var redacted *runtime.RawExtension
+	if instance.Status.InProgressProperties != nil {
+		redacted = instance.Status.InProgressProperties.Parameters
+	}
+	snapshot, err := json.MarshalIndent(redactServiceInstanceRequest(request, redacted), "", "  ")
+	if err != nil {
+		klog.Warning(pcb.Messagef("Unable to snapshot the %s request: %v", operation, err))
+		return
+	}
+	data := map[string]string{
+		"operation": string(operation),
+		"time":      time.Now().UTC().Format(time.RFC3339),
+		"request":   string(snapshot),
+	}
+
+	name := requestSnapshotConfigMapName(instance)
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(instance.Namespace)
+	existing, err := configMaps.Get(context.Background(), name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		// The ConfigMap is deleted along with the instance, but does not
+		// hold up its deletion.
+		ownerRef := *metav1.NewControllerRef(instance, v1beta1.SchemeGroupVersion.WithKind("ServiceInstance"))
+		ownerRef.BlockOwnerDeletion = nil
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       instance.Namespace,
+				Labels:          map[string]string{requestSnapshotLabel: "true"},
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Data: data,
+		}
+		_, err = configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+	case err == nil:
+		configMap := existing.DeepCopy()
+		configMap.Data = data
+		_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.Warning(pcb.Messagef("Unable to write the snapshot of the %s request to ConfigMap %s/%s: %v", operation, instance.Namespace, name, err))
+		return
+	}
+	klog.V(4).Info(pcb.Messagef("Wrote the snapshot of the %s request to ConfigMap %s/%s", operation, instance.Namespace, name))
+}
+
+// redactServiceInstanceRequest returns a copy of a provision or update
+// request without the values of the parameters taken from secrets, the
+// operation callback token and the originating identity. redacted holds the
+// parameters of the instance with the values taken from secrets redacted;
+// a parameter of the request missing from it is redacted as well.
+func redactServiceInstanceRequest(request interface{}, redacted *runtime.RawExtension) interface{} {
+	var redactedParameters map[string]interface{}
+	if redacted != nil && len(redacted.Raw) > 0 {
+		if err := json.Unmarshal(redacted.Raw, &redactedParameters); err != nil {
+			redactedParameters = nil
+		}
+	}
+
+	switch r := request.(type) {
+	case *osb.ProvisionRequest:
+		copied := *r
+		copied.Parameters = redactRequestParameters(r.Parameters, redactedParameters)
+		copied.Context = redactRequestContext(r.Context)
+		copied.OriginatingIdentity = redactOriginatingIdentity(r.OriginatingIdentity)
+		return &copied
+	case *osb.UpdateInstanceRequest:
+		copied := *r
+		copied.Parameters = redactRequestParameters(r.Parameters, redactedParameters)
+		copied.Context = redactRequestContext(r.Context)
+		copied.OriginatingIdentity = redactOriginatingIdentity(r.OriginatingIdentity)
+		return &copied
+	}
+	return request
+}
+
+func redactRequestParameters(parameters, redacted map[string]interface{}) map[string]interface{} {
+	if parameters == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(parameters))
+	for k := range parameters {
+		if v, ok := redacted[k]; ok {
+			out[k] = v
+		} else {
+			out[k] = redactedValue
+		}
+	}
+	return out
+}
+
+func redactRequestContext(requestContext map[string]interface{}) map[string]interface{} {
+	if _, ok := requestContext[operationCallbackTokenKey]; !ok {
+		return requestContext
+	}
+	out := make(map[string]interface{}, len(requestContext))
+	for k, v := range requestContext {
+		out[k] = v
+	}
+	out[operationCallbackTokenKey] = redactedValue
+	return out
+}
+
+func redactOriginatingIdentity(identity *osb.OriginatingIdentity) *osb.OriginatingIdentity {
+	if identity == nil {
+		return nil
+	}
+	return &osb.OriginatingIdentity{
+		Platform: identity.Platform,
+		Value:    redactedValue,
+	}
+}
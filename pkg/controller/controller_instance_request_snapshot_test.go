@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+)
+
+// TestRecordServiceInstanceRequestSnapshot tests that the last request sent
+// for an annotated instance is written to its ConfigMap without the values
+// of its secret parameters, its callback token and its originating identity.
+func TestRecordServiceInstanceRequestSnapshot(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.InstanceRequestSnapshots)); err != nil {
+		t.Fatalf("Failed to enable InstanceRequestSnapshots feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.InstanceRequestSnapshots))
+
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	fakeKubeClient := clientgofake.NewSimpleClientset()
+	testController.kubeClient = fakeKubeClient
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Annotations = map[string]string{v1beta1.CaptureRequestAnnotation: "true"}
+	instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
+		Parameters: &runtime.RawExtension{Raw: []byte(`{"name":"test-param","password":"<redacted>"}`)},
+	}
+	request := &osb.ProvisionRequest{
+		InstanceID: testServiceInstanceGUID,
+		ServiceID:  testClusterServiceClassGUID,
+		PlanID:     testClusterServicePlanGUID,
+		Parameters: map[string]interface{}{
+			"name":     "test-param",
+			"password": "letmein",
+		},
+		Context: map[string]interface{}{
+			"namespace":               testNamespace,
+			operationCallbackTokenKey: "secret-token",
+		},
+		OriginatingIdentity: &osb.OriginatingIdentity{Platform: "kubernetes", Value: `{"username":"alice"}`},
+	}
+
+	testController.recordServiceInstanceRequestSnapshot(instance, v1beta1.ServiceInstanceOperationProvision, request)
+
+	configMap, err := fakeKubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), requestSnapshotConfigMapName(instance), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected the snapshot ConfigMap to be created: %v", err)
+	}
+	if e, a := string(v1beta1.ServiceInstanceOperationProvision), configMap.Data["operation"]; e != a {
+		t.Fatalf("Unexpected operation; %s", expectedGot(e, a))
+	}
+	if e, a := "ServiceInstance", configMap.OwnerReferences[0].Kind; e != a {
+		t.Fatalf("Unexpected owner; %s", expectedGot(e, a))
+	}
+
+	var snapshot osb.ProvisionRequest
+	if err := json.Unmarshal([]byte(configMap.Data["request"]), &snapshot); err != nil {
+		t.Fatalf("Unexpected error unmarshalling the snapshot: %v", err)
+	}
+	if e, a := "test-param", snapshot.Parameters["name"]; e != a {
+		t.Fatalf("Unexpected name parameter; %s", expectedGot(e, a))
+	}
+	if e, a := redactedValue, snapshot.Parameters["password"]; e != a {
+		t.Fatalf("Expected the secret parameter to be redacted; %s", expectedGot(e, a))
+	}
+	if e, a := redactedValue, snapshot.Context[operationCallbackTokenKey]; e != a {
+		t.Fatalf("Expected the callback token to be redacted; %s", expectedGot(e, a))
+	}
+	if e, a := redactedValue, snapshot.OriginatingIdentity.Value; e != a {
+		t.Fatalf("Expected the originating identity to be redacted; %s", expectedGot(e, a))
+	}
+	if e, a := "letmein", request.Parameters["password"]; e != a {
+		t.Fatalf("Expected the request itself to be left alone; %s", expectedGot(e, a))
+	}
+}
+
+// TestRecordServiceInstanceRequestSnapshotNotAnnotated tests that no
+// snapshot is written for an instance without the annotation.
+func TestRecordServiceInstanceRequestSnapshotNotAnnotated(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.InstanceRequestSnapshots)); err != nil {
+		t.Fatalf("Failed to enable InstanceRequestSnapshots feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.InstanceRequestSnapshots))
+
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	testController.recordServiceInstanceRequestSnapshot(instance, v1beta1.ServiceInstanceOperationProvision, &osb.ProvisionRequest{})
+
+	if e, a := 0, len(fakeKubeClient.Actions()); e != a {
+		t.Fatalf("Unexpected number of kube actions; %s", expectedGot(e, a))
+	}
+}
@@ -77,6 +77,7 @@ func TestReconcileServiceInstanceNotInitializedStatus(t *testing.T) {
 		UserSpecifiedClassName: "ClusterServiceClass/test",
 		Conditions:             []v1beta1.ServiceInstanceCondition{},
 		DeprovisionStatus:      v1beta1.ServiceInstanceDeprovisionStatusNotRequired,
+		PrintableStatus:        v1beta1.ServiceInstancePrintableStatusProvisioning,
 	}
 
 	err := reconcileServiceInstance(t, testController, instance)
@@ -975,6 +976,59 @@ func TestReconcileServiceInstanceRespectsServicePlanDefaultsMutableFeatureGate(t
 	}
 }
 
+func TestReconcileServiceInstanceAppliesPlanSchemaDefaults(t *testing.T) {
+	err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true,%v=true", scfeatures.ServicePlanDefaults, scfeatures.PlanSchemaDefaults))
+	if err != nil {
+		t.Fatalf("Could not enable ServicePlanDefaults and PlanSchemaDefaults feature flags.")
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false,%v=false", scfeatures.ServicePlanDefaults, scfeatures.PlanSchemaDefaults))
+
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sc := getTestClusterServiceClass()
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(sc)
+	sp := getTestClusterServicePlan()
+
+	// Setup defaults in the schema of the plan, one of them overridden by
+	// the default parameters of the plan
+	sp.Spec.InstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{
+		"type": "object",
+		"properties": {
+			"secure": {"type": "boolean", "default": false},
+			"size": {"type": "integer", "default": 10}
+		}
+	}`)}
+	sp.Spec.DefaultProvisionParameters = &runtime.RawExtension{Raw: []byte(`{"secure": true}`)}
+
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(sp)
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 3)
+
+	updatedServiceInstance := assertUpdate(t, actions[1], instance)
+	updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
+	if !ok {
+		t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
+	}
+	wantParams := `{"secure":true,"size":10}`
+	gotParams := string(updateObject.Spec.Parameters.Raw)
+	if gotParams != wantParams {
+		t.Fatalf("The defaults of the plan schema were not applied to the service instance during reconcile.\n\nWANT: %v\nGOT: %v",
+			wantParams, gotParams)
+	}
+}
+
 // TestReconcileServiceInstanceResolvesReferences tests a simple successful
 // reconciliation and making sure that the ClusterServicePlanRef is correctly
 // resolved if the ClusterServiceClassRef is already set.
@@ -1375,6 +1429,7 @@ func TestReconcileServiceInstance(t *testing.T) {
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
 	assertServiceInstanceOperationSuccess(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
 	assertServiceInstanceDashboardURL(t, updatedServiceInstance, testDashboardURL)
+	assertServiceInstanceBroker(t, updatedServiceInstance, testClusterServiceBrokerName, "https://example.com")
 
 	events := getRecordedEvents(testController)
 
@@ -4311,13 +4366,24 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 		{
 			name: "sync - http error",
 			deprovReaction: &fakeosb.DeprovisionReaction{
-				Error: fakeosb.AsyncRequiredError(),
+				Error: osb.HTTPStatusCodeError{
+					StatusCode: http.StatusInternalServerError,
+				},
 			},
 			finishedOrphanMitigation:     false,
 			shouldError:                  true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
 			expectedReadyConditionReason: errorDeprovisionCallFailedReason,
 		},
+		{
+			name: "sync - async required",
+			deprovReaction: &fakeosb.DeprovisionReaction{
+				Error: fakeosb.AsyncRequiredError(),
+			},
+			finishedOrphanMitigation:     false,
+			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
+			expectedReadyConditionReason: errorBrokerAsyncRequiredReason,
+		},
 		{
 			name: "sync - http error - retry duration exceeded",
 			deprovReaction: &fakeosb.DeprovisionReaction{
@@ -6451,3 +6517,71 @@ func assertServiceInstanceOperationInProgressWithParameterAndUserSpecifiedFields
 
 	return updateObject
 }
+
+// TestAppendServiceInstanceOperationTimeline tests that the operation
+// timeline keeps only the latest entries.
+func TestAppendServiceInstanceOperationTimeline(t *testing.T) {
+	instance := getTestServiceInstance()
+
+	total := v1beta1.ServiceInstanceOperationTimelineMaxLength + 3
+	for i := 0; i < total; i++ {
+		appendServiceInstanceOperationTimeline(instance, v1beta1.ServiceInstanceOperationPhasePolling, fmt.Sprintf("poll %d", i))
+	}
+
+	timeline := instance.Status.OperationTimeline
+	if e, a := v1beta1.ServiceInstanceOperationTimelineMaxLength, len(timeline); e != a {
+		t.Fatalf("unexpected timeline length: expected %v, got %v", e, a)
+	}
+	if e, a := "poll 3", timeline[0].Message; e != a {
+		t.Fatalf("unexpected oldest entry: expected %q, got %q", e, a)
+	}
+	if e, a := fmt.Sprintf("poll %d", total-1), timeline[len(timeline)-1].Message; e != a {
+		t.Fatalf("unexpected newest entry: expected %q, got %q", e, a)
+	}
+}
+
+// TestServiceInstanceRequestContext tests that the instance's description
+// and selected labels are added to the OSB context.
+func TestServiceInstanceRequestContext(t *testing.T) {
+	instance := getTestServiceInstance()
+	if e, a := testContext, serviceInstanceRequestContext(instance, testClusterID); !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected context: expected %v, got %v", e, a)
+	}
+
+	instance.Labels = map[string]string{"app": "orders", "tier": "db"}
+	instance.Spec.Description = "orders database"
+	instance.Spec.ContextLabels = []string{"app", "missing"}
+
+	expected := map[string]interface{}{
+		"platform":             ContextProfilePlatformKubernetes,
+		"namespace":            testNamespace,
+		"instance_name":        testServiceInstanceName,
+		clusterIdentifierKey:   testClusterID,
+		"instance_description": "orders database",
+		"instance_labels":      map[string]interface{}{"app": "orders"},
+	}
+	if a := serviceInstanceRequestContext(instance, testClusterID); !reflect.DeepEqual(expected, a) {
+		t.Fatalf("unexpected context: expected %v, got %v", expected, a)
+	}
+}
+
+// TestServiceInstanceRequestContextNamespaceOverride tests that the
+// instance's ContextNamespaceOverride replaces its namespace in the OSB
+// context only when the ContextNamespaceOverride feature is enabled.
+func TestServiceInstanceRequestContextNamespaceOverride(t *testing.T) {
+	instance := getTestServiceInstance()
+	instance.Spec.ContextNamespaceOverride = "tenant-namespace"
+
+	if e, a := testNamespace, serviceInstanceRequestContext(instance, testClusterID)["namespace"]; e != a {
+		t.Fatalf("unexpected namespace with the feature disabled: expected %v, got %v", e, a)
+	}
+
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ContextNamespaceOverride)); err != nil {
+		t.Fatalf("Failed to enable ContextNamespaceOverride feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ContextNamespaceOverride))
+
+	if e, a := "tenant-namespace", serviceInstanceRequestContext(instance, testClusterID)["namespace"]; e != a {
+		t.Fatalf("unexpected namespace with the feature enabled: expected %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const updateDebouncedReason string = "UpdateDebounced"
+
+// serviceInstanceUpdateDebounces coalesces the generations of a
+// ServiceInstance edited several times in quick succession, so that a single
+// update request is sent to the broker for all of them.
+type serviceInstanceUpdateDebounces struct {
+	// window is how long the spec of an instance must stay unchanged
+	// before its update request is sent; zero or less disables debouncing.
+	window time.Duration
+
+	mutex sync.Mutex
+	// instances maps the UID of each instance with a pending update to the
+	// latest generation seen and when it was first seen.
+	instances map[types.UID]updateDebounceEntry
+}
+
+type updateDebounceEntry struct {
+	generation int64
+	seen       time.Time
+}
+
+// debounceServiceInstanceUpdate returns whether the update request of
+// instance must wait for its spec to stay unchanged for the debounce window.
+// If it must, the instance is added back to the queue once the window of its
+// latest generation ends. Each new generation restarts the window, and a
+// generation whose window has ended is not debounced again, so that retries
+// of its update request are not delayed.
+func (c *controller) debounceServiceInstanceUpdate(instance *v1beta1.ServiceInstance) bool {
+	d := &c.instanceUpdateDebounces
+	if d.window <= 0 {
+		return false
+	}
+
+	d.mutex.Lock()
+	if instance.Status.ObservedGeneration == instance.Generation {
+		delete(d.instances, instance.UID)
+		d.mutex.Unlock()
+		return false
+	}
+	if d.instances == nil {
+		d.instances = make(map[types.UID]updateDebounceEntry)
+	}
+	now := time.Now()
+	entry, ok := d.instances[instance.UID]
+	newGeneration := !ok || entry.generation != instance.Generation
+	if newGeneration {
+		entry = updateDebounceEntry{generation: instance.Generation, seen: now}
+		d.instances[instance.UID] = entry
+	}
+	d.mutex.Unlock()
+
+	remaining := entry.seen.Add(d.window).Sub(now)
+	if remaining <= 0 {
+		return false
+	}
+
+	if newGeneration {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		s := fmt.Sprintf("Waiting %v for further changes to the spec of generation %d before sending the update request", d.window, instance.Generation)
+		klog.V(4).Info(pcb.Message(s))
+		c.recorder.Event(instance, corev1.EventTypeNormal, updateDebouncedReason, s)
+	}
+	c.enqueueInstanceAfter(instance, remaining)
+	return true
+}
+
+// forgetServiceInstanceUpdateDebounce drops the debounce window of a deleted
+// instance.
+func (c *controller) forgetServiceInstanceUpdateDebounce(instance *v1beta1.ServiceInstance) {
+	d := &c.instanceUpdateDebounces
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.instances, instance.UID)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestReconcileServiceInstanceUpdateDebounced tests that the update request
+// of an instance edited several times in quick succession is only sent once
+// its spec has stayed unchanged for the debounce window.
+func TestReconcileServiceInstanceUpdateDebounced(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Response: &osb.UpdateInstanceResponse{},
+		},
+	})
+	testController.instanceUpdateDebounces.window = time.Hour
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithRefsAndExternalProperties()
+	instance.Generation = 2
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+
+	// Each new generation is held back and restarts the window.
+	for _, generation := range []int64{2, 3} {
+		instance.Generation = generation
+		if err := reconcileServiceInstance(t, testController, instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	}
+	expectedEvents := append(normalEventBuilder(updateDebouncedReason).stringArr(), normalEventBuilder(updateDebouncedReason).stringArr()...)
+	if err := checkEventPrefixes(getRecordedEvents(testController), expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reconciling the same generation again within the window does not
+	// restart it.
+	entry := testController.instanceUpdateDebounces.instances[instance.UID]
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := entry, testController.instanceUpdateDebounces.instances[instance.UID]; e != a {
+		t.Fatalf("Unexpected debounce entry; %s", expectedGot(e, a))
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	// Once the window of the latest generation has ended, the update is
+	// started for it.
+	entry.seen = time.Now().Add(-2 * time.Hour)
+	testController.instanceUpdateDebounces.instances[instance.UID] = entry
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceCurrentOperation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate)
+	if e, a := int64(3), updatedServiceInstance.(*v1beta1.ServiceInstance).Status.ObservedGeneration; e != a {
+		t.Fatalf("Unexpected observed generation; %s", expectedGot(e, a))
+	}
+
+	testController.instanceDelete(instance)
+	if _, ok := testController.instanceUpdateDebounces.instances[instance.UID]; ok {
+		t.Fatal("Expected the debounce entry of the deleted instance to be dropped")
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+
+	corev1 "k8s.io/api/core/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+)
+
+const (
+	successVerificationReason  string = "VerifiedSuccessfully"
+	successVerificationMessage string = "A temporary binding was created and deleted successfully"
+
+	verificationNotApplicableReason  string = "VerificationNotApplicable"
+	verificationNotApplicableMessage string = "The instance's plan is not bindable, so no temporary binding was made"
+
+	errorVerificationBindFailedReason    string = "VerificationBindFailed"
+	errorVerificationNoCredentialsReason string = "VerificationNoCredentials"
+	errorVerificationUnbindFailedReason  string = "VerificationUnbindFailed"
+)
+
+// shouldVerifyServiceInstance returns whether a temporary binding should be
+// made for the instance: it must have asked for verification, be ready, and
+// not have been verified since its last operation, unless the temporary
+// binding of its verification could not be deleted.
+func shouldVerifyServiceInstance(instance *v1beta1.ServiceInstance) bool {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.BindingVerification) {
+		return false
+	}
+	if !instance.Spec.VerifyBinding || !isServiceInstanceReady(instance) {
+		return false
+	}
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionVerified {
+			return isServiceInstanceVerificationBindingLeft(instance)
+		}
+	}
+	return true
+}
+
+// isServiceInstanceVerificationBindingLeft returns whether the temporary
+// binding of the verification of the instance could not be deleted, and is
+// possibly left on the broker.
+func isServiceInstanceVerificationBindingLeft(instance *v1beta1.ServiceInstance) bool {
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionVerified {
+			return cond.Status == v1beta1.ConditionFalse && cond.Reason == errorVerificationUnbindFailedReason
+		}
+	}
+	return false
+}
+
+// verificationBindingID returns the ID of the temporary binding of the
+// verification of instance. It is derived from the instance, so that a
+// temporary binding left on the broker can be deleted on a later attempt.
+func verificationBindingID(instance *v1beta1.ServiceInstance) string {
+	return string(instance.UID)
+}
+
+// verifyServiceInstanceBinding checks that the broker issues credentials for
+// a ready instance by making a synchronous bind and unbind with a temporary
+// binding. The bind request carries the context and originating identity of
+// the instance, like the bind requests of ServiceBindings do. A bind that
+// fails in a way that may have left the binding on the broker is followed
+// by an unbind, as in orphan mitigation. A temporary binding that could not
+// be deleted is deleted again with backoff before the instance is verified
+// again. The outcome is recorded in the instance's Verified condition; a
+// failed verification does not change the instance's Ready condition.
+func (c *controller) verifyServiceInstanceBinding(instance *v1beta1.ServiceInstance) error {
+	if !shouldVerifyServiceInstance(instance) {
+		return nil
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	var (
+		brokerClient osb.Client
+		serviceID    string
+		planID       string
+		bindable     bool
+	)
+	if instance.Spec.ClusterServiceClassSpecified() {
+		serviceClass, servicePlan, _, bClient, err := c.getClusterServiceClassPlanAndClusterServiceBroker(instance)
+		if err != nil {
+			return err
+		}
+		brokerClient = bClient
+		serviceID = serviceClass.Spec.ExternalID
+		planID = servicePlan.Spec.ExternalID
+		bindable = isClusterServicePlanBindable(serviceClass, servicePlan)
+	} else {
+		serviceClass, servicePlan, _, bClient, err := c.getServiceClassPlanAndServiceBroker(instance)
+		if err != nil {
+			return err
+		}
+		brokerClient = bClient
+		serviceID = serviceClass.Spec.ExternalID
+		planID = servicePlan.Spec.ExternalID
+		bindable = isServicePlanBindable(serviceClass, servicePlan)
+	}
+
+	toUpdate := instance.DeepCopy()
+	if !bindable {
+		setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionVerified, v1beta1.ConditionUnknown,
+			verificationNotApplicableReason, verificationNotApplicableMessage)
+		_, err := c.updateServiceInstanceStatus(toUpdate)
+		return err
+	}
+
+	var originatingIdentity *osb.OriginatingIdentity
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
+		var err error
+		originatingIdentity, err = buildOriginatingIdentity(instance.Spec.UserInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	bindingID := verificationBindingID(instance)
+	unbind := func() error {
+		_, err := brokerClient.Unbind(&osb.UnbindRequest{
+			BindingID:           bindingID,
+			InstanceID:          instance.Spec.ExternalID,
+			ServiceID:           serviceID,
+			PlanID:              planID,
+			OriginatingIdentity: originatingIdentity,
+		})
+		return err
+	}
+
+	if isServiceInstanceVerificationBindingLeft(instance) {
+		// don't DOS the broker while it fails to delete the temporary
+		// binding
+		if c.backoffAndRequeueIfRetrying(instance, "verification") {
+			return nil
+		}
+		klog.V(4).Info(pcb.Messagef("Deleting temporary binding %q left by the verification of the instance", bindingID))
+		if err := unbind(); err != nil {
+			c.setRetryBackoffRequired(instance)
+			message := fmt.Sprintf("Error deleting temporary binding %q: %v", bindingID, err)
+			return c.recordServiceInstanceVerification(toUpdate, v1beta1.ConditionFalse, errorVerificationUnbindFailedReason, message)
+		}
+		c.removeInstanceFromRetryMap(instance)
+	}
+
+	klog.V(4).Info(pcb.Message("Verifying instance with a temporary binding"))
+
+	status, reason, message := v1beta1.ConditionTrue, successVerificationReason, successVerificationMessage
+
+	response, err := brokerClient.Bind(&osb.BindRequest{
+		BindingID:           bindingID,
+		InstanceID:          instance.Spec.ExternalID,
+		ServiceID:           serviceID,
+		PlanID:              planID,
+		Context:             serviceInstanceRequestContext(instance, c.getClusterID()),
+		OriginatingIdentity: originatingIdentity,
+	})
+	switch {
+	case err != nil:
+		status, reason = v1beta1.ConditionFalse, errorVerificationBindFailedReason
+		message = fmt.Sprintf("Error creating temporary binding: %v", err)
+	case len(response.Credentials) == 0:
+		status, reason = v1beta1.ConditionFalse, errorVerificationNoCredentialsReason
+		message = "The temporary binding was created but the broker returned no credentials"
+	}
+
+	// The binding may have been created by a bind that failed with a
+	// timeout or a response that requires orphan mitigation.
+	if err == nil || requiresOrphanMitigation(classifyBrokerError("bind", err)) {
+		if unbindErr := unbind(); unbindErr != nil {
+			c.setRetryBackoffRequired(instance)
+			unbindMessage := fmt.Sprintf("Error deleting temporary binding %q: %v", bindingID, unbindErr)
+			if status == v1beta1.ConditionFalse {
+				unbindMessage = fmt.Sprintf("%s. %s", message, unbindMessage)
+			}
+			status, reason, message = v1beta1.ConditionFalse, errorVerificationUnbindFailedReason, unbindMessage
+		}
+	}
+
+	return c.recordServiceInstanceVerification(toUpdate, status, reason, message)
+}
+
+// recordServiceInstanceVerification records the outcome of the verification
+// of an instance in its Verified condition and in an event.
+func (c *controller) recordServiceInstanceVerification(toUpdate *v1beta1.ServiceInstance, status v1beta1.ConditionStatus, reason, message string) error {
+	pcb := pretty.NewInstanceContextBuilder(toUpdate)
+	if status == v1beta1.ConditionTrue {
+		klog.V(4).Info(pcb.Message(message))
+		c.recorder.Event(toUpdate, corev1.EventTypeNormal, reason, message)
+	} else {
+		klog.Warning(pcb.Message(message))
+		c.recorder.Event(toUpdate, corev1.EventTypeWarning, reason, message)
+	}
+
+	setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionVerified, status, reason, message)
+	_, err := c.updateServiceInstanceStatus(toUpdate)
+	return err
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+)
+
+func getTestServiceInstanceRequestingVerification() *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+	instance.UID = "test-instance-uid"
+	instance.Spec.VerifyBinding = true
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	return instance
+}
+
+// TestReconcileServiceInstanceVerifyBinding tests that a ready instance
+// requesting verification is bound and unbound once, and that the outcome
+// is recorded in the Verified condition.
+func TestReconcileServiceInstanceVerifyBinding(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BindingVerification)); err != nil {
+		t.Fatalf("Failed to enable BindingVerification feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingVerification))
+
+	cases := []struct {
+		name           string
+		bindReaction   *fakeosb.BindReaction
+		unbindReaction *fakeosb.UnbindReaction
+		brokerActions  []fakeosb.ActionType
+		status         v1beta1.ConditionStatus
+		reason         string
+	}{
+		{
+			name: "success",
+			bindReaction: &fakeosb.BindReaction{
+				Response: &osb.BindResponse{Credentials: map[string]interface{}{"a": "b"}},
+			},
+			unbindReaction: &fakeosb.UnbindReaction{Response: &osb.UnbindResponse{}},
+			brokerActions:  []fakeosb.ActionType{fakeosb.Bind, fakeosb.Unbind},
+			status:         v1beta1.ConditionTrue,
+			reason:         successVerificationReason,
+		},
+		{
+			name:          "bind failure",
+			bindReaction:  &fakeosb.BindReaction{Error: errors.New("fake bind failure")},
+			brokerActions: []fakeosb.ActionType{fakeosb.Bind},
+			status:        v1beta1.ConditionFalse,
+			reason:        errorVerificationBindFailedReason,
+		},
+		{
+			name:           "bind failure requiring orphan mitigation",
+			bindReaction:   &fakeosb.BindReaction{Error: osb.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError}},
+			unbindReaction: &fakeosb.UnbindReaction{Response: &osb.UnbindResponse{}},
+			brokerActions:  []fakeosb.ActionType{fakeosb.Bind, fakeosb.Unbind},
+			status:         v1beta1.ConditionFalse,
+			reason:         errorVerificationBindFailedReason,
+		},
+		{
+			name:           "bind failure requiring orphan mitigation and unbind failure",
+			bindReaction:   &fakeosb.BindReaction{Error: osb.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError}},
+			unbindReaction: &fakeosb.UnbindReaction{Error: errors.New("fake unbind failure")},
+			brokerActions:  []fakeosb.ActionType{fakeosb.Bind, fakeosb.Unbind},
+			status:         v1beta1.ConditionFalse,
+			reason:         errorVerificationUnbindFailedReason,
+		},
+		{
+			name: "no credentials",
+			bindReaction: &fakeosb.BindReaction{
+				Response: &osb.BindResponse{},
+			},
+			unbindReaction: &fakeosb.UnbindReaction{Response: &osb.UnbindResponse{}},
+			brokerActions:  []fakeosb.ActionType{fakeosb.Bind, fakeosb.Unbind},
+			status:         v1beta1.ConditionFalse,
+			reason:         errorVerificationNoCredentialsReason,
+		},
+		{
+			name: "unbind failure",
+			bindReaction: &fakeosb.BindReaction{
+				Response: &osb.BindResponse{Credentials: map[string]interface{}{"a": "b"}},
+			},
+			unbindReaction: &fakeosb.UnbindReaction{Error: errors.New("fake unbind failure")},
+			brokerActions:  []fakeosb.ActionType{fakeosb.Bind, fakeosb.Unbind},
+			status:         v1beta1.ConditionFalse,
+			reason:         errorVerificationUnbindFailedReason,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				BindReaction:   tc.bindReaction,
+				UnbindReaction: tc.unbindReaction,
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceRequestingVerification()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, len(tc.brokerActions))
+			for i, action := range brokerActions {
+				if e, a := tc.brokerActions[i], action.Type; e != a {
+					t.Fatalf("unexpected broker action %d; expected %v, got %v", i, e, a)
+				}
+			}
+
+			bindRequest := brokerActions[0].Request.(*osb.BindRequest)
+			if e, a := verificationBindingID(instance), bindRequest.BindingID; e != a {
+				t.Fatalf("Unexpected binding ID; %s", expectedGot(e, a))
+			}
+			if e, a := serviceInstanceRequestContext(instance, testClusterID), bindRequest.Context; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Unexpected bind request context; %s", expectedGot(e, a))
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertServiceInstanceReadyTrue(t, updatedServiceInstance)
+			assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionVerified, tc.status, tc.reason)
+
+			assertNumEvents(t, getRecordedEvents(testController), 1)
+
+			// A verified instance is not bound again, and the temporary
+			// binding left by a failed unbind is only deleted again after a
+			// backoff.
+			if err := reconcileServiceInstance(t, testController, updatedServiceInstance.(*v1beta1.ServiceInstance)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), len(tc.brokerActions))
+			assertNumberOfActions(t, fakeCatalogClient.Actions(), 1)
+		})
+	}
+}
+
+// TestReconcileServiceInstanceVerifyBindingDisabled tests that no temporary
+// binding is made when the BindingVerification feature is disabled.
+func TestReconcileServiceInstanceVerifyBindingDisabled(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	if err := reconcileServiceInstance(t, testController, getTestServiceInstanceRequestingVerification()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
+
+// TestReconcileServiceInstanceVerifyBindingLeft tests that the temporary
+// binding left on the broker by a failed unbind is deleted before the
+// instance is verified again.
+func TestReconcileServiceInstanceVerifyBindingLeft(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BindingVerification)); err != nil {
+		t.Fatalf("Failed to enable BindingVerification feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingVerification))
+
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{Credentials: map[string]interface{}{"a": "b"}},
+		},
+		UnbindReaction: &fakeosb.UnbindReaction{Response: &osb.UnbindResponse{}},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceRequestingVerification()
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionVerified, v1beta1.ConditionFalse,
+		errorVerificationUnbindFailedReason, "Error deleting temporary binding")
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 3)
+	for i, e := range []fakeosb.ActionType{fakeosb.Unbind, fakeosb.Bind, fakeosb.Unbind} {
+		if a := brokerActions[i].Type; e != a {
+			t.Fatalf("unexpected broker action %d; expected %v, got %v", i, e, a)
+		}
+	}
+	if e, a := verificationBindingID(instance), brokerActions[0].Request.(*osb.UnbindRequest).BindingID; e != a {
+		t.Fatalf("Unexpected binding ID; %s", expectedGot(e, a))
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionVerified, v1beta1.ConditionTrue, successVerificationReason)
+}
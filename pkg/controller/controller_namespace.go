@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+const (
+	// terminatingNamespaceMaxRetryDelay caps the delay before an instance or
+	// binding in a namespace being deleted is retried, so that the deletion
+	// of the namespace is not held up by the usual exponential backoff.
+	terminatingNamespaceMaxRetryDelay = 5 * time.Second
+	// blockedNamespacesReportInterval is how often the number of namespaces
+	// being deleted that still contain catalog resources is reported.
+	blockedNamespacesReportInterval = 30 * time.Second
+)
+
+// terminatingNamespaces holds the names of the namespaces being deleted.
+type terminatingNamespaces struct {
+	mutex sync.RWMutex
+	names sets.String
+}
+
+func newTerminatingNamespaces() *terminatingNamespaces {
+	return &terminatingNamespaces{names: sets.NewString()}
+}
+
+// has returns whether the namespace with the given name is being deleted.
+func (tn *terminatingNamespaces) has(name string) bool {
+	tn.mutex.RLock()
+	defer tn.mutex.RUnlock()
+	return tn.names.Has(name)
+}
+
+// set records whether the namespace with the given name is being deleted,
+// and returns whether that changed.
+func (tn *terminatingNamespaces) set(name string, terminating bool) bool {
+	tn.mutex.Lock()
+	defer tn.mutex.Unlock()
+	if tn.names.Has(name) == terminating {
+		return false
+	}
+	if terminating {
+		tn.names.Insert(name)
+	} else {
+		tn.names.Delete(name)
+	}
+	return true
+}
+
+// list returns the names of the namespaces being deleted.
+func (tn *terminatingNamespaces) list() []string {
+	tn.mutex.RLock()
+	defer tn.mutex.RUnlock()
+	return tn.names.List()
+}
+
+// namespaceRateLimiter is the rate limiter of the queues of instance and
+// binding keys. It limits the delay before an item is retried to
+// terminatingNamespaceMaxRetryDelay when its namespace is being deleted.
+type namespaceRateLimiter struct {
+	workqueue.RateLimiter
+	terminating *terminatingNamespaces
+}
+
+func newNamespaceRateLimiter(rateLimiter workqueue.RateLimiter, terminating *terminatingNamespaces) workqueue.RateLimiter {
+	return &namespaceRateLimiter{
+		RateLimiter: rateLimiter,
+		terminating: terminating,
+	}
+}
+
+// When returns how long to wait before the item is retried.
+func (r *namespaceRateLimiter) When(item interface{}) time.Duration {
+	delay := r.RateLimiter.When(item)
+	if delay <= terminatingNamespaceMaxRetryDelay {
+		return delay
+	}
+	key, ok := item.(string)
+	if !ok {
+		return delay
+	}
+	if namespace, _, err := cache.SplitMetaNamespaceKey(key); err == nil && r.terminating.has(namespace) {
+		return terminatingNamespaceMaxRetryDelay
+	}
+	return delay
+}
+
+// namespaceAdd handles the Namespace ADDED watch event
+func (c *controller) namespaceAdd(obj interface{}) {
+	c.observeNamespace(obj)
+}
+
+// namespaceUpdate handles the Namespace UPDATED watch event
+func (c *controller) namespaceUpdate(oldObj, newObj interface{}) {
+	c.observeNamespace(newObj)
+}
+
+// namespaceDelete handles the Namespace DELETED watch event
+func (c *controller) namespaceDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		klog.Errorf("Namespace: Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.terminatingNamespaces.set(key, false)
+}
+
+// observeNamespace records whether the namespace is being deleted. When a
+// namespace enters the Terminating phase, its instances and bindings are
+// requeued right away, without the backoff of previous failures.
+func (c *controller) observeNamespace(obj interface{}) {
+	namespace, ok := obj.(*corev1.Namespace)
+	if !ok {
+		return
+	}
+	terminating := namespace.DeletionTimestamp != nil || namespace.Status.Phase == corev1.NamespaceTerminating
+	if c.terminatingNamespaces.set(namespace.Name, terminating) && terminating {
+		klog.V(4).Infof("Namespace %q is being deleted, prioritizing its ServiceInstances and ServiceBindings", namespace.Name)
+		c.prioritizeNamespace(namespace.Name)
+	}
+}
+
+// prioritizeNamespace requeues the instances and bindings in the namespace,
+// resetting their backoff.
+func (c *controller) prioritizeNamespace(namespace string) {
+	instances, err := c.instanceLister.ServiceInstances(namespace).List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Namespace %q: Couldn't list ServiceInstances: %v", namespace, err)
+	}
+	for _, instance := range instances {
+		key, err := cache.MetaNamespaceKeyFunc(instance)
+		if err != nil {
+			continue
+		}
+		c.instanceQueue.Forget(key)
+		c.instanceQueue.Add(key)
+	}
+
+	bindings, err := c.bindingLister.ServiceBindings(namespace).List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Namespace %q: Couldn't list ServiceBindings: %v", namespace, err)
+	}
+	for _, binding := range bindings {
+		key, err := cache.MetaNamespaceKeyFunc(binding)
+		if err != nil {
+			continue
+		}
+		c.bindingQueue.Forget(key)
+		c.bindingQueue.Add(key)
+	}
+}
+
+// createReportBlockedNamespacesWorker creates a task that runs periodically to
+// report the namespaces whose deletion waits on catalog resources
+func (c *controller) createReportBlockedNamespacesWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		wait.Until(c.reportBlockedNamespaces, blockedNamespacesReportInterval, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// reportBlockedNamespaces sets the number of namespaces being deleted that
+// still contain instances or bindings.
+func (c *controller) reportBlockedNamespaces() {
+	blocked := 0
+	for _, namespace := range c.terminatingNamespaces.list() {
+		instances, err := c.instanceLister.ServiceInstances(namespace).List(labels.Everything())
+		if err == nil && len(instances) > 0 {
+			blocked++
+			continue
+		}
+		bindings, err := c.bindingLister.ServiceBindings(namespace).List(labels.Everything())
+		if err == nil && len(bindings) > 0 {
+			blocked++
+		}
+	}
+	metrics.NamespacesBlockedOnCatalogResources.Set(float64(blocked))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestNamespaceRateLimiter(t *testing.T) {
+	terminating := newTerminatingNamespaces()
+	rateLimiter := newNamespaceRateLimiter(workqueue.NewItemExponentialFailureRateLimiter(time.Minute, time.Hour), terminating)
+
+	key := testNamespace + "/" + testServiceInstanceName
+	if e, a := time.Minute, rateLimiter.When(key); e != a {
+		t.Fatalf("Unexpected delay; %s", expectedGot(e, a))
+	}
+
+	terminating.set(testNamespace, true)
+	if e, a := terminatingNamespaceMaxRetryDelay, rateLimiter.When(key); e != a {
+		t.Fatalf("Unexpected delay in a namespace being deleted; %s", expectedGot(e, a))
+	}
+	if e, a := 2, rateLimiter.NumRequeues(key); e != a {
+		t.Fatalf("Unexpected number of requeues; %s", expectedGot(e, a))
+	}
+
+	otherKey := "other-namespace/" + testServiceInstanceName
+	if e, a := time.Minute, rateLimiter.When(otherKey); e != a {
+		t.Fatalf("Unexpected delay in another namespace; %s", expectedGot(e, a))
+	}
+}
+
+// TestNamespaceTerminatingPrioritizesResources tests that the instances and
+// bindings of a namespace are requeued when the namespace is being deleted,
+// and only then.
+func TestNamespaceTerminatingPrioritizesResources(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstance())
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(getTestServiceBinding())
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: testNamespace, UID: testNamespaceGUID},
+	}
+	testController.namespaceAdd(namespace)
+	if e, a := 0, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued instances; %s", expectedGot(e, a))
+	}
+
+	terminatingNamespace := namespace.DeepCopy()
+	terminatingNamespace.Status.Phase = corev1.NamespaceTerminating
+	testController.namespaceUpdate(namespace, terminatingNamespace)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued instances; %s", expectedGot(e, a))
+	}
+	if e, a := 1, testController.bindingQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued bindings; %s", expectedGot(e, a))
+	}
+	if !testController.terminatingNamespaces.has(testNamespace) {
+		t.Fatal("Expected the namespace to be recorded as being deleted")
+	}
+
+	testController.namespaceDelete(cache.DeletedFinalStateUnknown{Key: testNamespace, Obj: terminatingNamespace})
+	if testController.terminatingNamespaces.has(testNamespace) {
+		t.Fatal("Expected the deleted namespace to be forgotten")
+	}
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+// namespacedParityGap is a known difference between the namespaced brokers,
+// classes and plans and the cluster-scoped ones, which keeps a case of the
+// conformance suite from passing.
+type namespacedParityGap struct {
+	// area is what the gap is about, e.g. "relist".
+	area string
+	// reason says how the namespaced resources behave differently.
+	reason string
+}
+
+func (g *namespacedParityGap) skip(t *testing.T) {
+	if g != nil {
+		t.Skipf("namespaced parity gap (%s): %s", g.area, g.reason)
+	}
+}
+
+// TestNamespacedServiceBrokerConformance checks that namespaced brokers,
+// classes, plans, instances and bindings behave like the cluster-scoped ones.
+// The cases that cannot pass yet are skipped with the gap they hit, so that
+// closing a gap is a matter of removing it from its case.
+func TestNamespacedServiceBrokerConformance(t *testing.T) {
+	cases := []struct {
+		name string
+		gap  *namespacedParityGap
+		run  func(t *testing.T)
+	}{
+		{
+			name: "auth/basic",
+			run:  testNamespacedBrokerAuth(getTestBrokerBasicAuthInfo(), getTestBasicAuthSecret(), true),
+		},
+		{
+			name: "auth/bearer",
+			run:  testNamespacedBrokerAuth(getTestBrokerBearerAuthInfo(), getTestBearerAuthSecret(), true),
+		},
+		{
+			name: "auth/invalid secret",
+			run:  testNamespacedBrokerAuth(getTestBrokerBasicAuthInfo(), getTestBearerAuthSecret(), false),
+		},
+		{
+			name: "auth/secret not found",
+			run:  testNamespacedBrokerAuth(getTestBrokerBearerAuthInfo(), nil, false),
+		},
+		{
+			name: "relist/creates classes and plans in the broker namespace",
+			run:  testNamespacedBrokerRelist,
+		},
+		{
+			name: "relist/filters with catalog restrictions",
+			run:  testNamespacedBrokerCatalogRestrictions,
+		},
+		{
+			name: "relist/takes over classes of another broker",
+			gap: &namespacedParityGap{
+				area:   "relist",
+				reason: "a cluster broker takes over or records the conflicting classes of another broker, a namespaced broker fails its relist",
+			},
+		},
+		{
+			name: "relist/leaves user-defined classes and plans alone",
+			gap: &namespacedParityGap{
+				area:   "relist",
+				reason: "namespaced classes and plans are all considered created from the catalog of their broker",
+			},
+		},
+		{
+			name: "deletion/removes classes and plans",
+			run:  testNamespacedBrokerDeletion,
+		},
+		{
+			name: "instance/provision",
+			run:  TestReconcileServiceInstanceNamespacedRefs,
+		},
+		{
+			name: "instance/deprovision",
+			run:  TestReconcileServiceInstanceDeleteWithNamespacedRefs,
+		},
+		{
+			name: "instance/resolve references",
+			run:  TestResolveNamespacedReferencesWorks,
+		},
+		{
+			name: "binding/bind",
+			run:  TestReconcileServiceBindingWithParametersNamespacedRefs,
+		},
+		{
+			name: "binding/unbind",
+			run:  TestReconcileServiceBindingDeleteNamespacedRefs,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.gap.skip(t)
+			if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NamespacedServiceBroker)); err != nil {
+				t.Fatalf("Failed to enable namespaced service broker feature: %v", err)
+			}
+			defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NamespacedServiceBroker))
+			tc.run(t)
+		})
+	}
+}
+
+// testNamespacedBrokerAuth checks that the credentials of a namespaced broker
+// are read from the namespace of the broker.
+func testNamespacedBrokerAuth(authInfo *v1beta1.ServiceBrokerAuthInfo, secret *corev1.Secret, shouldSucceed bool) func(t *testing.T) {
+	return func(t *testing.T) {
+		fakeKubeClient, fakeCatalogClient, fakeServiceBrokerClient, testController, _ := newTestController(t, getTestNamespacedCatalogConfig())
+		if secret != nil {
+			addGetSecretReaction(fakeKubeClient, secret)
+		} else {
+			addGetSecretNotFoundReaction(fakeKubeClient)
+		}
+
+		broker := getTestServiceBrokerWithAuth(authInfo)
+		err := reconcileServiceBroker(t, testController, broker)
+		if shouldSucceed && err != nil {
+			t.Fatalf("Unexpected error reconciling the broker: %v", err)
+		}
+		if !shouldSucceed && err == nil {
+			t.Fatal("Expected an error reconciling the broker")
+		}
+
+		kubeActions := fakeKubeClient.Actions()
+		assertNumberOfActions(t, kubeActions, 1)
+		if e, a := broker.Namespace, kubeActions[0].GetNamespace(); e != a {
+			t.Fatalf("Unexpected namespace of the auth secret; %s", expectedGot(e, a))
+		}
+
+		brokerActions := fakeServiceBrokerClient.Actions()
+		actions := fakeCatalogClient.Actions()
+		if !shouldSucceed {
+			assertNumberOfBrokerActions(t, brokerActions, 0)
+			assertNumberOfActions(t, actions, 1)
+			assertServiceBrokerReadyFalse(t, assertUpdateStatus(t, actions[0], broker))
+
+			events := getRecordedEvents(testController)
+			assertNumEvents(t, events, 1)
+			if e, a := corev1.EventTypeWarning+" "+errorAuthCredentialsReason, events[0]; !strings.HasPrefix(a, e) {
+				t.Fatalf("Received unexpected event; %s", expectedGot(e, a))
+			}
+			return
+		}
+		assertNumberOfBrokerActions(t, brokerActions, 1)
+		assertGetCatalog(t, brokerActions[0])
+		assertServiceBrokerReadyTrue(t, assertUpdateStatus(t, actions[len(actions)-1], broker))
+	}
+}
+
+// testNamespacedBrokerRelist checks that the catalog of a namespaced broker
+// is materialized in its namespace, with the broker as the controller of its
+// classes and plans.
+func testNamespacedBrokerRelist(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestNamespacedCatalogConfig())
+
+	broker := getTestServiceBroker()
+	if err := reconcileServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("Unexpected error reconciling the broker: %v", err)
+	}
+
+	classes, plans := createdNamespacedCatalogEntries(fakeCatalogClient.Actions())
+	if e, a := 1, len(classes); e != a {
+		t.Fatalf("Unexpected number of created classes; %s", expectedGot(e, a))
+	}
+	if e, a := 2, len(plans); e != a {
+		t.Fatalf("Unexpected number of created plans; %s", expectedGot(e, a))
+	}
+	entries := []metav1.Object{classes[0], plans[0], plans[1]}
+	for _, entry := range entries {
+		if e, a := broker.Namespace, entry.GetNamespace(); e != a {
+			t.Fatalf("Unexpected namespace of %q; %s", entry.GetName(), expectedGot(e, a))
+		}
+		owner := metav1.GetControllerOf(entry)
+		if owner == nil || owner.Kind != "ServiceBroker" || owner.Name != broker.Name {
+			t.Fatalf("Expected %q to be controlled by the broker, got %+v", entry.GetName(), owner)
+		}
+	}
+}
+
+// testNamespacedBrokerCatalogRestrictions checks that the catalog
+// restrictions of a namespaced broker filter the plans it materializes.
+func testNamespacedBrokerCatalogRestrictions(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestNamespacedCatalogConfig())
+
+	broker := getTestServiceBroker()
+	broker.Spec.CatalogRestrictions = &v1beta1.CatalogRestrictions{
+		ServicePlan: []string{"spec.externalName==" + testServicePlanName},
+	}
+	if err := reconcileServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("Unexpected error reconciling the broker: %v", err)
+	}
+
+	_, plans := createdNamespacedCatalogEntries(fakeCatalogClient.Actions())
+	if e, a := 1, len(plans); e != a {
+		t.Fatalf("Unexpected number of created plans; %s", expectedGot(e, a))
+	}
+	if e, a := testServicePlanName, plans[0].Spec.ExternalName; e != a {
+		t.Fatalf("Unexpected plan; %s", expectedGot(e, a))
+	}
+}
+
+// testNamespacedBrokerDeletion checks that deleting a namespaced broker
+// deletes its classes and plans before removing its finalizer.
+func testNamespacedBrokerDeletion(t *testing.T) {
+	_, fakeCatalogClient, fakeServiceBrokerClient, testController, _ := newTestController(t, getTestNamespacedCatalogConfig())
+
+	broker := getTestServiceBroker()
+	broker.DeletionTimestamp = &metav1.Time{}
+	broker.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	fakeCatalogClient.AddReactor(getServiceBrokerReactor(broker))
+	fakeCatalogClient.AddReactor(listServiceClassesReactor([]v1beta1.ServiceClass{*getTestServiceClass()}))
+	fakeCatalogClient.AddReactor(listServicePlansReactor([]v1beta1.ServicePlan{*getTestServicePlan()}))
+
+	if err := reconcileServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("Unexpected error reconciling the broker: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 7)
+	assertDelete(t, actions[2], getTestServicePlan())
+	assertDelete(t, actions[3], getTestServiceClass())
+	for _, action := range actions[2:4] {
+		if e, a := broker.Namespace, action.GetNamespace(); e != a {
+			t.Fatalf("Unexpected namespace of the deletion; %s", expectedGot(e, a))
+		}
+	}
+	assertEmptyFinalizers(t, assertUpdate(t, actions[6], broker))
+}
+
+// createdNamespacedCatalogEntries returns the namespaced classes and plans
+// created by the given actions.
+func createdNamespacedCatalogEntries(actions []clientgotesting.Action) ([]*v1beta1.ServiceClass, []*v1beta1.ServicePlan) {
+	var (
+		classes []*v1beta1.ServiceClass
+		plans   []*v1beta1.ServicePlan
+	)
+	for _, action := range actions {
+		create, ok := action.(clientgotesting.CreateAction)
+		if !ok {
+			continue
+		}
+		switch obj := create.GetObject().(type) {
+		case *v1beta1.ServiceClass:
+			classes = append(classes, obj)
+		case *v1beta1.ServicePlan:
+			plans = append(plans, obj)
+		}
+	}
+	return classes, plans
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	// OperationCallbackPath is the path under which the controller accepts
+	// the notifications of brokers that an operation completed, at
+	// OperationCallbackPath<namespace>/<name> for each ServiceInstance.
+	OperationCallbackPath = "/operations/callback/"
+
+	// operationCallbackURLKey and operationCallbackTokenKey are the fields
+	// of the OSB context that tell a broker with OperationCallbacks set
+	// where to notify the controller, and with which bearer token.
+	operationCallbackURLKey   = "callback_url"
+	operationCallbackTokenKey = "callback_token"
+)
+
+// operationCallbacks signs and verifies the tokens that authenticate the
+// operation callbacks of brokers.
+type operationCallbacks struct {
+	baseURL string
+	key     []byte
+}
+
+// url returns the address at which the broker notifies the controller that
+// an operation on instance completed.
+func (oc *operationCallbacks) url(instance *v1beta1.ServiceInstance) string {
+	return oc.baseURL + OperationCallbackPath + instance.Namespace + "/" + instance.Name
+}
+
+// token returns the bearer token of the operation callbacks of instance. It
+// covers the UID of the instance, so that it is not valid for an instance
+// recreated with the same name.
+func (oc *operationCallbacks) token(instance *v1beta1.ServiceInstance) string {
+	mac := hmac.New(sha256.New, oc.key)
+	mac.Write([]byte(instance.Namespace + "/" + instance.Name + "/" + string(instance.UID)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// EnableOperationCallbacks makes the controller accept operation callbacks,
+// and returns the handler serving them.
+func (c *controller) EnableOperationCallbacks(baseURL string, key []byte) http.Handler {
+	c.operationCallbacks = &operationCallbacks{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		key:     key,
+	}
+	return http.HandlerFunc(c.serveOperationCallback)
+}
+
+// serviceInstanceUsesOperationCallbacks returns whether the broker of
+// instance notifies the controller when its operations complete.
+func (c *controller) serviceInstanceUsesOperationCallbacks(instance *v1beta1.ServiceInstance) bool {
+	if c.operationCallbacks == nil {
+		return false
+	}
+	_, spec := c.getServiceInstanceBrokerSpec(instance)
+	return spec != nil && spec.OperationCallbacks
+}
+
+// addOperationCallbackContext adds the callback URL and token of instance to
+// the OSB context sent with its provision and update requests when its broker
+// uses operation callbacks. They are left out of the context checksum, so
+// that enabling callbacks does not by itself trigger an update request.
+func (c *controller) addOperationCallbackContext(instance *v1beta1.ServiceInstance, requestContext map[string]interface{}) {
+	if requestContext == nil || !c.serviceInstanceUsesOperationCallbacks(instance) {
+		return
+	}
+	requestContext[operationCallbackURLKey] = c.operationCallbacks.url(instance)
+	requestContext[operationCallbackTokenKey] = c.operationCallbacks.token(instance)
+}
+
+// serveOperationCallback polls the last operation of the instance named in
+// the path right away. The body of the request is ignored: the state of the
+// operation is always taken from the broker's last operation endpoint.
+func (c *controller) serveOperationCallback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, OperationCallbackPath), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	// An unknown instance and a wrong token get the same response, so
+	// that the callbacks do not reveal which instances exist.
+	instance, err := c.instanceLister.ServiceInstances(parts[0]).Get(parts[1])
+	if err != nil || !c.serviceInstanceUsesOperationCallbacks(instance) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	token, ok := bearerToken(r)
+	if !ok || !hmac.Equal([]byte(token), []byte(c.operationCallbacks.token(instance))) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if instance.Status.AsyncOpInProgress {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.V(4).Info(pcb.Message("Broker notified that the operation completed, polling the last operation"))
+		key := parts[0] + "/" + parts[1]
+		c.instancePollingQueue.Forget(key)
+		c.instanceQueue.Add(key)
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// bearerToken returns the bearer token in the Authorization header of r.
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(authorization, prefix), true
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	v1beta1informers "github.com/drycc-addons/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
+)
+
+const testOperationCallbackURL = "https://catalog.example.com/"
+
+// setUpOperationCallbacks enables the operation callbacks of testController
+// and adds a broker using them, with its class and plan, and instance to the
+// informers.
+func setUpOperationCallbacks(testController *controller, sharedInformers v1beta1informers.Interface, instance *v1beta1.ServiceInstance) http.Handler {
+	handler := testController.EnableOperationCallbacks(testOperationCallbackURL, []byte("test-key"))
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.OperationCallbacks = true
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	return handler
+}
+
+func TestServeOperationCallback(t *testing.T) {
+	cases := []struct {
+		name                string
+		method              string
+		path                string
+		authorization       func(token string) string
+		operationCallbacks  bool
+		expectedStatus      int
+		expectedQueueLength int
+	}{
+		{
+			name:                "valid token",
+			method:              http.MethodPost,
+			path:                OperationCallbackPath + testNamespace + "/" + testServiceInstanceName,
+			authorization:       func(token string) string { return "Bearer " + token },
+			operationCallbacks:  true,
+			expectedStatus:      http.StatusAccepted,
+			expectedQueueLength: 1,
+		},
+		{
+			name:               "wrong token",
+			method:             http.MethodPost,
+			path:               OperationCallbackPath + testNamespace + "/" + testServiceInstanceName,
+			authorization:      func(token string) string { return "Bearer " + token + "0" },
+			operationCallbacks: true,
+			expectedStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:               "token without bearer scheme",
+			method:             http.MethodPost,
+			path:               OperationCallbackPath + testNamespace + "/" + testServiceInstanceName,
+			authorization:      func(token string) string { return token },
+			operationCallbacks: true,
+			expectedStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:               "unknown instance",
+			method:             http.MethodPost,
+			path:               OperationCallbackPath + testNamespace + "/other-instance",
+			authorization:      func(token string) string { return "Bearer " + token },
+			operationCallbacks: true,
+			expectedStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:           "broker without operation callbacks",
+			method:         http.MethodPost,
+			path:           OperationCallbackPath + testNamespace + "/" + testServiceInstanceName,
+			authorization:  func(token string) string { return "Bearer " + token },
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:               "invalid path",
+			method:             http.MethodPost,
+			path:               OperationCallbackPath + testNamespace,
+			authorization:      func(token string) string { return "Bearer " + token },
+			operationCallbacks: true,
+			expectedStatus:     http.StatusNotFound,
+		},
+		{
+			name:               "invalid method",
+			method:             http.MethodGet,
+			path:               OperationCallbackPath + testNamespace + "/" + testServiceInstanceName,
+			authorization:      func(token string) string { return "Bearer " + token },
+			operationCallbacks: true,
+			expectedStatus:     http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+			handler := setUpOperationCallbacks(testController, sharedInformers, instance)
+			if !tc.operationCallbacks {
+				broker := getTestClusterServiceBroker()
+				sharedInformers.ClusterServiceBrokers().Informer().GetStore().Update(broker)
+			}
+
+			request := httptest.NewRequest(tc.method, tc.path, nil)
+			request.Header.Set("Authorization", tc.authorization(testController.operationCallbacks.token(instance)))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if e, a := tc.expectedStatus, recorder.Code; e != a {
+				t.Fatalf("Unexpected status code; %s", expectedGot(e, a))
+			}
+			if e, a := tc.expectedQueueLength, testController.instanceQueue.Len(); e != a {
+				t.Fatalf("Unexpected number of queued instances; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestBeginPollingServiceInstanceOperationCallbacks tests that an instance
+// whose broker uses operation callbacks is not polled with the usual
+// backoff.
+func TestBeginPollingServiceInstanceOperationCallbacks(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	setUpOperationCallbacks(testController, sharedInformers, instance)
+
+	if err := testController.beginPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key := testNamespace + "/" + testServiceInstanceName
+	if e, a := 0, testController.instancePollingQueue.NumRequeues(key); e != a {
+		t.Fatalf("Unexpected number of requeues; %s", expectedGot(e, a))
+	}
+	if e, a := 0, testController.instancePollingQueue.Len(); e != a {
+		t.Fatalf("Expected the instance not to be polled before the maximum polling interval; %s", expectedGot(e, a))
+	}
+}
+
+// TestAddOperationCallbackContext tests that the callback URL and token are
+// added to the context of the requests of an instance whose broker uses
+// operation callbacks, and only then.
+func TestAddOperationCallbackContext(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	instance := getTestServiceInstanceWithClusterRefs()
+	setUpOperationCallbacks(testController, sharedInformers, instance)
+
+	requestContext := map[string]interface{}{}
+	testController.addOperationCallbackContext(instance, requestContext)
+	expectedURL := "https://catalog.example.com" + OperationCallbackPath + testNamespace + "/" + testServiceInstanceName
+	if e, a := expectedURL, requestContext[operationCallbackURLKey]; e != a {
+		t.Fatalf("Unexpected callback URL; %s", expectedGot(e, a))
+	}
+	if e, a := testController.operationCallbacks.token(instance), requestContext[operationCallbackTokenKey]; e != a {
+		t.Fatalf("Unexpected callback token; %s", expectedGot(e, a))
+	}
+
+	recreated := instance.DeepCopy()
+	recreated.UID = "recreated"
+	if testController.operationCallbacks.token(instance) == testController.operationCallbacks.token(recreated) {
+		t.Fatal("Expected the token of a recreated instance to differ")
+	}
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Update(getTestClusterServiceBroker())
+	requestContext = map[string]interface{}{}
+	testController.addOperationCallbackContext(instance, requestContext)
+	if len(requestContext) != 0 {
+		t.Fatalf("Expected no callback in the context of an instance whose broker does not use them, got %v", requestContext)
+	}
+}
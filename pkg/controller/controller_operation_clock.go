@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	// clockSkewTolerance is how far ahead of the controller's clock a time
+	// persisted in the status of a resource may be before the clock of the
+	// controller that wrote it is considered skewed.
+	clockSkewTolerance = 5 * time.Second
+
+	// operationClockForgetAfter is how long the clock of an operation that
+	// is no longer seen is kept.
+	operationClockForgetAfter = time.Hour
+
+	clockSkewDetectedReason string = "ClockSkewDetected"
+)
+
+// operationObject is a resource whose operations are retried for the
+// controller's reconciliation retry duration.
+type operationObject interface {
+	runtime.Object
+	metav1.Object
+}
+
+// operationClock is the clock of an operation as seen by this controller.
+type operationClock struct {
+	// startTime is the persisted start time of the operation.
+	startTime metav1.Time
+	// firstSeen is when the controller first saw the operation. Like
+	// lastSeen, it holds a monotonic clock reading.
+	firstSeen time.Time
+	lastSeen  time.Time
+	// skewReported is set once the skew of the operation was reported.
+	skewReported bool
+}
+
+// operationClocks holds the clocks of the operations, by the UID of their
+// resource. The zero value is ready to use.
+type operationClocks struct {
+	mutex      sync.Mutex
+	operations map[types.UID]*operationClock
+	lastPrune  time.Time
+}
+
+// observe records that the operation of uid that started at startTime is
+// seen at now, and returns when it was first seen. When skewed is set, it
+// also returns whether the skew of the operation is to be reported, which
+// it is only once.
+func (o *operationClocks) observe(uid types.UID, startTime metav1.Time, now time.Time, skewed bool) (time.Time, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.operations == nil {
+		o.operations = make(map[types.UID]*operationClock)
+	}
+	if now.Sub(o.lastPrune) > operationClockForgetAfter {
+		for key, clock := range o.operations {
+			if now.Sub(clock.lastSeen) > operationClockForgetAfter {
+				delete(o.operations, key)
+			}
+		}
+		o.lastPrune = now
+	}
+
+	clock, ok := o.operations[uid]
+	if !ok || !clock.startTime.Equal(&startTime) {
+		clock = &operationClock{startTime: startTime, firstSeen: now}
+		o.operations[uid] = clock
+	}
+	clock.lastSeen = now
+	report := skewed && !clock.skewReported
+	if report {
+		clock.skewReported = true
+	}
+	return clock.firstSeen, report
+}
+
+// newAttemptTime returns the LastAttemptTime of a request sent to the
+// broker now.
+func newAttemptTime() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
+
+// lastAttemptTime returns the LastAttemptTime of obj, if its kind has one.
+func lastAttemptTime(obj operationObject) *metav1.Time {
+	switch obj := obj.(type) {
+	case *v1beta1.ServiceInstance:
+		return obj.Status.LastAttemptTime
+	case *v1beta1.ServiceBinding:
+		return obj.Status.LastAttemptTime
+	}
+	return nil
+}
+
+// operationElapsed returns how long the operation of obj that started at
+// operationStartTime has been running. It is measured on the wall clock, so
+// that an operation started before this controller did, or by another one,
+// keeps its progress. When the start or last attempt time persisted in the
+// status of obj is ahead of the controller's clock by more than
+// clockSkewTolerance, the controller that wrote it had a skewed clock and
+// the wall clock would extend the retry window by as much: the elapsed time
+// is then measured on the monotonic clock of this controller from when it
+// first saw the operation, and a warning is recorded on obj.
+func (c *controller) operationElapsed(obj operationObject, operationStartTime *metav1.Time) time.Duration {
+	now := time.Now()
+	skew := operationStartTime.Time.Sub(now)
+	if last := lastAttemptTime(obj); last != nil && last.Time.Sub(now) > skew {
+		skew = last.Time.Sub(now)
+	}
+	skewed := skew > clockSkewTolerance
+
+	firstSeen, report := c.operationClocks.observe(obj.GetUID(), *operationStartTime, now, skewed)
+	if !skewed {
+		return now.Sub(operationStartTime.Time)
+	}
+	if report {
+		msg := fmt.Sprintf("The operation was recorded %v ahead of the controller's clock; its retry window is measured from when the controller first saw it", skew.Round(time.Second))
+		klog.Warningf("%s/%s: %s", obj.GetNamespace(), obj.GetName(), msg)
+		c.recorder.Event(obj, corev1.EventTypeWarning, clockSkewDetectedReason, msg)
+	}
+	return now.Sub(firstSeen)
+}
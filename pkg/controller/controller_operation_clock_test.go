@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestReconciliationRetryDurationExceeded tests that the retry window is
+// measured on the wall clock from the operation start time.
+func TestReconciliationRetryDurationExceeded(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.reconciliationRetryDuration = time.Hour
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	if testController.reconciliationRetryDurationExceeded(instance, nil) {
+		t.Fatal("Expected an instance without an operation not to exceed its retry window")
+	}
+	recent := metav1.NewTime(time.Now().Add(-time.Minute))
+	if testController.reconciliationRetryDurationExceeded(instance, &recent) {
+		t.Fatal("Expected an operation started a minute ago to be within its retry window")
+	}
+	old := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	if !testController.reconciliationRetryDurationExceeded(instance, &old) {
+		t.Fatal("Expected an operation started two hours ago to exceed its retry window")
+	}
+	assertNumEvents(t, getRecordedEvents(testController), 0)
+}
+
+// TestReconciliationRetryDurationExceededClockSkew tests that the retry
+// window of an operation recorded ahead of the controller's clock is
+// measured from when the controller first saw it, and that the skew is
+// reported once.
+func TestReconciliationRetryDurationExceededClockSkew(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.reconciliationRetryDuration = time.Hour
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status.OperationStartTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+	instance.Status.LastAttemptTime = &metav1.Time{Time: time.Now().Add(time.Hour)}
+
+	for i := 0; i < 2; i++ {
+		if testController.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
+			t.Fatal("Expected a skewed operation to be within its retry window")
+		}
+	}
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+	if e, a := corev1.EventTypeWarning+" "+clockSkewDetectedReason, events[0]; !strings.HasPrefix(a, e) {
+		t.Fatalf("Received unexpected event; %s", expectedGot(e, a))
+	}
+
+	testController.operationClocks.operations[instance.UID].firstSeen = time.Now().Add(-2 * time.Hour)
+	if !testController.reconciliationRetryDurationExceeded(instance, instance.Status.OperationStartTime) {
+		t.Fatal("Expected a skewed operation first seen two hours ago to exceed its retry window")
+	}
+}
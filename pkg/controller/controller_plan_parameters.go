@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/planschema"
+)
+
+const (
+	// planParametersLabel labels the ConfigMaps documenting the parameters
+	// of plans, so that tooling can find them.
+	planParametersLabel = v1beta1.GroupName + "/plan-parameters"
+	// planExternalNameAnnotation holds the external name of the plan whose
+	// parameters a ConfigMap documents.
+	planExternalNameAnnotation = v1beta1.GroupName + "/plan-external-name"
+)
+
+// planParameterSchemas is implemented by ClusterServicePlans and
+// ServicePlans.
+type planParameterSchemas interface {
+	GetExternalName() string
+	GetInstanceCreateSchema() *runtime.RawExtension
+	GetInstanceUpdateSchema() *runtime.RawExtension
+	GetBindingCreateSchema() *runtime.RawExtension
+}
+
+// syncClusterServicePlanParameters writes the structural schemas of the
+// parameters of the plan to a ConfigMap in the controller's namespace when
+// the PlanParametersDocumentation feature is enabled.
+func (c *controller) syncClusterServicePlanParameters(plan *v1beta1.ClusterServicePlan) error {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.PlanParametersDocumentation) {
+		return nil
+	}
+	owner := metav1.NewControllerRef(plan, v1beta1.SchemeGroupVersion.WithKind("ClusterServicePlan"))
+	return c.syncPlanParameters(c.clusterIDConfigMapNamespace, "clusterserviceplan-"+plan.Name+"-parameters", plan, owner)
+}
+
+// syncServicePlanParameters writes the structural schemas of the parameters
+// of the plan to a ConfigMap in the plan's namespace when the
+// PlanParametersDocumentation feature is enabled.
+func (c *controller) syncServicePlanParameters(plan *v1beta1.ServicePlan) error {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.PlanParametersDocumentation) {
+		return nil
+	}
+	owner := metav1.NewControllerRef(plan, v1beta1.SchemeGroupVersion.WithKind("ServicePlan"))
+	return c.syncPlanParameters(plan.Namespace, "serviceplan-"+plan.Name+"-parameters", plan, owner)
+}
+
+// syncPlanParameters creates, updates or deletes the ConfigMap documenting
+// the parameters of a plan. A plan without parameter schemas has no
+// ConfigMap. A schema that cannot be converted is only logged, since
+// retrying does not help until the broker publishes another one.
+func (c *controller) syncPlanParameters(namespace, name string, plan planParameterSchemas, owner *metav1.OwnerReference) error {
+	data, err := planschema.Documentation(plan.GetInstanceCreateSchema(), plan.GetInstanceUpdateSchema(), plan.GetBindingCreateSchema())
+	if err != nil {
+		klog.Warningf("Plan %q: Couldn't convert the parameter schemas to structural schemas: %v", plan.GetExternalName(), err)
+		return nil
+	}
+
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(namespace)
+	existing, err := configMaps.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+
+	if len(data) == 0 {
+		if !found {
+			return nil
+		}
+		err := configMaps.Delete(context.Background(), name, metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !found {
+		// The ConfigMap is deleted along with the plan, but does not hold
+		// up its deletion.
+		ownerRef := *owner
+		ownerRef.BlockOwnerDeletion = nil
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				Labels:          map[string]string{planParametersLabel: "true"},
+				Annotations:     map[string]string{planExternalNameAnnotation: plan.GetExternalName()},
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Data: data,
+		}
+		klog.V(4).Infof("Plan %q: Creating ConfigMap %s/%s documenting its parameters", plan.GetExternalName(), namespace, name)
+		_, err := configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+		return err
+	}
+
+	if reflect.DeepEqual(existing.Data, data) {
+		return nil
+	}
+	configMap := existing.DeepCopy()
+	configMap.Data = data
+	klog.V(4).Infof("Plan %q: Updating ConfigMap %s/%s documenting its parameters", plan.GetExternalName(), namespace, name)
+	_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+	return err
+}
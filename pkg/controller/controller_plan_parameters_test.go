@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/planschema"
+)
+
+// TestReconcileClusterServicePlanParameters tests that the parameter schemas
+// of a plan are written to a ConfigMap, which is left alone while they do not
+// change, and deleted once the plan has no schemas anymore.
+func TestReconcileClusterServicePlanParameters(t *testing.T) {
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.PlanParametersDocumentation)); err != nil {
+		t.Fatalf("Failed to enable PlanParametersDocumentation feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.PlanParametersDocumentation))
+
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	var stored *corev1.ConfigMap
+	fakeKubeClient.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if stored == nil {
+			return true, nil, errors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, action.(clientgotesting.GetAction).GetName())
+		}
+		return true, stored, nil
+	})
+	fakeKubeClient.AddReactor("create", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		stored = action.(clientgotesting.CreateAction).GetObject().(*corev1.ConfigMap)
+		return true, stored, nil
+	})
+	fakeKubeClient.AddReactor("delete", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		stored = nil
+		return true, nil, nil
+	})
+
+	plan := getTestClusterServicePlan()
+	plan.Spec.InstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type": "object", "properties": {"size": {"type": "integer"}}}`)}
+
+	if err := testController.reconcileClusterServicePlan(plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	assertConfigMapAction(t, actions[0], "get")
+	create := assertConfigMapAction(t, actions[1], "create").(clientgotesting.CreateAction)
+	configMap := create.GetObject().(*corev1.ConfigMap)
+	if e, a := "clusterserviceplan-"+testClusterServicePlanGUID+"-parameters", configMap.Name; e != a {
+		t.Fatalf("Unexpected ConfigMap name; %s", expectedGot(e, a))
+	}
+	if e, a := DefaultClusterIDConfigMapNamespace, configMap.Namespace; e != a {
+		t.Fatalf("Unexpected ConfigMap namespace; %s", expectedGot(e, a))
+	}
+	if _, ok := configMap.Data[planschema.InstanceCreateKey]; !ok {
+		t.Fatalf("Expected the ConfigMap to document the instance create parameters, got %v", configMap.Data)
+	}
+	if e, a := 1, len(configMap.OwnerReferences); e != a {
+		t.Fatalf("Unexpected number of owner references; %s", expectedGot(e, a))
+	}
+	fakeKubeClient.ClearActions()
+
+	if err := testController.reconcileClusterServicePlan(plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions = fakeKubeClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertConfigMapAction(t, actions[0], "get")
+	fakeKubeClient.ClearActions()
+
+	plan.Spec.InstanceCreateParameterSchema = nil
+	if err := testController.reconcileClusterServicePlan(plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions = fakeKubeClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	assertConfigMapAction(t, actions[0], "get")
+	assertConfigMapAction(t, actions[1], "delete")
+}
+
+// TestReconcileClusterServicePlanParametersDisabled tests that no ConfigMap
+// is written without the PlanParametersDocumentation feature.
+func TestReconcileClusterServicePlanParametersDisabled(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	plan := getTestClusterServicePlan()
+	plan.Spec.InstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type": "object"}`)}
+
+	if err := testController.reconcileClusterServicePlan(plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+}
+
+func assertConfigMapAction(t *testing.T, action clientgotesting.Action, verb string) clientgotesting.Action {
+	if e, a := verb, action.GetVerb(); e != a {
+		t.Fatalf("Unexpected verb; %s", expectedGot(e, a))
+	}
+	if e, a := "configmaps", action.GetResource().Resource; e != a {
+		t.Fatalf("Unexpected resource; %s", expectedGot(e, a))
+	}
+	return action
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+// pollingQueues holds a polling queue for each broker, so that the
+// rate-limited polls of the operations of a slow broker never hold up the
+// polls of the operations of the other brokers. Each queue has its own rate
+// limiter and workers, which poll the last operations themselves, and its
+// own name, the name of the queues followed by the name of the broker, so
+// that the work queue metrics tell the brokers apart.
+//
+// The queue of a broker is created the first time one of its operations is
+// polled, and is torn down when the broker is deleted. A key is polled
+// through a single queue at a time: the queue it was last added to. The
+// operations whose broker cannot be resolved stay in the queue they were
+// polled through so far, or else share the queue of the empty broker name,
+// which has the name of the queues alone.
+type pollingQueues struct {
+	name           string
+	newRateLimiter func() workqueue.RateLimiter
+
+	mutex  sync.Mutex
+	queues map[string]*pollingQueue
+	// brokers maps the keys being polled to the broker of the queue they
+	// were last added to.
+	brokers map[string]string
+	// start starts the workers of a queue; it is set by run.
+	start    func(broker string, queue *pollingQueue)
+	shutDown bool
+}
+
+// pollingQueue is the polling queue of a broker. stopCh stops its workers.
+type pollingQueue struct {
+	workqueue.RateLimitingInterface
+	name   string
+	stopCh chan struct{}
+}
+
+func newPollingQueues(name string, newRateLimiter func() workqueue.RateLimiter) *pollingQueues {
+	return &pollingQueues{
+		name:           name,
+		newRateLimiter: newRateLimiter,
+		queues:         make(map[string]*pollingQueue),
+		brokers:        make(map[string]string),
+	}
+}
+
+// queueName returns the name of the polling queue of broker.
+func (p *pollingQueues) queueName(broker string) string {
+	if broker == "" {
+		return p.name
+	}
+	return p.name + "/" + broker
+}
+
+// queueFor assigns key to the polling queue of broker and returns the
+// queue, creating it if needed. A key whose broker cannot be resolved keeps
+// the queue it was assigned to, and a key that moves to another broker is
+// forgotten by the queue of the previous one. The caller must hold the lock.
+func (p *pollingQueues) queueFor(broker, key string) *pollingQueue {
+	previous, polled := p.brokers[key]
+	if broker == "" && polled {
+		broker = previous
+	}
+	if polled && previous != broker {
+		if queue, ok := p.queues[previous]; ok {
+			queue.Forget(key)
+		}
+	}
+	p.brokers[key] = broker
+
+	queue, ok := p.queues[broker]
+	if ok {
+		return queue
+	}
+	name := p.queueName(broker)
+	queue = &pollingQueue{
+		RateLimitingInterface: workqueue.NewNamedRateLimitingQueue(p.newRateLimiter(), name),
+		name:                  name,
+		stopCh:                make(chan struct{}),
+	}
+	p.queues[broker] = queue
+	switch {
+	case p.shutDown:
+		queue.ShutDown()
+		close(queue.stopCh)
+	case p.start != nil:
+		p.start(broker, queue)
+	}
+	return queue
+}
+
+// snapshot returns the polling queues created so far.
+func (p *pollingQueues) snapshot() []workqueue.RateLimitingInterface {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	queues := make([]workqueue.RateLimitingInterface, 0, len(p.queues))
+	for _, queue := range p.queues {
+		queues = append(queues, queue)
+	}
+	return queues
+}
+
+// AddRateLimited adds key to the polling queue of broker after the rate
+// limiter of the queue says it is ok.
+func (p *pollingQueues) AddRateLimited(broker, key string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.queueFor(broker, key).AddRateLimited(key)
+}
+
+// AddAfter adds key to the polling queue of broker after the given delay.
+func (p *pollingQueues) AddAfter(broker, key string, duration time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.queueFor(broker, key).AddAfter(key, duration)
+}
+
+// Forget stops the polling of key and resets its rate limiting in every
+// polling queue, so that it is forgotten even if its broker changed or can
+// no longer be resolved.
+func (p *pollingQueues) Forget(key string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	delete(p.brokers, key)
+	for _, queue := range p.queues {
+		queue.Forget(key)
+	}
+}
+
+// NumRequeues returns how many times key was requeued with rate limiting,
+// across the polling queues.
+func (p *pollingQueues) NumRequeues(key string) int {
+	requeues := 0
+	for _, queue := range p.snapshot() {
+		requeues += queue.NumRequeues(key)
+	}
+	return requeues
+}
+
+// Len returns the number of keys waiting to be processed, across the
+// polling queues.
+func (p *pollingQueues) Len() int {
+	length := 0
+	for _, queue := range p.snapshot() {
+		length += queue.Len()
+	}
+	return length
+}
+
+// polledBy returns whether key is polled through the queue of broker, and
+// not through the queue of another broker it was added to since.
+func (p *pollingQueues) polledBy(broker, key string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	polledBroker, polled := p.brokers[key]
+	return polled && polledBroker == broker
+}
+
+// run starts the given number of workers on each polling queue, including
+// the queues created later, until the queue is torn down or shut down. The
+// workers pass to poll the keys polled through their queue, and skip the
+// keys that moved to the queue of another broker.
+func (p *pollingQueues) run(workers int, resourceType string, poll func(key string) error, waitGroup *sync.WaitGroup) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.start = func(broker string, queue *pollingQueue) {
+		reconciler := func(key string) error {
+			if !p.polledBy(broker, key) {
+				return nil
+			}
+			return poll(key)
+		}
+		for i := 0; i < workers; i++ {
+			createWorker(queue, resourceType, maxRetries, false, reconciler, queue.stopCh, waitGroup)
+		}
+	}
+	for broker, queue := range p.queues {
+		p.start(broker, queue)
+	}
+}
+
+// remove tears down the polling queue of broker: it stops its workers,
+// shuts it down and deletes its work queue metrics. It returns the keys
+// that were polled through it, which are no longer polled.
+func (p *pollingQueues) remove(broker string) []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	queue, ok := p.queues[broker]
+	if !ok {
+		return nil
+	}
+	delete(p.queues, broker)
+	if !p.shutDown {
+		queue.ShutDown()
+		close(queue.stopCh)
+	}
+	metrics.DeleteWorkqueueMetrics(queue.name)
+
+	var keys []string
+	for key, polledBroker := range p.brokers {
+		if polledBroker == broker {
+			keys = append(keys, key)
+			delete(p.brokers, key)
+		}
+	}
+	return keys
+}
+
+// ShutDown shuts down every polling queue and stops its workers, including
+// those of the queues created later.
+func (p *pollingQueues) ShutDown() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.shutDown {
+		return
+	}
+	p.shutDown = true
+	for _, queue := range p.queues {
+		queue.ShutDown()
+		close(queue.stopCh)
+	}
+}
+
+// removePollingQueues tears down the polling queues of a deleted broker and
+// hands the instances and bindings that were polled through them to their
+// work queues, to be reconciled again.
+func (c *controller) removePollingQueues(broker string) {
+	for _, key := range c.instancePollingQueue.remove(broker) {
+		c.instanceQueue.Add(key)
+	}
+	for _, key := range c.bindingPollingQueue.remove(broker) {
+		c.bindingQueue.Add(key)
+	}
+}
+
+// pollingBrokerOfServiceInstance returns the name of the polling queue of
+// the broker of instance, or an empty name if it cannot be resolved.
+// ServiceBrokers are qualified with their namespace so that they never share
+// the queue of a ClusterServiceBroker or of a broker of another namespace.
+func (c *controller) pollingBrokerOfServiceInstance(instance *v1beta1.ServiceInstance) string {
+	broker, spec := c.getServiceInstanceBrokerSpec(instance)
+	if spec == nil {
+		return ""
+	}
+	if instance.Spec.ClusterServiceClassRef == nil {
+		return instance.Namespace + "/" + broker
+	}
+	return broker
+}
+
+// pollingBrokerOfServiceBinding returns the name of the polling queue of
+// the broker of the instance of binding, or an empty name if it cannot be
+// resolved.
+func (c *controller) pollingBrokerOfServiceBinding(binding *v1beta1.ServiceBinding) string {
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	if err != nil {
+		return ""
+	}
+	return c.pollingBrokerOfServiceInstance(instance)
+}
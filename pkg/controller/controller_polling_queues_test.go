@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+)
+
+// TestPollingQueuesIsolateBrokers tests that the instances of different
+// brokers are polled through separate queues, with names of their own, while
+// the queues are still counted together.
+func TestPollingQueuesIsolateBrokers(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	otherBroker := getTestClusterServiceBroker()
+	otherBroker.Name = "other-broker"
+	otherClass := getTestClusterServiceClass()
+	otherClass.Name = "other-class"
+	otherClass.Spec.ClusterServiceBrokerName = otherBroker.Name
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(otherBroker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(otherClass)
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	otherInstance := getTestServiceInstanceWithClusterRefs()
+	otherInstance.Name = "other-instance"
+	otherInstance.Spec.ClusterServiceClassRef.Name = otherClass.Name
+
+	if err := testController.beginPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := testController.beginPollingServiceInstance(otherInstance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	queues := testController.instancePollingQueue.snapshot()
+	if e, a := 2, len(queues); e != a {
+		t.Fatalf("Unexpected number of polling queues: %v", expectedGot(e, a))
+	}
+	for _, broker := range []string{testClusterServiceBrokerName, otherBroker.Name} {
+		if e, a := "instance-poller/"+broker, testController.instancePollingQueue.queues[broker].name; e != a {
+			t.Fatalf("Unexpected polling queue name: %v", expectedGot(e, a))
+		}
+	}
+	for _, queue := range queues {
+		if e, a := 1, queue.NumRequeues(testNamespace+"/"+instance.Name)+queue.NumRequeues(testNamespace+"/"+otherInstance.Name); e != a {
+			t.Fatalf("Expected each broker to poll its own instance: %v", expectedGot(e, a))
+		}
+	}
+
+	key := testNamespace + "/" + instance.Name
+	if e, a := 1, testController.instancePollingQueue.NumRequeues(key); e != a {
+		t.Fatalf("Unexpected number of requeues: %v", expectedGot(e, a))
+	}
+	if err := testController.finishPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 0, testController.instancePollingQueue.NumRequeues(key); e != a {
+		t.Fatalf("Expected the instance to be forgotten: %v", expectedGot(e, a))
+	}
+	if e, a := 1, testController.instancePollingQueue.NumRequeues(testNamespace+"/"+otherInstance.Name); e != a {
+		t.Fatalf("Expected the instance of the other broker to be left alone: %v", expectedGot(e, a))
+	}
+}
+
+// TestPollingQueuesUnresolvedBroker tests that the instances whose broker
+// cannot be resolved are still polled, through the queue they were polled
+// through so far if any, so that they are never polled through two queues.
+func TestPollingQueuesUnresolvedBroker(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	key := testNamespace + "/" + instance.Name
+	if err := testController.beginPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := "", testController.pollingBrokerOfServiceInstance(instance); e != a {
+		t.Fatalf("Unexpected polling broker: %v", expectedGot(e, a))
+	}
+	if e, a := 1, testController.instancePollingQueue.NumRequeues(key); e != a {
+		t.Fatalf("Unexpected number of requeues: %v", expectedGot(e, a))
+	}
+	if e, a := "instance-poller", testController.instancePollingQueue.queues[""].name; e != a {
+		t.Fatalf("Unexpected polling queue name: %v", expectedGot(e, a))
+	}
+
+	// Once polled through the queue of its broker, the instance stays there
+	// while its broker cannot be resolved.
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	if err := testController.beginPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Delete(getTestClusterServiceClass())
+	if err := testController.beginPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !testController.instancePollingQueue.polledBy(testClusterServiceBrokerName, key) {
+		t.Fatal("Expected the instance to be polled through the queue of its broker")
+	}
+	if e, a := 0, testController.instancePollingQueue.queues[""].NumRequeues(key); e != a {
+		t.Fatalf("Expected the instance to be forgotten by the queue of the unresolved brokers: %v", expectedGot(e, a))
+	}
+	if e, a := 2, testController.instancePollingQueue.queues[testClusterServiceBrokerName].NumRequeues(key); e != a {
+		t.Fatalf("Unexpected number of requeues: %v", expectedGot(e, a))
+	}
+}
+
+// TestPollingQueuesRemoveBroker tests that the polling queue of a deleted
+// broker is torn down, and that its instances are handed to the instance
+// work queue.
+func TestPollingQueuesRemoveBroker(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	key := testNamespace + "/" + instance.Name
+	if err := testController.beginPollingServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Delete(getTestClusterServiceBroker())
+	if err := testController.reconcileClusterServiceBrokerKey(testClusterServiceBrokerName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := 0, len(testController.instancePollingQueue.snapshot()); e != a {
+		t.Fatalf("Expected the polling queue of the broker to be torn down: %v", expectedGot(e, a))
+	}
+	if testController.instancePollingQueue.polledBy(testClusterServiceBrokerName, key) {
+		t.Fatal("Expected the instance to no longer be polled")
+	}
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Expected the instance to be handed to the instance work queue: %v", expectedGot(e, a))
+	}
+}
+
+// TestPollServiceInstanceKey tests that the workers of the polling queues
+// poll the last operation of the instances themselves, and hand the
+// instances without an operation in progress to the instance work queue.
+func TestPollServiceInstanceKey(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		PollLastOperationReaction: &fakeosb.PollLastOperationReaction{
+			Response: &osb.LastOperationResponse{
+				State:       osb.StateInProgress,
+				Description: strPtr(lastOperationDescription),
+			},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	key := testNamespace + "/" + instance.Name
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+
+	if err := testController.pollServiceInstanceKey(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	operationKey := osb.OperationKey(testOperation)
+	assertPollLastOperation(t, brokerActions[0], &osb.LastOperationRequest{
+		InstanceID:   testServiceInstanceGUID,
+		ServiceID:    strPtr(testClusterServiceClassGUID),
+		PlanID:       strPtr(testClusterServicePlanGUID),
+		OperationKey: &operationKey,
+	})
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 1)
+	if e, a := 0, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Expected the instance not to be handed to the instance work queue: %v", expectedGot(e, a))
+	}
+
+	sharedInformers.ServiceInstances().Informer().GetStore().Update(getTestServiceInstanceWithClusterRefs())
+	if err := testController.pollServiceInstanceKey(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Expected the instance to be handed to the instance work queue: %v", expectedGot(e, a))
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+)
+
+const (
+	readOnlyDriftReason string = "ReadOnlyDrift"
+	// readOnlyDriftReportInterval is how often the number of resources
+	// whose state drifted from their spec is reported in read-only mode.
+	readOnlyDriftReportInterval = 30 * time.Second
+)
+
+// serviceInstanceDrift returns why the state of the instance differs from
+// its spec, or "" if the instance is reconciled.
+func serviceInstanceDrift(instance *v1beta1.ServiceInstance) string {
+	switch {
+	case instance.Status.AsyncOpInProgress:
+		return "an asynchronous operation is in progress"
+	case instance.Status.OrphanMitigationInProgress:
+		return "orphan mitigation is in progress"
+	case instance.DeletionTimestamp != nil:
+		return "the instance is being deleted"
+	case instance.Status.CurrentOperation != "":
+		return fmt.Sprintf("the %s operation is in progress", instance.Status.CurrentOperation)
+	case instance.Status.ObservedGeneration != instance.Generation:
+		return "the spec changed since the instance was last reconciled"
+	case instance.Status.ProvisionStatus != v1beta1.ServiceInstanceProvisionStatusProvisioned:
+		return "the instance is not provisioned"
+	}
+	return ""
+}
+
+// serviceBindingDrift returns why the state of the binding differs from its
+// spec, or "" if the binding is reconciled.
+func serviceBindingDrift(binding *v1beta1.ServiceBinding) string {
+	switch {
+	case binding.Status.AsyncOpInProgress:
+		return "an asynchronous operation is in progress"
+	case binding.Status.OrphanMitigationInProgress:
+		return "orphan mitigation is in progress"
+	case binding.DeletionTimestamp != nil:
+		return "the binding is being deleted"
+	case binding.Status.CurrentOperation != "":
+		return fmt.Sprintf("the %s operation is in progress", binding.Status.CurrentOperation)
+	case binding.Status.ReconciledGeneration != binding.Generation:
+		return "the spec changed since the binding was last reconciled"
+	}
+	return ""
+}
+
+// serviceBrokerDrift returns why the state of a broker differs from its
+// spec, or "" if the broker is reconciled.
+func serviceBrokerDrift(meta *metav1.ObjectMeta, status *v1beta1.CommonServiceBrokerStatus) string {
+	switch {
+	case meta.DeletionTimestamp != nil:
+		return "the broker is being deleted"
+	case status.ReconciledGeneration != meta.Generation:
+		return "the spec changed since the catalog of the broker was last fetched"
+	}
+	return ""
+}
+
+// recordReadOnlyDrift reports, in read-only mode, that the object is not
+// reconciled although its state drifted from its spec.
+func (c *controller) recordReadOnlyDrift(obj runtime.Object, pcb *pretty.ContextBuilder, drift string) {
+	if drift == "" {
+		klog.V(4).Info(pcb.Message("Read-only mode: no drift"))
+		return
+	}
+	msg := fmt.Sprintf("Not reconciling in read-only mode although %s", drift)
+	klog.Info(pcb.Message(msg))
+	c.recorder.Event(obj, corev1.EventTypeWarning, readOnlyDriftReason, msg)
+}
+
+// createReportReadOnlyDriftWorker creates a task that runs periodically to
+// report the number of resources whose state drifted from their spec
+func (c *controller) createReportReadOnlyDriftWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		wait.Until(c.reportReadOnlyDrift, readOnlyDriftReportInterval, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// reportReadOnlyDrift sets the number of resources of each kind whose state
+// drifted from their spec.
+func (c *controller) reportReadOnlyDrift() {
+	if instances, err := c.instanceLister.List(labels.Everything()); err == nil {
+		drifted := 0
+		for _, instance := range instances {
+			if serviceInstanceDrift(instance) != "" {
+				drifted++
+			}
+		}
+		metrics.ReadOnlyDriftedResources.WithLabelValues("ServiceInstance").Set(float64(drifted))
+	}
+
+	if bindings, err := c.bindingLister.List(labels.Everything()); err == nil {
+		drifted := 0
+		for _, binding := range bindings {
+			if serviceBindingDrift(binding) != "" {
+				drifted++
+			}
+		}
+		metrics.ReadOnlyDriftedResources.WithLabelValues("ServiceBinding").Set(float64(drifted))
+	}
+
+	if brokers, err := c.clusterServiceBrokerLister.List(labels.Everything()); err == nil {
+		drifted := 0
+		for _, broker := range brokers {
+			if serviceBrokerDrift(&broker.ObjectMeta, &broker.Status.CommonServiceBrokerStatus) != "" {
+				drifted++
+			}
+		}
+		metrics.ReadOnlyDriftedResources.WithLabelValues("ClusterServiceBroker").Set(float64(drifted))
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
+		return
+	}
+	if brokers, err := c.serviceBrokerLister.List(labels.Everything()); err == nil {
+		drifted := 0
+		for _, broker := range brokers {
+			if serviceBrokerDrift(&broker.ObjectMeta, &broker.Status.CommonServiceBrokerStatus) != "" {
+				drifted++
+			}
+		}
+		metrics.ReadOnlyDriftedResources.WithLabelValues("ServiceBroker").Set(float64(drifted))
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// TestReconcileServiceInstanceReadOnly tests that an instance waiting to be
+// provisioned is only reported in read-only mode.
+func TestReconcileServiceInstanceReadOnly(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.readOnly = true
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+	if e, a := corev1.EventTypeWarning+" "+readOnlyDriftReason, events[0]; !strings.HasPrefix(a, e) {
+		t.Fatalf("Unexpected event; expected a %q event, got %q", e, a)
+	}
+}
+
+// TestReconcileServiceBindingReadOnly tests that a binding being deleted is
+// not unbound in read-only mode.
+func TestReconcileServiceBindingReadOnly(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, _ := newTestController(t, noFakeActions())
+	testController.readOnly = true
+
+	binding := getTestServiceBindingUnbinding()
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+	assertNumEvents(t, getRecordedEvents(testController), 1)
+}
+
+// TestReconcileClusterServiceBrokerReadOnly tests that the catalog of a
+// broker is not fetched in read-only mode, and that a reconciled broker is
+// not reported.
+func TestReconcileClusterServiceBrokerReadOnly(t *testing.T) {
+	_, fakeCatalogClient, fakeBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+	testController.readOnly = true
+
+	broker := getTestClusterServiceBroker()
+	broker.Generation = 1
+	broker.Status.ReconciledGeneration = 1
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumEvents(t, getRecordedEvents(testController), 0)
+}
+
+func TestServiceInstanceDrift(t *testing.T) {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Generation = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	if drift := serviceInstanceDrift(instance); drift != "" {
+		t.Fatalf("Expected a provisioned instance not to drift, got %q", drift)
+	}
+
+	instance.Generation = 2
+	if serviceInstanceDrift(instance) == "" {
+		t.Fatal("Expected an instance whose spec changed to drift")
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestMaxAttempts(t *testing.T) {
+	cases := []struct {
+		name       string
+		annotation *string
+		expected   int
+	}{
+		{name: "no annotation", expected: 3},
+		{name: "annotation", annotation: strPtr("5"), expected: 5},
+		{name: "no limit", annotation: strPtr("0"), expected: 0},
+		{name: "invalid annotation", annotation: strPtr("five"), expected: 3},
+		{name: "negative annotation", annotation: strPtr("-1"), expected: 3},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, _ := newTestController(t, noFakeActions())
+			testController.reconciliationMaxAttempts = 3
+
+			instance := getTestServiceInstance()
+			if tc.annotation != nil {
+				instance.Annotations = map[string]string{v1beta1.MaxAttemptsAnnotation: *tc.annotation}
+			}
+			if e, a := tc.expected, testController.maxAttempts(instance); e != a {
+				t.Fatalf("Unexpected max attempts; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileServiceInstanceProvisionMaxAttempts tests that a provision
+// request failing with a retriable error is not retried once the instance's
+// budget of attempts is used up.
+func TestReconcileServiceInstanceProvisionMaxAttempts(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Error: errors.New("fake creation failure"),
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Annotations = map[string]string{v1beta1.MaxAttemptsAnnotation: "1"}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("Reconcile not expected to fail : %v", err)
+	}
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	instance = assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+	if e, a := int32(0), instance.Status.OperationAttempts; e != a {
+		t.Fatalf("Unexpected attempts at the start of the operation; %s", expectedGot(e, a))
+	}
+
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("Reconcile not expected to fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	if _, ok := brokerActions[0].Request.(*osb.ProvisionRequest); !ok {
+		t.Fatalf("Unexpected request type; expected %T, got %T", &osb.ProvisionRequest{}, brokerActions[0].Request)
+	}
+
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, errorReconciliationMaxAttemptsReason)
+	if e, a := int32(1), updatedServiceInstance.(*v1beta1.ServiceInstance).Status.OperationAttempts; e != a {
+		t.Fatalf("Unexpected attempts; %s", expectedGot(e, a))
+	}
+	if updatedServiceInstance.(*v1beta1.ServiceInstance).Status.LastAttemptTime == nil {
+		t.Fatal("Expected the time of the attempt to be recorded")
+	}
+}
+
+// TestReconcileServiceInstanceProvisionWithinMaxAttempts tests that a
+// provision request failing with a retriable error is retried while the
+// instance's budget of attempts is not used up.
+func TestReconcileServiceInstanceProvisionWithinMaxAttempts(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Error: errors.New("fake creation failure"),
+		},
+	})
+	testController.reconciliationMaxAttempts = 2
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("Reconcile not expected to fail : %v", err)
+	}
+	instance = assertUpdateStatus(t, fakeCatalogClient.Actions()[1], instance).(*v1beta1.ServiceInstance)
+
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatal("Expected the provision request to be retried")
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceConditionMissing(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed)
+	if e, a := int32(1), updatedServiceInstance.(*v1beta1.ServiceInstance).Status.OperationAttempts; e != a {
+		t.Fatalf("Unexpected attempts; %s", expectedGot(e, a))
+	}
+}
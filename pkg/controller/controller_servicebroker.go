@@ -25,12 +25,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
 	"github.com/drycc-addons/service-catalog/pkg/metrics"
 	"github.com/drycc-addons/service-catalog/pkg/pretty"
 	"github.com/drycc-addons/service-catalog/pkg/util"
@@ -84,7 +87,7 @@ func (c *controller) serviceBrokerDelete(obj interface{}) {
 // returns true unless the broker has a ready condition with status true and
 // the controller's broker relist interval has not elapsed since the broker's
 // ready condition became true, or if the broker's RelistBehavior is set to Manual.
-func shouldReconcileServiceBroker(broker *v1beta1.ServiceBroker, now time.Time, defaultRelistInterval time.Duration) bool {
+func shouldReconcileServiceBroker(broker *v1beta1.ServiceBroker, now time.Time, defaultRelistInterval time.Duration, relistJitterFactor float64) bool {
 	return shouldReconcileServiceBrokerCommon(
 		pretty.NewServiceBrokerContextBuilder(broker),
 		&broker.ObjectMeta,
@@ -92,6 +95,7 @@ func shouldReconcileServiceBroker(broker *v1beta1.ServiceBroker, now time.Time,
 		&broker.Status.CommonServiceBrokerStatus,
 		now,
 		defaultRelistInterval,
+		relistJitterFactor,
 	)
 }
 
@@ -105,6 +109,7 @@ func (c *controller) reconcileServiceBrokerKey(key string) error {
 	if errors.IsNotFound(err) {
 		klog.Info(pcb.Message("Not doing work because the ServiceBroker has been deleted"))
 		c.brokerClientManager.RemoveBrokerClient(NewServiceBrokerKey(namespace, name))
+		c.removePollingQueues(namespace + "/" + name)
 		return nil
 	}
 	if err != nil {
@@ -116,6 +121,20 @@ func (c *controller) reconcileServiceBrokerKey(key string) error {
 }
 
 func (c *controller) serviceBrokerClient(broker *v1beta1.ServiceBroker) (osb.Client, error) {
+	return c.updateServiceBrokerClient(broker, NewServiceBrokerKey(broker.Namespace, broker.Name), c.OSBAPITimeOut)
+}
+
+// serviceBrokerBindingClient returns the client used for the bind, unbind and
+// binding last operation requests of the broker, whose timeout is the binding
+// request timeout of the broker.
+func (c *controller) serviceBrokerBindingClient(broker *v1beta1.ServiceBroker) (osb.Client, error) {
+	brokerKey := NewServiceBrokerKey(broker.Namespace, broker.Name)
+	return c.updateServiceBrokerClient(broker, brokerKey.ForBindings(), c.bindingRequestTimeout(&broker.Spec.CommonServiceBrokerSpec))
+}
+
+// updateServiceBrokerClient creates or updates the client of the broker stored
+// under brokerKey, with the given request timeout.
+func (c *controller) updateServiceBrokerClient(broker *v1beta1.ServiceBroker, brokerKey BrokerKey, timeout time.Duration) (osb.Client, error) {
 	pcb := pretty.NewServiceBrokerContextBuilder(broker)
 	authConfig, err := c.getAuthCredentialsFromServiceBroker(broker)
 	if err != nil {
@@ -128,9 +147,11 @@ func (c *controller) serviceBrokerClient(broker *v1beta1.ServiceBroker) (osb.Cli
 		return nil, err
 	}
 
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig, c.OSBAPITimeOut)
-
-	brokerClient, err := c.brokerClientManager.UpdateBrokerClient(NewServiceBrokerKey(broker.Namespace, broker.Name), clientConfig)
+	clientConfig, err := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig, timeout, c.brokerAPIVersion(&broker.Spec.CommonServiceBrokerSpec))
+	var brokerClient osb.Client
+	if err == nil {
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClient(brokerKey, clientConfig, broker.Spec.RequestRateLimit, broker.Spec.TLSConfig)
+	}
 	if err != nil {
 		s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 		klog.Info(pcb.Message(s))
@@ -151,15 +172,29 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 	pcb := pretty.NewServiceBrokerContextBuilder(broker)
 	klog.V(4).Infof(pcb.Message("Processing"))
 
+	if c.readOnly {
+		c.recordReadOnlyDrift(broker, pcb, serviceBrokerDrift(&broker.ObjectMeta, &broker.Status.CommonServiceBrokerStatus))
+		return nil
+	}
+
 	// * If the broker's ready condition is true and the RelistBehavior has been
 	// set to Manual, do not reconcile it.
 	// * If the broker's ready condition is true and the relist interval has not
 	// elapsed, do not reconcile it.
-	if !shouldReconcileServiceBroker(broker, time.Now(), c.brokerRelistInterval) {
+	if !shouldReconcileServiceBroker(broker, time.Now(), c.brokerRelistInterval, c.getBrokerRelistJitterFactor()) {
+		c.requeueBrokerForRelist(broker, &broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, &broker.Status.CommonServiceBrokerStatus, c.serviceBrokerQueue)
 		return nil
 	}
 
 	if broker.DeletionTimestamp == nil { // Add or update
+		if c.deferBrokerRelistForMaintenance(pcb, broker, &broker.Spec.CommonServiceBrokerSpec, c.serviceBrokerQueue) {
+			return nil
+		}
+		if !c.acquireBrokerRelist(pcb, broker, c.serviceBrokerQueue) {
+			return nil
+		}
+		defer c.brokerRelists.release()
+
 		klog.V(4).Info(pcb.Message("Processing adding/update event"))
 
 		brokerClient, err := c.serviceBrokerClient(broker)
@@ -169,7 +204,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 
 		// get the broker's catalog
 		now := metav1.Now()
-		brokerCatalog, err := brokerClient.GetCatalog()
+		brokerCatalog, err := c.getBrokerCatalog(brokerClient, &broker.Spec.CommonServiceBrokerSpec, broker.Namespace)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			klog.Warning(pcb.Message(s))
@@ -186,7 +221,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 					return err
 				}
 				broker = updated
-			} else if !time.Now().Before(broker.Status.OperationStartTime.Time.Add(c.reconciliationRetryDuration)) {
+			} else if c.reconciliationRetryDurationExceeded(broker, broker.Status.OperationStartTime) {
 				s := "Stopping reconciliation retries because too much time has elapsed"
 				klog.Info(pcb.Message(s))
 				c.recorder.Event(broker, corev1.EventTypeWarning, errorReconciliationRetryTimeoutReason, s)
@@ -208,10 +243,34 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		if broker.Status.OperationStartTime != nil {
 			toUpdate := broker.DeepCopy()
 			toUpdate.Status.OperationStartTime = nil
-			if _, err := c.serviceCatalogClient.ServiceBrokers(broker.Namespace).UpdateStatus(context.Background(), toUpdate, metav1.UpdateOptions{}); err != nil {
+			updated, err := c.serviceCatalogClient.ServiceBrokers(broker.Namespace).UpdateStatus(context.Background(), toUpdate, metav1.UpdateOptions{})
+			if err != nil {
 				klog.Error(pcb.Messagef("Error updating operation start time: %v", err))
 				return err
 			}
+			broker = updated
+		}
+
+		// with CatalogDeltaSync, a catalog left unchanged since the last
+		// relist is not materialized again, which spares listing the classes
+		// and plans of the broker and comparing each of them with the catalog
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.CatalogDeltaSync) {
+			offeredClasses, offeredPlans, err := c.countOfferedServiceClassesAndPlans(broker)
+			if err != nil {
+				return err
+			}
+			if catalogUnchanged(brokerCatalog, broker.Generation, &broker.Status.CommonServiceBrokerStatus, offeredClasses, offeredPlans) {
+				klog.V(4).Info(pcb.Message("Catalog unchanged since the last relist; skipping its classes and plans"))
+				metrics.CatalogEntriesUnchangedCount.WithLabelValues("ServiceClass").Add(float64(offeredClasses))
+				metrics.CatalogEntriesUnchangedCount.WithLabelValues("ServicePlan").Add(float64(offeredPlans))
+				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+					return err
+				}
+				c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successFetchedCatalogMessage)
+				metrics.BrokerServiceClassCount.WithLabelValues(broker.Name, broker.Namespace).Set(float64(offeredClasses))
+				metrics.BrokerServicePlanCount.WithLabelValues(broker.Name, broker.Namespace).Set(float64(offeredPlans))
+				return nil
+			}
 		}
 
 		// get the existing services and plans for this broker so that we can
@@ -241,115 +300,22 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 
 		klog.V(5).Info(pcb.Message("Successfully converted catalog payload from to service-catalog API"))
 
-		// reconcile the serviceClasses that were part of the broker's catalog
-		// payload
-		for _, payloadServiceClass := range payloadServiceClasses {
-			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
-			delete(existingServiceClassMap, payloadServiceClass.Name)
-			if existingServiceClass == nil {
-				existingServiceClass, _ = existingServiceClassMap[payloadServiceClass.Spec.ExternalID]
-				delete(existingServiceClassMap, payloadServiceClass.Spec.ExternalID)
-			}
-
-			klog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ServiceClassName(payloadServiceClass)))
-			if err := c.reconcileServiceClassFromServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
-				s := fmt.Sprintf(
-					"Error reconciling %s (broker %q): %s",
-					pretty.ServiceClassName(payloadServiceClass), broker.Name, err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
-
-			klog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ServiceClassName(payloadServiceClass)))
-		}
-
-		// handle the serviceClasses that were not in the broker's payload;
-		// mark these as having been removed from the broker's catalog
-		for _, existingServiceClass := range existingServiceClassMap {
-			if existingServiceClass.Status.RemovedFromBrokerCatalog {
-				continue
-			}
-
-			klog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ServiceClassName(existingServiceClass)))
-			existingServiceClass.Status.RemovedFromBrokerCatalog = true
-			_, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).UpdateStatus(context.Background(), existingServiceClass, metav1.UpdateOptions{})
-			if err != nil {
-				s := fmt.Sprintf(
-					"Error updating status of %s: %v",
-					pretty.ServiceClassName(existingServiceClass), err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
-		}
-
-		// reconcile the plans that were part of the broker's catalog payload
-		for _, payloadServicePlan := range payloadServicePlans {
-			existingServicePlan, _ := existingServicePlanMap[payloadServicePlan.Name]
-			delete(existingServicePlanMap, payloadServicePlan.Name)
-			if existingServicePlan == nil {
-				existingServicePlan, _ = existingServicePlanMap[payloadServicePlan.Spec.ExternalID]
-				delete(existingServicePlanMap, payloadServicePlan.Spec.ExternalID)
-			}
-
-			klog.V(4).Infof(
-				"ServiceBroker %q: reconciling %s",
-				broker.Name, pretty.ServicePlanName(payloadServicePlan),
-			)
-			if err := c.reconcileServicePlanFromServiceBrokerCatalog(broker, payloadServicePlan, existingServicePlan); err != nil {
-				s := fmt.Sprintf(
-					"Error reconciling %s: %s",
-					pretty.ServicePlanName(payloadServicePlan), err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s)
-				return err
-			}
-			klog.V(5).Info(pcb.Messagef("Reconciled %s", pretty.ServicePlanName(payloadServicePlan)))
-
-		}
-
-		// handle the servicePlans that were not in the broker's payload;
-		// mark these as deleted
-		for _, existingServicePlan := range existingServicePlanMap {
-			if existingServicePlan.Status.RemovedFromBrokerCatalog {
-				continue
-			}
-			klog.V(4).Info(pcb.Messagef("%s has been removed from broker's catalog; marking", pretty.ServicePlanName(existingServicePlan)))
-			existingServicePlan.Status.RemovedFromBrokerCatalog = true
-			_, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(context.Background(), existingServicePlan, metav1.UpdateOptions{})
-			if err != nil {
-				s := fmt.Sprintf(
-					"Error updating status of %s: %v",
-					pretty.ServicePlanName(existingServicePlan),
-					err,
-				)
-				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
-					errorSyncingCatalogMessage+s); err != nil {
-					return err
-				}
-				return err
-			}
+		// create or update the classes and plans of the broker's catalog
+		// payload, and mark the ones that are no longer in it as removed
+		payloadClasses, payloadPlans, existingClasses, existingPlans := namespacedCatalogEntries(payloadServiceClasses, payloadServicePlans, existingServiceClassMap, existingServicePlanMap)
+		m := c.newServiceBrokerCatalogMaterializer(broker)
+		// the summary is computed first, as materializing takes the existing
+		// entries that are still in the catalog out of their maps
+		summary := m.summarize(brokerCatalog, payloadClasses, payloadPlans, existingClasses, existingPlans, broker.Status.CatalogSummary)
+		if err := m.materialize(payloadClasses, payloadPlans, existingClasses, existingPlans); err != nil {
+			return err
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// everything worked correctly; record the summary of the catalog and
+		// update the broker's ready condition to status true
+		toUpdate := broker.DeepCopy()
+		toUpdate.Status.CatalogSummary = summary
+		if err := c.updateServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
@@ -444,170 +410,235 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 // catalog payload. The existingServiceClass parameter is the serviceClass
 // that already exists for the given broker with this serviceClass' k8s name.
 func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1beta1.ServiceBroker, serviceClass, existingServiceClass *v1beta1.ServiceClass) error {
-	pcb := pretty.NewServiceBrokerContextBuilder(broker)
-	serviceClass.Spec.ServiceBrokerName = broker.Name
+	m := c.newServiceBrokerCatalogMaterializer(broker)
+	var existing metav1.Object
+	if existingServiceClass != nil {
+		existing = existingServiceClass
+	}
+	return m.syncEntry(m.classes, serviceClass, existing)
+}
 
-	if existingServiceClass == nil {
-		otherServiceClass, err := c.serviceClassLister.ServiceClasses(broker.Namespace).Get(serviceClass.Name)
-		if err != nil {
-			// we expect _not_ to find a service class this way, so a not-
-			// found error is expected and legitimate.
-			if !errors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			// we do not expect to find an existing service class if we were
-			// not already passed one; the following if statement will almost
-			// certainly evaluate to true.
-			if otherServiceClass.Spec.ServiceBrokerName != broker.Name {
-				errMsg := fmt.Sprintf("%s already exists for Broker %q",
-					pretty.ServiceClassName(serviceClass), otherServiceClass.Spec.ServiceBrokerName,
-				)
-				klog.Error(pcb.Message(errMsg))
-				return fmt.Errorf(errMsg)
-			}
-		}
+// reconcileServicePlanFromServiceBrokerCatalog reconciles a
+// ServicePlan after the ServiceClass's catalog has been re-listed.
+func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta1.ServiceBroker, servicePlan, existingServicePlan *v1beta1.ServicePlan) error {
+	m := c.newServiceBrokerCatalogMaterializer(broker)
+	var existing metav1.Object
+	if existingServicePlan != nil {
+		existing = existingServicePlan
+	}
+	return m.syncEntry(m.plans, servicePlan, existing)
+}
 
-		klog.V(5).Info(pcb.Messagef("Fresh %s; creating", pretty.ServiceClassName(serviceClass)))
-		if _, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).Create(context.Background(), serviceClass, metav1.CreateOptions{}); err != nil {
-			klog.Error(pcb.Messagef("Error creating %s: %v", pretty.ServiceClassName(serviceClass), err))
-			return err
-		}
+// newServiceBrokerCatalogMaterializer returns the materializer of the
+// catalog of a ServiceBroker.
+func (c *controller) newServiceBrokerCatalogMaterializer(broker *v1beta1.ServiceBroker) *catalogMaterializer {
+	return c.newCatalogMaterializer(
+		pretty.NewServiceBrokerContextBuilder(broker),
+		broker,
+		broker.Name,
+		&serviceClassEntries{c: c, broker: broker},
+		&servicePlanEntries{c: c, broker: broker},
+		func(message string) error {
+			return c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				errorSyncingCatalogMessage+message)
+		},
+	)
+}
 
-		return nil
+// namespacedCatalogEntries returns the classes and plans of a catalog
+// payload and the existing ones as catalog entries.
+func namespacedCatalogEntries(payloadClasses []*v1beta1.ServiceClass, payloadPlans []*v1beta1.ServicePlan, existingClasses map[string]*v1beta1.ServiceClass, existingPlans map[string]*v1beta1.ServicePlan) ([]metav1.Object, []metav1.Object, map[string]metav1.Object, map[string]metav1.Object) {
+	classes := make([]metav1.Object, 0, len(payloadClasses))
+	for _, class := range payloadClasses {
+		classes = append(classes, class)
 	}
-
-	if existingServiceClass.Spec.ExternalID != serviceClass.Spec.ExternalID {
-		errMsg := fmt.Sprintf(
-			"%s already exists with OSB guid %q, received different guid %q",
-			pretty.ServiceClassName(serviceClass), existingServiceClass.Name, serviceClass.Name,
-		)
-		klog.Error(pcb.Message(errMsg))
-		return fmt.Errorf(errMsg)
+	plans := make([]metav1.Object, 0, len(payloadPlans))
+	for _, plan := range payloadPlans {
+		plans = append(plans, plan)
+	}
+	existingClassEntries := make(map[string]metav1.Object, len(existingClasses))
+	for name, class := range existingClasses {
+		existingClassEntries[name] = class
+	}
+	existingPlanEntries := make(map[string]metav1.Object, len(existingPlans))
+	for name, plan := range existingPlans {
+		existingPlanEntries[name] = plan
 	}
+	return classes, plans, existingClassEntries, existingPlanEntries
+}
 
-	klog.V(5).Info(pcb.Messagef("Found existing %s; updating", pretty.ServiceClassName(serviceClass)))
+// serviceClassEntries are the ServiceClasses of a ServiceBroker.
+type serviceClassEntries struct {
+	c      *controller
+	broker *v1beta1.ServiceBroker
+}
 
-	// There was an existing service class -- project the update onto it and
-	// update it.
-	toUpdate := existingServiceClass.DeepCopy()
-	toUpdate.Spec.BindingRetrievable = serviceClass.Spec.BindingRetrievable
-	toUpdate.Spec.Bindable = serviceClass.Spec.Bindable
-	toUpdate.Spec.PlanUpdatable = serviceClass.Spec.PlanUpdatable
-	toUpdate.Spec.Tags = serviceClass.Spec.Tags
-	toUpdate.Spec.Description = serviceClass.Spec.Description
-	toUpdate.Spec.Requires = serviceClass.Spec.Requires
-	toUpdate.Spec.ExternalName = serviceClass.Spec.ExternalName
-	toUpdate.Spec.ExternalMetadata = serviceClass.Spec.ExternalMetadata
+func (e *serviceClassEntries) kind() string {
+	return "ServiceClass"
+}
 
-	updatedServiceClass, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).Update(context.Background(), toUpdate, metav1.UpdateOptions{})
-	if err != nil {
-		klog.Error(pcb.Messagef("Error updating %s: %v", pretty.ServiceClassName(serviceClass), err))
-		return err
-	}
+func (e *serviceClassEntries) prettyName(entry metav1.Object) string {
+	return pretty.ServiceClassName(entry.(*v1beta1.ServiceClass))
+}
 
-	if updatedServiceClass.Status.RemovedFromBrokerCatalog {
-		klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ServiceClassName(serviceClass)))
-		updatedServiceClass.Status.RemovedFromBrokerCatalog = false
-		_, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).UpdateStatus(context.Background(), updatedServiceClass, metav1.UpdateOptions{})
-		if err != nil {
-			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ServiceClassName(updatedServiceClass), err)
-			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
-				return err
-			}
-			return err
-		}
-	}
+func (e *serviceClassEntries) externalID(entry metav1.Object) string {
+	return entry.(*v1beta1.ServiceClass).Spec.ExternalID
+}
 
-	return nil
+func (e *serviceClassEntries) externalName(entry metav1.Object) string {
+	return entry.(*v1beta1.ServiceClass).Spec.ExternalName
 }
 
-// reconcileServicePlanFromServiceBrokerCatalog reconciles a
-// ServicePlan after the ServiceClass's catalog has been re-listed.
-func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta1.ServiceBroker, servicePlan, existingServicePlan *v1beta1.ServicePlan) error {
-	pcb := pretty.NewServiceBrokerContextBuilder(broker)
-	servicePlan.Spec.ServiceBrokerName = broker.Name
+func (e *serviceClassEntries) brokerName(entry metav1.Object) string {
+	return entry.(*v1beta1.ServiceClass).Spec.ServiceBrokerName
+}
 
-	if existingServicePlan == nil {
-		otherServicePlan, err := c.servicePlanLister.ServicePlans(broker.Namespace).Get(servicePlan.Name)
-		if err != nil {
-			// we expect _not_ to find a service class this way, so a not-
-			// found error is expected and legitimate.
-			if !errors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			// we do not expect to find an existing service class if we were
-			// not already passed one; the following if statement will almost
-			// certainly evaluate to true.
-			if otherServicePlan.Spec.ServiceBrokerName != broker.Name {
-				errMsg := fmt.Sprintf(
-					"%s already exists for Broker %q",
-					pretty.ServicePlanName(servicePlan), otherServicePlan.Spec.ServiceBrokerName,
-				)
-				klog.Error(pcb.Message(errMsg))
-				return fmt.Errorf(errMsg)
-			}
-		}
+func (e *serviceClassEntries) className(entry metav1.Object) string {
+	return entry.GetName()
+}
 
-		// An error returned from a lister Get call means that the object does
-		// not exist.  Create a new ServicePlan.
-		if _, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Create(context.Background(), servicePlan, metav1.CreateOptions{}); err != nil {
-			klog.Error(pcb.Messagef("Error creating %s: %v", pretty.ServicePlanName(servicePlan), err))
-			return err
-		}
+func (e *serviceClassEntries) removedFromBrokerCatalog(entry metav1.Object) bool {
+	return entry.(*v1beta1.ServiceClass).Status.RemovedFromBrokerCatalog
+}
 
-		return nil
-	}
+// managed returns true as the namespaced classes of a broker are all
+// created from its catalog.
+func (e *serviceClassEntries) managed(entry metav1.Object) bool {
+	return true
+}
 
-	if existingServicePlan.Spec.ExternalID != servicePlan.Spec.ExternalID {
-		errMsg := fmt.Sprintf(
-			"%s already exists with OSB guid %q, received different guid %q",
-			pretty.ServicePlanName(servicePlan), existingServicePlan.Spec.ExternalID, servicePlan.Spec.ExternalID,
-		)
-		klog.Error(pcb.Message(errMsg))
-		return fmt.Errorf(errMsg)
-	}
+func (e *serviceClassEntries) prepare(payload metav1.Object) {
+	payload.(*v1beta1.ServiceClass).Spec.ServiceBrokerName = e.broker.Name
+	markAsNamespacedServiceCatalogManagedResource(payload, e.broker)
+}
 
-	klog.V(5).Info(pcb.Messagef("Found existing %s; updating", pretty.ServicePlanName(servicePlan)))
+func (e *serviceClassEntries) get(name string) (metav1.Object, error) {
+	return e.c.serviceClassLister.ServiceClasses(e.broker.Namespace).Get(name)
+}
 
-	// There was an existing service plan -- project the update onto it and
-	// update it.
-	toUpdate := existingServicePlan.DeepCopy()
-	toUpdate.Spec.Description = servicePlan.Spec.Description
-	toUpdate.Spec.Bindable = servicePlan.Spec.Bindable
-	toUpdate.Spec.Free = servicePlan.Spec.Free
-	toUpdate.Spec.ExternalName = servicePlan.Spec.ExternalName
-	toUpdate.Spec.ExternalMetadata = servicePlan.Spec.ExternalMetadata
-	toUpdate.Spec.InstanceCreateParameterSchema = servicePlan.Spec.InstanceCreateParameterSchema
-	toUpdate.Spec.InstanceUpdateParameterSchema = servicePlan.Spec.InstanceUpdateParameterSchema
-	toUpdate.Spec.ServiceBindingCreateParameterSchema = servicePlan.Spec.ServiceBindingCreateParameterSchema
+func (e *serviceClassEntries) claim(other metav1.Object) error {
+	return fmt.Errorf("%s already exists for Broker %q",
+		pretty.ServiceClassName(other.(*v1beta1.ServiceClass)), e.brokerName(other),
+	)
+}
 
-	updatedPlan, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Update(context.Background(), toUpdate, metav1.UpdateOptions{})
-	if err != nil {
-		klog.Error(pcb.Messagef("Error updating %s: %v", pretty.ServicePlanName(servicePlan), err))
-		return err
-	}
+func (e *serviceClassEntries) create(payload metav1.Object) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ServiceClasses(e.broker.Namespace).Create(context.Background(), payload.(*v1beta1.ServiceClass), metav1.CreateOptions{FieldManager: catalogFieldManager})
+}
 
-	if updatedPlan.Status.RemovedFromBrokerCatalog {
-		updatedPlan.Status.RemovedFromBrokerCatalog = false
-		klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ServicePlanName(updatedPlan)))
+func (e *serviceClassEntries) update(existing, payload metav1.Object) (metav1.Object, error) {
+	toUpdate := existing.(*v1beta1.ServiceClass).DeepCopy()
+	projectServiceClassSpec(&toUpdate.Spec.CommonServiceClassSpec, &payload.(*v1beta1.ServiceClass).Spec.CommonServiceClassSpec)
+	markAsNamespacedServiceCatalogManagedResource(toUpdate, e.broker)
+	return e.c.serviceCatalogClient.ServiceClasses(e.broker.Namespace).Update(context.Background(), toUpdate, metav1.UpdateOptions{FieldManager: catalogFieldManager})
+}
 
-		_, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(context.Background(), updatedPlan, metav1.UpdateOptions{})
-		if err != nil {
-			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ServicePlanName(updatedPlan), err)
-			klog.Error(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
-				return err
-			}
-			return err
-		}
-	}
+func (e *serviceClassEntries) upToDate(existing, payload metav1.Object) bool {
+	return isServiceCatalogManagedResource(existing) &&
+		serviceClassSpecUpToDate(&existing.(*v1beta1.ServiceClass).Spec.CommonServiceClassSpec, &payload.(*v1beta1.ServiceClass).Spec.CommonServiceClassSpec)
+}
 
-	return nil
+func (e *serviceClassEntries) apply(payload []byte, name string) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ServiceClasses(e.broker.Namespace).Patch(context.Background(), name, types.ApplyPatchType, payload, catalogApplyOptions())
+}
+
+func (e *serviceClassEntries) patchManagedFields(name string, patch []byte) error {
+	_, err := e.c.serviceCatalogClient.ServiceClasses(e.broker.Namespace).Patch(context.Background(), name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+func (e *serviceClassEntries) setRemovedFromBrokerCatalog(entry metav1.Object, removed bool) error {
+	class := entry.(*v1beta1.ServiceClass)
+	class.Status.RemovedFromBrokerCatalog = removed
+	_, err := e.c.serviceCatalogClient.ServiceClasses(e.broker.Namespace).UpdateStatus(context.Background(), class, metav1.UpdateOptions{})
+	return err
+}
+
+// servicePlanEntries are the ServicePlans of a ServiceBroker.
+type servicePlanEntries struct {
+	c      *controller
+	broker *v1beta1.ServiceBroker
+}
+
+func (e *servicePlanEntries) kind() string {
+	return "ServicePlan"
+}
+
+func (e *servicePlanEntries) prettyName(entry metav1.Object) string {
+	return pretty.ServicePlanName(entry.(*v1beta1.ServicePlan))
+}
+
+func (e *servicePlanEntries) externalID(entry metav1.Object) string {
+	return entry.(*v1beta1.ServicePlan).Spec.ExternalID
+}
+
+func (e *servicePlanEntries) externalName(entry metav1.Object) string {
+	return entry.(*v1beta1.ServicePlan).Spec.ExternalName
+}
+
+func (e *servicePlanEntries) brokerName(entry metav1.Object) string {
+	return entry.(*v1beta1.ServicePlan).Spec.ServiceBrokerName
+}
+
+func (e *servicePlanEntries) className(entry metav1.Object) string {
+	return entry.(*v1beta1.ServicePlan).Spec.ServiceClassRef.Name
+}
+
+func (e *servicePlanEntries) removedFromBrokerCatalog(entry metav1.Object) bool {
+	return entry.(*v1beta1.ServicePlan).Status.RemovedFromBrokerCatalog
+}
+
+// managed returns true as the namespaced plans of a broker are all created
+// from its catalog.
+func (e *servicePlanEntries) managed(entry metav1.Object) bool {
+	return true
+}
+
+func (e *servicePlanEntries) prepare(payload metav1.Object) {
+	payload.(*v1beta1.ServicePlan).Spec.ServiceBrokerName = e.broker.Name
+	markAsNamespacedServiceCatalogManagedResource(payload, e.broker)
+}
+
+func (e *servicePlanEntries) get(name string) (metav1.Object, error) {
+	return e.c.servicePlanLister.ServicePlans(e.broker.Namespace).Get(name)
+}
+
+func (e *servicePlanEntries) claim(other metav1.Object) error {
+	return fmt.Errorf("%s already exists for Broker %q",
+		pretty.ServicePlanName(other.(*v1beta1.ServicePlan)), e.brokerName(other),
+	)
+}
+
+func (e *servicePlanEntries) create(payload metav1.Object) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ServicePlans(e.broker.Namespace).Create(context.Background(), payload.(*v1beta1.ServicePlan), metav1.CreateOptions{FieldManager: catalogFieldManager})
+}
+
+func (e *servicePlanEntries) update(existing, payload metav1.Object) (metav1.Object, error) {
+	toUpdate := existing.(*v1beta1.ServicePlan).DeepCopy()
+	projectServicePlanSpec(&toUpdate.Spec.CommonServicePlanSpec, &payload.(*v1beta1.ServicePlan).Spec.CommonServicePlanSpec)
+	markAsNamespacedServiceCatalogManagedResource(toUpdate, e.broker)
+	return e.c.serviceCatalogClient.ServicePlans(e.broker.Namespace).Update(context.Background(), toUpdate, metav1.UpdateOptions{FieldManager: catalogFieldManager})
+}
+
+func (e *servicePlanEntries) upToDate(existing, payload metav1.Object) bool {
+	return isServiceCatalogManagedResource(existing) &&
+		servicePlanSpecUpToDate(&existing.(*v1beta1.ServicePlan).Spec.CommonServicePlanSpec, &payload.(*v1beta1.ServicePlan).Spec.CommonServicePlanSpec)
+}
+
+func (e *servicePlanEntries) apply(payload []byte, name string) (metav1.Object, error) {
+	return e.c.serviceCatalogClient.ServicePlans(e.broker.Namespace).Patch(context.Background(), name, types.ApplyPatchType, payload, catalogApplyOptions())
+}
+
+func (e *servicePlanEntries) patchManagedFields(name string, patch []byte) error {
+	_, err := e.c.serviceCatalogClient.ServicePlans(e.broker.Namespace).Patch(context.Background(), name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+func (e *servicePlanEntries) setRemovedFromBrokerCatalog(entry metav1.Object, removed bool) error {
+	plan := entry.(*v1beta1.ServicePlan)
+	plan.Status.RemovedFromBrokerCatalog = removed
+	_, err := e.c.serviceCatalogClient.ServicePlans(e.broker.Namespace).UpdateStatus(context.Background(), plan, metav1.UpdateOptions{})
+	return err
 }
 
 // updateCommonStatusCondition updates the common ready condition for the given CommonServiceBrokerStatus
@@ -660,6 +691,10 @@ func (c *controller) updateServiceBrokerCondition(broker *v1beta1.ServiceBroker,
 
 	pcb := pretty.NewServiceBrokerContextBuilder(toUpdate)
 	updateCommonStatusCondition(pcb, toUpdate.ObjectMeta, &toUpdate.Status.CommonServiceBrokerStatus, conditionType, status, reason, message)
+	// record the OSB API version the catalog was fetched with
+	if conditionType == v1beta1.ServiceBrokerConditionReady && status == v1beta1.ConditionTrue {
+		toUpdate.Status.OSBAPIVersion = c.brokerAPIVersion(&toUpdate.Spec.CommonServiceBrokerSpec).HeaderValue()
+	}
 
 	toUpdate.RecalculatePrinterColumnStatusFields()
 
@@ -701,6 +736,32 @@ func (c *controller) updateServiceBrokerFinalizers(
 	return err
 }
 
+// countOfferedServiceClassesAndPlans returns the number of classes and plans
+// of broker held by the listers that are not marked as removed from its
+// catalog.
+func (c *controller) countOfferedServiceClassesAndPlans(broker *v1beta1.ServiceBroker) (int, int, error) {
+	serviceClasses, err := c.serviceClassLister.ServiceClasses(broker.Namespace).List(labels.Everything())
+	if err != nil {
+		return 0, 0, err
+	}
+	servicePlans, err := c.servicePlanLister.ServicePlans(broker.Namespace).List(labels.Everything())
+	if err != nil {
+		return 0, 0, err
+	}
+	var offeredClasses, offeredPlans int
+	for _, serviceClass := range serviceClasses {
+		if serviceClass.Spec.ServiceBrokerName == broker.Name && !serviceClass.Status.RemovedFromBrokerCatalog {
+			offeredClasses++
+		}
+	}
+	for _, servicePlan := range servicePlans {
+		if servicePlan.Spec.ServiceBrokerName == broker.Name && !servicePlan.Status.RemovedFromBrokerCatalog {
+			offeredPlans++
+		}
+	}
+	return offeredClasses, offeredPlans, nil
+}
+
 func (c *controller) getCurrentServiceClassesAndPlansForNamespacedBroker(broker *v1beta1.ServiceBroker) ([]v1beta1.ServiceClass, []v1beta1.ServicePlan, error) {
 	pcb := pretty.NewServiceBrokerContextBuilder(broker)
 	labelSelector := labels.SelectorFromSet(labels.Set{
@@ -777,3 +838,19 @@ func getServiceBrokerLastConditionState(status v1beta1.CommonServiceBrokerStatus
 	}
 	return ""
 }
+
+// markAsNamespacedServiceCatalogManagedResource makes broker the controller
+// of obj, like markAsServiceCatalogManagedResource does for the classes and
+// plans of cluster brokers, so that the classes and plans of a namespaced
+// broker are garbage collected along with it.
+func markAsNamespacedServiceCatalogManagedResource(obj metav1.Object, broker *v1beta1.ServiceBroker) {
+	if isServiceCatalogManagedResource(obj) {
+		return
+	}
+
+	var blockOwnerDeletion = false
+	controllerRef := *metav1.NewControllerRef(broker, v1beta1.SchemeGroupVersion.WithKind("ServiceBroker"))
+	controllerRef.BlockOwnerDeletion = &blockOwnerDeletion
+
+	obj.SetOwnerReferences(append(obj.GetOwnerReferences(), controllerRef))
+}
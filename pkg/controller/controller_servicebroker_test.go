@@ -29,6 +29,7 @@ import (
 	"github.com/drycc-addons/service-catalog/test/fake"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -43,7 +44,7 @@ func TestShouldReconcileServiceBroker(t *testing.T) {
 	broker := getTestClusterServiceBroker()
 	broker.Spec.RelistDuration = &metav1.Duration{Duration: 3 * time.Minute}
 
-	if !shouldReconcileClusterServiceBroker(broker, time.Now(), 24*time.Hour) {
+	if !shouldReconcileClusterServiceBroker(broker, time.Now(), 24*time.Hour, 0) {
 		t.Error("expected true, bot got false")
 	}
 }
@@ -464,3 +465,52 @@ func TestReconcileServiceBrokerExistingServiceClassAndServicePlan(t *testing.T)
 		t.Fatalf("LastConditionState has unexpected value. Expected: %v, got: %v", "Ready", updateObject.Status.LastConditionState)
 	}
 }
+
+// TestGetAuthCredentialsFromServiceBrokerServiceAccountToken tests that a
+// broker authenticating with a service account token gets a token requested
+// for its URL as its bearer token.
+func TestGetAuthCredentialsFromServiceBrokerServiceAccountToken(t *testing.T) {
+	err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.BrokerServiceAccountTokenAuth))
+	if err != nil {
+		t.Fatalf("Failed to enable the BrokerServiceAccountTokenAuth feature: %v", err)
+	}
+	defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BrokerServiceAccountTokenAuth))
+
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	fakeKubeClient.AddReactor("create", "serviceaccounts", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		request := action.(clientgotesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		if audiences := request.Spec.Audiences; len(audiences) != 1 || audiences[0] != "https://example.com" {
+			t.Errorf("The audience of the token must be the URL of the broker, got %v", audiences)
+		}
+		request = request.DeepCopy()
+		request.Status = authenticationv1.TokenRequestStatus{
+			Token:               "service-account-token",
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(time.Hour)),
+		}
+		return true, request, nil
+	})
+
+	broker := getTestServiceBrokerWithAuth(&v1beta1.ServiceBrokerAuthInfo{
+		ServiceAccountToken: &v1beta1.ServiceAccountTokenAuthConfig{ServiceAccountName: "broker-client"},
+	})
+
+	for i := 0; i < 2; i++ {
+		authConfig, err := testController.getAuthCredentialsFromServiceBroker(broker)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if authConfig.BearerConfig == nil || authConfig.BearerConfig.Token != "service-account-token" {
+			t.Fatalf("Expected the service account token as the bearer token, got %+v", authConfig)
+		}
+	}
+
+	// The token is requested once and reused until it is due for renewal.
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 1)
+	if e, a := "token", kubeActions[0].GetSubresource(); e != a {
+		t.Fatalf("Unexpected subresource: %s", expectedGot(e, a))
+	}
+	if e, a := broker.Namespace, kubeActions[0].GetNamespace(); e != a {
+		t.Fatalf("Unexpected namespace: %s", expectedGot(e, a))
+	}
+}
@@ -31,6 +31,20 @@ import (
 )
 
 func (c *controller) serviceClassAdd(obj interface{}) {
+	c.enqueueServiceClass(obj)
+
+	// Instances that were created before the ServiceClass appeared in the
+	// broker's catalog may be waiting on it to resolve their references.
+	if serviceClass, ok := obj.(*v1beta1.ServiceClass); ok {
+		c.enqueueInstancesReferencingServiceClass(serviceClass)
+	}
+}
+
+func (c *controller) serviceClassUpdate(oldObj, newObj interface{}) {
+	c.enqueueServiceClass(newObj)
+}
+
+func (c *controller) enqueueServiceClass(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("Couldn't get key for object %+v: %v", obj, err)
@@ -39,10 +53,6 @@ func (c *controller) serviceClassAdd(obj interface{}) {
 	c.serviceClassQueue.Add(key)
 }
 
-func (c *controller) serviceClassUpdate(oldObj, newObj interface{}) {
-	c.serviceClassAdd(newObj)
-}
-
 func (c *controller) serviceClassDelete(obj interface{}) {
 	serviceClass, ok := obj.(*v1beta1.ServiceClass)
 	if serviceClass == nil || !ok {
@@ -79,6 +89,10 @@ func (c *controller) reconcileServiceClass(serviceClass *v1beta1.ServiceClass) e
 	pcb := pretty.NewContextBuilder(pretty.ServiceClass, serviceClass.Namespace, serviceClass.Name, "")
 	klog.Info(pcb.Message("Processing"))
 
+	if c.readOnly {
+		return nil
+	}
+
 	if !serviceClass.Status.RemovedFromBrokerCatalog {
 		return nil
 	}
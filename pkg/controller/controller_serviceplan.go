@@ -33,6 +33,20 @@ import (
 // Service plan handlers and control-loop
 
 func (c *controller) servicePlanAdd(obj interface{}) {
+	c.enqueueServicePlan(obj)
+
+	// Instances that were created before the ServicePlan appeared in the
+	// broker's catalog may be waiting on it to resolve their references.
+	if servicePlan, ok := obj.(*v1beta1.ServicePlan); ok {
+		c.enqueueInstancesReferencingServicePlan(servicePlan)
+	}
+}
+
+func (c *controller) servicePlanUpdate(oldObj, newObj interface{}) {
+	c.enqueueServicePlan(newObj)
+}
+
+func (c *controller) enqueueServicePlan(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("ServicePlan: Couldn't get key for object %+v: %v", obj, err)
@@ -41,10 +55,6 @@ func (c *controller) servicePlanAdd(obj interface{}) {
 	c.servicePlanQueue.Add(key)
 }
 
-func (c *controller) servicePlanUpdate(oldObj, newObj interface{}) {
-	c.servicePlanAdd(newObj)
-}
-
 func (c *controller) servicePlanDelete(obj interface{}) {
 	servicePlan, ok := obj.(*v1beta1.ServicePlan)
 	if servicePlan == nil || !ok {
@@ -82,10 +92,14 @@ func (c *controller) reconcileServicePlan(servicePlan *v1beta1.ServicePlan) erro
 	pcb := pretty.NewContextBuilder(pretty.ServicePlan, servicePlan.Namespace, servicePlan.Name, "")
 	klog.Infof("ServicePlan %q (ExternalName: %q): processing", servicePlan.Name, servicePlan.Spec.ExternalName)
 
-	if !servicePlan.Status.RemovedFromBrokerCatalog {
+	if c.readOnly {
 		return nil
 	}
 
+	if !servicePlan.Status.RemovedFromBrokerCatalog {
+		return c.syncServicePlanParameters(servicePlan)
+	}
+
 	klog.Infof(pcb.Message("removed from broker catalog; determining whether there are instances remaining"))
 
 	serviceInstances, err := c.findServiceInstancesOnServicePlan(servicePlan)
@@ -17,20 +17,27 @@ limitations under the License.
 package controller
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
 	"runtime/debug"
+	"strings"
 	"testing"
 	"time"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"sigs.k8s.io/yaml"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	servicecataloginformers "github.com/drycc-addons/service-catalog/pkg/client/informers_generated/externalversions"
 	v1beta1informers "github.com/drycc-addons/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
 	"github.com/drycc-addons/service-catalog/pkg/util"
 
 	servicecatalogclientset "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
@@ -43,9 +50,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/diff"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	kubeinformers "k8s.io/client-go/informers"
 	clientgofake "k8s.io/client-go/kubernetes/fake"
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 )
 
 // NOTE:
@@ -58,6 +68,15 @@ import (
 // Other controller_*_test.go files contain tests related to the reconciliation
 // loops for the different catalog API resources.
 
+func TestMain(m *testing.M) {
+	// The fake clientset does not implement server-side apply, so the broker
+	// tests expect the classes and plans to be created and updated.
+	if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.CatalogServerSideApply)); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
 const (
 	testClusterServiceClassGUID            = "cscguid"
 	testClusterServicePlanGUID             = "cspguid"
@@ -1278,6 +1297,7 @@ func getTestServiceBindingAsyncBinding(operation string) *v1beta1.ServiceBinding
 	}
 	if operation != "" {
 		binding.Status.LastOperation = &operation
+		binding.Status.LastOperationKey = operation
 	}
 
 	return binding
@@ -1316,6 +1336,7 @@ func getTestServiceBindingAsyncUnbinding(operation string) *v1beta1.ServiceBindi
 	}
 	if operation != "" {
 		binding.Status.LastOperation = &operation
+		binding.Status.LastOperationKey = operation
 	}
 
 	return binding
@@ -1354,6 +1375,7 @@ func getTestServiceBindingAsyncOrphanMitigation(operation string) *v1beta1.Servi
 	}
 	if operation != "" {
 		binding.Status.LastOperation = &operation
+		binding.Status.LastOperationKey = operation
 	}
 
 	return binding
@@ -1392,6 +1414,37 @@ func TestEmptyCatalogConversion(t *testing.T) {
 	}
 }
 
+func TestCatalogConversionAllowContextUpdates(t *testing.T) {
+	catalog := &osb.CatalogResponse{
+		Services: []osb.Service{
+			{
+				ID:       "allowed-id",
+				Name:     "allowed",
+				Metadata: map[string]interface{}{"allow_context_updates": true},
+				Plans:    []osb.Plan{{ID: "allowed-plan-id", Name: "plan"}},
+			},
+			{
+				ID:    "not-allowed-id",
+				Name:  "not-allowed",
+				Plans: []osb.Plan{{ID: "not-allowed-plan-id", Name: "plan"}},
+			},
+		},
+	}
+	serviceClasses, _, err := convertAndFilterCatalog(catalog, nil, emptyServiceClasses, emptyServicePlans)
+	if err != nil {
+		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
+	}
+	if len(serviceClasses) != 2 {
+		t.Fatalf("Expected 2 serviceclasses, but got: %d", len(serviceClasses))
+	}
+	if !serviceClasses[0].Spec.AllowContextUpdates {
+		t.Fatalf("Expected %q to allow context updates", serviceClasses[0].Spec.ExternalName)
+	}
+	if serviceClasses[1].Spec.AllowContextUpdates {
+		t.Fatalf("Expected %q not to allow context updates", serviceClasses[1].Spec.ExternalName)
+	}
+}
+
 func TestCatalogConversion(t *testing.T) {
 	catalog := &osb.CatalogResponse{}
 	err := json.Unmarshal([]byte(testCatalog), &catalog)
@@ -1451,6 +1504,42 @@ func TestCatalogConversionWithPreexistingClassesAndPlans(t *testing.T) {
 	checkPlan(servicePlans[1], "0f4008b5-xxxx-xxxx-xxxx-dace631cd648", "0f4008b5-xxxx-xxxx-xxxx-dace631cd648", "fake-plan-2", "Shared fake Server, 5tb persistent disk, 40 max concurrent connections. 100 async", t)
 }
 
+func TestCatalogConversionMaintenanceInfo(t *testing.T) {
+	catalog := &osb.CatalogResponse{
+		Services: []osb.Service{
+			{
+				ID:   "service-id",
+				Name: "service",
+				Plans: []osb.Plan{
+					{
+						ID:              "upgradable-plan-id",
+						Name:            "upgradable-plan",
+						MaintenanceInfo: &osb.MaintenanceInfo{Version: "2.1.0", Description: "OS upgrade"},
+					},
+					{
+						ID:   "plan-id",
+						Name: "plan",
+					},
+				},
+			},
+		},
+	}
+	_, servicePlans, err := convertAndFilterCatalog(catalog, nil, emptyServiceClasses, emptyServicePlans)
+	if err != nil {
+		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
+	}
+	if len(servicePlans) != 2 {
+		t.Fatalf("Expected 2 plans, but got: %d", len(servicePlans))
+	}
+	expected := &v1beta1.MaintenanceInfo{Version: "2.1.0", Description: "OS upgrade"}
+	if e, a := expected, servicePlans[0].Spec.MaintenanceInfo; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected maintenance info: %v", expectedGot(e, a))
+	}
+	if a := servicePlans[1].Spec.MaintenanceInfo; a != nil {
+		t.Fatalf("Expected no maintenance info, but got: %+v", a)
+	}
+}
+
 func TestCatalogConversionWithParameterSchemas(t *testing.T) {
 	catalog := &osb.CatalogResponse{}
 	err := json.Unmarshal([]byte(alphaParameterSchemaCatalogBytes), &catalog)
@@ -2423,15 +2512,26 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Namespaces(),
+		nil,
 		brokerClFunc,
 		24*time.Hour,
+		0,
+		0,
+		0,
+		0,
 		osb.LatestAPIVersion().HeaderValue(),
 		fakeRecorder,
 		7*24*time.Hour,
+		0,
 		7*24*time.Hour,
 		DefaultClusterIDConfigMapName,
 		DefaultClusterIDConfigMapNamespace,
 		60*time.Second,
+		30*time.Second,
+		nil,
+		false,
+		nil,
 	)
 
 	if err != nil {
@@ -2460,8 +2560,23 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 	return fakeKubeClient, fakeCatalogClient, fakeOSBClient, testController.(*controller), serviceCatalogSharedInformers
 }
 
+// getRecordedEvents returns the events recorded by the test controller,
+// without the correlation appended to ServiceInstance and ServiceBinding
+// event messages.
 func getRecordedEvents(testController *controller) []string {
-	source := testController.recorder.(*record.FakeRecorder).Events
+	events := getCorrelatedRecordedEvents(testController)
+	for i, event := range events {
+		if j := strings.LastIndex(event, eventCorrelationPrefix); j >= 0 && strings.HasSuffix(event, ")") {
+			events[i] = event[:j]
+		}
+	}
+	return events
+}
+
+// getCorrelatedRecordedEvents returns the events recorded by the test
+// controller as they were recorded.
+func getCorrelatedRecordedEvents(testController *controller) []string {
+	source := testController.recorder.(*correlatingEventRecorder).EventRecorder.(*record.FakeRecorder).Events
 	done := false
 	events := []string{}
 	for !done {
@@ -2923,6 +3038,19 @@ func assertServiceInstanceDashboardURL(t *testing.T, obj runtime.Object, dashboa
 	}
 }
 
+func assertServiceInstanceBroker(t *testing.T, obj runtime.Object, brokerName, brokerURL string) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceInstance", obj)
+	}
+	if e, a := brokerName, instance.Status.BrokerName; e != a {
+		fatalf(t, "Unexpected BrokerName: %s", expectedGot(e, a))
+	}
+	if e, a := brokerURL, instance.Status.BrokerURL; e != a {
+		fatalf(t, "Unexpected BrokerURL: %s", expectedGot(e, a))
+	}
+}
+
 func assertServiceInstanceDeprovisionStatus(t *testing.T, obj runtime.Object, deprovisionStatus v1beta1.ServiceInstanceDeprovisionStatus) {
 	instance, ok := obj.(*v1beta1.ServiceInstance)
 	if !ok {
@@ -3698,6 +3826,7 @@ func assertServiceBindingRequestRetriableOrphanMitigation(t *testing.T, obj runt
 func assertServiceBindingAsyncInProgress(t *testing.T, obj runtime.Object, operation v1beta1.ServiceBindingOperation, reason string, operationKey string, originalBinding *v1beta1.ServiceBinding) {
 	assertServiceBindingReadyFalse(t, obj, reason)
 	assertServiceBindingLastOperation(t, obj, operationKey)
+	assertServiceBindingLastOperationKey(t, obj, operationKey)
 	assertServiceBindingCurrentOperation(t, obj, operation)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
@@ -3748,6 +3877,16 @@ func assertServiceBindingLastOperation(t *testing.T, obj runtime.Object, operati
 	}
 }
 
+func assertServiceBindingLastOperationKey(t *testing.T, obj runtime.Object, operationKey string) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+	if e, a := operationKey, binding.Status.LastOperationKey; e != a {
+		fatalf(t, "Unexpected last operation key; %s", expectedGot(e, a))
+	}
+}
+
 func assertServiceBindingAsyncOpInProgressTrue(t *testing.T, obj runtime.Object) {
 	binding, ok := obj.(*v1beta1.ServiceBinding)
 	if !ok {
@@ -4091,3 +4230,76 @@ func updateObjectReactor(resource string) (string, string, clientgotesting.React
 		return true, e.GetObject(), nil
 	}
 }
+
+// TestNewClientConfigurationForBrokerTLSConfig tests that the TLS
+// configuration of a broker is applied to its client configuration.
+func TestNewClientConfigurationForBrokerTLSConfig(t *testing.T) {
+	broker := getTestClusterServiceBroker()
+	broker.Spec.TLSConfig = &v1beta1.ServiceBrokerTLSConfig{
+		MinVersion:   "1.2",
+		CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+	}
+
+	clientConfig, err := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, nil, time.Minute, osb.LatestAPIVersion())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clientConfig.TLSConfig == nil {
+		t.Fatal("Expected the client configuration to have a TLS configuration")
+	}
+	if e, a := uint16(tls.VersionTLS12), clientConfig.TLSConfig.MinVersion; e != a {
+		t.Fatalf("Unexpected min TLS version; %s", expectedGot(e, a))
+	}
+	if e, a := []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, clientConfig.TLSConfig.CipherSuites; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected cipher suites; %s", expectedGot(e, a))
+	}
+	if clientConfig.TLSConfig.VerifyConnection != nil {
+		t.Fatal("Expected the server identity not to be verified without server DNS names or SPIFFE IDs")
+	}
+
+	broker.Spec.TLSConfig.ServerDNSNames = []string{"broker.example.com"}
+	clientConfig, err = NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, nil, time.Minute, osb.LatestAPIVersion())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clientConfig.TLSConfig.VerifyConnection == nil {
+		t.Fatal("Expected the server identity to be verified")
+	}
+	otherServer := tls.ConnectionState{PeerCertificates: []*x509.Certificate{{DNSNames: []string{"attacker.example.com"}}}}
+	if err := clientConfig.TLSConfig.VerifyConnection(otherServer); err == nil {
+		t.Fatal("Expected the connection to a server with an unexpected certificate to be rejected")
+	}
+
+	broker.Spec.TLSConfig.MinVersion = "1.4"
+	if _, err := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, nil, time.Minute, osb.LatestAPIVersion()); err == nil {
+		t.Fatal("Expected an error for an invalid TLS configuration")
+	}
+}
+
+// TestWorkerRecordsReconcileDuration tests that the worker records the
+// duration of each reconciliation by resource type and result.
+func TestWorkerRecordsReconcileDuration(t *testing.T) {
+	metrics.ReconcileDuration.Reset()
+	defer metrics.ReconcileDuration.Reset()
+
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	queue.Add("succeeds")
+	queue.Add("fails")
+	queue.ShutDown()
+
+	worker(queue, "TestResource", 0, true, func(key string) error {
+		if key == "fails" {
+			return fmt.Errorf("reconcile failed")
+		}
+		return nil
+	})()
+
+	if e, a := 2, testutil.CollectAndCount(metrics.ReconcileDuration); e != a {
+		t.Fatalf("Unexpected number of reconcile duration series; %s", expectedGot(e, a))
+	}
+	for _, result := range []string{"success", "error"} {
+		if !metrics.ReconcileDuration.DeleteLabelValues("TestResource", result) {
+			t.Fatalf("Expected the duration of a reconciliation with result %q to be recorded", result)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// The keys of the tunables ConfigMap, named after the flags of the
+// controller manager they override.
+const (
+	tunableReconciliationRetryDuration = "reconciliation-retry-duration"
+	tunableReconciliationMaxAttempts   = "reconciliation-max-attempts"
+	tunableBrokerRelistJitterFactor    = "broker-relist-jitter-factor"
+	tunableMaxConcurrentProvisions     = "max-concurrent-provisions"
+)
+
+// tunablesMonitorInterval is how often the tunables ConfigMap is read.
+const tunablesMonitorInterval = 15 * time.Second
+
+// tunables are the settings of the controller that may change while it
+// runs, without restarting it and losing the caches of its informers.
+type tunables struct {
+	reconciliationRetryDuration time.Duration
+	reconciliationMaxAttempts   int
+	brokerRelistJitterFactor    float64
+	maxConcurrentProvisions     int
+}
+
+// set sets the tunable with the given key of the tunables ConfigMap to
+// value.
+func (t *tunables) set(key, value string) error {
+	switch key {
+	case tunableReconciliationRetryDuration:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		if d <= 0 {
+			return fmt.Errorf("must be greater than zero")
+		}
+		t.reconciliationRetryDuration = d
+	case tunableReconciliationMaxAttempts:
+		n, err := parseTunableCount(value)
+		if err != nil {
+			return err
+		}
+		t.reconciliationMaxAttempts = n
+	case tunableBrokerRelistJitterFactor:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if f < 0 {
+			return fmt.Errorf("must not be negative")
+		}
+		t.brokerRelistJitterFactor = f
+	case tunableMaxConcurrentProvisions:
+		n, err := parseTunableCount(value)
+		if err != nil {
+			return err
+		}
+		t.maxConcurrentProvisions = n
+	default:
+		return fmt.Errorf("not a setting that may change while the controller runs")
+	}
+	return nil
+}
+
+// parseTunableCount parses a tunable that counts something, where zero
+// means no limit.
+func parseTunableCount(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("must not be negative")
+	}
+	return n, nil
+}
+
+// WatchTunables makes the controller apply the settings of the tunables
+// ConfigMap while it runs.
+func (c *controller) WatchTunables(namespace, name string) {
+	c.tunablesConfigMapNamespace = namespace
+	c.tunablesConfigMapName = name
+	c.defaultTunables = c.getTunables()
+}
+
+// createTunablesMonitorWorker creates a worker that periodically applies the
+// settings of the tunables ConfigMap.
+func (c *controller) createTunablesMonitorWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		wait.Until(c.monitorTunables, tunablesMonitorInterval, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// monitorTunables applies the settings of the tunables ConfigMap. A setting
+// missing from the ConfigMap, or a missing ConfigMap, reverts to the value
+// the controller was started with. A ConfigMap with an invalid setting is
+// not applied at all, so that a mistake does not change the other settings.
+func (c *controller) monitorTunables() {
+	var data map[string]string
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.tunablesConfigMapNamespace).Get(context.Background(), c.tunablesConfigMapName, metav1.GetOptions{})
+	if err == nil {
+		data = cm.Data
+	} else if !errors.IsNotFound(err) {
+		klog.Warningf("Unable to get the tunables configmap %s/%s: %v", c.tunablesConfigMapNamespace, c.tunablesConfigMapName, err)
+		return
+	}
+
+	t := c.defaultTunables
+	for key, value := range data {
+		if err := t.set(key, value); err != nil {
+			klog.Warningf("Not applying the tunables configmap %s/%s because of its invalid %s %q: %v", c.tunablesConfigMapNamespace, c.tunablesConfigMapName, key, value, err)
+			return
+		}
+	}
+	if current := c.getTunables(); t != current {
+		klog.Infof("Applying the tunables configmap %s/%s: %+v", c.tunablesConfigMapNamespace, c.tunablesConfigMapName, t)
+		c.setTunables(t)
+	}
+}
+
+// getTunables returns the current settings that may change while the
+// controller runs.
+func (c *controller) getTunables() tunables {
+	c.tunablesLock.RLock()
+	defer c.tunablesLock.RUnlock()
+	c.provisions.mutex.Lock()
+	defer c.provisions.mutex.Unlock()
+	return tunables{
+		reconciliationRetryDuration: c.reconciliationRetryDuration,
+		reconciliationMaxAttempts:   c.reconciliationMaxAttempts,
+		brokerRelistJitterFactor:    c.brokerRelistJitterFactor,
+		maxConcurrentProvisions:     c.provisions.limit,
+	}
+}
+
+// setTunables changes the settings that may change while the controller
+// runs.
+func (c *controller) setTunables(t tunables) {
+	c.tunablesLock.Lock()
+	c.reconciliationRetryDuration = t.reconciliationRetryDuration
+	c.reconciliationMaxAttempts = t.reconciliationMaxAttempts
+	c.brokerRelistJitterFactor = t.brokerRelistJitterFactor
+	c.tunablesLock.Unlock()
+	c.setServiceInstanceProvisionLimit(t.maxConcurrentProvisions)
+}
+
+func (c *controller) getReconciliationRetryDuration() time.Duration {
+	c.tunablesLock.RLock()
+	defer c.tunablesLock.RUnlock()
+	return c.reconciliationRetryDuration
+}
+
+func (c *controller) getReconciliationMaxAttempts() int {
+	c.tunablesLock.RLock()
+	defer c.tunablesLock.RUnlock()
+	return c.reconciliationMaxAttempts
+}
+
+func (c *controller) getBrokerRelistJitterFactor() float64 {
+	c.tunablesLock.RLock()
+	defer c.tunablesLock.RUnlock()
+	return c.brokerRelistJitterFactor
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+const (
+	testTunablesConfigMapNamespace = "catalog"
+	testTunablesConfigMapName      = "controller-manager-tunables"
+)
+
+// TestMonitorTunables tests that the settings of the tunables ConfigMap are
+// applied, that an invalid ConfigMap is not, and that the settings revert to
+// those the controller was started with once the ConfigMap is deleted.
+func TestMonitorTunables(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.reconciliationRetryDuration = time.Hour
+	testController.reconciliationMaxAttempts = 3
+	testController.WatchTunables(testTunablesConfigMapNamespace, testTunablesConfigMapName)
+	started := testController.getTunables()
+
+	var cm *corev1.ConfigMap
+	fakeKubeClient.AddReactor("get", "configmaps", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if cm == nil {
+			return true, nil, errors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, testTunablesConfigMapName)
+		}
+		return true, cm, nil
+	})
+
+	cm = &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testTunablesConfigMapNamespace,
+			Name:      testTunablesConfigMapName,
+		},
+		Data: map[string]string{
+			tunableReconciliationRetryDuration: "2h",
+			tunableMaxConcurrentProvisions:     "5",
+		},
+	}
+	testController.monitorTunables()
+	expected := started
+	expected.reconciliationRetryDuration = 2 * time.Hour
+	expected.maxConcurrentProvisions = 5
+	if e, a := expected, testController.getTunables(); e != a {
+		t.Fatalf("Unexpected tunables; %s", expectedGot(e, a))
+	}
+
+	cm.Data[tunableReconciliationMaxAttempts] = "-1"
+	cm.Data[tunableReconciliationRetryDuration] = "3h"
+	testController.monitorTunables()
+	if e, a := expected, testController.getTunables(); e != a {
+		t.Fatalf("Expected an invalid configmap not to be applied; %s", expectedGot(e, a))
+	}
+
+	cm = nil
+	testController.monitorTunables()
+	if e, a := started, testController.getTunables(); e != a {
+		t.Fatalf("Expected the tunables to revert; %s", expectedGot(e, a))
+	}
+}
+
+// TestSetServiceInstanceProvisionLimit tests that raising the number of
+// provisions that may be in flight requeues the instances in line for the
+// slots it frees.
+func TestSetServiceInstanceProvisionLimit(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.provisions.limit = 1
+
+	first := getTestServiceInstanceNamed("first")
+	second := getTestServiceInstanceNamed("second")
+	third := getTestServiceInstanceNamed("third")
+	for _, instance := range []*v1beta1.ServiceInstance{first, second, third} {
+		sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+		testController.acquireServiceInstanceProvision(instance)
+	}
+
+	testController.setServiceInstanceProvisionLimit(2)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued instances; %s", expectedGot(e, a))
+	}
+	if !testController.acquireServiceInstanceProvision(second) {
+		t.Fatal("Expected the second provision to be allowed once the limit is raised")
+	}
+	if testController.acquireServiceInstanceProvision(third) {
+		t.Fatal("Expected the third provision to keep waiting")
+	}
+
+	testController.setServiceInstanceProvisionLimit(0)
+	if !testController.acquireServiceInstanceProvision(third) {
+		t.Fatal("Expected the third provision to be allowed once there is no limit")
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+// Kinds of broker errors, as reported in metrics.
+const (
+	brokerErrorKindTerminal  = "terminal"
+	brokerErrorKindRetriable = "retriable"
+)
+
+// terminalError is a broker error after which the operation must not be
+// retried.
+type terminalError struct {
+	err error
+}
+
+func (e *terminalError) Error() string { return e.err.Error() }
+
+func (e *terminalError) Unwrap() error { return e.err }
+
+// retriableError is a broker error after which the operation may be retried.
+type retriableError struct {
+	err error
+}
+
+func (e *retriableError) Error() string { return e.err.Error() }
+
+func (e *retriableError) Unwrap() error { return e.err }
+
+// orphanMitigationRequiredError wraps a terminalError or a retriableError
+// after which the broker may hold a resource the controller does not know
+// about, so that orphan mitigation must be performed.
+type orphanMitigationRequiredError struct {
+	err error
+}
+
+func (e *orphanMitigationRequiredError) Error() string { return e.err.Error() }
+
+func (e *orphanMitigationRequiredError) Unwrap() error { return e.err }
+
+// Error codes of the HTTP 422 responses with which a broker rejects a request
+// it may accept later.
+const (
+	// brokerErrorConcurrency means that another operation on the same
+	// instance or binding is in progress.
+	brokerErrorConcurrency = "ConcurrencyError"
+	// brokerErrorAsyncRequired means that the broker only handles the
+	// request asynchronously.
+	brokerErrorAsyncRequired = "AsyncRequired"
+)
+
+// brokerBusyError wraps a retriableError with which a broker rejected a
+// request because of the state of the resource rather than the request
+// itself, so that the request should be sent again after a backoff.
+type brokerBusyError struct {
+	err error
+	// code is the error code of the response, brokerErrorConcurrency or
+	// brokerErrorAsyncRequired.
+	code string
+}
+
+func (e *brokerBusyError) Error() string { return e.err.Error() }
+
+func (e *brokerBusyError) Unwrap() error { return e.err }
+
+// brokerErrorMaintenanceInfoConflict is the error code of the HTTP 422
+// responses with which a broker rejects a provision or update request whose
+// maintenance_info does not match the one of the plan in its catalog.
+const brokerErrorMaintenanceInfoConflict = "MaintenanceInfoConflict"
+
+// maintenanceInfoConflictError wraps the terminalError with which a broker
+// rejected a request because of its maintenance_info, which sending again
+// cannot resolve.
+type maintenanceInfoConflictError struct {
+	err error
+}
+
+func (e *maintenanceInfoConflictError) Error() string { return e.err.Error() }
+
+func (e *maintenanceInfoConflictError) Unwrap() error { return e.err }
+
+// classifyBrokerError returns the given error returned by a broker for the
+// given operation wrapped in the error types above, and records it in the
+// broker error metrics:
+//
+//   - an HTTP 400 response is terminal, and any other failure is retriable;
+//   - an HTTP 422 response with the ConcurrencyError or AsyncRequired error
+//     code means that the broker is busy;
+//   - an HTTP 422 response with the MaintenanceInfoConflict error code is a
+//     terminal maintenance info conflict;
+//   - an HTTP response with a 2xx status other than 200 or a 5xx status, or
+//     a timeout, requires orphan mitigation.
+func classifyBrokerError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var classified error
+	if httpErr, ok := osb.IsHTTPError(err); ok {
+		if httpErr.StatusCode == http.StatusBadRequest {
+			classified = &terminalError{err: err}
+		} else {
+			classified = &retriableError{err: err}
+		}
+		if code, ok := brokerBusyErrorCode(httpErr); ok {
+			classified = &brokerBusyError{err: classified, code: code}
+		}
+		if isMaintenanceInfoConflictResponse(httpErr) {
+			classified = &maintenanceInfoConflictError{err: &terminalError{err: err}}
+		}
+		if shouldStartOrphanMitigation(httpErr.StatusCode) {
+			classified = &orphanMitigationRequiredError{err: classified}
+		}
+	} else {
+		classified = &retriableError{err: err}
+		if isTimeoutError(err) {
+			classified = &orphanMitigationRequiredError{err: classified}
+		}
+	}
+
+	kind := brokerErrorKindRetriable
+	if isTerminalError(classified) {
+		kind = brokerErrorKindTerminal
+	}
+	metrics.BrokerErrorCount.WithLabelValues(operation, kind, strconv.FormatBool(requiresOrphanMitigation(classified))).Inc()
+
+	return classified
+}
+
+// isTerminalError returns whether the operation that failed with the given
+// error must not be retried.
+func isTerminalError(err error) bool {
+	var terminalErr *terminalError
+	return errors.As(err, &terminalErr)
+}
+
+// isRetriableError returns whether the operation that failed with the given
+// error may be retried.
+func isRetriableError(err error) bool {
+	var retriableErr *retriableError
+	return errors.As(err, &retriableErr)
+}
+
+// requiresOrphanMitigation returns whether orphan mitigation must be
+// performed after an operation failed with the given error.
+func requiresOrphanMitigation(err error) bool {
+	var orphanErr *orphanMitigationRequiredError
+	return errors.As(err, &orphanErr)
+}
+
+// isBrokerBusyError returns the error code with which a broker rejected a
+// request because it is busy, and whether it did.
+func isBrokerBusyError(err error) (string, bool) {
+	var busyErr *brokerBusyError
+	if !errors.As(err, &busyErr) {
+		return "", false
+	}
+	return busyErr.code, true
+}
+
+// isMaintenanceInfoConflictError returns whether a broker rejected a request
+// because its maintenance_info conflicts with the catalog of the broker.
+func isMaintenanceInfoConflictError(err error) bool {
+	var conflictErr *maintenanceInfoConflictError
+	return errors.As(err, &conflictErr)
+}
+
+// isMaintenanceInfoConflictResponse returns whether an HTTP response is an
+// HTTP 422 response with the MaintenanceInfoConflict error code.
+func isMaintenanceInfoConflictResponse(httpErr *osb.HTTPStatusCodeError) bool {
+	return httpErr.StatusCode == http.StatusUnprocessableEntity && httpErr.ErrorMessage != nil &&
+		*httpErr.ErrorMessage == brokerErrorMaintenanceInfoConflict
+}
+
+// brokerBusyErrorCode returns the error code of an HTTP 422 response meaning
+// that the broker is busy, and whether the response means so.
+func brokerBusyErrorCode(httpErr *osb.HTTPStatusCodeError) (string, bool) {
+	if httpErr.StatusCode != http.StatusUnprocessableEntity || httpErr.ErrorMessage == nil {
+		return "", false
+	}
+	switch code := *httpErr.ErrorMessage; code {
+	case brokerErrorConcurrency, brokerErrorAsyncRequired:
+		return code, true
+	}
+	return "", false
+}
+
+// isTimeoutError returns whether the given error is a network timeout.
+func isTimeoutError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// shouldStartOrphanMitigation returns whether an error with the given status
+// code indicates that orphan migitation should start.
+func shouldStartOrphanMitigation(statusCode int) bool {
+	is2XX := statusCode >= 200 && statusCode < 300
+	is5XX := statusCode >= 500 && statusCode < 600
+
+	return (is2XX && statusCode != http.StatusOK) || is5XX
+}
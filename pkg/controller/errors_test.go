@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timed out" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyBrokerError(t *testing.T) {
+	cases := []struct {
+		name                     string
+		err                      error
+		terminal                 bool
+		requiresOrphanMitigation bool
+		busyCode                 string
+		maintenanceInfoConflict  bool
+	}{
+		{
+			name:     "bad request",
+			err:      osb.HTTPStatusCodeError{StatusCode: 400},
+			terminal: true,
+		},
+		{
+			name: "conflict",
+			err:  osb.HTTPStatusCodeError{StatusCode: 409},
+		},
+		{
+			name:     "concurrency error",
+			err:      osb.HTTPStatusCodeError{StatusCode: 422, ErrorMessage: strPtr("ConcurrencyError")},
+			busyCode: "ConcurrencyError",
+		},
+		{
+			name:     "async required",
+			err:      osb.HTTPStatusCodeError{StatusCode: 422, ErrorMessage: strPtr("AsyncRequired")},
+			busyCode: "AsyncRequired",
+		},
+		{
+			name:                    "maintenance info conflict",
+			err:                     osb.HTTPStatusCodeError{StatusCode: 422, ErrorMessage: strPtr("MaintenanceInfoConflict")},
+			terminal:                true,
+			maintenanceInfoConflict: true,
+		},
+		{
+			name: "other unprocessable entity",
+			err:  osb.HTTPStatusCodeError{StatusCode: 422, ErrorMessage: strPtr("RequiresApp")},
+		},
+		{
+			name:                     "created",
+			err:                      osb.HTTPStatusCodeError{StatusCode: 201},
+			requiresOrphanMitigation: true,
+		},
+		{
+			name:                     "internal server error",
+			err:                      osb.HTTPStatusCodeError{StatusCode: 500},
+			requiresOrphanMitigation: true,
+		},
+		{
+			name:                     "timeout",
+			err:                      &url.Error{Op: "Put", URL: "https://broker", Err: timeoutError{}},
+			requiresOrphanMitigation: true,
+		},
+		{
+			name: "other error",
+			err:  fmt.Errorf("connection refused"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := classifyBrokerError("provision", tc.err)
+			if e, a := tc.terminal, isTerminalError(err); e != a {
+				t.Errorf("Unexpected terminal classification; %s", expectedGot(e, a))
+			}
+			if e, a := !tc.terminal, isRetriableError(err); e != a {
+				t.Errorf("Unexpected retriable classification; %s", expectedGot(e, a))
+			}
+			if e, a := tc.requiresOrphanMitigation, requiresOrphanMitigation(err); e != a {
+				t.Errorf("Unexpected orphan mitigation classification; %s", expectedGot(e, a))
+			}
+			code, busy := isBrokerBusyError(err)
+			if e, a := tc.busyCode != "", busy; e != a {
+				t.Errorf("Unexpected busy classification; %s", expectedGot(e, a))
+			}
+			if e, a := tc.busyCode, code; e != a {
+				t.Errorf("Unexpected busy error code; %s", expectedGot(e, a))
+			}
+			if e, a := tc.maintenanceInfoConflict, isMaintenanceInfoConflictError(err); e != a {
+				t.Errorf("Unexpected maintenance info conflict classification; %s", expectedGot(e, a))
+			}
+			if e, a := tc.err.Error(), err.Error(); e != a {
+				t.Errorf("Unexpected error message; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+func TestClassifyBrokerErrorNil(t *testing.T) {
+	if err := classifyBrokerError("provision", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
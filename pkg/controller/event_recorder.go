@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// correlatingEventRecorder is an EventRecorder that appends to the message of
+// every ServiceInstance and ServiceBinding event the identifiers needed to
+// correlate the event with the broker's logs: the UID and external ID of the
+// object and the key of the broker operation in progress, if any. The
+// correlation is appended so that messages keep their existing prefix.
+type correlatingEventRecorder struct {
+	record.EventRecorder
+}
+
+// newCorrelatingEventRecorder returns a correlatingEventRecorder that records
+// events with recorder.
+func newCorrelatingEventRecorder(recorder record.EventRecorder) record.EventRecorder {
+	return &correlatingEventRecorder{EventRecorder: recorder}
+}
+
+func (r *correlatingEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(object, eventtype, reason, message+eventCorrelation(object))
+}
+
+func (r *correlatingEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *correlatingEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...) + eventCorrelation(object)
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+}
+
+// eventCorrelationPrefix starts the correlation appended to event messages.
+const eventCorrelationPrefix = " (UID: "
+
+// eventCorrelation returns the correlation to append to the message of an
+// event about object, in the form
+// ` (UID: "<UID>" ExternalID: "<ExternalID>" OperationKey: "<OperationKey>")`,
+// leaving out the empty identifiers. It is empty for other kinds of objects.
+func eventCorrelation(object runtime.Object) string {
+	var uid types.UID
+	var externalID string
+	var operationKey *string
+	switch o := object.(type) {
+	case *v1beta1.ServiceInstance:
+		uid, externalID, operationKey = o.UID, o.Spec.ExternalID, o.Status.LastOperation
+	case *v1beta1.ServiceBinding:
+		uid, externalID, operationKey = o.UID, o.Spec.ExternalID, o.Status.LastOperation
+	default:
+		return ""
+	}
+
+	fields := []string{fmt.Sprintf("%q", uid)}
+	if externalID != "" {
+		fields = append(fields, fmt.Sprintf("ExternalID: %q", externalID))
+	}
+	if operationKey != nil && *operationKey != "" {
+		fields = append(fields, fmt.Sprintf("OperationKey: %q", *operationKey))
+	}
+	return eventCorrelationPrefix + strings.Join(fields, " ") + ")"
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestCorrelatingEventRecorder(t *testing.T) {
+	instance := getTestServiceInstance()
+	instance.UID = "instance-uid"
+
+	asyncInstance := instance.DeepCopy()
+	asyncInstance.Status.LastOperation = strPtr("op-key")
+
+	binding := getTestServiceBinding()
+	binding.UID = "binding-uid"
+
+	cases := []struct {
+		name     string
+		object   runtime.Object
+		expected string
+	}{
+		{
+			name:     "instance",
+			object:   instance,
+			expected: `Normal Reason message (UID: "instance-uid" ExternalID: "` + testServiceInstanceGUID + `")`,
+		},
+		{
+			name:     "instance with operation in progress",
+			object:   asyncInstance,
+			expected: `Normal Reason message (UID: "instance-uid" ExternalID: "` + testServiceInstanceGUID + `" OperationKey: "op-key")`,
+		},
+		{
+			name:     "binding",
+			object:   binding,
+			expected: `Normal Reason message (UID: "binding-uid" ExternalID: "` + testServiceBindingGUID + `")`,
+		},
+		{
+			name:     "broker",
+			object:   getTestClusterServiceBroker(),
+			expected: `Normal Reason message`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeRecorder := record.NewFakeRecorder(1)
+			recorder := newCorrelatingEventRecorder(fakeRecorder)
+
+			recorder.Eventf(tc.object, corev1.EventTypeNormal, "Reason", "%s", "message")
+
+			if e, a := tc.expected, <-fakeRecorder.Events; e != a {
+				t.Fatalf("Unexpected event; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+)
+
+const (
+	namespaceCacheHit  = "hit"
+	namespaceCacheMiss = "miss"
+)
+
+// namespaceCache looks up the namespaces that instances and bindings live
+// in, so that building the context sent to brokers on provision, update and
+// bind does not cost an API round-trip every time. Namespaces are served
+// from the namespace informer, which keeps them current as they are updated
+// or deleted; the API server is only asked for namespaces the informer has
+// not seen yet.
+type namespaceCache struct {
+	lister     corelisters.NamespaceLister
+	kubeClient kubernetes.Interface
+}
+
+// newNamespaceCache creates a namespaceCache that reads namespaces from the
+// given lister and fetches the ones it is missing with the given client.
+func newNamespaceCache(lister corelisters.NamespaceLister, kubeClient kubernetes.Interface) *namespaceCache {
+	return &namespaceCache{
+		lister:     lister,
+		kubeClient: kubeClient,
+	}
+}
+
+// Get returns the namespace with the given name, fetching it from the API
+// server if the informer does not have it. The returned object must not be
+// modified.
+func (nc *namespaceCache) Get(name string) (*corev1.Namespace, error) {
+	if ns, err := nc.lister.Get(name); err == nil {
+		metrics.NamespaceCacheRequestCount.WithLabelValues(namespaceCacheHit).Inc()
+		return ns, nil
+	}
+
+	metrics.NamespaceCacheRequestCount.WithLabelValues(namespaceCacheMiss).Inc()
+	return nc.kubeClient.CoreV1().Namespaces().Get(context.Background(), name, metav1.GetOptions{})
+}
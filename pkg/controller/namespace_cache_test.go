@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNamespaceCache(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: testNamespace, UID: testNamespaceGUID},
+	}
+	fakeKubeClient := clientgofake.NewSimpleClientset(namespace)
+	namespaceInformer := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Namespaces()
+	nc := newNamespaceCache(namespaceInformer.Lister(), fakeKubeClient)
+
+	// The informer has not seen the namespace yet, so it is fetched.
+	ns, err := nc.Get(testNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := namespace.UID, ns.UID; e != a {
+		t.Fatalf("unexpected namespace UID: expected %v, got %v", e, a)
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 1)
+
+	namespaceInformer.Informer().GetStore().Add(namespace)
+	for i := 0; i < 2; i++ {
+		ns, err := nc.Get(testNamespace)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if e, a := namespace.UID, ns.UID; e != a {
+			t.Fatalf("unexpected namespace UID: expected %v, got %v", e, a)
+		}
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 1)
+
+	namespaceInformer.Informer().GetStore().Delete(namespace)
+	if _, err := nc.Get(testNamespace); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 2)
+}
+
+func TestNamespaceCacheNotFound(t *testing.T) {
+	fakeKubeClient := clientgofake.NewSimpleClientset()
+	namespaceInformer := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Namespaces()
+	nc := newNamespaceCache(namespaceInformer.Lister(), fakeKubeClient)
+
+	if _, err := nc.Get(testNamespace); err == nil {
+		t.Fatal("expected an error for a missing namespace")
+	}
+}
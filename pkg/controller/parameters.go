@@ -18,16 +18,18 @@ package controller
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/paramplugin"
+	scparameters "github.com/drycc-addons/service-catalog/pkg/util/parameters"
 	"github.com/peterbourgon/mergemap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/kubernetes"
-	"sigs.k8s.io/yaml"
 )
 
 // buildParameters generates the parameters JSON structure to be passed
@@ -37,12 +39,12 @@ import (
 // The second return value is a map of parameters with secret values redacted,
 // replaced with "<redacted>".
 // The third return value is any error that caused the function to fail.
-func buildParameters(kubeClient kubernetes.Interface, namespace string, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension) (map[string]interface{}, map[string]interface{}, error) {
+func buildParameters(kubeClient kubernetes.Interface, plugins paramplugin.Registry, namespace string, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension) (map[string]interface{}, map[string]interface{}, error) {
 	params := make(map[string]interface{})
 	paramsWithSecretsRedacted := make(map[string]interface{})
 	if parametersFrom != nil {
 		for _, p := range parametersFrom {
-			fps, err := fetchParametersFromSource(kubeClient, namespace, &p)
+			fps, err := fetchParametersFromSource(kubeClient, plugins, namespace, &p)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -81,7 +83,7 @@ func buildParameters(kubeClient kubernetes.Interface, namespace string, paramete
 
 // fetchParametersFromSource fetches data from a specified external source and
 // represents it in the parameters map format
-func fetchParametersFromSource(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
+func fetchParametersFromSource(kubeClient kubernetes.Interface, plugins paramplugin.Registry, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
 	var params map[string]interface{}
 	if parametersFrom.SecretKeyRef != nil {
 		data, err := fetchSecretKeyValue(kubeClient, namespace, parametersFrom.SecretKeyRef)
@@ -94,19 +96,28 @@ func fetchParametersFromSource(kubeClient kubernetes.Interface, namespace string
 		}
 		params = p
 
+	} else if parametersFrom.PluginRef != nil {
+		if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ParametersPlugins) || plugins == nil {
+			return nil, fmt.Errorf("parameters plugin %q cannot be used, parameters plugins are not enabled", parametersFrom.PluginRef.Name)
+		}
+		p, err := plugins.Fetch(parametersFrom.PluginRef.Name, paramplugin.Request{
+			Namespace: namespace,
+			Key:       parametersFrom.PluginRef.Key,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params = p
+	}
+	if err := scparameters.Coerce(params, parametersFrom.Coerce); err != nil {
+		return nil, err
 	}
 	return params, nil
 }
 
 // UnmarshalRawParameters produces a map structure from a given raw YAML/JSON input
 func UnmarshalRawParameters(in []byte) (map[string]interface{}, error) {
-	parameters := make(map[string]interface{})
-	if len(in) > 0 {
-		if err := yaml.Unmarshal(in, &parameters); err != nil {
-			return parameters, err
-		}
-	}
-	return parameters, nil
+	return scparameters.Unmarshal(in)
 }
 
 // MarshalRawParameters marshals the specified map of parameters into JSON
@@ -138,15 +149,7 @@ func fetchSecretKeyValue(kubeClient kubernetes.Interface, namespace string, secr
 // generateChecksumOfParameters generates a checksum for the map of parameters.
 // This checksum is used to determine if parameters have changed.
 func generateChecksumOfParameters(params map[string]interface{}) (string, error) {
-	if params == nil || len(params) == 0 {
-		return "", nil
-	}
-	paramsAsJSON, err := json.Marshal(params)
-	if err != nil {
-		return "", err
-	}
-	hash := sha256.Sum256(paramsAsJSON)
-	return fmt.Sprintf("%x", hash), nil
+	return scparameters.Checksum(params)
 }
 
 // prepareInProgressPropertyParameters generates the required parameters for setting
@@ -156,8 +159,8 @@ func generateChecksumOfParameters(params map[string]interface{}) (string, error)
 // 2 - a checksum for the map of parameters. This checksum is used to determine if parameters have changed.
 // 3 - the map of parameters marshaled into JSON as a RawExtension
 // 4 - any error that caused the function to fail.
-func prepareInProgressPropertyParameters(kubeClient kubernetes.Interface, namespace string, specParameters *runtime.RawExtension, specParametersFrom []v1beta1.ParametersFromSource) (map[string]interface{}, string, *runtime.RawExtension, error) {
-	parameters, parametersWithSecretsRedacted, err := buildParameters(kubeClient, namespace, specParametersFrom, specParameters)
+func prepareInProgressPropertyParameters(kubeClient kubernetes.Interface, plugins paramplugin.Registry, namespace string, specParameters *runtime.RawExtension, specParametersFrom []v1beta1.ParametersFromSource) (map[string]interface{}, string, *runtime.RawExtension, error) {
+	parameters, parametersWithSecretsRedacted, err := buildParameters(kubeClient, plugins, namespace, specParametersFrom, specParameters)
 	if err != nil {
 		return nil, "", nil, fmt.Errorf(
 			"failed to prepare parameters %s: %s",
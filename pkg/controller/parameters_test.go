@@ -17,13 +17,17 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/paramplugin"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	clientgofake "k8s.io/client-go/kubernetes/fake"
 )
 
@@ -32,6 +36,7 @@ func TestBuildParameters(t *testing.T) {
 		Data: map[string][]byte{
 			"json-key":   []byte("{ \"json\": true }"),
 			"string-key": []byte("textFromSecret"),
+			"port-key":   []byte(`{ "port": "5432", "tls": "true" }`),
 		},
 	}
 
@@ -98,6 +103,47 @@ func TestBuildParameters(t *testing.T) {
 			secret:        secret,
 			shouldSucceed: false,
 		},
+		{
+			name: "parametersFrom: secretKey with coerced values",
+			parametersFrom: []v1beta1.ParametersFromSource{
+				{
+					SecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "port-key",
+					},
+					Coerce: map[string]v1beta1.ParameterType{
+						"port": v1beta1.ParameterTypeInteger,
+						"tls":  v1beta1.ParameterTypeBoolean,
+					},
+				},
+			},
+			secret: secret,
+			expectedParameters: map[string]interface{}{
+				"port": int64(5432),
+				"tls":  true,
+			},
+			expectedParametersWithSecretsRedacted: map[string]interface{}{
+				"port": "<redacted>",
+				"tls":  "<redacted>",
+			},
+			shouldSucceed: true,
+		},
+		{
+			name: "parametersFrom: secretKey with value that cannot be coerced",
+			parametersFrom: []v1beta1.ParametersFromSource{
+				{
+					SecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "port-key",
+					},
+					Coerce: map[string]v1beta1.ParameterType{
+						"tls": v1beta1.ParameterTypeInteger,
+					},
+				},
+			},
+			secret:        secret,
+			shouldSucceed: false,
+		},
 		{
 			name: "parametersFrom + parameters: normal",
 			parametersFrom: []v1beta1.ParametersFromSource{
@@ -147,6 +193,84 @@ func TestBuildParameters(t *testing.T) {
 	}
 }
 
+// fakeParametersPlugins is a paramplugin.Registry serving parameters from
+// memory, keyed by plugin name and then by key.
+type fakeParametersPlugins map[string]map[string]map[string]interface{}
+
+func (f fakeParametersPlugins) Fetch(name string, req paramplugin.Request) (map[string]interface{}, error) {
+	params, ok := f[name][req.Key]
+	if !ok {
+		return nil, fmt.Errorf("no parameters for %q in plugin %q", req.Key, name)
+	}
+	return params, nil
+}
+
+func TestBuildParametersFromPlugin(t *testing.T) {
+	plugins := fakeParametersPlugins{
+		"vault": {
+			"db": {"password": "s3cr3t"},
+		},
+	}
+	parametersFrom := []v1beta1.ParametersFromSource{
+		{PluginRef: &v1beta1.ParametersPluginReference{Name: "vault", Key: "db"}},
+	}
+	parameters := &runtime.RawExtension{Raw: []byte(`{ "p1": "v1" }`)}
+
+	cases := []struct {
+		name           string
+		enabled        bool
+		parametersFrom []v1beta1.ParametersFromSource
+		shouldSucceed  bool
+	}{
+		{
+			name:           "plugin parameters are merged and redacted",
+			enabled:        true,
+			parametersFrom: parametersFrom,
+			shouldSucceed:  true,
+		},
+		{
+			name:    "unknown key",
+			enabled: true,
+			parametersFrom: []v1beta1.ParametersFromSource{
+				{PluginRef: &v1beta1.ParametersPluginReference{Name: "vault", Key: "missing"}},
+			},
+		},
+		{
+			name:           "feature disabled",
+			enabled:        false,
+			parametersFrom: parametersFrom,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.ParametersPlugins, tc.enabled)); err != nil {
+				t.Fatalf("Failed to set ParametersPlugins feature: %v", err)
+			}
+			defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ParametersPlugins))
+
+			actual, actualWithSecretsRedacted, err := buildParameters(&clientgofake.Clientset{}, plugins, "test-ns", tc.parametersFrom, parameters)
+			if !tc.shouldSucceed {
+				if err == nil {
+					t.Fatal("Expected error, but got success")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to build parameters: %v", err)
+			}
+			expected := map[string]interface{}{"p1": "v1", "password": "s3cr3t"}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Fatalf("incorrect result: diff \n%v", diff.ObjectGoPrintSideBySide(expected, actual))
+			}
+			expectedWithSecretsRedacted := map[string]interface{}{"p1": "v1", "password": "<redacted>"}
+			if !reflect.DeepEqual(actualWithSecretsRedacted, expectedWithSecretsRedacted) {
+				t.Fatalf("incorrect result with redacted secrets: diff \n%v", diff.ObjectGoPrintSideBySide(expectedWithSecretsRedacted, actualWithSecretsRedacted))
+			}
+		})
+	}
+}
+
 func testBuildParameters(t *testing.T, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension, secret *corev1.Secret, expected map[string]interface{}, expectedWithSecretsRdacted map[string]interface{}, shouldSucceed bool) {
 	// create a fake kube client
 	fakeKubeClient := &clientgofake.Clientset{}
@@ -156,7 +280,7 @@ func testBuildParameters(t *testing.T, parametersFrom []v1beta1.ParametersFromSo
 		addGetSecretNotFoundReaction(fakeKubeClient)
 	}
 
-	actual, actualWithSecretsRedacted, err := buildParameters(fakeKubeClient, "test-ns", parametersFrom, parameters)
+	actual, actualWithSecretsRedacted, err := buildParameters(fakeKubeClient, nil, "test-ns", parametersFrom, parameters)
 	if shouldSucceed {
 		if err != nil {
 			t.Fatalf("Failed to build parameters: %v", err)
@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay replays the reconciliation of a ServiceInstance against fake
+// clients and a fake broker, so that the manifests users submit with their
+// issues can be triaged offline.
+package replay
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/yaml"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalogfake "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	servicecataloginformers "github.com/drycc-addons/service-catalog/pkg/client/informers_generated/externalversions"
+	"github.com/drycc-addons/service-catalog/pkg/controller"
+)
+
+const (
+	// clusterID is the cluster ID presented to the fake broker of a
+	// replay, so that its output does not depend on the cluster.
+	clusterID = "replay-cluster-id"
+
+	// namespaceUID is the UID of the namespace created for the instance of
+	// a replay, which brokers receive as its space GUID.
+	namespaceUID = "replay-namespace-uid"
+
+	// eventBufferSize is the number of events of a replay that are
+	// kept.
+	eventBufferSize = 100
+)
+
+// BrokerFixture holds the responses of the fake broker that a replay
+// runs against. The responses are those of the OSB API. A request the
+// fixture has neither a response nor an error for fails as an unexpected
+// broker action.
+type BrokerFixture struct {
+	Provision      *osb.ProvisionResponse      `json:"provision,omitempty"`
+	UpdateInstance *osb.UpdateInstanceResponse `json:"updateInstance,omitempty"`
+	Deprovision    *osb.DeprovisionResponse    `json:"deprovision,omitempty"`
+	LastOperation  *osb.LastOperationResponse  `json:"lastOperation,omitempty"`
+	// Errors holds the errors the broker returns instead of a response, by
+	// request: provision, updateInstance, deprovision or lastOperation.
+	Errors map[string]BrokerError `json:"errors,omitempty"`
+}
+
+// BrokerError is an error response of the fake broker of a replay.
+type BrokerError struct {
+	StatusCode   int     `json:"statusCode"`
+	ErrorMessage *string `json:"error,omitempty"`
+	Description  *string `json:"description,omitempty"`
+}
+
+// brokerError returns the error of the fixture for request, or nil.
+func (f *BrokerFixture) brokerError(request string) error {
+	e, ok := f.Errors[request]
+	if !ok {
+		return nil
+	}
+	return osb.HTTPStatusCodeError{
+		StatusCode:   e.StatusCode,
+		ErrorMessage: e.ErrorMessage,
+		Description:  e.Description,
+	}
+}
+
+// fakeClientConfiguration returns the configuration of a fake broker that
+// responds as the fixture says.
+func (f *BrokerFixture) fakeClientConfiguration() fakeosb.FakeClientConfiguration {
+	config := fakeosb.FakeClientConfiguration{}
+	if f.Provision != nil || f.brokerError("provision") != nil {
+		config.ProvisionReaction = &fakeosb.ProvisionReaction{Response: f.Provision, Error: f.brokerError("provision")}
+	}
+	if f.UpdateInstance != nil || f.brokerError("updateInstance") != nil {
+		config.UpdateInstanceReaction = &fakeosb.UpdateInstanceReaction{Response: f.UpdateInstance, Error: f.brokerError("updateInstance")}
+	}
+	if f.Deprovision != nil || f.brokerError("deprovision") != nil {
+		config.DeprovisionReaction = &fakeosb.DeprovisionReaction{Response: f.Deprovision, Error: f.brokerError("deprovision")}
+	}
+	if f.LastOperation != nil || f.brokerError("lastOperation") != nil {
+		config.PollLastOperationReaction = &fakeosb.PollLastOperationReaction{Response: f.LastOperation, Error: f.brokerError("lastOperation")}
+	}
+	return config
+}
+
+// ServiceInstance runs one reconciliation of the first ServiceInstance
+// of objects against fake clients seeded with objects and a fake broker
+// responding as fixture says, without an API server, and writes to out the
+// requests it sent to the broker, the actions it took on the APIs, the
+// events it recorded and its result.
+//
+// The Service Catalog resources of objects seed the catalog client and the
+// listers of the controller; the others seed the Kubernetes client. The
+// namespace of the instance is created if objects do not hold it.
+func ServiceInstance(objects []runtime.Object, fixture *BrokerFixture, out io.Writer) error {
+	var instance *v1beta1.ServiceInstance
+	var catalogObjects, kubeObjects []runtime.Object
+	hasNamespace := map[string]bool{}
+	for _, obj := range objects {
+		switch obj := obj.(type) {
+		case *v1beta1.ServiceInstance:
+			if instance == nil {
+				instance = obj
+			}
+			catalogObjects = append(catalogObjects, obj)
+		case *v1beta1.ClusterServiceBroker, *v1beta1.ServiceBroker,
+			*v1beta1.ClusterServiceClass, *v1beta1.ServiceClass,
+			*v1beta1.ClusterServicePlan, *v1beta1.ServicePlan,
+			*v1beta1.ServiceBinding:
+			catalogObjects = append(catalogObjects, obj)
+		case *corev1.Namespace:
+			hasNamespace[obj.Name] = true
+			kubeObjects = append(kubeObjects, obj)
+		default:
+			kubeObjects = append(kubeObjects, obj)
+		}
+	}
+	if instance == nil {
+		return fmt.Errorf("no ServiceInstance to replay")
+	}
+	if !hasNamespace[instance.Namespace] {
+		kubeObjects = append(kubeObjects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: instance.Namespace, UID: namespaceUID}})
+	}
+	if fixture == nil {
+		fixture = &BrokerFixture{}
+	}
+
+	kubeClient := clientgofake.NewSimpleClientset(kubeObjects...)
+	catalogClient := servicecatalogfake.NewSimpleClientset(catalogObjects...)
+	fakeBroker := fakeosb.NewFakeClient(fixture.fakeClientConfiguration())
+	recorder := record.NewFakeRecorder(eventBufferSize)
+
+	informerFactory := servicecataloginformers.NewSharedInformerFactory(catalogClient, 0)
+	informers := informerFactory.Servicecatalog().V1beta1()
+	c, err := controller.NewController(
+		kubeClient,
+		catalogClient.ServicecatalogV1beta1(),
+		informers.ClusterServiceBrokers(),
+		informers.ServiceBrokers(),
+		informers.ClusterServiceClasses(),
+		informers.ServiceClasses(),
+		informers.ServiceInstances(),
+		informers.ServiceBindings(),
+		informers.ClusterServicePlans(),
+		informers.ServicePlans(),
+		kubeinformers.NewSharedInformerFactory(kubeClient, 0).Core().V1().Namespaces(),
+		nil,
+		fakeosb.ReturnFakeClientFunc(fakeBroker),
+		24*time.Hour,
+		0,
+		0,
+		0,
+		0,
+		osb.LatestAPIVersion().HeaderValue(),
+		recorder,
+		7*24*time.Hour,
+		0,
+		7*24*time.Hour,
+		controller.DefaultClusterIDConfigMapName,
+		controller.DefaultClusterIDConfigMapNamespace,
+		60*time.Second,
+		30*time.Second,
+		nil,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	reconciler, ok := c.(controller.InstanceReconciler)
+	if !ok {
+		return fmt.Errorf("the controller cannot reconcile a ServiceInstance on its own")
+	}
+	reconciler.SetClusterID(clusterID)
+
+	// The informers are not started: the listers are filled directly, as
+	// the objects would have been observed.
+	for _, obj := range catalogObjects {
+		var store interface{ Add(interface{}) error }
+		switch obj := obj.(type) {
+		case *v1beta1.ClusterServiceBroker:
+			store = informers.ClusterServiceBrokers().Informer().GetStore()
+			if _, err := c.BrokerClientManager().UpdateBrokerClient(controller.NewClusterServiceBrokerKey(obj.Name), &osb.ClientConfiguration{Name: obj.Name, URL: obj.Spec.URL}, nil, nil); err != nil {
+				return err
+			}
+		case *v1beta1.ServiceBroker:
+			store = informers.ServiceBrokers().Informer().GetStore()
+			if _, err := c.BrokerClientManager().UpdateBrokerClient(controller.NewServiceBrokerKey(obj.Namespace, obj.Name), &osb.ClientConfiguration{Name: obj.Name, URL: obj.Spec.URL}, nil, nil); err != nil {
+				return err
+			}
+		case *v1beta1.ClusterServiceClass:
+			store = informers.ClusterServiceClasses().Informer().GetStore()
+		case *v1beta1.ServiceClass:
+			store = informers.ServiceClasses().Informer().GetStore()
+		case *v1beta1.ClusterServicePlan:
+			store = informers.ClusterServicePlans().Informer().GetStore()
+		case *v1beta1.ServicePlan:
+			store = informers.ServicePlans().Informer().GetStore()
+		case *v1beta1.ServiceInstance:
+			store = informers.ServiceInstances().Informer().GetStore()
+		case *v1beta1.ServiceBinding:
+			store = informers.ServiceBindings().Informer().GetStore()
+		}
+		if err := store.Add(obj); err != nil {
+			return err
+		}
+	}
+	kubeClient.ClearActions()
+	catalogClient.ClearActions()
+
+	fmt.Fprintf(out, "Reconciling ServiceInstance %s/%s\n", instance.Namespace, instance.Name)
+	reconcileErr := reconciler.ReconcileServiceInstance(instance.DeepCopy())
+
+	fmt.Fprintln(out, "\nBroker requests:")
+	for _, action := range fakeBroker.Actions() {
+		request, err := yaml.Marshal(action.Request)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "- %s\n%s", action.Type, indentOutput(string(request)))
+	}
+	fmt.Fprintln(out, "\nCatalog API actions:")
+	writeActions(out, catalogClient.Actions())
+	fmt.Fprintln(out, "\nKubernetes API actions:")
+	writeActions(out, kubeClient.Actions())
+	fmt.Fprintln(out, "\nEvents:")
+	for len(recorder.Events) > 0 {
+		fmt.Fprintf(out, "- %s\n", <-recorder.Events)
+	}
+
+	if reconcileErr != nil {
+		fmt.Fprintf(out, "\nResult: error: %v\n", reconcileErr)
+	} else {
+		fmt.Fprintln(out, "\nResult: success")
+	}
+	return nil
+}
+
+// writeActions writes the API actions of a replay, along with the
+// conditions of the ServiceInstances it created or updated.
+func writeActions(out io.Writer, actions []clientgotesting.Action) {
+	for _, action := range actions {
+		resource := action.GetResource().Resource
+		if action.GetSubresource() != "" {
+			resource += "/" + action.GetSubresource()
+		}
+		var name string
+		var instance *v1beta1.ServiceInstance
+		// Updates are create actions as well, and deletes and patches get
+		// actions.
+		switch action := action.(type) {
+		case clientgotesting.GetAction:
+			name = action.GetName()
+		case clientgotesting.CreateAction:
+			if obj, ok := action.GetObject().(metav1.Object); ok {
+				name = obj.GetName()
+			}
+			instance, _ = action.GetObject().(*v1beta1.ServiceInstance)
+		}
+		if action.GetNamespace() != "" {
+			name = action.GetNamespace() + "/" + name
+		}
+		fmt.Fprintf(out, "- %s %s %s\n", action.GetVerb(), resource, name)
+		if instance == nil {
+			continue
+		}
+		for _, condition := range instance.Status.Conditions {
+			fmt.Fprintf(out, "    %s=%s %s: %s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+		}
+	}
+}
+
+// indentOutput indents each line of s under the item of a list.
+func indentOutput(s string) string {
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "    " + line
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
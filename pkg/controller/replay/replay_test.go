@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
+	fakeosb "github.com/drycc-addons/go-open-service-broker-client/v2/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	testClusterServiceBrokerName = "test-clusterservicebroker"
+	testClusterServiceClassGUID  = "cscguid"
+	testClusterServiceClassName  = "test-clusterserviceclass"
+	testClusterServicePlanGUID   = "cspguid"
+	testClusterServicePlanName   = "test-clusterserviceplan"
+	testServiceInstanceGUID      = "iguid"
+	testServiceInstanceName      = "test-instance"
+	testNamespace                = "test-ns"
+	testOperation                = "test-operation"
+)
+
+func getTestClusterServiceBroker() *v1beta1.ClusterServiceBroker {
+	return &v1beta1.ClusterServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{Name: testClusterServiceBrokerName},
+		Spec: v1beta1.ClusterServiceBrokerSpec{
+			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{
+				URL: "https://example.com",
+			},
+		},
+	}
+}
+
+func getTestClusterServiceClass() *v1beta1.ClusterServiceClass {
+	return &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: testClusterServiceClassGUID},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			ClusterServiceBrokerName: testClusterServiceBrokerName,
+			CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+				ExternalName: testClusterServiceClassName,
+				ExternalID:   testClusterServiceClassGUID,
+				Bindable:     true,
+			},
+		},
+	}
+}
+
+func getTestClusterServicePlan() *v1beta1.ClusterServicePlan {
+	return &v1beta1.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: testClusterServicePlanGUID},
+		Spec: v1beta1.ClusterServicePlanSpec{
+			ClusterServiceBrokerName: testClusterServiceBrokerName,
+			CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+				ExternalID:   testClusterServicePlanGUID,
+				ExternalName: testClusterServicePlanName,
+			},
+			ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: testClusterServiceClassGUID},
+		},
+	}
+}
+
+// getTestServiceInstanceAsyncProvisioning returns an instance of the plan of
+// getTestClusterServicePlan being provisioned, with operation as its last
+// operation if it is not empty.
+func getTestServiceInstanceAsyncProvisioning(operation string) *v1beta1.ServiceInstance {
+	operationStartTime := metav1.NewTime(time.Now().Add(-1 * time.Hour))
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testServiceInstanceName,
+			Namespace:  testNamespace,
+			Generation: 1,
+		},
+		Spec: v1beta1.ServiceInstanceSpec{
+			PlanReference: v1beta1.PlanReference{
+				ClusterServiceClassExternalName: testClusterServiceClassName,
+				ClusterServicePlanExternalName:  testClusterServicePlanName,
+			},
+			ClusterServiceClassRef: &v1beta1.ClusterObjectReference{Name: testClusterServiceClassGUID},
+			ClusterServicePlanRef:  &v1beta1.ClusterObjectReference{Name: testClusterServicePlanGUID},
+			ExternalID:             testServiceInstanceGUID,
+		},
+		Status: v1beta1.ServiceInstanceStatus{
+			Conditions: []v1beta1.ServiceInstanceCondition{{
+				Type:               v1beta1.ServiceInstanceConditionReady,
+				Status:             v1beta1.ConditionFalse,
+				Message:            "Provisioning",
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+			}},
+			AsyncOpInProgress:  true,
+			OperationStartTime: &operationStartTime,
+			CurrentOperation:   v1beta1.ServiceInstanceOperationProvision,
+			InProgressProperties: &v1beta1.ServiceInstancePropertiesState{
+				ClusterServicePlanExternalName: testClusterServicePlanName,
+				ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+			},
+			ObservedGeneration: 1,
+			DeprovisionStatus:  v1beta1.ServiceInstanceDeprovisionStatusRequired,
+		},
+	}
+	if operation != "" {
+		instance.Status.LastOperation = &operation
+	}
+	return instance
+}
+
+// TestServiceInstancePoll tests that a replay polls the last operation
+// of an instance being provisioned and records its status.
+func TestServiceInstancePoll(t *testing.T) {
+	objects := []runtime.Object{
+		getTestClusterServiceBroker(),
+		getTestClusterServiceClass(),
+		getTestClusterServicePlan(),
+		getTestServiceInstanceAsyncProvisioning(testOperation),
+	}
+	fixture := &BrokerFixture{
+		LastOperation: &osb.LastOperationResponse{State: osb.StateSucceeded},
+	}
+
+	out := &bytes.Buffer{}
+	if err := ServiceInstance(objects, fixture, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, e := range []string{
+		"- " + string(fakeosb.PollLastOperation),
+		"- update serviceinstances/status " + testNamespace + "/" + testServiceInstanceName,
+		"Ready=True",
+		"Result: success",
+	} {
+		if !strings.Contains(out.String(), e) {
+			t.Fatalf("Expected the output to contain %q, got:\n%s", e, out.String())
+		}
+	}
+}
+
+// TestServiceInstanceBrokerError tests that a replay reports the errors
+// of the broker fixture.
+func TestServiceInstanceBrokerError(t *testing.T) {
+	instance := getTestServiceInstanceAsyncProvisioning("")
+	instance.Status.AsyncOpInProgress = false
+	objects := []runtime.Object{
+		getTestClusterServiceBroker(),
+		getTestClusterServiceClass(),
+		getTestClusterServicePlan(),
+		instance,
+	}
+	description := "the broker is down"
+	fixture := &BrokerFixture{
+		Errors: map[string]BrokerError{
+			"provision": {StatusCode: http.StatusInternalServerError, Description: &description},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	if err := ServiceInstance(objects, fixture, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, e := range []string{
+		"- " + string(fakeosb.ProvisionInstance),
+		testServiceInstanceGUID,
+		description,
+	} {
+		if !strings.Contains(out.String(), e) {
+			t.Fatalf("Expected the output to contain %q, got:\n%s", e, out.String())
+		}
+	}
+}
+
+// TestServiceInstanceWithoutInstance tests that a replay requires a
+// ServiceInstance.
+func TestServiceInstanceWithoutInstance(t *testing.T) {
+	err := ServiceInstance([]runtime.Object{getTestClusterServiceBroker()}, nil, &bytes.Buffer{})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+}
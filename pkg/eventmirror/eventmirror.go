@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventmirror mirrors the Warning events of the controller into a
+// central namespace, so that platform teams can alert on the events of all
+// the catalog resources of a cluster from a single namespace.
+//
+// An event must be in the namespace of the object it is about, so a mirrored
+// event is about an object of the same kind and name in the central
+// namespace. Its related object is the object the event is actually about,
+// whose namespace also labels the event.
+package eventmirror
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// SourceNamespaceLabel labels a mirrored event with the namespace of the
+// object it is about.
+const SourceNamespaceLabel = "servicecatalog.k8s.io/source-namespace"
+
+// Sink writes events to the central namespace instead of the namespace of
+// the object they are about.
+type Sink struct {
+	record.EventSink
+	// Namespace is the central namespace.
+	Namespace string
+}
+
+var _ record.EventSink = &Sink{}
+
+// Create creates the mirror of event in the central namespace.
+func (s *Sink) Create(event *corev1.Event) (*corev1.Event, error) {
+	return s.EventSink.Create(s.mirror(event))
+}
+
+// Update updates the mirror of event in the central namespace.
+func (s *Sink) Update(event *corev1.Event) (*corev1.Event, error) {
+	return s.EventSink.Update(s.mirror(event))
+}
+
+// Patch patches the mirror of oldEvent in the central namespace.
+func (s *Sink) Patch(oldEvent *corev1.Event, data []byte) (*corev1.Event, error) {
+	return s.EventSink.Patch(s.mirror(oldEvent), data)
+}
+
+// mirror returns the mirror of event in the central namespace. The mirror
+// keeps the name of event, so that it is updated and patched along with it.
+func (s *Sink) mirror(event *corev1.Event) *corev1.Event {
+	mirrored := event.DeepCopy()
+	if event.Namespace == s.Namespace {
+		return mirrored
+	}
+	source := event.InvolvedObject
+	mirrored.Namespace = s.Namespace
+	mirrored.InvolvedObject.Namespace = s.Namespace
+	mirrored.Related = &source
+	if source.Namespace != "" {
+		if mirrored.Labels == nil {
+			mirrored.Labels = make(map[string]string)
+		}
+		mirrored.Labels[SourceNamespaceLabel] = source.Namespace
+	}
+	return mirrored
+}
+
+// recorder records events with a recorder and also records the Warning ones
+// with the recorder of the central namespace.
+type recorder struct {
+	record.EventRecorder
+	mirror record.EventRecorder
+}
+
+// NewRecorder returns a recorder that records events with r, and also
+// records the Warning ones with mirror, which must write to a Sink.
+func NewRecorder(r, mirror record.EventRecorder) record.EventRecorder {
+	return &recorder{EventRecorder: r, mirror: mirror}
+}
+
+func (r *recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(object, eventtype, reason, message)
+	if eventtype == corev1.EventTypeWarning {
+		r.mirror.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+	if eventtype == corev1.EventTypeWarning {
+		r.mirror.Eventf(object, eventtype, reason, messageFmt, args...)
+	}
+}
+
+func (r *recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+	if eventtype == corev1.EventTypeWarning {
+		r.mirror.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+	}
+}
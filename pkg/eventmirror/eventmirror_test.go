@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventmirror
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeSink records the events written to it.
+type fakeSink struct {
+	events []*corev1.Event
+}
+
+func (s *fakeSink) Create(event *corev1.Event) (*corev1.Event, error) {
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+func (s *fakeSink) Update(event *corev1.Event) (*corev1.Event, error) {
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+func (s *fakeSink) Patch(oldEvent *corev1.Event, data []byte) (*corev1.Event, error) {
+	s.events = append(s.events, oldEvent)
+	return oldEvent, nil
+}
+
+func TestSink(t *testing.T) {
+	fake := &fakeSink{}
+	sink := &Sink{EventSink: fake, Namespace: "catalog-events"}
+	source := corev1.ObjectReference{Kind: "ServiceInstance", Namespace: "team-a", Name: "mysql", UID: "uid"}
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "team-a", Name: "mysql.1234"},
+		InvolvedObject: source,
+		Type:           corev1.EventTypeWarning,
+	}
+
+	if _, err := sink.Create(event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := sink.Patch(event, []byte("{}")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fake.events) != 2 {
+		t.Fatalf("expected 2 events to be written, got %d", len(fake.events))
+	}
+	for _, mirrored := range fake.events {
+		if mirrored.Namespace != "catalog-events" || mirrored.InvolvedObject.Namespace != "catalog-events" {
+			t.Fatalf("expected the event to be mirrored into catalog-events, got %q about an object in %q", mirrored.Namespace, mirrored.InvolvedObject.Namespace)
+		}
+		if mirrored.Name != event.Name {
+			t.Fatalf("expected the mirrored event to keep its name %q, got %q", event.Name, mirrored.Name)
+		}
+		if mirrored.Related == nil || *mirrored.Related != source {
+			t.Fatalf("expected the mirrored event to be related to %v, got %v", source, mirrored.Related)
+		}
+		if e, a := "team-a", mirrored.Labels[SourceNamespaceLabel]; e != a {
+			t.Fatalf("expected the mirrored event to be labeled with namespace %q, got %q", e, a)
+		}
+	}
+	if event.Namespace != "team-a" || event.Related != nil {
+		t.Fatal("expected the original event to be left unchanged")
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	r := record.NewFakeRecorder(10)
+	mirror := record.NewFakeRecorder(10)
+	recorder := NewRecorder(r, mirror)
+
+	recorder.Event(nil, corev1.EventTypeNormal, "Provisioned", "provisioned")
+	recorder.Eventf(nil, corev1.EventTypeWarning, "ProvisionCallFailed", "provision failed: %v", "oops")
+
+	if e, a := 2, len(r.Events); e != a {
+		t.Fatalf("expected %d events to be recorded, got %d", e, a)
+	}
+	if e, a := 1, len(mirror.Events); e != a {
+		t.Fatalf("expected %d event to be mirrored, got %d", e, a)
+	}
+	if e, a := "Warning ProvisionCallFailed provision failed: oops", <-mirror.Events; e != a {
+		t.Fatalf("unexpected mirrored event; expected %q, got %q", e, a)
+	}
+}
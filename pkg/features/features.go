@@ -53,6 +53,7 @@ const (
 	// ServiceClasses, and ServicePlans.
 	// owner: @eriknelson & @jeremyrickard
 	// alpha: v0.1.10
+	// GA: v0.2.0
 	NamespacedServiceBroker utilfeature.Feature = "NamespacedServiceBroker"
 
 	// UpdateDashboardURL enables the update of DashboardURL in response
@@ -77,6 +78,113 @@ const (
 	// owner: @piotrmiskiewicz
 	// alpha: v0.3.0
 	CascadingDeletion utilfeature.Feature = "CascadingDeletion"
+
+	// BindingVerification enables the temporary bind and unbind performed
+	// after provisioning for instances that set VerifyBinding
+	// alpha: v0.4.0
+	BindingVerification utilfeature.Feature = "BindingVerification"
+
+	// ParametersPlugins enables parametersFrom sources that fetch parameters
+	// from plugins installed alongside the controller manager
+	// alpha: v0.4.0
+	ParametersPlugins utilfeature.Feature = "ParametersPlugins"
+
+	// OperationLease enables recording a lease on a ServiceInstance before
+	// sending it a provision, update or deprovision request, so that only
+	// one controller worker sends the request
+	// alpha: v0.4.0
+	OperationLease utilfeature.Feature = "OperationLease"
+
+	// SerializeBindingOperations enables sending the bind and unbind
+	// requests for the bindings of a ServiceInstance one at a time, for
+	// brokers that reject concurrent requests with a ConcurrencyError
+	// alpha: v0.4.0
+	SerializeBindingOperations utilfeature.Feature = "SerializeBindingOperations"
+
+	// RejectInstanceDeletionWithBindings enables rejecting the deletion of a
+	// ServiceInstance in the validating webhook while ServiceBindings to it
+	// exist, instead of leaving the instance Terminating until they are
+	// deleted
+	// alpha: v0.4.0
+	RejectInstanceDeletionWithBindings utilfeature.Feature = "RejectInstanceDeletionWithBindings"
+
+	// ContextNamespaceOverride enables the ContextNamespaceOverride field of
+	// ServiceInstances, which presents another namespace than the instance's
+	// own in the OSB context
+	// alpha: v0.4.0
+	ContextNamespaceOverride utilfeature.Feature = "ContextNamespaceOverride"
+
+	// PlanParametersDocumentation enables writing the parameter schemas of
+	// plans, converted to structural schemas, to ConfigMaps so that tooling
+	// can document the parameters a plan expects
+	// alpha: v0.4.0
+	PlanParametersDocumentation utilfeature.Feature = "PlanParametersDocumentation"
+
+	// CatalogServerSideApply enables writing the classes and plans of broker
+	// catalogs with server-side apply, several at a time, instead of
+	// creating or updating them one by one
+	// alpha: v0.4.0
+	// beta: v0.5.0
+	CatalogServerSideApply utilfeature.Feature = "CatalogServerSideApply"
+
+	// BindingSecretDriftRepair enables watching the Secrets holding the
+	// credentials of ServiceBindings, and restoring those modified or
+	// deleted outside of the controller
+	// alpha: v0.4.0
+	BindingSecretDriftRepair utilfeature.Feature = "BindingSecretDriftRepair"
+
+	// PlanSchemaDefaults enables applying the default values that the
+	// provisioning parameter schema of a plan specifies to the parameters of
+	// new service instances
+	// alpha: v0.4.0
+	PlanSchemaDefaults utilfeature.Feature = "PlanSchemaDefaults"
+
+	// InstanceRequestSnapshots enables writing a redacted snapshot of the
+	// last provision or update request sent for the service instances
+	// annotated for it to a ConfigMap, to debug what a broker received
+	// alpha: v0.4.0
+	InstanceRequestSnapshots utilfeature.Feature = "InstanceRequestSnapshots"
+
+	// InstanceReadinessPublish enables mirroring the readiness of the
+	// service instances that set readinessPublish into a ConfigMap of
+	// their namespace
+	// alpha: v0.4.0
+	InstanceReadinessPublish utilfeature.Feature = "InstanceReadinessPublish"
+
+	// DebugAnnotations enables the annotations of service instances that
+	// make the controller skip individual steps of preparing their requests,
+	// to isolate which one makes a broker reject them
+	// alpha: v0.4.0
+	DebugAnnotations utilfeature.Feature = "DebugAnnotations"
+
+	// BrokerCatalogSources enables loading the catalog of a broker from the
+	// ConfigMap, URL or OCI artifact of its spec.catalogSource instead of
+	// its /v2/catalog endpoint
+	// alpha: v0.4.0
+	BrokerCatalogSources utilfeature.Feature = "BrokerCatalogSources"
+
+	// CatalogDeltaSync enables skipping the writes of the classes and plans
+	// of broker catalogs that are unchanged since the last relist
+	// alpha: v0.4.0
+	CatalogDeltaSync utilfeature.Feature = "CatalogDeltaSync"
+
+	// BindingAdoption enables recording the service bindings that set
+	// adoptExisting as ready without binding them, to take over bindings
+	// created at the broker outside of service catalog
+	// alpha: v0.4.0
+	BindingAdoption utilfeature.Feature = "BindingAdoption"
+
+	// BrokerServiceAccountTokenAuth enables authenticating to namespaced
+	// brokers with the token of a service account, requested with the
+	// TokenRequest API and renewed before it expires
+	// alpha: v0.4.0
+	BrokerServiceAccountTokenAuth utilfeature.Feature = "BrokerServiceAccountTokenAuth"
+
+	// BrokerCatalogEvents enables recording events on brokers for the
+	// classes and plans added, changed or removed by each relist of their
+	// catalog
+	// alpha: v0.4.0
+	BrokerCatalogEvents utilfeature.Feature = "BrokerCatalogEvents"
 )
 
 func init() {
@@ -87,12 +195,30 @@ func init() {
 // To add a new feature, define a key for it above and add it here. The features will be
 // available throughout service catalog binaries.
 var defaultServiceCatalogFeatureGates = map[utilfeature.Feature]utilfeature.FeatureSpec{
-	PodPreset:                  {Default: false, PreRelease: utilfeature.Alpha},
-	OriginatingIdentity:        {Default: true, PreRelease: utilfeature.GA},
-	AsyncBindingOperations:     {Default: false, PreRelease: utilfeature.Alpha},
-	NamespacedServiceBroker:    {Default: true, PreRelease: utilfeature.Alpha},
-	UpdateDashboardURL:         {Default: false, PreRelease: utilfeature.Alpha},
-	OriginatingIdentityLocking: {Default: true, PreRelease: utilfeature.Alpha},
-	ServicePlanDefaults:        {Default: false, PreRelease: utilfeature.Alpha},
-	CascadingDeletion:          {Default: false, PreRelease: utilfeature.Alpha},
+	PodPreset:                          {Default: false, PreRelease: utilfeature.Alpha},
+	OriginatingIdentity:                {Default: true, PreRelease: utilfeature.GA},
+	AsyncBindingOperations:             {Default: false, PreRelease: utilfeature.Alpha},
+	NamespacedServiceBroker:            {Default: true, PreRelease: utilfeature.GA},
+	UpdateDashboardURL:                 {Default: false, PreRelease: utilfeature.Alpha},
+	OriginatingIdentityLocking:         {Default: true, PreRelease: utilfeature.Alpha},
+	ServicePlanDefaults:                {Default: false, PreRelease: utilfeature.Alpha},
+	CascadingDeletion:                  {Default: false, PreRelease: utilfeature.Alpha},
+	BindingVerification:                {Default: false, PreRelease: utilfeature.Alpha},
+	ParametersPlugins:                  {Default: false, PreRelease: utilfeature.Alpha},
+	OperationLease:                     {Default: false, PreRelease: utilfeature.Alpha},
+	SerializeBindingOperations:         {Default: false, PreRelease: utilfeature.Alpha},
+	RejectInstanceDeletionWithBindings: {Default: false, PreRelease: utilfeature.Alpha},
+	ContextNamespaceOverride:           {Default: false, PreRelease: utilfeature.Alpha},
+	PlanParametersDocumentation:        {Default: false, PreRelease: utilfeature.Alpha},
+	CatalogServerSideApply:             {Default: true, PreRelease: utilfeature.Beta},
+	BindingSecretDriftRepair:           {Default: false, PreRelease: utilfeature.Alpha},
+	PlanSchemaDefaults:                 {Default: false, PreRelease: utilfeature.Alpha},
+	InstanceRequestSnapshots:           {Default: false, PreRelease: utilfeature.Alpha},
+	InstanceReadinessPublish:           {Default: false, PreRelease: utilfeature.Alpha},
+	DebugAnnotations:                   {Default: false, PreRelease: utilfeature.Alpha},
+	BrokerCatalogSources:               {Default: false, PreRelease: utilfeature.Alpha},
+	CatalogDeltaSync:                   {Default: false, PreRelease: utilfeature.Alpha},
+	BindingAdoption:                    {Default: false, PreRelease: utilfeature.Alpha},
+	BrokerServiceAccountTokenAuth:      {Default: false, PreRelease: utilfeature.Alpha},
+	BrokerCatalogEvents:                {Default: false, PreRelease: utilfeature.Alpha},
 }
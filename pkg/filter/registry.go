@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// PredicateFunc reports whether a class or plan of a broker catalog,
+// presented by its properties, is accepted.
+type PredicateFunc func(Properties) bool
+
+var (
+	predicatesMutex        sync.RWMutex
+	serviceClassPredicates = map[string]PredicateFunc{}
+	servicePlanPredicates  = map[string]PredicateFunc{}
+)
+
+// RegisterServiceClassPredicate registers a predicate that the classes of
+// every broker catalog must pass, in addition to the catalog restrictions of
+// their broker. It lets a distribution embedding the controller manager
+// filter catalogs in ways the restrictions cannot express, and is meant to be
+// called from an init function. It panics if a class predicate with the same
+// name is already registered.
+func RegisterServiceClassPredicate(name string, predicate PredicateFunc) {
+	register(serviceClassPredicates, "class", name, predicate)
+}
+
+// RegisterServicePlanPredicate is RegisterServiceClassPredicate for plans.
+func RegisterServicePlanPredicate(name string, predicate PredicateFunc) {
+	register(servicePlanPredicates, "plan", name, predicate)
+}
+
+func register(predicates map[string]PredicateFunc, kind, name string, predicate PredicateFunc) {
+	predicatesMutex.Lock()
+	defer predicatesMutex.Unlock()
+	if _, ok := predicates[name]; ok {
+		panic(fmt.Sprintf("service %s predicate %q is already registered", kind, name))
+	}
+	predicates[name] = predicate
+}
+
+// ServiceClassPredicate creates the Predicate that the classes of a broker
+// catalog are tested with: the given class restrictions of the broker and
+// the registered class predicates.
+func ServiceClassPredicate(restrictions []string) (Predicate, error) {
+	return withRegisteredPredicates(restrictions, serviceClassPredicates)
+}
+
+// ServicePlanPredicate is ServiceClassPredicate for plans.
+func ServicePlanPredicate(restrictions []string) (Predicate, error) {
+	return withRegisteredPredicates(restrictions, servicePlanPredicates)
+}
+
+func withRegisteredPredicates(restrictions []string, predicates map[string]PredicateFunc) (Predicate, error) {
+	predicate, err := CreatePredicate(restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	predicatesMutex.RLock()
+	defer predicatesMutex.RUnlock()
+	if len(predicates) == 0 {
+		return predicate, nil
+	}
+	names := make([]string, 0, len(predicates))
+	for name := range predicates {
+		names = append(names, name)
+	}
+	// Test the predicates in a stable order.
+	sort.Strings(names)
+	funcs := make([]PredicateFunc, 0, len(names))
+	for _, name := range names {
+		funcs = append(funcs, predicates[name])
+	}
+	return registeredPredicate{Predicate: predicate, funcs: funcs}, nil
+}
+
+// registeredPredicate is a predicate made of the catalog restrictions of a
+// broker and the registered predicates. Its String is the one of the
+// restrictions, as the registered predicates cannot be expressed as a
+// selector.
+type registeredPredicate struct {
+	Predicate
+	funcs []PredicateFunc
+}
+
+// Accepts returns true if the restrictions and every registered predicate
+// accept the given set of properties.
+func (rp registeredPredicate) Accepts(p Properties) bool {
+	if !rp.Predicate.Accepts(p) {
+		return false
+	}
+	for _, accepts := range rp.funcs {
+		if !accepts(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns false as the registered predicates restrict the acceptance
+// space.
+func (rp registeredPredicate) Empty() bool {
+	return false
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func unregisterServicePlanPredicate(name string) {
+	predicatesMutex.Lock()
+	defer predicatesMutex.Unlock()
+	delete(servicePlanPredicates, name)
+}
+
+func TestServicePlanPredicateWithRegisteredPredicate(t *testing.T) {
+	RegisterServicePlanPredicate("no-beta", func(p Properties) bool {
+		return p.Get("spec.externalName") != "beta"
+	})
+	defer unregisterServicePlanPredicate("no-beta")
+
+	predicate, err := ServicePlanPredicate([]string{"spec.free=true"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if predicate.Empty() {
+		t.Fatal("Expected a predicate with a registered predicate not to be empty")
+	}
+	if e, a := "spec.free=true", predicate.String(); e != a {
+		t.Fatalf("Unexpected predicate string: expected %q, got %q", e, a)
+	}
+
+	cases := []struct {
+		properties labels.Set
+		accepted   bool
+	}{
+		{labels.Set{"spec.externalName": "gold", "spec.free": "true"}, true},
+		{labels.Set{"spec.externalName": "beta", "spec.free": "true"}, false},
+		{labels.Set{"spec.externalName": "gold", "spec.free": "false"}, false},
+	}
+	for _, tc := range cases {
+		if e, a := tc.accepted, predicate.Accepts(tc.properties); e != a {
+			t.Errorf("Unexpected acceptance of %v: expected %v, got %v", tc.properties, e, a)
+		}
+	}
+
+	// Classes are not tested with the plan predicates.
+	classPredicate, err := ServiceClassPredicate(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !classPredicate.Empty() {
+		t.Fatal("Expected the class predicate to be empty")
+	}
+}
+
+func TestRegisterServiceClassPredicateTwice(t *testing.T) {
+	accept := func(Properties) bool { return true }
+	RegisterServiceClassPredicate("twice", accept)
+	defer func() {
+		predicatesMutex.Lock()
+		delete(serviceClassPredicates, "twice")
+		predicatesMutex.Unlock()
+		if recover() == nil {
+			t.Fatal("Expected registering a predicate twice to panic")
+		}
+	}()
+	RegisterServiceClassPredicate("twice", accept)
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory periodically summarizes the service instances and
+// bindings of the cluster from the controller's informer caches: how many
+// there are per class, plan, broker and namespace, and how many failed.
+// Platform dashboards can read the summary instead of listing every instance
+// from the API server themselves.
+package inventory
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/util"
+)
+
+// SummaryPath is the path at which the summary is served.
+const SummaryPath = "/inventory/v1/summary"
+
+// Group counts the instances sharing a class, plan, broker or namespace.
+// Namespace is set for the namespaced classes, plans and brokers, and Class
+// is set for plans, whose external names are only unique within their class.
+type Group struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Class     string `json:"class,omitempty"`
+	Instances int    `json:"instances"`
+	Failed    int    `json:"failed"`
+}
+
+// Summary is the inventory of the instances and bindings of the cluster.
+// Classes and plans are named after their external names. Instances whose
+// class or plan cannot be resolved are counted in the totals and in their
+// namespace only.
+type Summary struct {
+	// GeneratedAt is when the summary was computed.
+	GeneratedAt time.Time `json:"generatedAt"`
+
+	Instances int `json:"instances"`
+	// FailedInstances is the number of instances whose last operation
+	// failed and will not be retried.
+	FailedInstances int `json:"failedInstances"`
+	// Statuses counts the instances by printable status.
+	Statuses map[string]int `json:"statuses"`
+
+	Bindings       int `json:"bindings"`
+	FailedBindings int `json:"failedBindings"`
+
+	Classes    []Group `json:"classes"`
+	Plans      []Group `json:"plans"`
+	Brokers    []Group `json:"brokers"`
+	Namespaces []Group `json:"namespaces"`
+}
+
+// Listers are the listers the inventory is computed from.
+type Listers struct {
+	ServiceInstances      listers.ServiceInstanceLister
+	ServiceBindings       listers.ServiceBindingLister
+	ClusterServiceClasses listers.ClusterServiceClassLister
+	ServiceClasses        listers.ServiceClassLister
+	ClusterServicePlans   listers.ClusterServicePlanLister
+	ServicePlans          listers.ServicePlanLister
+}
+
+// Inventory keeps the last summary computed from its listers.
+type Inventory struct {
+	listers Listers
+
+	mutex   sync.RWMutex
+	summary *Summary
+}
+
+// New creates an Inventory computing its summary from the given listers.
+func New(listers Listers) *Inventory {
+	return &Inventory{listers: listers}
+}
+
+// Run computes the summary every interval until stopCh is closed.
+func (inv *Inventory) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(inv.refresh, interval, stopCh)
+}
+
+// refresh computes the summary served from now on.
+func (inv *Inventory) refresh() {
+	summary, err := inv.compute(time.Now())
+	if err != nil {
+		klog.Warningf("Unable to compute the inventory summary: %v", err)
+		return
+	}
+	inv.mutex.Lock()
+	inv.summary = summary
+	inv.mutex.Unlock()
+}
+
+// Install registers the inventory at SummaryPath of mux.
+func (inv *Inventory) Install(mux *http.ServeMux) {
+	mux.Handle(SummaryPath, inv)
+}
+
+// ServeHTTP serves the last summary, or 503 Service Unavailable until the
+// first one is computed.
+func (inv *Inventory) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	inv.mutex.RLock()
+	summary := inv.summary
+	inv.mutex.RUnlock()
+	if summary == nil {
+		w.Header().Set("Retry-After", "10")
+		util.WriteErrorResponse(w, http.StatusServiceUnavailable, errors.New("the inventory summary has not been computed yet"))
+		return
+	}
+	util.WriteResponse(w, http.StatusOK, summary)
+}
+
+// groupKey identifies a Group while the summary is computed.
+type groupKey struct {
+	namespace string
+	class     string
+	name      string
+}
+
+// groups accumulates the Groups of one dimension of the summary.
+type groups map[groupKey]*Group
+
+func (g groups) add(key groupKey, failed bool) {
+	if key.name == "" {
+		return
+	}
+	group, ok := g[key]
+	if !ok {
+		group = &Group{Name: key.name, Namespace: key.namespace, Class: key.class}
+		g[key] = group
+	}
+	group.Instances++
+	if failed {
+		group.Failed++
+	}
+}
+
+// sorted returns the Groups ordered by namespace, class and name,
+// cluster-scoped ones first.
+func (g groups) sorted() []Group {
+	out := make([]Group, 0, len(g))
+	for _, group := range g {
+		out = append(out, *group)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Namespace != out[j].Namespace {
+			return out[i].Namespace < out[j].Namespace
+		}
+		if out[i].Class != out[j].Class {
+			return out[i].Class < out[j].Class
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// compute computes the summary of the instances and bindings in the caches.
+func (inv *Inventory) compute(now time.Time) (*Summary, error) {
+	instances, err := inv.listers.ServiceInstances.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := inv.listers.ServiceBindings.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		GeneratedAt: now.UTC(),
+		Statuses:    map[string]int{},
+	}
+	classes, plans, brokers, namespaces := groups{}, groups{}, groups{}, groups{}
+	for _, instance := range instances {
+		failed := isInstanceFailed(instance)
+		summary.Instances++
+		if failed {
+			summary.FailedInstances++
+		}
+		if status := instance.Status.PrintableStatus; status != "" {
+			summary.Statuses[string(status)]++
+		}
+		namespaces.add(groupKey{name: instance.Namespace}, failed)
+
+		o := inv.offering(instance)
+		classes.add(groupKey{namespace: o.namespace, name: o.class}, failed)
+		if o.class != "" {
+			plans.add(groupKey{namespace: o.namespace, class: o.class, name: o.plan}, failed)
+		}
+		brokers.add(groupKey{namespace: o.namespace, name: o.broker}, failed)
+	}
+	for _, binding := range bindings {
+		summary.Bindings++
+		if isBindingFailed(binding) {
+			summary.FailedBindings++
+		}
+	}
+
+	summary.Classes = classes.sorted()
+	summary.Plans = plans.sorted()
+	summary.Brokers = brokers.sorted()
+	summary.Namespaces = namespaces.sorted()
+	return summary, nil
+}
+
+// offering is the class, plan and broker of an instance. namespace is set
+// when they are namespaced.
+type offering struct {
+	namespace string
+	class     string
+	plan      string
+	broker    string
+}
+
+// offering resolves the external names of the class and plan of an instance
+// and the name of its broker. The broker the instance was provisioned by is
+// preferred to the one currently offering its class.
+func (inv *Inventory) offering(instance *v1beta1.ServiceInstance) offering {
+	var o offering
+	spec := instance.Spec
+	switch {
+	case spec.ClusterServiceClassRef != nil:
+		if class, err := inv.listers.ClusterServiceClasses.Get(spec.ClusterServiceClassRef.Name); err == nil {
+			o.class = class.Spec.ExternalName
+			o.broker = class.Spec.ClusterServiceBrokerName
+		}
+		if spec.ClusterServicePlanRef != nil {
+			if plan, err := inv.listers.ClusterServicePlans.Get(spec.ClusterServicePlanRef.Name); err == nil {
+				o.plan = plan.Spec.ExternalName
+			}
+		}
+	case spec.ServiceClassRef != nil:
+		o.namespace = instance.Namespace
+		if class, err := inv.listers.ServiceClasses.ServiceClasses(instance.Namespace).Get(spec.ServiceClassRef.Name); err == nil {
+			o.class = class.Spec.ExternalName
+			o.broker = class.Spec.ServiceBrokerName
+		}
+		if spec.ServicePlanRef != nil {
+			if plan, err := inv.listers.ServicePlans.ServicePlans(instance.Namespace).Get(spec.ServicePlanRef.Name); err == nil {
+				o.plan = plan.Spec.ExternalName
+			}
+		}
+	}
+	if instance.Status.BrokerName != "" {
+		o.broker = instance.Status.BrokerName
+	}
+	return o
+}
+
+func isInstanceFailed(instance *v1beta1.ServiceInstance) bool {
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == v1beta1.ServiceInstanceConditionFailed {
+			return condition.Status == v1beta1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func isBindingFailed(binding *v1beta1.ServiceBinding) bool {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == v1beta1.ServiceBindingConditionFailed {
+			return condition.Status == v1beta1.ConditionTrue
+		}
+	}
+	return false
+}
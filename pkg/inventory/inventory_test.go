@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+)
+
+func newIndexer(objects ...interface{}) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, object := range objects {
+		indexer.Add(object)
+	}
+	return indexer
+}
+
+func newInstance(namespace, name string, failed bool) *v1beta1.ServiceInstance {
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+	if failed {
+		instance.Status.Conditions = []v1beta1.ServiceInstanceCondition{{Type: v1beta1.ServiceInstanceConditionFailed, Status: v1beta1.ConditionTrue}}
+		instance.Status.PrintableStatus = v1beta1.ServiceInstancePrintableStatusFailed
+	} else {
+		instance.Status.PrintableStatus = v1beta1.ServiceInstancePrintableStatusReady
+	}
+	return instance
+}
+
+func newTestInventory() *Inventory {
+	clusterClass := &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-class"},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			CommonServiceClassSpec:   v1beta1.CommonServiceClassSpec{ExternalName: "db"},
+			ClusterServiceBrokerName: "cluster-broker",
+		},
+	}
+	clusterPlan := &v1beta1.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-small"},
+		Spec: v1beta1.ClusterServicePlanSpec{
+			CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "small"},
+		},
+	}
+	class := &v1beta1.ServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "queue-class"},
+		Spec: v1beta1.ServiceClassSpec{
+			CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalName: "queue"},
+			ServiceBrokerName:      "team-broker",
+		},
+	}
+	plan := &v1beta1.ServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "queue-small"},
+		Spec: v1beta1.ServicePlanSpec{
+			CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "small"},
+		},
+	}
+
+	db1 := newInstance("app", "db1", false)
+	db1.Spec.ClusterServiceClassRef = &v1beta1.ClusterObjectReference{Name: "db-class"}
+	db1.Spec.ClusterServicePlanRef = &v1beta1.ClusterObjectReference{Name: "db-small"}
+	db2 := newInstance("team", "db2", true)
+	db2.Spec.ClusterServiceClassRef = &v1beta1.ClusterObjectReference{Name: "db-class"}
+	db2.Spec.ClusterServicePlanRef = &v1beta1.ClusterObjectReference{Name: "db-small"}
+	db2.Status.BrokerName = "old-broker"
+	queue := newInstance("team", "queue", false)
+	queue.Spec.ServiceClassRef = &v1beta1.LocalObjectReference{Name: "queue-class"}
+	queue.Spec.ServicePlanRef = &v1beta1.LocalObjectReference{Name: "queue-small"}
+	unresolved := newInstance("app", "unresolved", false)
+
+	binding := &v1beta1.ServiceBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "db1"}}
+	failedBinding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "db2"},
+		Status: v1beta1.ServiceBindingStatus{
+			Conditions: []v1beta1.ServiceBindingCondition{{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue}},
+		},
+	}
+
+	return New(Listers{
+		ServiceInstances:      listers.NewServiceInstanceLister(newIndexer(db1, db2, queue, unresolved)),
+		ServiceBindings:       listers.NewServiceBindingLister(newIndexer(binding, failedBinding)),
+		ClusterServiceClasses: listers.NewClusterServiceClassLister(newIndexer(clusterClass)),
+		ServiceClasses:        listers.NewServiceClassLister(newIndexer(class)),
+		ClusterServicePlans:   listers.NewClusterServicePlanLister(newIndexer(clusterPlan)),
+		ServicePlans:          listers.NewServicePlanLister(newIndexer(plan)),
+	})
+}
+
+func TestCompute(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	summary, err := newTestInventory().compute(now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := &Summary{
+		GeneratedAt:     now,
+		Instances:       4,
+		FailedInstances: 1,
+		Statuses:        map[string]int{"Ready": 3, "Failed": 1},
+		Bindings:        2,
+		FailedBindings:  1,
+		Classes: []Group{
+			{Name: "db", Instances: 2, Failed: 1},
+			{Name: "queue", Namespace: "team", Instances: 1},
+		},
+		Plans: []Group{
+			{Name: "small", Class: "db", Instances: 2, Failed: 1},
+			{Name: "small", Namespace: "team", Class: "queue", Instances: 1},
+		},
+		Brokers: []Group{
+			{Name: "cluster-broker", Instances: 1},
+			{Name: "old-broker", Instances: 1, Failed: 1},
+			{Name: "team-broker", Namespace: "team", Instances: 1},
+		},
+		Namespaces: []Group{
+			{Name: "app", Instances: 2},
+			{Name: "team", Instances: 2, Failed: 1},
+		},
+	}
+	if !reflect.DeepEqual(expected, summary) {
+		t.Fatalf("Unexpected summary:\nexpected %+v\ngot      %+v", expected, summary)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	inv := newTestInventory()
+	mux := http.NewServeMux()
+	inv.Install(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SummaryPath, nil))
+	if e, a := http.StatusServiceUnavailable, rec.Code; e != a {
+		t.Fatalf("Expected the summary to be unavailable before it is computed: expected %v, got %v", e, a)
+	}
+
+	inv.refresh()
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SummaryPath, nil))
+	if e, a := http.StatusOK, rec.Code; e != a {
+		t.Fatalf("Unexpected status code: expected %v, got %v", e, a)
+	}
+	var summary Summary
+	if err := json.Unmarshal(rec.Body.Bytes(), &summary); err != nil {
+		t.Fatalf("Unexpected error decoding the summary: %v", err)
+	}
+	if e, a := 4, summary.Instances; e != a {
+		t.Fatalf("Unexpected number of instances: expected %v, got %v", e, a)
+	}
+}
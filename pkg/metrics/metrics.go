@@ -72,6 +72,219 @@ var (
 		},
 		[]string{"broker", "method", "status"},
 	)
+
+	// OSBRequestDuration exposes how long the HTTP requests made to Open
+	// Service Brokers take, so that slow brokers can be alerted on. The
+	// metric is broken out by broker name and broker method.
+	OSBRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Name:      "osb_request_duration_seconds",
+			Help:      "How long in seconds the HTTP requests from the OSB Client to the specified Service Broker take, grouped by broker name and broker method.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		},
+		[]string{"broker", "method"},
+	)
+
+	// ReconcileDuration exposes how long the controller takes to reconcile
+	// a resource once taken from its work queue, including the requests to
+	// brokers. The metric is broken out by resource type and by whether the
+	// reconciliation succeeded ('success') or is to be retried ('error').
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Name:      "reconcile_duration_seconds",
+			Help:      "How long in seconds the reconciliation of a resource takes, grouped by resource type and result.",
+			Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		},
+		[]string{"resource_type", "result"},
+	)
+
+	// NamespaceCacheRequestCount exposes the number of namespace lookups made
+	// by the controller when building requests to brokers. The metric is
+	// broken out by whether the namespace was found in the controller's
+	// cache ('hit') or had to be fetched from the API server ('miss').
+	NamespaceCacheRequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "namespace_cache_request_count",
+			Help:      "Cumulative number of namespace lookups by the controller grouped by cache result.",
+		},
+		[]string{"result"},
+	)
+
+	// NamespacesBlockedOnCatalogResources exposes the number of namespaces
+	// being deleted that still contain ServiceInstances or ServiceBindings,
+	// and whose deletion is therefore waiting on the controller.
+	NamespacesBlockedOnCatalogResources = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "namespaces_blocked_on_catalog_resources",
+			Help:      "Number of namespaces being deleted that still contain service instances or bindings.",
+		},
+	)
+
+	// BrokerClientCount exposes the number of OSB clients the controller
+	// holds for brokers.
+	BrokerClientCount = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "broker_client_count",
+			Help:      "Number of OSB clients held for brokers.",
+		},
+	)
+
+	// BrokerClientCreationCount exposes the number of OSB clients created for
+	// brokers. The metric is broken out by broker and by whether the client
+	// was created for a new broker ('new') or replaced one whose configuration
+	// changed ('config-changed').
+	BrokerClientCreationCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "broker_client_creation_count",
+			Help:      "Cumulative number of OSB clients created for brokers grouped by broker and reason.",
+		},
+		[]string{"broker", "reason"},
+	)
+
+	// BrokerErrorCount exposes the number of errors returned by brokers for
+	// the operations of the controller. The metric is broken out by
+	// operation, by whether the error is 'terminal' or 'retriable', and by
+	// whether it required orphan mitigation.
+	BrokerErrorCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "broker_error_count",
+			Help:      "Cumulative number of errors returned by brokers grouped by operation, kind, and whether orphan mitigation is required.",
+		},
+		[]string{"operation", "kind", "orphan_mitigation"},
+	)
+
+	// BindingCredentialsAge exposes the time since the credentials of each
+	// ServiceBinding were last written to its secrets, so that credentials
+	// older than a rotation policy allows can be found.
+	BindingCredentialsAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "binding_credentials_age_seconds",
+			Help:      "Seconds since the credentials of a service binding were last written, by namespace and binding.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// ReadOnlyDriftedResources exposes, when the controller runs in
+	// read-only mode, the number of resources of each kind whose state
+	// drifted from their spec and would otherwise be reconciled.
+	ReadOnlyDriftedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "read_only_drifted_resources",
+			Help:      "Number of resources whose state drifted from their spec while the controller is in read-only mode, by kind.",
+		},
+		[]string{"kind"},
+	)
+
+	// ProvisionsInFlight exposes, when the number of provisions in flight
+	// is limited, the number of service instances whose provision is in
+	// flight.
+	ProvisionsInFlight = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "provisions_in_flight",
+			Help:      "Number of service instances whose provision is in flight, when the number of provisions in flight is limited.",
+		},
+	)
+
+	// ProvisionsWaiting exposes, when the number of provisions in flight is
+	// limited, the number of service instances waiting to send their
+	// provision request.
+	ProvisionsWaiting = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "provisions_waiting",
+			Help:      "Number of service instances waiting to send their provision request because too many provisions are in flight.",
+		},
+	)
+
+	// CatalogCacheSynced exposes whether the caches of the catalog synced,
+	// so that the instance and binding workers started.
+	CatalogCacheSynced = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "catalog_cache_synced",
+			Help:      "Whether the caches of the brokers, classes, plans and instances synced, so that instances and bindings are reconciled (1) or not yet (0).",
+		},
+	)
+
+	// CatalogCacheObjects exposes the number of classes and plans cached
+	// by the controller while its caches sync. The metric is broken out by
+	// kind.
+	CatalogCacheObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "catalog_cache_objects",
+			Help:      "Number of classes and plans cached by the controller while the caches of the catalog sync, by kind.",
+		},
+		[]string{"kind"},
+	)
+
+	// CatalogCacheWarmupSeconds exposes how long the instance and binding
+	// workers waited, or have been waiting, for the caches of the catalog
+	// to sync.
+	CatalogCacheWarmupSeconds = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "catalog_cache_warmup_seconds",
+			Help:      "Seconds the instance and binding workers waited, or have been waiting, for the caches of the catalog to sync.",
+		},
+	)
+
+	// CatalogCacheWarmStartCount exposes the number of times an informer
+	// of the catalog started from its snapshot on disk instead of listing
+	// from the API server. The metric is broken out by kind.
+	CatalogCacheWarmStartCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "catalog_cache_warm_start_count",
+			Help:      "Number of times an informer of the catalog started from its snapshot on disk, by kind.",
+		},
+		[]string{"kind"},
+	)
+
+	// CatalogEntriesUnchangedCount exposes the number of classes and plans
+	// that were not written on a relist of their broker as the catalog left
+	// them unchanged. The metric is broken out by kind.
+	CatalogEntriesUnchangedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "catalog_entries_unchanged_count",
+			Help:      "Cumulative number of classes and plans left unwritten on a relist as the catalog did not change them, by kind.",
+		},
+		[]string{"kind"},
+	)
+
+	// BindingSecretWritesSkippedCount exposes the number of writes of the
+	// secrets of bindings that were skipped as the secrets already held the
+	// credentials.
+	BindingSecretWritesSkippedCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "binding_secret_writes_skipped_count",
+			Help:      "Cumulative number of binding secret writes skipped as the secret already held the credentials.",
+		},
+	)
+
+	// FilterLabelRepairCount exposes the number of classes, plans and
+	// instances whose filter labels did not match their spec and were
+	// repaired. The metric is broken out by kind.
+	FilterLabelRepairCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "filter_label_repair_count",
+			Help:      "Cumulative number of resources whose stale filter labels were repaired, by kind.",
+		},
+		[]string{"kind"},
+	)
 )
 
 func register(registry *prometheus.Registry) {
@@ -79,6 +292,25 @@ func register(registry *prometheus.Registry) {
 		registry.MustRegister(BrokerServiceClassCount)
 		registry.MustRegister(BrokerServicePlanCount)
 		registry.MustRegister(OSBRequestCount)
+		registry.MustRegister(OSBRequestDuration)
+		registry.MustRegister(ReconcileDuration)
+		registry.MustRegister(NamespaceCacheRequestCount)
+		registry.MustRegister(NamespacesBlockedOnCatalogResources)
+		registry.MustRegister(BrokerClientCount)
+		registry.MustRegister(BrokerClientCreationCount)
+		registry.MustRegister(BrokerErrorCount)
+		registry.MustRegister(BindingCredentialsAge)
+		registry.MustRegister(ReadOnlyDriftedResources)
+		registry.MustRegister(ProvisionsInFlight)
+		registry.MustRegister(ProvisionsWaiting)
+		registry.MustRegister(FilterLabelRepairCount)
+		registry.MustRegister(CatalogCacheSynced)
+		registry.MustRegister(CatalogCacheObjects)
+		registry.MustRegister(CatalogCacheWarmupSeconds)
+		registry.MustRegister(CatalogCacheWarmStartCount)
+		registry.MustRegister(CatalogEntriesUnchangedCount)
+		registry.MustRegister(BindingSecretWritesSkippedCount)
+		registerWorkqueueMetrics(registry)
 	})
 }
 
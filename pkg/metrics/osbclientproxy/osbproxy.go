@@ -20,6 +20,7 @@ package osbclientproxy
 
 import (
 	"fmt"
+	"time"
 
 	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/metrics"
@@ -65,15 +66,17 @@ const (
 // metrics.
 func (pc proxyclient) GetCatalog() (*osb.CatalogResponse, error) {
 	klog.V(9).Info("OSBClientProxy getCatalog()")
+	start := time.Now()
 	response, err := pc.realOSBClient.GetCatalog()
-	pc.updateMetrics(getCatalog, err)
+	pc.updateMetrics(getCatalog, start, err)
 	return response, err
 }
 
 func (pc proxyclient) GetInstance(r *osb.GetInstanceRequest) (*osb.GetInstanceResponse, error) {
 	klog.V(9).Info("OSBClientProxy getInstance()")
+	start := time.Now()
 	response, err := pc.realOSBClient.GetInstance(r)
-	pc.updateMetrics(getInstance, err)
+	pc.updateMetrics(getInstance, start, err)
 	return response, err
 }
 
@@ -82,8 +85,9 @@ func (pc proxyclient) GetInstance(r *osb.GetInstanceRequest) (*osb.GetInstanceRe
 // method to the underlying implementation and capturing request metrics.
 func (pc proxyclient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.ProvisionResponse, error) {
 	klog.V(9).Info("OSBClientProxy ProvisionInstance()")
+	start := time.Now()
 	response, err := pc.realOSBClient.ProvisionInstance(r)
-	pc.updateMetrics(provisionInstance, err)
+	pc.updateMetrics(provisionInstance, start, err)
 	return response, err
 
 }
@@ -93,8 +97,9 @@ func (pc proxyclient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.Provision
 // to the underlying implementation and capturing request metrics.
 func (pc proxyclient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateInstanceResponse, error) {
 	klog.V(9).Info("OSBClientProxy UpdateInstance()")
+	start := time.Now()
 	response, err := pc.realOSBClient.UpdateInstance(r)
-	pc.updateMetrics(updateInstance, err)
+	pc.updateMetrics(updateInstance, start, err)
 	return response, err
 }
 
@@ -103,8 +108,9 @@ func (pc proxyclient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateI
 // method to the underlying implementation and capturing request metrics.
 func (pc proxyclient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.DeprovisionResponse, error) {
 	klog.V(9).Info("OSBClientProxy DeprovisionInstance()")
+	start := time.Now()
 	response, err := pc.realOSBClient.DeprovisionInstance(r)
-	pc.updateMetrics(deprovisionInstance, err)
+	pc.updateMetrics(deprovisionInstance, start, err)
 	return response, err
 }
 
@@ -113,8 +119,9 @@ func (pc proxyclient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.Depro
 // method to the underlying implementation and capturing request metrics.
 func (pc proxyclient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastOperationResponse, error) {
 	klog.V(9).Info("OSBClientProxy PollLastOperation()")
+	start := time.Now()
 	response, err := pc.realOSBClient.PollLastOperation(r)
-	pc.updateMetrics(pollLastOperation, err)
+	pc.updateMetrics(pollLastOperation, start, err)
 	return response, err
 }
 
@@ -123,8 +130,9 @@ func (pc proxyclient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastO
 // the method to the underlying implementation and capturing request metrics.
 func (pc proxyclient) PollBindingLastOperation(r *osb.BindingLastOperationRequest) (*osb.LastOperationResponse, error) {
 	klog.V(9).Info("OSBClientProxy PollBindingLastOperation()")
+	start := time.Now()
 	response, err := pc.realOSBClient.PollBindingLastOperation(r)
-	pc.updateMetrics(pollBindingLastOperation, err)
+	pc.updateMetrics(pollBindingLastOperation, start, err)
 	return response, err
 }
 
@@ -132,8 +140,9 @@ func (pc proxyclient) PollBindingLastOperation(r *osb.BindingLastOperationReques
 // method to the underlying implementation and capturing request metrics.
 func (pc proxyclient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
 	klog.V(9).Info("OSBClientProxy Bind().")
+	start := time.Now()
 	response, err := pc.realOSBClient.Bind(r)
-	pc.updateMetrics(bind, err)
+	pc.updateMetrics(bind, start, err)
 	return response, err
 }
 
@@ -141,8 +150,9 @@ func (pc proxyclient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
 // the method to the underlying implementation and capturing request metrics.
 func (pc proxyclient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error) {
 	klog.V(9).Info("OSBClientProxy Unbind()")
+	start := time.Now()
 	response, err := pc.realOSBClient.Unbind(r)
-	pc.updateMetrics(unbind, err)
+	pc.updateMetrics(unbind, start, err)
 	return response, err
 }
 
@@ -151,16 +161,19 @@ func (pc proxyclient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error)
 // metrics.
 func (pc proxyclient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingResponse, error) {
 	klog.V(9).Info("OSBClientProxy GetBinding()")
+	start := time.Now()
 	response, err := pc.realOSBClient.GetBinding(r)
-	pc.updateMetrics(getBinding, err)
+	pc.updateMetrics(getBinding, start, err)
 	return response, err
 }
 
 const clientErr = "client-error"
 
 // updateMetrics bumps the request count metric for the specific broker, method
-// and status
-func (pc proxyclient) updateMetrics(method string, err error) {
+// and status, and records the duration of the request that started at start
+func (pc proxyclient) updateMetrics(method string, start time.Time, err error) {
+	metrics.OSBRequestDuration.WithLabelValues(pc.brokerName, method).Observe(time.Since(start).Seconds())
+
 	var statusGroup string
 
 	// for this metric, lack of an error translates into a 2xx status
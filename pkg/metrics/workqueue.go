@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const workqueueSubsystem = "workqueue"
+
+var (
+	// WorkqueueDepth exposes the current number of items waiting in each of
+	// the controller's work queues.
+	WorkqueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "depth",
+			Help:      "Current depth of the work queue.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueAdds exposes the number of items added to each work queue.
+	WorkqueueAdds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "adds_total",
+			Help:      "Total number of items added to the work queue.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueLatency exposes how long items wait in each work queue before
+	// being processed.
+	WorkqueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "queue_duration_seconds",
+			Help:      "How long in seconds an item stays in the work queue before being processed.",
+			Buckets:   prometheus.ExponentialBuckets(10e-9, 10, 12),
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueWorkDuration exposes how long processing an item from each
+	// work queue takes.
+	WorkqueueWorkDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "work_duration_seconds",
+			Help:      "How long in seconds processing an item from the work queue takes.",
+			Buckets:   prometheus.ExponentialBuckets(10e-9, 10, 12),
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueUnfinishedWork exposes how long the items currently being
+	// processed from each work queue have been in progress.
+	WorkqueueUnfinishedWork = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "unfinished_work_seconds",
+			Help:      "How many seconds of work has been done that is in progress and hasn't been observed by work_duration.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueLongestRunningProcessor exposes how long the longest running
+	// processor of each work queue has been running.
+	WorkqueueLongestRunningProcessor = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "longest_running_processor_seconds",
+			Help:      "How many seconds the longest running processor for the work queue has been running.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueRetries exposes the number of items requeued with rate
+	// limiting on each work queue.
+	WorkqueueRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "retries_total",
+			Help:      "Total number of retries handled by the work queue.",
+		},
+		[]string{"name"},
+	)
+)
+
+// workqueueMetricsProvider exposes the metrics of the named client-go work
+// queues used by the controller through Prometheus.
+type workqueueMetricsProvider struct{}
+
+var _ workqueue.MetricsProvider = workqueueMetricsProvider{}
+
+func (workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return WorkqueueDepth.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return WorkqueueAdds.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return WorkqueueLatency.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return WorkqueueWorkDuration.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return WorkqueueUnfinishedWork.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return WorkqueueLongestRunningProcessor.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return WorkqueueRetries.WithLabelValues(name)
+}
+
+// DeleteWorkqueueMetrics deletes the metrics of the work queue of the given
+// name, once the queue is torn down.
+func DeleteWorkqueueMetrics(name string) {
+	WorkqueueDepth.DeleteLabelValues(name)
+	WorkqueueAdds.DeleteLabelValues(name)
+	WorkqueueLatency.DeleteLabelValues(name)
+	WorkqueueWorkDuration.DeleteLabelValues(name)
+	WorkqueueUnfinishedWork.DeleteLabelValues(name)
+	WorkqueueLongestRunningProcessor.DeleteLabelValues(name)
+	WorkqueueRetries.DeleteLabelValues(name)
+}
+
+func registerWorkqueueMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(WorkqueueDepth)
+	registry.MustRegister(WorkqueueAdds)
+	registry.MustRegister(WorkqueueLatency)
+	registry.MustRegister(WorkqueueWorkDuration)
+	registry.MustRegister(WorkqueueUnfinishedWork)
+	registry.MustRegister(WorkqueueLongestRunningProcessor)
+	registry.MustRegister(WorkqueueRetries)
+}
+
+// SetWorkqueueMetricsProvider makes the client-go work queues report their
+// metrics through Prometheus. The provider only applies to the work queues
+// created after it is set, so it must be set before the controller is
+// constructed.
+func SetWorkqueueMetricsProvider() {
+	workqueue.SetProvider(workqueueMetricsProvider{})
+}
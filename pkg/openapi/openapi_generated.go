@@ -31,72 +31,88 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform":                schema_pkg_apis_servicecatalog_v1beta1_AddKeyTransform(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform":           schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig":                schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig":          schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions":            schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig":         schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig":   schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference":         schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBroker":           schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBroker(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo":   schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerList":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerSpec":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerStatus":     schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClass":            schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClass(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassList":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassSpec":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassStatus":      schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlan":             schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlan(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanList":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanSpec":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanStatus":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerSpec":        schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerStatus":      schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassSpec":         schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassStatus":       schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanSpec":          schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanStatus":        schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference":           schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference":                schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource":           schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.PlanReference":                  schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform":             schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform":             schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference":             schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform":                schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBinding":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBinding(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition":        schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingList":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState":  schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingSpec":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingStatus":           schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBroker":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceBroker(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo":          schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition":         schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerCondition(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerList":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerStatus":            schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClass":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassList":               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassSpec":               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassStatus":             schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstance":                schema_pkg_apis_servicecatalog_v1beta1_ServiceInstance(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition":       schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceList":            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState": schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceSpec":            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceStatus":          schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlan":                    schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanList":                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanSpec":                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanStatus":              schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo":                       schema_pkg_apis_servicecatalog_v1beta1_UserInfo(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1.PodPreset":                           schema_pkg_apis_settings_v1alpha1_PodPreset(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1.PodPresetList":                       schema_pkg_apis_settings_v1alpha1_PodPresetList(ref),
-		"github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1.PodPresetSpec":                       schema_pkg_apis_settings_v1alpha1_PodPresetSpec(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                    schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform":                       schema_pkg_apis_servicecatalog_v1beta1_AddKeyTransform(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform":                  schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig":                       schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig":                 schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit":                schema_pkg_apis_servicecatalog_v1beta1_BrokerRequestRateLimit(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy":                     schema_pkg_apis_servicecatalog_v1beta1_BrokerRetryPolicy(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions":                   schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource":                         schema_pkg_apis_servicecatalog_v1beta1_CatalogSource(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary":                        schema_pkg_apis_servicecatalog_v1beta1_CatalogSummary(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig":                schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig":          schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference":                schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBroker":                  schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBroker(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo":          schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerList":              schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerSpec":              schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerStatus":            schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClass":                   schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClass(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassList":               schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassSpec":               schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassStatus":             schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlan":                    schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlan(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanList":                schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanSpec":                schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanStatus":              schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ConfigMapKeyReference":                 schema_pkg_apis_servicecatalog_v1beta1_ConfigMapKeyReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerSpec":               schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerStatus":             schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassSpec":                schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassStatus":              schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanSpec":                 schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanStatus":               schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference":                  schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo":                       schema_pkg_apis_servicecatalog_v1beta1_MaintenanceInfo(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow":                     schema_pkg_apis_servicecatalog_v1beta1_MaintenanceWindow(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference":                       schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource":                  schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersPluginReference":             schema_pkg_apis_servicecatalog_v1beta1_ParametersPluginReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.PlanReference":                         schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform":                    schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform":                    schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference":                    schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTarget":                          schema_pkg_apis_servicecatalog_v1beta1_SecretTarget(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform":                       schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceAccountTokenAuthConfig":         schema_pkg_apis_servicecatalog_v1beta1_ServiceAccountTokenAuthConfig(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBinding":                        schema_pkg_apis_servicecatalog_v1beta1_ServiceBinding(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition":               schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingList":                    schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState":         schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingSpec":                    schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingStatus":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBroker":                         schema_pkg_apis_servicecatalog_v1beta1_ServiceBroker(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition":                schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerCondition(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerList":                     schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec":                     schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerStatus":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig":                schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerTLSConfig(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClass":                          schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceClassCondition(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassList":                      schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassSpec":                      schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassStatus":                    schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstance":                       schema_pkg_apis_servicecatalog_v1beta1_ServiceInstance(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition":              schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceList":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationLease":         schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperationLease(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationTimelineEntry": schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperationTimelineEntry(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState":        schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceReadinessPublish":       schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceReadinessPublish(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceSpec":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceStatus":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlan":                           schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition":                  schema_pkg_apis_servicecatalog_v1beta1_ServicePlanCondition(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanList":                       schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanSpec":                       schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanStatus":                     schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo":                              schema_pkg_apis_servicecatalog_v1beta1_UserInfo(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1.PodPreset":                                  schema_pkg_apis_settings_v1alpha1_PodPreset(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1.PodPresetList":                              schema_pkg_apis_settings_v1alpha1_PodPresetList(ref),
+		"github.com/drycc-addons/service-catalog/pkg/apis/settings/v1alpha1.PodPresetSpec":                              schema_pkg_apis_settings_v1alpha1_PodPresetSpec(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                           schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AppArmorProfile":                             schema_k8sio_api_core_v1_AppArmorProfile(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
@@ -489,6 +505,68 @@ func schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerRequestRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerRequestRateLimit is a token bucket limit of the rate of the requests sent to a broker.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"qps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QPS is the number of requests per second sent to the broker.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of requests that can be sent at once, above QPS. Defaults to QPS.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"qps"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_BrokerRetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BrokerRetryPolicy is an exponential backoff of the retries of the operations of the instances and bindings of a broker.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"initialInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialInterval is the delay before the first retry of a failed request, which is doubled on each following retry. Defaults to 1s when MaxInterval is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInterval is the maximum delay between two retries. Defaults to 20m when InitialInterval is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxElapsedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxElapsedTime is how long the operation of an instance or binding is retried before it fails. If unset, the controller uses its reconciliation retry duration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -542,6 +620,109 @@ func schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_CatalogSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CatalogSource is a source of the catalog of a broker other than its /v2/catalog endpoint, such as a catalog reviewed offline or one served more reliably than by the broker. The catalog is a document in the format of the response of the /v2/catalog endpoint, in JSON or YAML. Exactly one of the members must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef is the key of a ConfigMap holding the catalog. The ConfigMap of a ServiceBroker must be in the namespace of the broker.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ConfigMapKeyReference"),
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTP or HTTPS address of the catalog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ociArtifact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCIArtifact is the reference of an OCI artifact holding the catalog, such as registry.example.com/catalogs/broker:1.0 or registry.example.com/catalogs/broker@sha256:<digest>. The artifact is pulled anonymously, and the catalog is its layer of media type application/vnd.servicecatalog.catalog.v1+json, or its only layer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ConfigMapKeyReference"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_CatalogSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CatalogSummary summarizes the catalog of a broker, so that changes to it can be audited from the broker itself.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"checksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checksum is the SHA-256 checksum of the catalog returned by the broker, before the catalog restrictions are applied.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"classCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassCount is the number of classes offered by the broker, after the catalog restrictions are applied.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"planCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanCount is the number of plans offered by the broker, after the catalog restrictions are applied.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"added": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Added lists the external names of the classes, and of the plans as CLASS/PLAN, added to the catalog by the last fetch that changed the classes or plans offered. At most 10 names are listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"removed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Removed lists the external names of the classes, and of the plans as CLASS/PLAN, removed from the catalog by the last fetch that changed the classes or plans offered. At most 10 names are listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"checksum", "classCount", "planCount"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -764,6 +945,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 							Format:      "byte",
 						},
 					},
+					"tlsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSConfig restricts the TLS versions, cipher suites and server identities used when communicating with this Broker.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig"),
+						},
+					},
 					"relistBehavior": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RelistBehavior specifies the type of relist behavior the catalog should exhibit when relisting ServiceClasses available from a broker.",
@@ -792,18 +979,77 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
 						},
 					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion pins the version of the Open Service Broker API that the controller uses to communicate with this broker, for example \"2.13\". If unset, the controller uses its preferred version, which defaults to the latest version it supports.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows are recurring periods during which the controller defers non-critical operations against the broker. Deletions are never deferred.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+					"operationCallbacks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationCallbacks declares that the broker notifies the controller when an asynchronous operation completes, through the callback URL and token passed in the context of provision and update requests. The controller then polls the last operation of the instances of this broker only when notified, and at the maximum polling interval as a fallback.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bindingRequestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BindingRequestTimeout is the timeout of the bind, unbind and binding last operation requests sent to the broker, which often answers them much faster than provision requests. If unset, the controller uses its default binding request timeout.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"requestRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestRateLimit limits the rate of the requests the controller sends to the broker, such as provision, bind and last operation requests, so that a slow broker is not overwhelmed while many instances are reconciled. If unset, the requests are not limited.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy is the backoff of the retries of the failed provision, update and bind requests sent to the broker, for brokers whose long operations make the default backoff of the controller too noisy. If unset, the controller uses its default backoff and reconciliation retry duration.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy"),
+						},
+					},
+					"catalogSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nCatalogSource is where the catalog of the broker is loaded from instead of its /v2/catalog endpoint. Provision, update, bind and the other requests are still sent to URL. Requires the BrokerCatalogSources feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource"),
+						},
+					},
 					"authInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AuthInfo contains the data that the service catalog should use to authenticate with the ClusterServiceBroker.",
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo"),
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority decides which ClusterServiceBroker a ClusterServiceClass belongs to when several brokers offer a class with the same external ID: the broker with the highest priority wins, and ties go to the broker whose name sorts first. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -855,12 +1101,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref commo
 							Format:      "",
 						},
 					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API used for the requests to this broker, as of the last successful catalog fetch. It is the version the controller sends, not one advertised by the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogSummary summarizes the catalog fetched by the last successful catalog fetch and the classes and plans it added and removed.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary"),
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration", "lastConditionState"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1023,6 +1282,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref common.R
 							Format:      "",
 						},
 					},
+					"allowContextUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowContextUpdates indicates whether the broker accepts update requests for instances of this ServiceClass that change only the context, such as the labels of the instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"externalMetadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalMetadata is a blob of information about the ServiceClass, meant to be user-facing content and display instructions. This field may contain platform-specific conventional values.",
@@ -1065,6 +1331,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref common.R
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"catalogVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogVisibility is whether this ServiceClass is offered to users. Platform admins can set it to Hidden to leave the class out of the listings of svcat and to reject new ServiceInstances of it, without changing the catalog restrictions of the broker. Existing ServiceInstances are not affected. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"clusterServiceBrokerName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ClusterServiceBrokerName is the reference to the Broker that provides this ClusterServiceClass.\n\nImmutable.",
@@ -1097,10 +1370,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref common
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is an array of ServiceClassConditions capturing aspects of the class's status.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition"),
+									},
+								},
+							},
+						},
+					},
+					"conflictingBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingBrokers lists the other brokers whose catalogs offer a class with the same external ID. Their classes are not reconciled while this class belongs to a broker taking precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition"},
 	}
 }
 
@@ -1278,12 +1582,32 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"maintenanceInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceInfo is the maintenance info the broker publishes for this plan in its catalog, as of version 2.15 of the OSB API.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo"),
+						},
+					},
 					"defaultProvisionParameters": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DefaultProvisionParameters are default parameters passed to the broker when an instance of this plan is provisioned. Any parameters defined on the instance are merged with these defaults, with instance-defined parameters taking precedence over defaults.",
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"maxBindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBindings is the number of ServiceBindings each ServiceInstance of this plan may have. When unset, the limit the broker publishes in the plan's external metadata applies, if any. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted, so that it can be set by hand.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"catalogVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogVisibility is whether this ServicePlan is offered to users. Platform admins can set it to Hidden to leave the plan out of the listings of svcat and to reject new ServiceInstances of it, without changing the catalog restrictions of the broker. Existing ServiceInstances are not affected. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"clusterServiceBrokerName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ClusterServiceBrokerName is the name of the ClusterServiceBroker that offers this ClusterServicePlan.",
@@ -1304,7 +1628,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -1323,18 +1647,86 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref common.
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is an array of ServicePlanConditions capturing aspects of the plan's status.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition"),
+									},
+								},
+							},
+						},
+					},
+					"conflictingBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingBrokers lists the other brokers whose catalogs offer a plan with the same external ID. Their plans are not reconciled while this plan belongs to a broker taking precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition"},
 	}
 }
 
-func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_servicecatalog_v1beta1_ConfigMapKeyReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CommonServiceBrokerSpec represents a description of a Broker.",
+				Description: "ConfigMapKeyReference references a key of a ConfigMap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the ConfigMap. Defaults to the namespace of the referencing object, and is required if it is cluster-scoped.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the ConfigMap.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the ConfigMap to select.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CommonServiceBrokerSpec represents a description of a Broker.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"url": {
@@ -1359,6 +1751,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.R
 							Format:      "byte",
 						},
 					},
+					"tlsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSConfig restricts the TLS versions, cipher suites and server identities used when communicating with this Broker.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig"),
+						},
+					},
 					"relistBehavior": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RelistBehavior specifies the type of relist behavior the catalog should exhibit when relisting ServiceClasses available from a broker.",
@@ -1387,12 +1785,64 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.R
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
 						},
 					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion pins the version of the Open Service Broker API that the controller uses to communicate with this broker, for example \"2.13\". If unset, the controller uses its preferred version, which defaults to the latest version it supports.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows are recurring periods during which the controller defers non-critical operations against the broker. Deletions are never deferred.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+					"operationCallbacks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationCallbacks declares that the broker notifies the controller when an asynchronous operation completes, through the callback URL and token passed in the context of provision and update requests. The controller then polls the last operation of the instances of this broker only when notified, and at the maximum polling interval as a fallback.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bindingRequestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BindingRequestTimeout is the timeout of the bind, unbind and binding last operation requests sent to the broker, which often answers them much faster than provision requests. If unset, the controller uses its default binding request timeout.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"requestRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestRateLimit limits the rate of the requests the controller sends to the broker, such as provision, bind and last operation requests, so that a slow broker is not overwhelmed while many instances are reconciled. If unset, the requests are not limited.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy is the backoff of the retries of the failed provision, update and bind requests sent to the broker, for brokers whose long operations make the default backoff of the controller too noisy. If unset, the controller uses its default backoff and reconciliation retry duration.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy"),
+						},
+					},
+					"catalogSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nCatalogSource is where the catalog of the broker is loaded from instead of its /v2/catalog endpoint. Provision, update, bind and the other requests are still sent to URL. Requires the BrokerCatalogSources feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource"),
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1444,12 +1894,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref common
 							Format:      "",
 						},
 					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API used for the requests to this broker, as of the last successful catalog fetch. It is the version the controller sends, not one advertised by the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogSummary summarizes the catalog fetched by the last successful catalog fetch and the classes and plans it added and removed.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary"),
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration", "lastConditionState"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1508,6 +1971,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref common.Re
 							Format:      "",
 						},
 					},
+					"allowContextUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowContextUpdates indicates whether the broker accepts update requests for instances of this ServiceClass that change only the context, such as the labels of the instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"externalMetadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalMetadata is a blob of information about the ServiceClass, meant to be user-facing content and display instructions. This field may contain platform-specific conventional values.",
@@ -1550,6 +2020,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"catalogVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogVisibility is whether this ServiceClass is offered to users. Platform admins can set it to Hidden to leave the class out of the listings of svcat and to reject new ServiceInstances of it, without changing the catalog restrictions of the broker. Existing ServiceInstances are not affected. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"externalName", "externalID", "description", "bindable", "bindingRetrievable", "planUpdatable"},
 			},
@@ -1574,10 +2051,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref common.
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is an array of ServiceClassConditions capturing aspects of the class's status.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition"),
+									},
+								},
+							},
+						},
+					},
+					"conflictingBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingBrokers lists the other brokers whose catalogs offer a class with the same external ID. Their classes are not reconciled while this class belongs to a broker taking precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition"},
 	}
 }
 
@@ -1651,18 +2159,38 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref common.Ref
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"maintenanceInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceInfo is the maintenance info the broker publishes for this plan in its catalog, as of version 2.15 of the OSB API.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo"),
+						},
+					},
 					"defaultProvisionParameters": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DefaultProvisionParameters are default parameters passed to the broker when an instance of this plan is provisioned. Any parameters defined on the instance are merged with these defaults, with instance-defined parameters taking precedence over defaults.",
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"maxBindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBindings is the number of ServiceBindings each ServiceInstance of this plan may have. When unset, the limit the broker publishes in the plan's external metadata applies, if any. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted, so that it can be set by hand.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"catalogVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogVisibility is whether this ServicePlan is offered to users. Platform admins can set it to Hidden to leave the plan out of the listings of svcat and to reject new ServiceInstances of it, without changing the catalog restrictions of the broker. Existing ServiceInstances are not affected. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"externalName", "externalID", "description", "free"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -1681,10 +2209,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref common.R
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is an array of ServicePlanConditions capturing aspects of the plan's status.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition"),
+									},
+								},
+							},
+						},
+					},
+					"conflictingBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingBrokers lists the other brokers whose catalogs offer a plan with the same external ID. Their plans are not reconciled while this plan belongs to a broker taking precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition"},
 	}
 }
 
@@ -1708,6 +2267,81 @@ func schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref common.Refe
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_MaintenanceInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceInfo identifies the version of the software a broker deploys for a plan, as of version 2.15 of the OSB API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the maintenance info, in the format of semantic versioning.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human readable description of the version.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a recurring period during which the controller defers operations against a broker.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression in the standard five-field format (\"minute hour day-of-month month day-of-week\"), evaluated in UTC, that gives the times at which the window starts.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window lasts after each start time.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"deferredOperations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeferredOperations is the list of operations that are deferred while the window is active. Defaults to Relist, Update and Provision.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1748,11 +2382,63 @@ func schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref common.Refe
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"),
 						},
 					},
+					"pluginRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nThe parameters plugin to fetch the parameters from, such as one that looks them up in an external secret manager. The plugin must return a JSON object. Requires the ParametersPlugins feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersPluginReference"),
+						},
+					},
+					"coerce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Coerce maps the names of top-level parameters from this source to the type their values are converted to before they are sent to the broker, so that a secret holding {\"port\": \"5432\"} can provide the integer 5432. Parameters that are missing from the source are ignored.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersPluginReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ParametersPluginReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ParametersPluginReference references a value provided by a parameters plugin installed alongside the controller manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the plugin to fetch the parameters from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key identifying the parameters within the plugin, such as the path of a secret in an external secret manager.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+		},
 	}
 }
 
@@ -1935,6 +2621,51 @@ func schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_SecretTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretTarget is an additional secret a ServiceBinding writes its credentials to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the secret to create in the ServiceBinding's namespace. It must differ from the ServiceBinding's SecretName and from the names of the other targets.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretTransforms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of transformations that should be applied to the credentials associated with the ServiceBinding before they are inserted into this Secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform"),
+									},
+								},
+							},
+						},
+					},
+					"secretType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretType is the type of this Secret. Defaults to Opaque.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secretName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1956,21 +2687,50 @@ func schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref common.Reference
 					},
 					"addKeysFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddKeysFrom represents a transform that merges all the entries of an existing Secret into the credentials Secret",
-							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform"),
+							Description: "AddKeysFrom represents a transform that merges all the entries of an existing Secret into the credentials Secret",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform"),
+						},
+					},
+					"removeKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoveKey represents a transform that removes a credentials Secret entry",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceAccountTokenAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAccountTokenAuthConfig provides config for the authentication of namespaced brokers with the token of a service account.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName is the name of the service account, in the namespace of the broker, the token is requested for. The audience of the token is the URL of the broker.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"removeKey": {
+					"expirationSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RemoveKey represents a transform that removes a credentials Secret entry",
-							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform"),
+							Description: "ExpirationSeconds is the requested lifetime of the token. The token is renewed once 80% of its lifetime has passed. Defaults to 3600, must be at least 600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"serviceAccountName"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform"},
 	}
 }
 
@@ -2221,6 +2981,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							},
 						},
 					},
+					"secretType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretType is the type of the secret named by SecretName, such as kubernetes.io/basic-auth or kubernetes.io/tls, so that the secret can be consumed by controllers that require a specific type. The credentials, after SecretTransforms are applied, must hold the keys the type requires. Defaults to Opaque.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"additionalSecretTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalSecretTargets is a list of further secrets in the ServiceBinding's namespace that will hold the credentials associated with the ServiceBinding. Each target applies its own transformations to the credentials returned by the broker, independently of SecretTransforms.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTarget"),
+									},
+								},
+							},
+						},
+					},
+					"secretConflictPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretConflictPolicy decides what happens when the secret named by SecretName already exists and is not owned by this ServiceBinding. Defaults to Fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretTemplate is the name of a secret template configured in the controller manager. When it is set, the credentials associated with the ServiceBinding are delivered through the objects rendered from the template, such as an ExternalSecret or a PushSecret handled by an external secret store operator, instead of the secret named by SecretName. SecretTransforms are applied to the credentials before the template is rendered.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"externalID": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalID is the identity of this object for use with the OSB API.\n\nImmutable.",
@@ -2235,12 +3030,26 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo"),
 						},
 					},
+					"updateRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateRequests is a strictly increasing, non-negative integer counter that can be manually incremented by a user to renew the binding. The binding is deleted at the broker and bound again, and the new credentials are written to its secrets. It is the only field of the spec that may be changed once the ServiceBinding is created.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"adoptExisting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nAdoptExisting records the ServiceBinding as ready without sending a bind request, for a binding created at the broker outside of Service Catalog whose credentials are already in the secret named by SecretName. ExternalID must be the ID of that binding at the broker, so that it is unbound when the ServiceBinding is deleted. Requires the BindingAdoption feature.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"instanceRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTarget", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2279,6 +3088,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 							Format:      "",
 						},
 					},
+					"lastOperationKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastOperationKey is the operation key the broker returned for the last asynchronous bind or unbind of the ServiceBinding. Unlike LastOperation, it is kept once the operation has finished, so that the operation can be found in the logs of the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"currentOperation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CurrentOperation is the operation the Controller is currently performing on the ServiceBinding.",
@@ -2300,6 +3116,19 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"operationAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationAttempts is the number of requests sent to the broker for the current or, once it has finished, the last operation. The operation fails once the attempts reach the budget set by the MaxAttempts annotation or, without it, by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptTime is the time at which the last request of the current or last operation was sent to the broker, by the clock of the controller that sent it. The controller compares it with its own clock to detect skew between the clocks of the controllers.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"inProgressProperties": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InProgressProperties is the properties state of the ServiceBinding when a Bind is in progress. If the current operation is an Unbind, this will be nil.",
@@ -2336,6 +3165,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 							Format:      "",
 						},
 					},
+					"lastCredentialsRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCredentialsRotationTime is the time at which the credentials of the ServiceBinding were last written to its secrets.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"conditions", "asyncOpInProgress", "reconciledGeneration", "orphanMitigationInProgress", "unbindStatus", "lastConditionState"},
 			},
@@ -2419,11 +3254,17 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref common.Ref
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig"),
 						},
 					},
+					"serviceAccountToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nServiceAccountTokenAuthConfig provides configuration to send a token of a service account in the namespace of the broker as a bearer token. The broker can verify the token with a TokenReview. Requires the BrokerServiceAccountTokenAuth feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceAccountTokenAuthConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceAccountTokenAuthConfig"},
 	}
 }
 
@@ -2559,6 +3400,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref common.Referen
 							Format:      "byte",
 						},
 					},
+					"tlsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSConfig restricts the TLS versions, cipher suites and server identities used when communicating with this Broker.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig"),
+						},
+					},
 					"relistBehavior": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RelistBehavior specifies the type of relist behavior the catalog should exhibit when relisting ServiceClasses available from a broker.",
@@ -2587,6 +3434,58 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref common.Referen
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions"),
 						},
 					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion pins the version of the Open Service Broker API that the controller uses to communicate with this broker, for example \"2.13\". If unset, the controller uses its preferred version, which defaults to the latest version it supports.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows are recurring periods during which the controller defers non-critical operations against the broker. Deletions are never deferred.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+					"operationCallbacks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationCallbacks declares that the broker notifies the controller when an asynchronous operation completes, through the callback URL and token passed in the context of provision and update requests. The controller then polls the last operation of the instances of this broker only when notified, and at the maximum polling interval as a fallback.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bindingRequestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BindingRequestTimeout is the timeout of the bind, unbind and binding last operation requests sent to the broker, which often answers them much faster than provision requests. If unset, the controller uses its default binding request timeout.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"requestRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestRateLimit limits the rate of the requests the controller sends to the broker, such as provision, bind and last operation requests, so that a slow broker is not overwhelmed while many instances are reconciled. If unset, the requests are not limited.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy is the backoff of the retries of the failed provision, update and bind requests sent to the broker, for brokers whose long operations make the default backoff of the controller too noisy. If unset, the controller uses its default backoff and reconciliation retry duration.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy"),
+						},
+					},
+					"catalogSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nCatalogSource is where the catalog of the broker is loaded from instead of its /v2/catalog endpoint. Provision, update, bind and the other requests are still sent to URL. Requires the BrokerCatalogSources feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource"),
+						},
+					},
 					"authInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AuthInfo contains the data that the service catalog should use to authenticate with the ServiceBroker.",
@@ -2598,7 +3497,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRequestRateLimit", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.BrokerRetryPolicy", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceWindow", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerTLSConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2650,12 +3549,90 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref common.Refer
 							Format:      "",
 						},
 					},
+					"osbAPIVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API used for the requests to this broker, as of the last successful catalog fetch. It is the version the controller sends, not one advertised by the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogSummary summarizes the catalog fetched by the last successful catalog fetch and the classes and plans it added and removed.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary"),
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration", "lastConditionState"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogSummary", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerTLSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBrokerTLSConfig restricts the TLS connections to a broker.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinVersion is the minimum TLS version accepted from the broker: \"1.0\", \"1.1\", \"1.2\" or \"1.3\". Defaults to the controller's default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cipherSuites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CipherSuites is the list of cipher suites offered to the broker for TLS 1.2 and earlier, by their IANA names, for example \"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256\". The cipher suites of TLS 1.3 are not configurable. Defaults to the controller's defaults.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"serverDNSNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerDNSNames are the DNS names expected in the serving certificate of the broker. The certificate must hold one of them as a subject alternative name, possibly through a wildcard, or the connection is rejected. This protects against the hijack of the DNS name of the broker URL by a server with another certificate issued by a trusted CA. Cannot be used with insecureSkipTLSVerify.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"serverSPIFFEIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSPIFFEIDs are the SPIFFE IDs expected in the serving certificate of the broker, for example \"spiffe://example.org/ns/brokers/sa/broker\". The certificate must hold one of them as a URI subject alternative name, or the connection is rejected. Cannot be used with insecureSkipTLSVerify.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -2709,6 +3686,60 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceClassCondition contains condition information for a ServiceClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the condition, currently ('Conflict').",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of ('True', 'False', 'Unknown').",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the timestamp corresponding to the last status change of this condition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief machine readable explanation for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the details of the last transition, complementing reason.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status", "lastTransitionTime", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2813,6 +3844,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"allowContextUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowContextUpdates indicates whether the broker accepts update requests for instances of this ServiceClass that change only the context, such as the labels of the instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"externalMetadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalMetadata is a blob of information about the ServiceClass, meant to be user-facing content and display instructions. This field may contain platform-specific conventional values.",
@@ -2855,6 +3893,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"catalogVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogVisibility is whether this ServiceClass is offered to users. Platform admins can set it to Hidden to leave the class out of the listings of svcat and to reject new ServiceInstances of it, without changing the catalog restrictions of the broker. Existing ServiceInstances are not affected. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"serviceBrokerName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ServiceBrokerName is the reference to the Broker that provides this ServiceClass.\n\nImmutable.",
@@ -2887,10 +3932,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref common.Refere
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is an array of ServiceClassConditions capturing aspects of the class's status.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition"),
+									},
+								},
+							},
+						},
+					},
+					"conflictingBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingBrokers lists the other brokers whose catalogs offer a class with the same external ID. Their classes are not reconciled while this class belongs to a broker taking precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassCondition"},
 	}
 }
 
@@ -3052,6 +4128,73 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperationLease(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceInstanceOperationLease records which controller may send requests to the broker for a ServiceInstance's current operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"holderIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HolderIdentity identifies the controller holding the lease.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expireTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireTime is when the lease expires unless the operation ends first.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"holderIdentity", "expireTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceOperationTimelineEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceInstanceOperationTimelineEntry records a phase an operation on a ServiceInstance went through.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase the operation entered.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is when the operation entered the phase.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3102,6 +4245,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref c
 							Format:      "",
 						},
 					},
+					"contextChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContextChecksum is the checksum of the context that was sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"userInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UserInfo is information about the user that made the request.",
@@ -3117,6 +4267,28 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref c
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceReadinessPublish(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceInstanceReadinessPublish is where the readiness of a ServiceInstance is published.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap, in the namespace of the instance, the readiness is written to. The controller creates it, and does not write to a ConfigMap it does not control.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"configMapName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3274,11 +4446,53 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.Refer
 							Format:      "int64",
 						},
 					},
+					"verifyBinding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nVerifyBinding requests that, once the instance is ready, the controller creates and immediately deletes a temporary binding with the broker to check that it issues working credentials. The outcome is reported in the Verified condition. It requires the BindingVerification feature.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human readable description of the instance. It is sent to the broker as instance_description in the OSB context so that broker-side inventories can show something more meaningful than the instance's external ID.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"contextLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContextLabels lists the keys of the instance's labels that are sent to the broker as instance_labels in the OSB context. Labels that are not set on the instance are left out.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"contextNamespaceOverride": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nContextNamespaceOverride is the namespace presented to the broker in the OSB context instead of the instance's own, so that automation can provision from a management namespace on behalf of a tenant. Only users allowed to create ServiceInstances in that namespace may set it. It requires the ContextNamespaceOverride feature.\n\nImmutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"readinessPublish": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nReadinessPublish makes the controller mirror the readiness of the instance, along with connection hints that are not secret, into a ConfigMap of its namespace, so that applications can wait for the instance by mounting it instead of reading the catalog API. It requires the InstanceReadinessPublish feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceReadinessPublish"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceReadinessPublish", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3362,6 +4576,46 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"operationAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationAttempts is the number of requests sent to the broker for the current or, once it has finished, the last operation. The operation fails once the attempts reach the budget set by the MaxAttempts annotation or, without it, by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptTime is the time at which the last request of the current or last operation was sent to the broker, by the clock of the controller that sent it. The controller compares it with its own clock to detect skew between the clocks of the controllers.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"provisionQueuePosition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProvisionQueuePosition is the position, starting at 1, of the ServiceInstance among those waiting to send their provision request while the controller already has as many provisions in flight as it allows. It is unset once the request may be sent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"operationTimeline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationTimeline lists the phases the current or, once it has finished, the last operation went through, oldest first. It is reset when a new operation starts and holds at most the latest ServiceInstanceOperationTimelineMaxLength entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationTimelineEntry"),
+									},
+								},
+							},
+						},
+					},
+					"operationLease": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nOperationLease is held by the controller that is sending the current operation's request to the broker. It requires the OperationLease feature.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationLease"),
+						},
+					},
 					"inProgressProperties": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InProgressProperties is the properties state of the ServiceInstance when a Provision, Update or Deprovision is in progress.",
@@ -3374,6 +4628,26 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState"),
 						},
 					},
+					"brokerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BrokerName is the name of the ClusterServiceBroker or ServiceBroker that the provision request of the ServiceInstance was sent to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"brokerURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BrokerURL is the URL of the broker that the provision request of the ServiceInstance was sent to, as it was when the request was sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceInfo is the maintenance info of the plan of the ServiceInstance when the broker last provisioned the ServiceInstance or moved it to another plan. It is unset if the plan published no maintenance info then.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo"),
+						},
+					},
 					"provisionStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ProvisionStatus describes whether the instance is in the provisioned state.",
@@ -3404,6 +4678,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"printableStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrintableStatus summarizes the status of the ServiceInstance in a single word. It is used for printing in a kubectl output via additionalPrinterColumns",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"userSpecifiedPlanName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UserSpecifiedPlanName aggregates cluster or namespace PlanName It is used for printing in a kubectl output via additionalPrinterColumns",
@@ -3425,7 +4706,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationLease", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceOperationTimelineEntry", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3484,6 +4765,60 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServicePlanCondition contains condition information for a ServicePlan.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the condition, currently ('Conflict').",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of ('True', 'False', 'Unknown').",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the timestamp corresponding to the last status change of this condition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief machine readable explanation for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the details of the last transition, complementing reason.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status", "lastTransitionTime", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3603,12 +4938,32 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref common.Reference
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"maintenanceInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceInfo is the maintenance info the broker publishes for this plan in its catalog, as of version 2.15 of the OSB API.",
+							Ref:         ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo"),
+						},
+					},
 					"defaultProvisionParameters": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DefaultProvisionParameters are default parameters passed to the broker when an instance of this plan is provisioned. Any parameters defined on the instance are merged with these defaults, with instance-defined parameters taking precedence over defaults.",
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"maxBindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBindings is the number of ServiceBindings each ServiceInstance of this plan may have. When unset, the limit the broker publishes in the plan's external metadata applies, if any. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted, so that it can be set by hand.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"catalogVisibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogVisibility is whether this ServicePlan is offered to users. Platform admins can set it to Hidden to leave the plan out of the listings of svcat and to reject new ServiceInstances of it, without changing the catalog restrictions of the broker. Existing ServiceInstances are not affected. Unlike the fields set from the broker's catalog, it is kept when the catalog is relisted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"serviceBrokerName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ServiceBrokerName is the name of the ServiceBroker that offers this ServicePlan.",
@@ -3629,7 +4984,7 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.MaintenanceInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3648,10 +5003,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref common.Referen
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is an array of ServicePlanConditions capturing aspects of the plan's status.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition"),
+									},
+								},
+							},
+						},
+					},
+					"conflictingBrokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingBrokers lists the other brokers whose catalogs offer a plan with the same external ID. Their plans are not reconciled while this plan belongs to a broker taking precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCondition"},
 	}
 }
 
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package paramplugin implements the plugins that parametersFrom sources can
+// fetch parameters from, so that sensitive parameters can be kept in an
+// external secret manager rather than in Kubernetes Secrets.
+//
+// A plugin is an executable named after the plugin in the plugin directory.
+// For every lookup it is run with a JSON encoded Request on its standard
+// input and must write a JSON object holding the parameters to its standard
+// output. A non-zero exit status fails the lookup; anything written to
+// standard error is included in the error.
+package paramplugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Request is the lookup passed to a plugin.
+type Request struct {
+	// Namespace is the namespace of the resource the parameters are for.
+	Namespace string `json:"namespace"`
+	// Key identifies the parameters within the plugin.
+	Key string `json:"key"`
+}
+
+// Registry resolves parameters plugins by name.
+type Registry interface {
+	// Fetch looks up the parameters for req using the named plugin.
+	Fetch(name string, req Request) (map[string]interface{}, error)
+}
+
+// execRegistry runs plugins as executables found in a directory.
+type execRegistry struct {
+	dir     string
+	timeout time.Duration
+}
+
+// NewExecRegistry returns a Registry running the executables in dir as
+// plugins, killing any that runs for longer than timeout.
+func NewExecRegistry(dir string, timeout time.Duration) Registry {
+	return &execRegistry{
+		dir:     dir,
+		timeout: timeout,
+	}
+}
+
+// Fetch runs the named plugin for req and decodes its output.
+func (r *execRegistry) Fetch(name string, req Request) (map[string]interface{}, error) {
+	// Plugin names are restricted to DNS labels so that a reference can
+	// never escape the plugin directory.
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid parameters plugin name %q: %s", name, strings.Join(errs, ", "))
+	}
+	path := filepath.Join(r.dir, name)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("parameters plugin %q not found: %v", name, err)
+	}
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("parameters plugin %q timed out after %v", name, r.timeout)
+		}
+		return nil, fmt.Errorf("parameters plugin %q failed: %v: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	params := make(map[string]interface{})
+	if err := json.Unmarshal(stdout.Bytes(), &params); err != nil {
+		return nil, fmt.Errorf("parameters plugin %q did not return a JSON object: %v", name, err)
+	}
+	return params, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package paramplugin
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writePlugin(t *testing.T, dir, name, script string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatalf("failed to write plugin: %v", err)
+	}
+}
+
+func TestExecRegistryFetch(t *testing.T) {
+	dir := t.TempDir()
+	// echoes the request back so the test can check what the plugin received
+	writePlugin(t, dir, "echo", `cat`)
+	writePlugin(t, dir, "fail", `echo "no such secret" >&2; exit 1`)
+	writePlugin(t, dir, "notjson", `echo "password"`)
+	writePlugin(t, dir, "slow", `sleep 5`)
+
+	registry := NewExecRegistry(dir, time.Second)
+
+	cases := []struct {
+		name     string
+		plugin   string
+		expected map[string]interface{}
+		errorMsg string
+	}{
+		{
+			name:   "success",
+			plugin: "echo",
+			expected: map[string]interface{}{
+				"namespace": "test-ns",
+				"key":       "db/password",
+			},
+		},
+		{
+			name:     "plugin failure",
+			plugin:   "fail",
+			errorMsg: "no such secret",
+		},
+		{
+			name:     "output not a JSON object",
+			plugin:   "notjson",
+			errorMsg: "did not return a JSON object",
+		},
+		{
+			name:     "timeout",
+			plugin:   "slow",
+			errorMsg: "timed out",
+		},
+		{
+			name:     "missing plugin",
+			plugin:   "missing",
+			errorMsg: "not found",
+		},
+		{
+			name:     "plugin outside the plugin directory",
+			plugin:   "../echo",
+			errorMsg: "invalid parameters plugin name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params, err := registry.Fetch(tc.plugin, Request{Namespace: "test-ns", Key: "db/password"})
+			if tc.errorMsg != "" {
+				if err == nil {
+					t.Fatalf("expected an error containing %q", tc.errorMsg)
+				}
+				if !strings.Contains(err.Error(), tc.errorMsg) {
+					t.Fatalf("expected an error containing %q, got %v", tc.errorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.expected, params) {
+				t.Fatalf("unexpected parameters: expected %v, got %v", tc.expected, params)
+			}
+		})
+	}
+}
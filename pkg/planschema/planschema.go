@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package planschema converts the JSON schemas of the parameters of service
+// plans, as published by brokers, to Kubernetes structural schemas, so that
+// tooling that understands CRD schemas can document the parameters.
+package planschema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// Keys of the documentation data of a plan, one for each parameter schema.
+const (
+	InstanceCreateKey = "instance-create.yaml"
+	InstanceUpdateKey = "instance-update.yaml"
+	BindingCreateKey  = "binding-create.yaml"
+)
+
+// Documentation returns the structural schemas of the given parameter
+// schemas of a plan in YAML, keyed by InstanceCreateKey, InstanceUpdateKey
+// and BindingCreateKey. Schemas that are not set are left out.
+func Documentation(instanceCreate, instanceUpdate, bindingCreate *runtime.RawExtension) (map[string]string, error) {
+	data := map[string]string{}
+	for key, schema := range map[string]*runtime.RawExtension{
+		InstanceCreateKey: instanceCreate,
+		InstanceUpdateKey: instanceUpdate,
+		BindingCreateKey:  bindingCreate,
+	} {
+		if schema == nil || len(schema.Raw) == 0 {
+			continue
+		}
+		props, err := Structural(schema.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+		b, err := yaml.Marshal(props)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+		data[key] = string(b)
+	}
+	return data, nil
+}
+
+// Defaults returns the parameters holding the default values that the given
+// parameter schema of a plan specifies for its properties, at any depth of
+// nested objects, or nil when it specifies none. A nested object without a
+// default of its own is included when its properties have defaults.
+func Defaults(schema *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if schema == nil || len(schema.Raw) == 0 {
+		return nil, nil
+	}
+	node := map[string]interface{}{}
+	if err := json.Unmarshal(schema.Raw, &node); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %v", err)
+	}
+	defaults := propertyDefaults(node)
+	if len(defaults) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(defaults)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: b}, nil
+}
+
+func propertyDefaults(node map[string]interface{}) map[string]interface{} {
+	properties, _ := node["properties"].(map[string]interface{})
+	defaults := map[string]interface{}{}
+	for name, v := range properties {
+		property, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, ok := property["default"]; ok {
+			defaults[name] = value
+		} else if nested := propertyDefaults(property); len(nested) > 0 {
+			defaults[name] = nested
+		}
+	}
+	return defaults
+}
+
+// Structural converts a JSON schema to a structural schema: every node has a
+// type, or preserves unknown fields when its type cannot be told. Keywords
+// that structural schemas do not support, such as $ref and the logical
+// junctors, are dropped.
+func Structural(schema []byte) (*apiextensionsv1.JSONSchemaProps, error) {
+	node := map[string]interface{}{}
+	if err := json.Unmarshal(schema, &node); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %v", err)
+	}
+	return convert(node)
+}
+
+func convert(node map[string]interface{}) (*apiextensionsv1.JSONSchemaProps, error) {
+	props := &apiextensionsv1.JSONSchemaProps{}
+	props.Title, _ = node["title"].(string)
+	props.Description, _ = node["description"].(string)
+	props.Format, _ = node["format"].(string)
+	props.Pattern, _ = node["pattern"].(string)
+
+	switch t := node["type"].(type) {
+	case string:
+		props.Type = t
+	case []interface{}:
+		var types []string
+		for _, v := range t {
+			s, _ := v.(string)
+			if s == "null" {
+				props.Nullable = true
+			} else if s != "" {
+				types = append(types, s)
+			}
+		}
+		if len(types) == 1 {
+			props.Type = types[0]
+		}
+	}
+	if props.Type == "" {
+		if _, ok := node["properties"]; ok {
+			props.Type = "object"
+		} else if _, ok := node["items"]; ok {
+			props.Type = "array"
+		}
+	}
+
+	props.Minimum = float(node["minimum"])
+	props.Maximum = float(node["maximum"])
+	props.ExclusiveMinimum, _ = node["exclusiveMinimum"].(bool)
+	props.ExclusiveMaximum, _ = node["exclusiveMaximum"].(bool)
+	props.MultipleOf = float(node["multipleOf"])
+	props.MinLength = integer(node["minLength"])
+	props.MaxLength = integer(node["maxLength"])
+	props.MinItems = integer(node["minItems"])
+	props.MaxItems = integer(node["maxItems"])
+	props.MinProperties = integer(node["minProperties"])
+	props.MaxProperties = integer(node["maxProperties"])
+	props.UniqueItems, _ = node["uniqueItems"].(bool)
+
+	if required, ok := node["required"].([]interface{}); ok {
+		for _, v := range required {
+			if s, ok := v.(string); ok {
+				props.Required = append(props.Required, s)
+			}
+		}
+	}
+	if enum, ok := node["enum"].([]interface{}); ok {
+		for _, v := range enum {
+			raw, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			props.Enum = append(props.Enum, apiextensionsv1.JSON{Raw: raw})
+		}
+	}
+	if v, ok := node["default"]; ok {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		props.Default = &apiextensionsv1.JSON{Raw: raw}
+	}
+
+	switch props.Type {
+	case "object":
+		if err := convertObject(node, props); err != nil {
+			return nil, err
+		}
+	case "array":
+		items, ok := node["items"].(map[string]interface{})
+		if !ok {
+			// Tuples cannot be expressed, so the items are left open.
+			items = map[string]interface{}{}
+		}
+		schema, err := convert(items)
+		if err != nil {
+			return nil, err
+		}
+		props.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: schema}
+	case "":
+		props.XPreserveUnknownFields = boolPtr(true)
+	}
+	return props, nil
+}
+
+// convertObject converts the properties of an object node. Structural
+// schemas cannot combine properties with additional properties, so such an
+// object preserves the fields it does not describe.
+func convertObject(node map[string]interface{}, props *apiextensionsv1.JSONSchemaProps) error {
+	properties, _ := node["properties"].(map[string]interface{})
+	for name, v := range properties {
+		child, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		schema, err := convert(child)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		if props.Properties == nil {
+			props.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+		}
+		props.Properties[name] = *schema
+	}
+
+	switch additional := node["additionalProperties"].(type) {
+	case map[string]interface{}:
+		if len(props.Properties) > 0 {
+			props.XPreserveUnknownFields = boolPtr(true)
+			return nil
+		}
+		schema, err := convert(additional)
+		if err != nil {
+			return err
+		}
+		props.AdditionalProperties = &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: schema}
+	case bool:
+		if additional {
+			props.XPreserveUnknownFields = boolPtr(true)
+		}
+	default:
+		// JSON schema allows additional properties by default.
+		props.XPreserveUnknownFields = boolPtr(true)
+	}
+	return nil
+}
+
+func float(v interface{}) *float64 {
+	f, ok := v.(float64)
+	if !ok {
+		return nil
+	}
+	return &f
+}
+
+func integer(v interface{}) *int64 {
+	f, ok := v.(float64)
+	if !ok {
+		return nil
+	}
+	i := int64(f)
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planschema
+
+import (
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestStructural(t *testing.T) {
+	schema := []byte(`{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["size"],
+		"properties": {
+			"size": {"type": "integer", "minimum": 1, "default": 10, "description": "Size in GB"},
+			"tier": {"type": ["string", "null"], "enum": ["basic", "premium"]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"extra": {"$ref": "#/definitions/extra"}
+		}
+	}`)
+
+	props, err := Structural(schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	minimum := float64(1)
+	preserve := true
+	expected := &apiextensionsv1.JSONSchemaProps{
+		Type:     "object",
+		Required: []string{"size"},
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"size": {
+				Type:        "integer",
+				Description: "Size in GB",
+				Minimum:     &minimum,
+				Default:     &apiextensionsv1.JSON{Raw: []byte("10")},
+			},
+			"tier": {
+				Type:     "string",
+				Nullable: true,
+				Enum:     []apiextensionsv1.JSON{{Raw: []byte(`"basic"`)}, {Raw: []byte(`"premium"`)}},
+			},
+			"tags": {
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			"labels": {
+				Type: "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+					Allows: true,
+					Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+				},
+			},
+			"extra": {XPreserveUnknownFields: &preserve},
+		},
+	}
+	if !reflect.DeepEqual(expected, props) {
+		t.Fatalf("unexpected structural schema:\nexpected %+v\ngot      %+v", expected, props)
+	}
+}
+
+func TestStructuralOpenObject(t *testing.T) {
+	props, err := Structural([]byte(`{"properties": {"name": {"type": "string"}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "object", props.Type; e != a {
+		t.Fatalf("unexpected type: expected %q, got %q", e, a)
+	}
+	if props.XPreserveUnknownFields == nil || !*props.XPreserveUnknownFields {
+		t.Fatal("expected an object allowing additional properties to preserve unknown fields")
+	}
+}
+
+func TestStructuralInvalid(t *testing.T) {
+	if _, err := Structural([]byte(`["not", "a", "schema"]`)); err == nil {
+		t.Fatal("expected an error for a schema that is not an object")
+	}
+}
+
+func TestDocumentation(t *testing.T) {
+	data, err := Documentation(
+		&runtime.RawExtension{Raw: []byte(`{"type": "object", "additionalProperties": false, "properties": {"size": {"type": "integer"}}}`)},
+		nil,
+		&runtime.RawExtension{},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{
+		InstanceCreateKey: "properties:\n  size:\n    type: integer\ntype: object\n",
+	}
+	if !reflect.DeepEqual(expected, data) {
+		t.Fatalf("unexpected documentation:\nexpected %q\ngot      %q", expected, data)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	defaults, err := Defaults(&runtime.RawExtension{Raw: []byte(`{
+		"type": "object",
+		"properties": {
+			"size": {"type": "integer", "default": 10},
+			"name": {"type": "string"},
+			"backup": {
+				"type": "object",
+				"properties": {
+					"enabled": {"type": "boolean", "default": true},
+					"schedule": {"type": "string"}
+				}
+			},
+			"tags": {
+				"type": "object",
+				"default": {"team": "none"},
+				"properties": {"team": {"type": "string", "default": "ops"}}
+			}
+		}
+	}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"backup":{"enabled":true},"size":10,"tags":{"team":"none"}}`
+	if defaults == nil || string(defaults.Raw) != expected {
+		t.Fatalf("unexpected defaults:\nexpected %s\ngot      %v", expected, defaults)
+	}
+}
+
+func TestDefaultsNone(t *testing.T) {
+	for _, schema := range []*runtime.RawExtension{
+		nil,
+		{},
+		{Raw: []byte(`{"type": "object", "properties": {"size": {"type": "integer"}}}`)},
+	} {
+		defaults, err := Defaults(schema)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if defaults != nil {
+			t.Fatalf("expected no defaults, got %s", defaults.Raw)
+		}
+	}
+}
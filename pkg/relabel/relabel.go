@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package relabel periodically checks that the filter labels of the classes,
+// plans and instances hold the hashes of the spec fields they are computed
+// from, and repairs the ones that do not. The labels are set by the mutating
+// webhooks, and the controller relies on them to resolve the classes and
+// plans referenced by external name or ID; a label gone stale, for example
+// because the external name of a class changed while the webhook was
+// unavailable, makes the class unreachable until it is repaired.
+package relabel
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalogclientset "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
+	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/metrics"
+	"github.com/drycc-addons/service-catalog/pkg/util"
+)
+
+// Listers are the listers the labels are checked from.
+type Listers struct {
+	ClusterServiceClasses listers.ClusterServiceClassLister
+	ServiceClasses        listers.ServiceClassLister
+	ClusterServicePlans   listers.ClusterServicePlanLister
+	ServicePlans          listers.ServicePlanLister
+	ServiceInstances      listers.ServiceInstanceLister
+}
+
+// Relabeler repairs the filter labels of the resources of its listers.
+type Relabeler struct {
+	client  servicecatalogclientset.ServicecatalogV1beta1Interface
+	listers Listers
+}
+
+// New creates a Relabeler checking the resources of the given listers and
+// repairing them with client.
+func New(client servicecatalogclientset.ServicecatalogV1beta1Interface, listers Listers) *Relabeler {
+	return &Relabeler{client: client, listers: listers}
+}
+
+// Run checks the labels every interval until stopCh is closed.
+func (r *Relabeler) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		if err := r.check(); err != nil {
+			klog.Warningf("Unable to repair the filter labels: %v", err)
+		}
+	}, interval, stopCh)
+}
+
+// check repairs the labels of every resource whose labels do not match its
+// spec, and returns the errors of the repairs that failed.
+func (r *Relabeler) check() error {
+	return utilerrors.NewAggregate([]error{
+		r.checkClusterServiceClasses(),
+		r.checkServiceClasses(),
+		r.checkClusterServicePlans(),
+		r.checkServicePlans(),
+		r.checkServiceInstances(),
+	})
+}
+
+func (r *Relabeler) checkClusterServiceClasses() error {
+	classes, err := r.listers.ClusterServiceClasses.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, class := range classes {
+		expected := ClusterServiceClassLabels(class)
+		if !stale(class.Labels, expected) {
+			continue
+		}
+		class = class.DeepCopy()
+		class.Labels = merge(class.Labels, expected)
+		_, err := r.client.ClusterServiceClasses().Update(context.Background(), class, metav1.UpdateOptions{})
+		errs = append(errs, repaired("ClusterServiceClass", class, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *Relabeler) checkServiceClasses() error {
+	classes, err := r.listers.ServiceClasses.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, class := range classes {
+		expected := ServiceClassLabels(class)
+		if !stale(class.Labels, expected) {
+			continue
+		}
+		class = class.DeepCopy()
+		class.Labels = merge(class.Labels, expected)
+		_, err := r.client.ServiceClasses(class.Namespace).Update(context.Background(), class, metav1.UpdateOptions{})
+		errs = append(errs, repaired("ServiceClass", class, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *Relabeler) checkClusterServicePlans() error {
+	plans, err := r.listers.ClusterServicePlans.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, plan := range plans {
+		expected := ClusterServicePlanLabels(plan)
+		if !stale(plan.Labels, expected) {
+			continue
+		}
+		plan = plan.DeepCopy()
+		plan.Labels = merge(plan.Labels, expected)
+		_, err := r.client.ClusterServicePlans().Update(context.Background(), plan, metav1.UpdateOptions{})
+		errs = append(errs, repaired("ClusterServicePlan", plan, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *Relabeler) checkServicePlans() error {
+	plans, err := r.listers.ServicePlans.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, plan := range plans {
+		expected := ServicePlanLabels(plan)
+		if !stale(plan.Labels, expected) {
+			continue
+		}
+		plan = plan.DeepCopy()
+		plan.Labels = merge(plan.Labels, expected)
+		_, err := r.client.ServicePlans(plan.Namespace).Update(context.Background(), plan, metav1.UpdateOptions{})
+		errs = append(errs, repaired("ServicePlan", plan, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *Relabeler) checkServiceInstances() error {
+	instances, err := r.listers.ServiceInstances.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, instance := range instances {
+		if instance.DeletionTimestamp != nil {
+			continue
+		}
+		expected := ServiceInstanceLabels(instance)
+		if !stale(instance.Labels, expected) {
+			continue
+		}
+		instance = instance.DeepCopy()
+		instance.Labels = merge(instance.Labels, expected)
+		_, err := r.client.ServiceInstances(instance.Namespace).Update(context.Background(), instance, metav1.UpdateOptions{})
+		errs = append(errs, repaired("ServiceInstance", instance, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// repaired logs and counts the repair of the labels of obj, unless it failed
+// with err, which it returns.
+func repaired(kind string, obj metav1.Object, err error) error {
+	if err != nil {
+		return err
+	}
+	klog.V(2).Infof("Repaired the filter labels of %s %q in namespace %q", kind, obj.GetName(), obj.GetNamespace())
+	metrics.FilterLabelRepairCount.WithLabelValues(kind).Inc()
+	return nil
+}
+
+// stale returns true if any of the expected labels is missing or has
+// another value.
+func stale(actual, expected map[string]string) bool {
+	for key, value := range expected {
+		if actual[key] != value {
+			return true
+		}
+	}
+	return false
+}
+
+// merge sets the expected labels in actual, keeping the other ones.
+func merge(actual, expected map[string]string) map[string]string {
+	if actual == nil {
+		actual = make(map[string]string, len(expected))
+	}
+	for key, value := range expected {
+		actual[key] = value
+	}
+	return actual
+}
+
+// labelSet builds the filter labels of the given fields and values.
+func labelSet(fieldsAndValues ...string) map[string]string {
+	set := make(map[string]string, len(fieldsAndValues)/2)
+	for i := 0; i+1 < len(fieldsAndValues); i += 2 {
+		set[v1beta1.GroupName+"/"+fieldsAndValues[i]] = util.GenerateSHA(fieldsAndValues[i+1])
+	}
+	return set
+}
+
+// ClusterServiceClassLabels returns the filter labels the webhook sets on a
+// ClusterServiceClass.
+func ClusterServiceClassLabels(class *v1beta1.ClusterServiceClass) map[string]string {
+	return labelSet(
+		v1beta1.FilterSpecExternalID, class.Spec.ExternalID,
+		v1beta1.FilterSpecExternalName, class.Spec.ExternalName,
+		v1beta1.FilterSpecClusterServiceBrokerName, class.Spec.ClusterServiceBrokerName,
+	)
+}
+
+// ServiceClassLabels returns the filter labels the webhook sets on a
+// ServiceClass.
+func ServiceClassLabels(class *v1beta1.ServiceClass) map[string]string {
+	return labelSet(
+		v1beta1.FilterSpecExternalID, class.Spec.ExternalID,
+		v1beta1.FilterSpecExternalName, class.Spec.ExternalName,
+		v1beta1.FilterSpecServiceBrokerName, class.Spec.ServiceBrokerName,
+	)
+}
+
+// ClusterServicePlanLabels returns the filter labels the webhook sets on a
+// ClusterServicePlan.
+func ClusterServicePlanLabels(plan *v1beta1.ClusterServicePlan) map[string]string {
+	return labelSet(
+		v1beta1.FilterSpecExternalID, plan.Spec.ExternalID,
+		v1beta1.FilterSpecExternalName, plan.Spec.ExternalName,
+		v1beta1.FilterSpecClusterServiceClassRefName, plan.Spec.ClusterServiceClassRef.Name,
+		v1beta1.FilterSpecClusterServiceBrokerName, plan.Spec.ClusterServiceBrokerName,
+	)
+}
+
+// ServicePlanLabels returns the filter labels the webhook sets on a
+// ServicePlan.
+func ServicePlanLabels(plan *v1beta1.ServicePlan) map[string]string {
+	return labelSet(
+		v1beta1.FilterSpecExternalID, plan.Spec.ExternalID,
+		v1beta1.FilterSpecExternalName, plan.Spec.ExternalName,
+		v1beta1.FilterSpecServiceClassRefName, plan.Spec.ServiceClassRef.Name,
+		v1beta1.FilterSpecServiceBrokerName, plan.Spec.ServiceBrokerName,
+	)
+}
+
+// ServiceInstanceLabels returns the filter labels the webhook sets on a
+// ServiceInstance, which are only those of the references it resolved.
+func ServiceInstanceLabels(instance *v1beta1.ServiceInstance) map[string]string {
+	spec := instance.Spec
+	var fieldsAndValues []string
+	if spec.ClusterServiceClassRef != nil {
+		fieldsAndValues = append(fieldsAndValues, v1beta1.FilterSpecClusterServiceClassRefName, spec.ClusterServiceClassRef.Name)
+		if spec.ClusterServicePlanRef != nil {
+			fieldsAndValues = append(fieldsAndValues, v1beta1.FilterSpecClusterServicePlanRefName, spec.ClusterServicePlanRef.Name)
+		}
+	}
+	if spec.ServiceClassRef != nil {
+		fieldsAndValues = append(fieldsAndValues, v1beta1.FilterSpecServiceClassRefName, spec.ServiceClassRef.Name)
+		if spec.ServicePlanRef != nil {
+			fieldsAndValues = append(fieldsAndValues, v1beta1.FilterSpecServicePlanRefName, spec.ServicePlanRef.Name)
+		}
+	}
+	return labelSet(fieldsAndValues...)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package relabel
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	fakeclientset "github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	listers "github.com/drycc-addons/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/util"
+)
+
+func newIndexer(objects ...runtime.Object) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, object := range objects {
+		indexer.Add(object)
+	}
+	return indexer
+}
+
+func TestCheck(t *testing.T) {
+	upToDate := &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "up-to-date"},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			CommonServiceClassSpec:   v1beta1.CommonServiceClassSpec{ExternalName: "db", ExternalID: "up-to-date"},
+			ClusterServiceBrokerName: "broker",
+		},
+	}
+	upToDate.Labels = ClusterServiceClassLabels(upToDate)
+
+	renamed := &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "renamed"},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			CommonServiceClassSpec:   v1beta1.CommonServiceClassSpec{ExternalName: "new-name", ExternalID: "renamed"},
+			ClusterServiceBrokerName: "broker",
+		},
+	}
+	renamed.Labels = ClusterServiceClassLabels(renamed)
+	renamed.Labels[v1beta1.GroupName+"/"+v1beta1.FilterSpecExternalName] = util.GenerateSHA("old-name")
+	renamed.Labels["app"] = "orders"
+
+	unlabeled := &v1beta1.ServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "unlabeled"},
+		Spec: v1beta1.ServicePlanSpec{
+			CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "small", ExternalID: "unlabeled"},
+			ServiceBrokerName:     "broker",
+			ServiceClassRef:       v1beta1.LocalObjectReference{Name: "queue"},
+		},
+	}
+
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "instance"},
+		Spec: v1beta1.ServiceInstanceSpec{
+			ClusterServiceClassRef: &v1beta1.ClusterObjectReference{Name: "up-to-date"},
+		},
+	}
+	instance.Labels = ServiceInstanceLabels(instance)
+
+	client := fakeclientset.NewSimpleClientset(upToDate, renamed, unlabeled, instance)
+	r := New(client.ServicecatalogV1beta1(), Listers{
+		ClusterServiceClasses: listers.NewClusterServiceClassLister(newIndexer(upToDate, renamed)),
+		ServiceClasses:        listers.NewServiceClassLister(newIndexer()),
+		ClusterServicePlans:   listers.NewClusterServicePlanLister(newIndexer()),
+		ServicePlans:          listers.NewServicePlanLister(newIndexer(unlabeled)),
+		ServiceInstances:      listers.NewServiceInstanceLister(newIndexer(instance)),
+	})
+
+	if err := r.check(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var updated []metav1.Object
+	for _, action := range client.Actions() {
+		update, ok := action.(clientgotesting.UpdateAction)
+		if !ok {
+			t.Fatalf("Unexpected action: %+v", action)
+		}
+		updated = append(updated, update.GetObject().(metav1.Object))
+	}
+	if e, a := 2, len(updated); e != a {
+		t.Fatalf("Unexpected number of updates: expected %v, got %v", e, a)
+	}
+
+	class := updated[0].(*v1beta1.ClusterServiceClass)
+	if e, a := util.GenerateSHA("new-name"), class.Labels[v1beta1.GroupName+"/"+v1beta1.FilterSpecExternalName]; e != a {
+		t.Fatalf("Unexpected external name label: expected %v, got %v", e, a)
+	}
+	if e, a := "orders", class.Labels["app"]; e != a {
+		t.Fatalf("Expected the other labels to be kept: expected %v, got %v", e, a)
+	}
+
+	plan := updated[1].(*v1beta1.ServicePlan)
+	for key, value := range ServicePlanLabels(unlabeled) {
+		if e, a := value, plan.Labels[key]; e != a {
+			t.Fatalf("Unexpected %v label: expected %v, got %v", key, e, a)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secrettemplate delivers the credentials of ServiceBindings through
+// objects rendered from templates, such as the ExternalSecrets or PushSecrets
+// of an external secret store operator, instead of plain Secrets.
+//
+// A secret template is a Go template producing one or more Kubernetes objects
+// in YAML or JSON, separated by "---". It is rendered with a Data. The kinds
+// and names of the objects must not depend on the credentials, because the
+// template is rendered without them to find the objects to delete.
+package secrettemplate
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+)
+
+// templateExtension is the extension of the files holding secret templates.
+const templateExtension = ".yaml"
+
+// Data is what a secret template is rendered with.
+type Data struct {
+	// Name and Namespace are those of the ServiceBinding.
+	Name      string
+	Namespace string
+	// SecretName is the SecretName of the ServiceBinding.
+	SecretName string
+	// Credentials are the credentials of the ServiceBinding, after its
+	// SecretTransforms, serialized as in the data of a Secret. They are
+	// empty when the template is rendered to delete its objects.
+	Credentials map[string]string
+}
+
+var funcs = template.FuncMap{
+	"b64enc": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// Templates holds the secret templates, and creates and deletes the objects
+// rendered from them.
+type Templates struct {
+	templates map[string]*template.Template
+	client    dynamic.Interface
+	mapper    meta.RESTMapper
+}
+
+// New returns the secret templates with the given names and texts, which
+// create and delete their objects with client.
+func New(texts map[string]string, client dynamic.Interface, mapper meta.RESTMapper) (*Templates, error) {
+	templates := make(map[string]*template.Template, len(texts))
+	for name, text := range texts {
+		t, err := template.New(name).Funcs(funcs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret template %q: %v", name, err)
+		}
+		templates[name] = t
+	}
+	return &Templates{templates: templates, client: client, mapper: mapper}, nil
+}
+
+// Load returns the secret templates held in the files of dir, each named
+// after its file without the .yaml extension.
+func Load(dir string, client dynamic.Interface, mapper meta.RESTMapper) (*Templates, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the secret templates: %v", err)
+	}
+	texts := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != templateExtension {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the secret templates: %v", err)
+		}
+		texts[strings.TrimSuffix(entry.Name(), templateExtension)] = string(b)
+	}
+	return New(texts, client, mapper)
+}
+
+// Names returns the sorted names of the secret templates.
+func (t *Templates) Names() []string {
+	names := make([]string, 0, len(t.templates))
+	for name := range t.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render returns the objects rendered from the named template. Each object
+// is placed in the namespace of the ServiceBinding.
+func (t *Templates) Render(name string, data Data) ([]*unstructured.Unstructured, error) {
+	tmpl, ok := t.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("secret template %q is not configured", name)
+	}
+	if data.Credentials == nil {
+		data.Credentials = map[string]string{}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		// The error may quote the credentials, so it is not returned.
+		return nil, fmt.Errorf("unable to render secret template %q", name)
+	}
+
+	var objects []*unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(&buf, buf.Len())
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("secret template %q produced an invalid object", name)
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		if object.GetAPIVersion() == "" || object.GetKind() == "" || object.GetName() == "" {
+			return nil, fmt.Errorf("secret template %q produced an object without apiVersion, kind or name", name)
+		}
+		object.SetNamespace(data.Namespace)
+		objects = append(objects, object)
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("secret template %q produced no objects", name)
+	}
+	return objects, nil
+}
+
+// Apply creates or updates the objects rendered from the named template,
+// controlled by owner. An object that exists and is not controlled by owner
+// is not changed, and an error is returned.
+func (t *Templates) Apply(name string, data Data, owner metav1.OwnerReference) error {
+	objects, err := t.Render(name, data)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		resource, err := t.resource(object)
+		if err != nil {
+			return err
+		}
+		object.SetOwnerReferences([]metav1.OwnerReference{owner})
+
+		existing, err := resource.Get(context.Background(), object.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			if _, err := resource.Create(context.Background(), object, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf(`unable to create %s "%s/%s": %v`, object.GetKind(), object.GetNamespace(), object.GetName(), err)
+			}
+			continue
+		case err != nil:
+			return fmt.Errorf(`unable to get %s "%s/%s": %v`, object.GetKind(), object.GetNamespace(), object.GetName(), err)
+		}
+		if ref := metav1.GetControllerOf(existing); ref == nil || ref.UID != owner.UID {
+			return fmt.Errorf(`%s "%s/%s" is not controlled by %s %q`, object.GetKind(), object.GetNamespace(), object.GetName(), owner.Kind, owner.Name)
+		}
+		object.SetResourceVersion(existing.GetResourceVersion())
+		if _, err := resource.Update(context.Background(), object, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf(`unable to update %s "%s/%s": %v`, object.GetKind(), object.GetNamespace(), object.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Delete deletes the objects rendered from the named template that are
+// controlled by owner.
+func (t *Templates) Delete(name string, data Data, owner metav1.OwnerReference) error {
+	data.Credentials = nil
+	objects, err := t.Render(name, data)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		resource, err := t.resource(object)
+		if err != nil {
+			return err
+		}
+		existing, err := resource.Get(context.Background(), object.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return fmt.Errorf(`unable to get %s "%s/%s": %v`, object.GetKind(), object.GetNamespace(), object.GetName(), err)
+		}
+		if ref := metav1.GetControllerOf(existing); ref == nil || ref.UID != owner.UID {
+			continue
+		}
+		uid := existing.GetUID()
+		err = resource.Delete(context.Background(), object.GetName(), metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf(`unable to delete %s "%s/%s": %v`, object.GetKind(), object.GetNamespace(), object.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// resource returns the client of the resource of object.
+func (t *Templates) resource(object *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := object.GroupVersionKind()
+	mapping, err := t.mapper.RESTMapping(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, gvk.Version)
+	if err != nil {
+		// The kind may have been installed since the mapper was filled.
+		if resettable, ok := t.mapper.(meta.ResettableRESTMapper); ok && meta.IsNoMatchError(err) {
+			resettable.Reset()
+		}
+		return nil, fmt.Errorf("unable to find the resource of %s: %v", gvk, err)
+	}
+	return t.client.Resource(mapping.Resource).Namespace(object.GetNamespace()), nil
+}
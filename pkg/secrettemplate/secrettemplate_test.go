@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrettemplate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+const pushSecretTemplate = `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .SecretName }}
+data:
+{{- range $key, $value := .Credentials }}
+  {{ $key }}: {{ b64enc $value }}
+{{- end }}
+---
+apiVersion: external-secrets.io/v1alpha1
+kind: PushSecret
+metadata:
+  name: {{ .Name }}
+spec:
+  secretStoreRefs:
+  - name: vault
+    kind: ClusterSecretStore
+  selector:
+    secret:
+      name: {{ .SecretName }}
+`
+
+var (
+	secretResource     = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	pushSecretResource = schema.GroupVersionResource{Group: "external-secrets.io", Version: "v1alpha1", Resource: "pushsecrets"}
+)
+
+var testData = Data{
+	Name:        "test-binding",
+	Namespace:   "test-ns",
+	SecretName:  "test-secret",
+	Credentials: map[string]string{"password": "s3cr3t"},
+}
+
+var testOwner = metav1.OwnerReference{
+	APIVersion: "servicecatalog.k8s.io/v1beta1",
+	Kind:       "ServiceBinding",
+	Name:       "test-binding",
+	UID:        "test-binding-uid",
+	Controller: func() *bool { b := true; return &b }(),
+}
+
+func newTestTemplates(t *testing.T, objects ...runtime.Object) (*Templates, *dynamicfake.FakeDynamicClient) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "external-secrets.io", Version: "v1alpha1", Kind: "PushSecret"}, meta.RESTScopeNamespace)
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		secretResource:     "SecretList",
+		pushSecretResource: "PushSecretList",
+	}, objects...)
+	templates, err := New(map[string]string{"push-secret": pushSecretTemplate}, client, mapper)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return templates, client
+}
+
+func TestRender(t *testing.T) {
+	templates, _ := newTestTemplates(t)
+	objects, err := templates.Render("push-secret", testData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 2, len(objects); e != a {
+		t.Fatalf("unexpected number of objects: expected %v, got %v", e, a)
+	}
+	data, _, _ := unstructured.NestedStringMap(objects[0].Object, "data")
+	if e, a := map[string]string{"password": "czNjcjN0"}, data; !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected data: expected %v, got %v", e, a)
+	}
+	for _, object := range objects {
+		if e, a := testData.Namespace, object.GetNamespace(); e != a {
+			t.Errorf("unexpected namespace of %s: expected %v, got %v", object.GetKind(), e, a)
+		}
+	}
+
+	if _, err := templates.Render("vault", testData); err == nil {
+		t.Error("expected an error for a template that is not configured")
+	}
+}
+
+func TestRenderInvalidObject(t *testing.T) {
+	templates, err := New(map[string]string{"invalid": "metadata:\n  name: {{ .Name }}\n"}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := templates.Render("invalid", testData); err == nil {
+		t.Error("expected an error for an object without apiVersion and kind")
+	}
+}
+
+func TestApplyAndDelete(t *testing.T) {
+	templates, client := newTestTemplates(t)
+	if err := templates.Apply("push-secret", testData, testOwner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pushSecret, err := client.Resource(pushSecretResource).Namespace(testData.Namespace).Get(context.Background(), testData.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []metav1.OwnerReference{testOwner}, pushSecret.GetOwnerReferences(); !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected owner references: expected %v, got %v", e, a)
+	}
+
+	// Applying again updates the objects.
+	rotated := testData
+	rotated.Credentials = map[string]string{"password": "rotated"}
+	if err := templates.Apply("push-secret", rotated, testOwner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret, err := client.Resource(secretResource).Namespace(testData.Namespace).Get(context.Background(), testData.SecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if password, _, _ := unstructured.NestedString(secret.Object, "data", "password"); password != "cm90YXRlZA==" {
+		t.Errorf("expected the secret to hold the rotated password, got %q", password)
+	}
+
+	if err := templates.Delete("push-secret", testData, testOwner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, resource := range []schema.GroupVersionResource{secretResource, pushSecretResource} {
+		list, err := client.Resource(resource).Namespace(testData.Namespace).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(list.Items) != 0 {
+			t.Errorf("expected the %s to be deleted", resource.Resource)
+		}
+	}
+}
+
+func TestApplyNotControlled(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("Secret")
+	existing.SetNamespace(testData.Namespace)
+	existing.SetName(testData.SecretName)
+	templates, client := newTestTemplates(t, existing)
+
+	if err := templates.Apply("push-secret", testData, testOwner); err == nil {
+		t.Fatal("expected an error for an object not controlled by the owner")
+	}
+	if err := templates.Delete("push-secret", testData, testOwner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err := client.Resource(secretResource).Namespace(testData.Namespace).Get(context.Background(), testData.SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		t.Error("expected an object not controlled by the owner not to be deleted")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "push-secret.yaml"), []byte(pushSecretTemplate), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("not a template"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	templates, err := Load(dir, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"push-secret"}, templates.Names(); !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected templates: expected %v, got %v", e, a)
+	}
+}
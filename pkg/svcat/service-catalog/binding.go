@@ -26,6 +26,7 @@ import (
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/hashicorp/go-multierror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -104,6 +105,86 @@ func (sdk *SDK) Bind(namespace, bindingName, externalID, instanceName, secretNam
 	return result, nil
 }
 
+// RetryBinding clears the Failed condition of a binding whose bind request
+// failed, so that the controller binds it again with the same secret. It
+// refuses bindings that have not failed, that are being deleted, or whose
+// orphan mitigation or asynchronous operation is still in progress.
+func (sdk *SDK) RetryBinding(ns, name string) (*v1beta1.ServiceBinding, error) {
+	const retries = 3
+	for j := 0; j < retries; j++ {
+		binding, err := sdk.RetrieveBinding(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !sdk.IsBindingFailed(binding):
+			return nil, fmt.Errorf("binding '%s.%s' has not failed", ns, name)
+		case binding.DeletionTimestamp != nil:
+			return nil, fmt.Errorf("binding '%s.%s' is being deleted", ns, name)
+		case binding.Status.OrphanMitigationInProgress:
+			return nil, fmt.Errorf("binding '%s.%s' cannot be retried until its orphan mitigation completes", ns, name)
+		case binding.Status.AsyncOpInProgress:
+			return nil, fmt.Errorf("binding '%s.%s' cannot be retried while an operation is in progress", ns, name)
+		}
+
+		conditions := binding.Status.Conditions[:0]
+		for _, cond := range binding.Status.Conditions {
+			if cond.Type != v1beta1.ServiceBindingConditionFailed {
+				conditions = append(conditions, cond)
+			}
+		}
+		binding.Status.Conditions = conditions
+
+		result, err := sdk.ServiceCatalog().ServiceBindings(ns).UpdateStatus(context.Background(), binding, v1.UpdateOptions{})
+		if err == nil {
+			return result, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not retry binding '%s.%s': %w", ns, name, err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not retry binding '%s.%s' after %d tries", ns, name, retries)
+}
+
+// TouchBinding increments the updateRequests field of a binding, so that the
+// controller renews it: it unbinds from the broker and binds again, writing
+// the new credentials to the secrets of the binding. It refuses bindings that
+// are being deleted, and failed bindings, which the controller does not renew
+// until they are retried.
+func (sdk *SDK) TouchBinding(ns, name string, retries int) error {
+	for j := 0; j < retries; j++ {
+		binding, err := sdk.RetrieveBinding(ns, name)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case binding.DeletionTimestamp != nil:
+			return fmt.Errorf("binding '%s.%s' is being deleted", ns, name)
+		case sdk.IsBindingFailed(binding):
+			return fmt.Errorf("binding '%s.%s' has failed and must be retried instead", ns, name)
+		}
+
+		binding.Spec.UpdateRequests = binding.Spec.UpdateRequests + 1
+
+		_, err = sdk.ServiceCatalog().ServiceBindings(ns).Update(context.Background(), binding, v1.UpdateOptions{})
+		if err == nil {
+			return nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return fmt.Errorf("could not touch binding '%s.%s': %w", ns, name, err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return fmt.Errorf("could not touch binding '%s.%s' after %d tries", ns, name, retries)
+}
+
 // Unbind deletes all bindings associated to an instance.
 func (sdk *SDK) Unbind(ns, instanceName string) ([]types.NamespacedName, error) {
 	instance, err := sdk.RetrieveInstance(ns, instanceName)
@@ -122,6 +203,99 @@ func (sdk *SDK) Unbind(ns, instanceName string) ([]types.NamespacedName, error)
 	return sdk.DeleteBindings(namespacedNames)
 }
 
+// UnbindProgress reports that one of the bindings removed by UnbindAndWait
+// is gone, or could not be removed when Err is set. Done counts the bindings
+// reported so far, out of Total.
+type UnbindProgress struct {
+	Binding types.NamespacedName
+	Err     error
+	Done    int
+	Total   int
+}
+
+// UnbindAndWait deletes all bindings associated to an instance and waits for
+// them to be gone. When progress is not nil, it is called, one call at a
+// time, as each binding is removed or fails to be. The removed bindings are
+// returned along with an error gathering the failures.
+func (sdk *SDK) UnbindAndWait(ns, instanceName string, interval time.Duration, timeout *time.Duration, progress func(UnbindProgress)) ([]types.NamespacedName, error) {
+	instance, err := sdk.RetrieveInstance(ns, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := sdk.RetrieveBindingsByInstance(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutex sync.Mutex
+	var g sync.WaitGroup
+	deleted := []types.NamespacedName(nil)
+	unbindErr := &multierror.Error{
+		ErrorFormat: func(errors []error) string {
+			return joinErrors("error:", errors, "\n  ")
+		},
+	}
+	for _, b := range bindings {
+		g.Add(1)
+		go func(binding types.NamespacedName) {
+			defer g.Done()
+			err := sdk.DeleteBinding(binding.Namespace, binding.Name)
+			if err == nil {
+				err = sdk.waitForBindingToNotExist(binding.Namespace, binding.Name, interval, timeout)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				unbindErr = multierror.Append(unbindErr, err)
+			} else {
+				deleted = append(deleted, binding)
+			}
+			if progress != nil {
+				progress(UnbindProgress{
+					Binding: binding,
+					Err:     err,
+					Done:    len(deleted) + len(unbindErr.Errors),
+					Total:   len(bindings),
+				})
+			}
+		}(types.NamespacedName{Namespace: b.Namespace, Name: b.Name})
+	}
+	g.Wait()
+
+	return deleted, unbindErr.ErrorOrNil()
+}
+
+// waitForBindingToNotExist waits for the binding to be deleted, and fails if
+// the controller gives up unbinding it.
+func (sdk *SDK) waitForBindingToNotExist(ns, name string, interval time.Duration, timeout *time.Duration) error {
+	if timeout == nil {
+		notimeout := time.Duration(math.MaxInt64)
+		timeout = &notimeout
+	}
+
+	var unbindFailed bool
+	err := wait.PollUntilContextTimeout(context.Background(), interval, *timeout, true,
+		func(ctx context.Context) (bool, error) {
+			binding, err := sdk.ServiceCatalog().ServiceBindings(ns).Get(ctx, name, v1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					return true, nil
+				}
+				return true, err
+			}
+			unbindFailed = binding.Status.UnbindStatus == v1beta1.ServiceBindingUnbindStatusFailed
+			return unbindFailed, nil
+		})
+	if err != nil {
+		return fmt.Errorf("waiting for binding %s/%s to be deleted failed: %w", ns, name, err)
+	}
+	if unbindFailed {
+		return fmt.Errorf("could not delete binding %s/%s", ns, name)
+	}
+	return nil
+}
+
 // DeleteBindings deletes bindings by name.
 func (sdk *SDK) DeleteBindings(bindings []types.NamespacedName) ([]types.NamespacedName, error) {
 	var g sync.WaitGroup
@@ -174,6 +174,80 @@ var _ = Describe("Binding", func() {
 		})
 	})
 
+	Describe("RetryBinding", func() {
+		It("Clears the Failed condition of a failed binding", func() {
+			sb.Status.Conditions = []v1beta1.ServiceBindingCondition{
+				{Type: v1beta1.ServiceBindingConditionReady, Status: v1beta1.ConditionFalse, Reason: "BindCallFailed"},
+				{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue, Reason: "BindCallFailed"},
+			}
+			svcCatClient = fake.NewSimpleClientset(sb, sb2)
+			sdk = &SDK{
+				ServiceCatalogClient: svcCatClient,
+			}
+
+			binding, err := sdk.RetryBinding(sb.Namespace, sb.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binding.Status.Conditions).To(Equal(sb.Status.Conditions[:1]))
+
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("get", "servicebindings")).To(BeTrue())
+			Expect(actions[1].Matches("update", "servicebindings")).To(BeTrue())
+			Expect(actions[1].GetSubresource()).To(Equal("status"))
+		})
+		It("Refuses bindings that have not failed", func() {
+			_, err := sdk.RetryBinding(sb.Namespace, sb.Name)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("has not failed"))
+			Expect(svcCatClient.Actions()).To(HaveLen(1))
+		})
+		It("Refuses bindings whose orphan mitigation is in progress", func() {
+			sb.Status.Conditions = []v1beta1.ServiceBindingCondition{
+				{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue},
+			}
+			sb.Status.OrphanMitigationInProgress = true
+			svcCatClient = fake.NewSimpleClientset(sb)
+			sdk = &SDK{
+				ServiceCatalogClient: svcCatClient,
+			}
+
+			_, err := sdk.RetryBinding(sb.Namespace, sb.Name)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("orphan mitigation"))
+			Expect(svcCatClient.Actions()).To(HaveLen(1))
+		})
+	})
+
+	Describe("TouchBinding", func() {
+		It("Increments the updateRequests of the binding", func() {
+			Expect(sdk.TouchBinding(sb.Namespace, sb.Name, 3)).To(Succeed())
+
+			actions := svcCatClient.Actions()
+			Expect(actions).To(HaveLen(2))
+			Expect(actions[0].Matches("get", "servicebindings")).To(BeTrue())
+			Expect(actions[1].Matches("update", "servicebindings")).To(BeTrue())
+			update, ok := actions[1].(testing.UpdateActionImpl)
+			Expect(ok).To(BeTrue())
+			obj, ok := update.Object.(*v1beta1.ServiceBinding)
+			Expect(ok).To(BeTrue())
+			Expect(obj.Name).To(Equal(sb.Name))
+			Expect(obj.Spec.UpdateRequests).To(Equal(int64(1)))
+		})
+		It("Refuses failed bindings", func() {
+			sb.Status.Conditions = []v1beta1.ServiceBindingCondition{
+				{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue},
+			}
+			svcCatClient = fake.NewSimpleClientset(sb)
+			sdk = &SDK{
+				ServiceCatalogClient: svcCatClient,
+			}
+
+			err := sdk.TouchBinding(sb.Namespace, sb.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("must be retried instead"))
+			Expect(svcCatClient.Actions()).To(HaveLen(1))
+		})
+	})
+
 	Describe("Unbind", func() {
 		It("Calls the generated v1beta1 method to delete a binding", func() {
 			instanceNamespace := sb.Namespace
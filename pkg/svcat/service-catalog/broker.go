@@ -21,9 +21,13 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"reflect"
 	"time"
 
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/util/tlsconfig"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -33,6 +37,10 @@ import (
 // and a servicebroker with the same name
 const MultipleBrokersFoundError = "more than one broker found"
 
+// errorFetchingCatalogReason is the reason the controller sets on a broker's
+// Ready condition when it fails to fetch the broker's catalog.
+const errorFetchingCatalogReason = "ErrorFetchingCatalog"
+
 // Broker provides a unifying layer of cluster and namespace scoped broker resources.
 type Broker interface {
 
@@ -42,6 +50,12 @@ type Broker interface {
 	// GetNamespace returns the broker's namespace, or "" if it's cluster-scoped.
 	GetNamespace() string
 
+	// GetGeneration returns the generation of the broker's spec.
+	GetGeneration() int64
+
+	// GetResourceVersion returns the broker's resource version.
+	GetResourceVersion() string
+
 	// GetURL returns the broker's URL.
 	GetURL() string
 
@@ -50,6 +64,11 @@ type Broker interface {
 
 	// GetStatus returns the broker's status.
 	GetStatus() v1beta1.CommonServiceBrokerStatus
+
+	// GetAuthType returns the mechanism used to authenticate to the broker,
+	// "basic", "bearer" or "serviceAccountToken", or "" when there is none.
+	// The credentials themselves are never exposed.
+	GetAuthType() string
 }
 
 // Deregister deletes a broker
@@ -172,6 +191,130 @@ func (sdk *SDK) RetrieveBrokerByClass(class *v1beta1.ClusterServiceClass,
 	return broker, nil
 }
 
+// brokerCatalogTimeout is how long RetrieveBrokerCatalog waits for a broker
+// to return its catalog.
+const brokerCatalogTimeout = 60 * time.Second
+
+// serviceAccountTokenExpirationSeconds is the lifetime of the service
+// account token RetrieveBrokerCatalog requests for a broker authenticating
+// with one, the shortest the TokenRequest API issues.
+const serviceAccountTokenExpirationSeconds int64 = 600
+
+// RetrieveBrokerCatalog fetches the catalog the broker currently
+// advertises, as the controller does when it relists the broker. It
+// authenticates with the secret referenced by the broker, or with a token of
+// its service account, so it requires permission to read that secret or to
+// create that token.
+func (sdk *SDK) RetrieveBrokerCatalog(broker Broker) (*osb.CatalogResponse, error) {
+	authConfig, err := sdk.retrieveBrokerAuthConfig(broker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the credentials of broker %s (%s)", broker.GetName(), err)
+	}
+
+	spec := broker.GetSpec()
+	config := osb.DefaultClientConfiguration()
+	config.Name = broker.GetName()
+	config.URL = spec.URL
+	config.AuthConfig = authConfig
+	config.EnableAlphaFeatures = true
+	config.Insecure = spec.InsecureSkipTLSVerify
+	config.CAData = spec.CABundle
+	config.TimeoutSeconds = int(brokerCatalogTimeout.Seconds())
+	if spec.TLSConfig != nil {
+		config.TLSConfig, err = tlsconfig.New(spec.TLSConfig.MinVersion, spec.TLSConfig.CipherSuites)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS configuration of broker %s (%s)", broker.GetName(), err)
+		}
+	}
+	client, err := osb.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := client.GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the catalog of broker %s (%s)", broker.GetName(), err)
+	}
+	return catalog, nil
+}
+
+// retrieveBrokerAuthConfig returns the credentials the broker is called
+// with, read from the secret its auth info references, or nil if it has
+// none.
+func (sdk *SDK) retrieveBrokerAuthConfig(broker Broker) (*osb.AuthConfig, error) {
+	var namespace, basicSecret, bearerSecret, serviceAccount string
+	switch b := broker.(type) {
+	case *v1beta1.ClusterServiceBroker:
+		if b.Spec.AuthInfo == nil {
+			return nil, nil
+		}
+		if basic := b.Spec.AuthInfo.Basic; basic != nil && basic.SecretRef != nil {
+			namespace, basicSecret = basic.SecretRef.Namespace, basic.SecretRef.Name
+		} else if bearer := b.Spec.AuthInfo.Bearer; bearer != nil && bearer.SecretRef != nil {
+			namespace, bearerSecret = bearer.SecretRef.Namespace, bearer.SecretRef.Name
+		}
+	case *v1beta1.ServiceBroker:
+		if b.Spec.AuthInfo == nil {
+			return nil, nil
+		}
+		namespace = b.Namespace
+		if basic := b.Spec.AuthInfo.Basic; basic != nil && basic.SecretRef != nil {
+			basicSecret = basic.SecretRef.Name
+		} else if bearer := b.Spec.AuthInfo.Bearer; bearer != nil && bearer.SecretRef != nil {
+			bearerSecret = bearer.SecretRef.Name
+		} else if token := b.Spec.AuthInfo.ServiceAccountToken; token != nil {
+			serviceAccount = token.ServiceAccountName
+		}
+	default:
+		return nil, nil
+	}
+
+	switch {
+	case basicSecret != "":
+		secret, err := sdk.Core().Secrets(namespace).Get(context.Background(), basicSecret, v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		username, ok := secret.Data["username"]
+		if !ok {
+			return nil, fmt.Errorf("auth secret %s/%s has no username", namespace, basicSecret)
+		}
+		password, ok := secret.Data["password"]
+		if !ok {
+			return nil, fmt.Errorf("auth secret %s/%s has no password", namespace, basicSecret)
+		}
+		return &osb.AuthConfig{
+			BasicAuthConfig: &osb.BasicAuthConfig{Username: string(username), Password: string(password)},
+		}, nil
+	case bearerSecret != "":
+		secret, err := sdk.Core().Secrets(namespace).Get(context.Background(), bearerSecret, v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		token, ok := secret.Data["token"]
+		if !ok {
+			return nil, fmt.Errorf("auth secret %s/%s has no token", namespace, bearerSecret)
+		}
+		return &osb.AuthConfig{
+			BearerConfig: &osb.BearerConfig{Token: string(token)},
+		}, nil
+	case serviceAccount != "":
+		expirationSeconds := serviceAccountTokenExpirationSeconds
+		request, err := sdk.Core().ServiceAccounts(namespace).CreateToken(context.Background(), serviceAccount, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				Audiences:         []string{broker.GetURL()},
+				ExpirationSeconds: &expirationSeconds,
+			},
+		}, v1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &osb.AuthConfig{
+			BearerConfig: &osb.BearerConfig{Token: request.Status.Token},
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported auth info")
+}
+
 // Register creates a broker
 func (sdk *SDK) Register(brokerName string, url string, opts *RegisterOptions, scopeOpts *ScopeOptions) (Broker, error) {
 	var err error
@@ -330,6 +473,110 @@ func (sdk *SDK) WaitForBroker(name string, opts *ScopeOptions, interval time.Dur
 	return broker, err
 }
 
+// WaitForBrokerRelist waits for the controller to relist the specified
+// broker after a sync has been requested, and returns an error if the
+// broker's catalog could not be fetched.
+func (sdk *SDK) WaitForBrokerRelist(name string, opts ScopeOptions, interval time.Duration, timeout *time.Duration) (broker Broker, err error) {
+	if timeout == nil {
+		notimeout := time.Duration(math.MaxInt64)
+		timeout = &notimeout
+	}
+	requested, err := sdk.RetrieveBrokerByID(name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	broker = requested
+	var fetchErr error
+	err = wait.PollUntilContextTimeout(context.Background(), interval, *timeout, true,
+		func(context.Context) (bool, error) {
+			broker, err = sdk.RetrieveBrokerByID(name, opts)
+			if err != nil {
+				return false, err
+			}
+
+			if broker.GetStatus().ReconciledGeneration >= requested.GetGeneration() {
+				fetchErr = nil
+				return true, nil
+			}
+			fetchErr = brokerFetchError(broker)
+			if sdk.IsBrokerFailed(broker) {
+				return true, nil
+			}
+			// A fetch error that was already reported before the sync was
+			// requested is only conclusive once the controller has updated the
+			// broker again.
+			return fetchErr != nil && broker.GetResourceVersion() != requested.GetResourceVersion(), nil
+		})
+	if fetchErr != nil {
+		return broker, fetchErr
+	}
+	return broker, err
+}
+
+// BrokerRelistError is returned by WaitForBrokerRelist when the controller
+// failed to fetch the broker's catalog.
+type BrokerRelistError struct {
+	Broker  string
+	Message string
+}
+
+func (e *BrokerRelistError) Error() string {
+	return fmt.Sprintf("relist of broker %s failed (%s)", e.Broker, e.Message)
+}
+
+// brokerFetchError returns the error reported by the controller when it last
+// failed to fetch the broker's catalog, if the broker is not ready.
+func brokerFetchError(broker Broker) error {
+	for _, cond := range broker.GetStatus().Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionReady && cond.Status == v1beta1.ConditionFalse &&
+			cond.Reason == errorFetchingCatalogReason {
+			return &BrokerRelistError{Broker: broker.GetName(), Message: cond.Message}
+		}
+	}
+	return nil
+}
+
+// BrokerRelistResult counts the classes that a broker relist added, removed
+// or changed.
+type BrokerRelistResult struct {
+	Added   int
+	Removed int
+	Changed int
+}
+
+// CompareClasses compares the classes of a broker before and after a relist.
+// Classes the broker no longer offers count as removed even while they are
+// kept around for existing instances.
+func CompareClasses(before, after []Class) BrokerRelistResult {
+	key := func(class Class) string {
+		return class.GetNamespace() + "/" + class.GetName()
+	}
+	previous := make(map[string]Class, len(before))
+	for _, class := range before {
+		if !class.GetStatus().RemovedFromBrokerCatalog {
+			previous[key(class)] = class
+		}
+	}
+
+	var result BrokerRelistResult
+	for _, class := range after {
+		if class.GetStatus().RemovedFromBrokerCatalog {
+			continue
+		}
+		old, ok := previous[key(class)]
+		switch {
+		case !ok:
+			result.Added++
+		case !reflect.DeepEqual(old.GetSpec(), class.GetSpec()):
+			result.Changed++
+		}
+		delete(previous, key(class))
+	}
+	result.Removed = len(previous)
+	return result
+}
+
 // IsBrokerReady returns if the broker is in the Ready status.
 func (sdk *SDK) IsBrokerReady(broker Broker) bool {
 	return sdk.BrokerHasStatus(broker, v1beta1.ServiceBrokerConditionReady)
@@ -18,13 +18,17 @@ package servicecatalog_test
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 
 	. "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
@@ -415,6 +419,57 @@ var _ = Describe("Broker", func() {
 			Expect(actions[0].(testing.GetActionImpl).Name).To(Equal(brokerName))
 		})
 	})
+	Describe("RetrieveBrokerCatalog", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				username, password, ok := r.BasicAuth()
+				if r.URL.Path != "/v2/catalog" || !ok || username != "admin" || password != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					w.Write([]byte(`{}`))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"services":[{"id":"class-id","name":"mysql","description":"MySQL","bindable":true,"plans":[{"id":"plan-id","name":"small","description":"Small"}]}]}`))
+			}))
+			csb.Spec.URL = server.URL
+			csb.Spec.AuthInfo = &v1beta1.ClusterServiceBrokerAuthInfo{
+				Basic: &v1beta1.ClusterBasicAuthConfig{
+					SecretRef: &v1beta1.ObjectReference{Namespace: "catalog", Name: "broker-auth"},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("fetches the catalog with the credentials of the broker's auth secret", func() {
+			sdk.K8sClient = k8sfake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "catalog", Name: "broker-auth"},
+				Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+			})
+
+			catalog, err := sdk.RetrieveBrokerCatalog(csb)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(catalog.Services).To(HaveLen(1))
+			Expect(catalog.Services[0].Name).To(Equal("mysql"))
+			Expect(catalog.Services[0].Plans).To(HaveLen(1))
+			Expect(catalog.Services[0].Plans[0].Name).To(Equal("small"))
+		})
+
+		It("Bubbles up errors getting the auth secret", func() {
+			sdk.K8sClient = k8sfake.NewSimpleClientset()
+
+			catalog, err := sdk.RetrieveBrokerCatalog(csb)
+
+			Expect(catalog).To(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("unable to get the credentials of broker foobar"))
+		})
+	})
 	Describe("Register", func() {
 		It("creates a namespaced broker by calling the v1beta1 Create method with the passed in arguments", func() {
 			brokerName := "potato_broker"
@@ -810,4 +865,82 @@ var _ = Describe("Broker", func() {
 
 		})
 	})
+	Describe("WaitForBrokerRelist", func() {
+		var (
+			interval time.Duration
+			timeout  time.Duration
+			relisted *v1beta1.ClusterServiceBroker
+		)
+		BeforeEach(func() {
+			interval = 10 * time.Millisecond
+			timeout = 1 * time.Second
+			csb.Generation = 2
+			csb.Status.ReconciledGeneration = 1
+			relisted = csb.DeepCopy()
+			relisted.Status.ReconciledGeneration = 2
+		})
+
+		It("waits until the controller has reconciled the requested generation", func() {
+			counter := 0
+			svcCatClient.PrependReactor("get", "clusterservicebrokers", func(action testing.Action) (bool, runtime.Object, error) {
+				counter++
+				if counter > 3 {
+					return true, relisted, nil
+				}
+				return true, csb, nil
+			})
+
+			broker, err := sdk.WaitForBrokerRelist(csb.Name, ScopeOptions{Scope: ClusterScope}, interval, &timeout)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(broker.GetStatus().ReconciledGeneration).To(Equal(int64(2)))
+		})
+
+		It("returns the fetch error once the controller reports it", func() {
+			failed := csb.DeepCopy()
+			failed.ResourceVersion = "2"
+			failed.Status.Conditions = []v1beta1.ServiceBrokerCondition{{
+				Type:    v1beta1.ServiceBrokerConditionReady,
+				Status:  v1beta1.ConditionFalse,
+				Reason:  "ErrorFetchingCatalog",
+				Message: "connection refused",
+			}}
+			counter := 0
+			svcCatClient.PrependReactor("get", "clusterservicebrokers", func(action testing.Action) (bool, runtime.Object, error) {
+				counter++
+				if counter > 1 {
+					return true, failed, nil
+				}
+				return true, csb, nil
+			})
+
+			_, err := sdk.WaitForBrokerRelist(csb.Name, ScopeOptions{Scope: ClusterScope}, interval, &timeout)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Describe("CompareClasses", func() {
+		It("counts added, removed and changed classes", func() {
+			class := func(name, description string, removed bool) Class {
+				c := &v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				c.Spec.Description = description
+				c.Status.RemovedFromBrokerCatalog = removed
+				return c
+			}
+			before := []Class{
+				class("kept", "same", false),
+				class("changed", "old", false),
+				class("dropped", "gone", false),
+				class("deprecated", "old", false),
+			}
+			after := []Class{
+				class("kept", "same", false),
+				class("changed", "new", false),
+				class("deprecated", "old", true),
+				class("new", "new", false),
+			}
+
+			Expect(CompareClasses(before, after)).To(Equal(BrokerRelistResult{Added: 1, Removed: 2, Changed: 1}))
+		})
+	})
 })
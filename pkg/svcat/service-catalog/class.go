@@ -61,6 +61,9 @@ type Class interface {
 	// GetSpec returns the spec.
 	GetSpec() v1beta1.CommonServiceClassSpec
 
+	// GetStatus returns the status.
+	GetStatus() v1beta1.CommonServiceClassStatus
+
 	// GetServiceBrokerName returns the name of the service
 	// broker for the class.
 	GetServiceBrokerName() string
@@ -70,6 +73,9 @@ type Class interface {
 
 	// IsClusterServiceCLass returns true if the class is a ClusterServiceClass
 	IsClusterServiceClass() bool
+
+	// IsHidden returns true if a platform admin has hidden the class.
+	IsHidden() bool
 }
 
 // RetrieveClasses lists all classes defined in the cluster.
@@ -110,6 +116,17 @@ func (sdk *SDK) RetrieveClasses(opts ScopeOptions, brokerFilter string) ([]Class
 	return classes, nil
 }
 
+// VisibleClasses returns the classes that a platform admin has not hidden.
+func VisibleClasses(classes []Class) []Class {
+	var visible []Class
+	for _, class := range classes {
+		if !class.IsHidden() {
+			visible = append(visible, class)
+		}
+	}
+	return visible
+}
+
 // RetrieveClassByName gets a class by its external name.
 func (sdk *SDK) RetrieveClassByName(name string, opts ScopeOptions) (Class, error) {
 	var searchResults []Class
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// planParameterNamesCache holds the parameter names of the plans looked up
+// for shell completion, by class, plan and namespace.
+type planParameterNamesCache struct {
+	mu    sync.Mutex
+	names map[string][]string
+}
+
+// RetrievePlanParameterNames returns the sorted names of the properties of
+// the provisioning schema of a plan, to complete the --param keys of a
+// command. The class and plan are looked up by their external names, or by
+// their Kubernetes names with lookupByKubeName, like svcat provision does.
+// The names are cached by the SDK, so the schema of a plan is only fetched
+// once.
+func (sdk *SDK) RetrievePlanParameterNames(className, planName string, lookupByKubeName bool, scopeOpts ScopeOptions) ([]string, error) {
+	key := fmt.Sprintf("%s/%s/%s/%t", scopeOpts.Namespace, className, planName, lookupByKubeName)
+
+	sdk.planParameterNames.mu.Lock()
+	defer sdk.planParameterNames.mu.Unlock()
+	if names, found := sdk.planParameterNames.names[key]; found {
+		return names, nil
+	}
+
+	plan, err := sdk.retrievePlanForCompletion(className, planName, lookupByKubeName, scopeOpts)
+	if err != nil {
+		return nil, err
+	}
+	names, err := schemaPropertyNames(plan.GetInstanceCreateSchema())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the provisioning schema of plan '%s' (%s)", planName, err)
+	}
+
+	if sdk.planParameterNames.names == nil {
+		sdk.planParameterNames.names = map[string][]string{}
+	}
+	sdk.planParameterNames.names[key] = names
+	return names, nil
+}
+
+// retrievePlanForCompletion gets the plan of a class by the names given on
+// the command line.
+func (sdk *SDK) retrievePlanForCompletion(className, planName string, lookupByKubeName bool, scopeOpts ScopeOptions) (Plan, error) {
+	var class Class
+	var err error
+	if lookupByKubeName {
+		class, err = sdk.RetrieveClassByID(className, scopeOpts)
+	} else {
+		class, err = sdk.RetrieveClassByName(className, scopeOpts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if class.IsClusterServiceClass() {
+		scopeOpts.Scope = ClusterScope
+	} else {
+		scopeOpts.Scope = NamespaceScope
+	}
+	if lookupByKubeName {
+		return sdk.RetrievePlanByID(planName, scopeOpts)
+	}
+	return sdk.RetrievePlanByClassIDAndName(class.GetName(), planName, scopeOpts)
+}
+
+// schemaPropertyNames returns the sorted names of the top-level properties
+// of a JSON schema.
+func schemaPropertyNames(schema *runtime.RawExtension) ([]string, error) {
+	if schema == nil || len(schema.Raw) == 0 {
+		return []string{}, nil
+	}
+	var s struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(schema.Raw, &s); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	. "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Completion", func() {
+	var (
+		sdk          *SDK
+		svcCatClient *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		csc := &v1beta1.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "someclass"},
+		}
+		csp := &v1beta1.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "someplan"},
+			Spec: v1beta1.ClusterServicePlanSpec{
+				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+					InstanceCreateParameterSchema: &runtime.RawExtension{
+						Raw: []byte(`{"type":"object","properties":{"size":{"type":"integer"},"location":{"type":"string"}}}`),
+					},
+				},
+				ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: csc.Name},
+			},
+		}
+		svcCatClient = fake.NewSimpleClientset(csc, csp)
+		sdk = &SDK{
+			ServiceCatalogClient: svcCatClient,
+		}
+	})
+
+	Describe("RetrievePlanParameterNames", func() {
+		It("Returns the sorted properties of the provisioning schema of the plan", func() {
+			names, err := sdk.RetrievePlanParameterNames("someclass", "someplan", true, ScopeOptions{Scope: AllScope})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"location", "size"}))
+		})
+		It("Caches the names of a plan", func() {
+			_, err := sdk.RetrievePlanParameterNames("someclass", "someplan", true, ScopeOptions{Scope: AllScope})
+			Expect(err).NotTo(HaveOccurred())
+			actions := len(svcCatClient.Actions())
+
+			names, err := sdk.RetrievePlanParameterNames("someclass", "someplan", true, ScopeOptions{Scope: AllScope})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"location", "size"}))
+			Expect(svcCatClient.Actions()).To(HaveLen(actions))
+		})
+		It("Bubbles up errors", func() {
+			_, err := sdk.RetrievePlanParameterNames("someclass", "missingplan", true, ScopeOptions{Scope: AllScope})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControllerLogsAnnotation is the annotation of the controller manager pods
+// that names the container whose logs hold the logs of the controller.
+const ControllerLogsAnnotation = "servicecatalog.k8s.io/debug-logs-container"
+
+// RetrieveControllerLogsByInstance gets the lines of the logs of the
+// controller manager pods in a namespace that mention an instance, by name
+// or by external ID. Only the last tailLines lines of the log of each pod
+// are searched, or all of them if tailLines is 0. The controller manager
+// pods are the pods annotated with ControllerLogsAnnotation.
+func (sdk *SDK) RetrieveControllerLogsByInstance(namespace string, instance *v1beta1.ServiceInstance, tailLines int64) ([]string, error) {
+	pods, err := sdk.Core().Pods(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the pods in %s (%s)", namespace, err)
+	}
+
+	name := pretty.NewContextBuilder(pretty.ServiceInstance, instance.Namespace, instance.Name, "").String()
+	var lines []string
+	found := false
+	for _, pod := range pods.Items {
+		container, ok := pod.Annotations[ControllerLogsAnnotation]
+		if !ok {
+			continue
+		}
+		found = true
+
+		opts := &corev1.PodLogOptions{Container: container}
+		if tailLines > 0 {
+			opts.TailLines = &tailLines
+		}
+		stream, err := sdk.Core().Pods(namespace).GetLogs(pod.Name, opts).Stream(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the logs of pod %s/%s (%s)", namespace, pod.Name, err)
+		}
+		scanner := bufio.NewScanner(stream)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.Contains(line, name) || (instance.Spec.ExternalID != "" && strings.Contains(line, instance.Spec.ExternalID)) {
+				lines = append(lines, pod.Name+": "+line)
+			}
+		}
+		err = scanner.Err()
+		stream.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the logs of pod %s/%s (%s)", namespace, pod.Name, err)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no pod in %s is annotated with %s", namespace, ControllerLogsAnnotation)
+	}
+	return lines, nil
+}
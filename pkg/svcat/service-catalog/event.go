@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// RetrieveEventsByInstance gets the events recorded for an instance, oldest
+// first. The events of earlier instances of the same name are left out.
+func (sdk *SDK) RetrieveEventsByInstance(instance *v1beta1.ServiceInstance) ([]corev1.Event, error) {
+	return sdk.retrieveEvents("ServiceInstance", &instance.ObjectMeta)
+}
+
+func (sdk *SDK) retrieveEvents(kind string, obj *metav1.ObjectMeta) ([]corev1.Event, error) {
+	selector := fields.Set{
+		"involvedObject.kind": kind,
+		"involvedObject.name": obj.Name,
+	}
+	if obj.UID != "" {
+		selector["involvedObject.uid"] = string(obj.UID)
+	}
+	opts := metav1.ListOptions{FieldSelector: selector.AsSelector().String()}
+	list, err := sdk.Core().Events(obj.Namespace).List(context.Background(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list events of %s %s/%s (%s)", kind, obj.Namespace, obj.Name, err)
+	}
+
+	events := make([]corev1.Event, 0, len(list.Items))
+	for _, event := range list.Items {
+		involved := event.InvolvedObject
+		if involved.Kind != kind || involved.Name != obj.Name || (obj.UID != "" && involved.UID != obj.UID) {
+			continue
+		}
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+	return events, nil
+}
+
+// eventTime returns the last time an event occurred.
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
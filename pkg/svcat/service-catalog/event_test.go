@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+
+	. "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event", func() {
+	var (
+		sdk       *SDK
+		k8sClient *k8sfake.Clientset
+		instance  *v1beta1.ServiceInstance
+	)
+
+	newEvent := func(name, kind, objName, uid string, last time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "foobar_namespace"},
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      kind,
+				Name:      objName,
+				Namespace: "foobar_namespace",
+				UID:       types.UID(uid),
+			},
+			LastTimestamp: metav1.NewTime(last),
+		}
+	}
+
+	BeforeEach(func() {
+		instance = &v1beta1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foobar",
+				Namespace: "foobar_namespace",
+				UID:       "foobar-uid",
+			},
+		}
+		now := time.Now()
+		k8sClient = k8sfake.NewSimpleClientset(
+			newEvent("recent", "ServiceInstance", "foobar", "foobar-uid", now),
+			newEvent("old", "ServiceInstance", "foobar", "foobar-uid", now.Add(-time.Hour)),
+			newEvent("previous-instance", "ServiceInstance", "foobar", "other-uid", now),
+			newEvent("other-instance", "ServiceInstance", "barbaz", "barbaz-uid", now),
+			newEvent("binding", "ServiceBinding", "foobar", "binding-uid", now),
+		)
+		sdk = &SDK{
+			K8sClient: k8sClient,
+		}
+	})
+
+	Describe("RetrieveEventsByInstance", func() {
+		It("Gets the events of the instance, oldest first", func() {
+			events, err := sdk.RetrieveEventsByInstance(instance)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].Name).To(Equal("old"))
+			Expect(events[1].Name).To(Equal("recent"))
+
+			actions := k8sClient.Actions()
+			Expect(actions[0].Matches("list", "events")).To(BeTrue())
+			Expect(actions[0].GetNamespace()).To(Equal(instance.Namespace))
+			selector := actions[0].(testing.ListActionImpl).GetListRestrictions().Fields.String()
+			Expect(selector).To(ContainSubstring("involvedObject.kind=ServiceInstance"))
+			Expect(selector).To(ContainSubstring("involvedObject.name=foobar"))
+			Expect(selector).To(ContainSubstring("involvedObject.uid=foobar-uid"))
+		})
+		It("Bubbles up errors", func() {
+			errorMessage := "forbidden"
+			k8sClient.PrependReactor("list", "events", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New(errorMessage)
+			})
+
+			events, err := sdk.RetrieveEventsByInstance(instance)
+
+			Expect(err).To(HaveOccurred())
+			Expect(events).To(BeNil())
+			Expect(err.Error()).Should(ContainSubstring(errorMessage))
+		})
+	})
+})
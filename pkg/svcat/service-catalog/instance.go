@@ -30,22 +30,86 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-// RetrieveInstances lists all instances in a namespace.
-func (sdk *SDK) RetrieveInstances(ns, classFilter, planFilter string) (*v1beta1.ServiceInstanceList, error) {
-	instances, err := sdk.ServiceCatalog().ServiceInstances(ns).List(context.Background(), v1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("unable to list instances in %s: %w", ns, err)
+// DefaultChunkSize is the number of instances listed per request by default.
+const DefaultChunkSize int64 = 500
+
+// InstanceIterator lists the instances in a namespace, or in all namespaces
+// when it is empty, a chunk at a time, so that huge lists neither time out
+// nor need to be held in memory at once.
+type InstanceIterator struct {
+	sdk  *SDK
+	ns   string
+	opts v1.ListOptions
+	meta v1.ListMeta
+	page []v1beta1.ServiceInstance
+	next int
+	done bool
+	err  error
+}
+
+// NewInstanceIterator returns an iterator over the instances in a namespace,
+// which lists at most chunkSize instances per request, or all of them in one
+// request if chunkSize is 0.
+func (sdk *SDK) NewInstanceIterator(ns string, chunkSize int64) *InstanceIterator {
+	return &InstanceIterator{
+		sdk:  sdk,
+		ns:   ns,
+		opts: v1.ListOptions{Limit: chunkSize},
 	}
+}
 
-	if classFilter == "" && planFilter == "" {
-		return instances, nil
+// Next returns the next instance, listing the next chunk when needed. It
+// returns nil once all the instances have been returned, or when listing
+// failed, which Err reports.
+func (it *InstanceIterator) Next() *v1beta1.ServiceInstance {
+	for it.next >= len(it.page) {
+		if it.done || it.err != nil {
+			return nil
+		}
+		instances, err := it.sdk.ServiceCatalog().ServiceInstances(it.ns).List(context.Background(), it.opts)
+		if err != nil {
+			it.err = fmt.Errorf("unable to list instances in %s: %w", it.ns, err)
+			return nil
+		}
+		it.meta = instances.ListMeta
+		it.page = instances.Items
+		it.next = 0
+		it.opts.Continue = instances.Continue
+		it.done = instances.Continue == ""
 	}
+	instance := &it.page[it.next]
+	it.next++
+	return instance
+}
 
+// Err returns the error that stopped the iteration, if any.
+func (it *InstanceIterator) Err() error {
+	return it.err
+}
+
+// ListMeta returns the list metadata of the last chunk listed, without its
+// continue token.
+func (it *InstanceIterator) ListMeta() v1.ListMeta {
+	meta := it.meta
+	meta.Continue = ""
+	meta.RemainingItemCount = nil
+	return meta
+}
+
+// RetrieveInstances lists all instances in a namespace.
+func (sdk *SDK) RetrieveInstances(ns, classFilter, planFilter string) (*v1beta1.ServiceInstanceList, error) {
+	return sdk.RetrieveInstancesInChunks(ns, classFilter, planFilter, 0)
+}
+
+// RetrieveInstancesInChunks lists all instances in a namespace, chunkSize
+// instances per request.
+func (sdk *SDK) RetrieveInstancesInChunks(ns, classFilter, planFilter string, chunkSize int64) (*v1beta1.ServiceInstanceList, error) {
 	filtered := v1beta1.ServiceInstanceList{
 		Items: []v1beta1.ServiceInstance{},
 	}
 
-	for _, instance := range instances.Items {
+	it := sdk.NewInstanceIterator(ns, chunkSize)
+	for instance := it.Next(); instance != nil; instance = it.Next() {
 		if classFilter != "" && instance.Spec.GetSpecifiedClusterServiceClass() != classFilter {
 			continue
 		}
@@ -54,8 +118,44 @@ func (sdk *SDK) RetrieveInstances(ns, classFilter, planFilter string) (*v1beta1.
 			continue
 		}
 
-		filtered.Items = append(filtered.Items, instance)
+		filtered.Items = append(filtered.Items, *instance)
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
 	}
+	filtered.ListMeta = it.ListMeta()
+
+	return &filtered, nil
+}
+
+// RetrieveInstancesByCondition lists the instances in a namespace, or in all
+// namespaces when ns is empty, that have a condition of the given type with
+// the given status.
+func (sdk *SDK) RetrieveInstancesByCondition(ns string, conditionType v1beta1.ServiceInstanceConditionType, status v1beta1.ConditionStatus) (*v1beta1.ServiceInstanceList, error) {
+	return sdk.RetrieveInstancesByConditionInChunks(ns, conditionType, status, 0)
+}
+
+// RetrieveInstancesByConditionInChunks lists the instances in a namespace, or
+// in all namespaces when ns is empty, that have a condition of the given type
+// with the given status, chunkSize instances per request.
+func (sdk *SDK) RetrieveInstancesByConditionInChunks(ns string, conditionType v1beta1.ServiceInstanceConditionType, status v1beta1.ConditionStatus, chunkSize int64) (*v1beta1.ServiceInstanceList, error) {
+	filtered := v1beta1.ServiceInstanceList{
+		Items: []v1beta1.ServiceInstance{},
+	}
+
+	it := sdk.NewInstanceIterator(ns, chunkSize)
+	for instance := it.Next(); instance != nil; instance = it.Next() {
+		for _, cond := range instance.Status.Conditions {
+			if cond.Type == conditionType && cond.Status == status {
+				filtered.Items = append(filtered.Items, *instance)
+				break
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	filtered.ListMeta = it.ListMeta()
 
 	return &filtered, nil
 }
@@ -166,6 +266,17 @@ func (sdk *SDK) InstanceToServiceClassAndPlan(instance *v1beta1.ServiceInstance,
 // by their k8s names. Depending on provisionClusterInstance, it will create either
 // an instance of a cluster class/plan or a namespaced class/plan
 func (sdk *SDK) Provision(instanceName, classKubeName, planKubeName string, provisionClusterInstance bool, opts *ProvisionOptions) (*v1beta1.ServiceInstance, error) {
+	request := NewProvisionRequest(instanceName, classKubeName, planKubeName, provisionClusterInstance, opts)
+	result, err := sdk.ServiceCatalog().ServiceInstances(opts.Namespace).Create(context.Background(), request, v1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("provision request failed (%s)", err)
+	}
+	return result, nil
+}
+
+// NewProvisionRequest builds the instance that Provision creates, without
+// creating it.
+func NewProvisionRequest(instanceName, classKubeName, planKubeName string, provisionClusterInstance bool, opts *ProvisionOptions) *v1beta1.ServiceInstance {
 	var request *v1beta1.ServiceInstance
 	if provisionClusterInstance {
 		request = &v1beta1.ServiceInstance{
@@ -200,11 +311,7 @@ func (sdk *SDK) Provision(instanceName, classKubeName, planKubeName string, prov
 			},
 		}
 	}
-	result, err := sdk.ServiceCatalog().ServiceInstances(opts.Namespace).Create(context.Background(), request, v1.CreateOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("provision request failed (%s)", err)
-	}
-	return result, nil
+	return request
 }
 
 // Deprovision deletes an instance.
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scparameters "github.com/drycc-addons/service-catalog/pkg/util/parameters"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedactedParameterValue replaces the values of parameters that come from
+// parametersFrom sources in the status of instances and bindings.
+const RedactedParameterValue = "<redacted>"
+
+// ParameterChange is a parameter whose value in the spec of an instance
+// differs from the one the broker last accepted.
+type ParameterChange struct {
+	Name string
+	// Spec is the value in the spec, or nil if the parameter was removed.
+	Spec interface{}
+	// Observed is the value the broker last accepted, or nil if the
+	// parameter was added.
+	Observed interface{}
+}
+
+// InstanceParametersDiff is the difference between the parameters in the
+// spec of an instance and the ones the broker last accepted.
+type InstanceParametersDiff struct {
+	// Changes are the changed parameters, sorted by name. The values of
+	// parameters from parametersFrom sources are redacted, so a change of
+	// them only shows when the parameter was added or removed.
+	Changes []ParameterChange
+	// RedactedChanged tells whether the values of the parameters from
+	// parametersFrom sources changed. It is nil when this cannot be told:
+	// when they could not be read, or when other parameters changed too.
+	RedactedChanged *bool
+	// Applied is false when the broker has not accepted any parameters yet.
+	Applied bool
+	// InProgress is true when an operation sending the parameters to the
+	// broker is in progress.
+	InProgress bool
+}
+
+// DiffInstanceParameters compares the parameters in the spec of the
+// instance, including the ones from its parametersFrom sources, with the
+// ones the broker last accepted, to show changes that were not applied yet.
+func (sdk *SDK) DiffInstanceParameters(instance *v1beta1.ServiceInstance) (*InstanceParametersDiff, error) {
+	diff := &InstanceParametersDiff{
+		InProgress: instance.Status.InProgressProperties != nil,
+	}
+
+	spec := map[string]interface{}{}
+	redacted := map[string]interface{}{}
+	if instance.Spec.Parameters != nil && len(instance.Spec.Parameters.Raw) > 0 {
+		params, err := scparameters.Unmarshal(instance.Spec.Parameters.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameters in the spec of instance %s/%s (%s)", instance.Namespace, instance.Name, err)
+		}
+		for k, v := range params {
+			spec[k] = v
+			redacted[k] = v
+		}
+	}
+	// The values of parametersFrom sources are only used for the checksum,
+	// which is not compared when any of them cannot be read.
+	full := map[string]interface{}{}
+	for k, v := range spec {
+		full[k] = v
+	}
+	readable := true
+	for _, source := range instance.Spec.ParametersFrom {
+		params, err := sdk.fetchParametersFromSecret(instance.Namespace, source)
+		if err != nil || params == nil {
+			readable = false
+		}
+		for k, v := range params {
+			full[k] = v
+			redacted[k] = RedactedParameterValue
+		}
+	}
+
+	observed := map[string]interface{}{}
+	var checksum string
+	if props := instance.Status.ExternalProperties; props != nil {
+		diff.Applied = true
+		checksum = props.ParameterChecksum
+		if props.Parameters != nil && len(props.Parameters.Raw) > 0 {
+			params, err := scparameters.Unmarshal(props.Parameters.Raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parameters in the status of instance %s/%s (%s)", instance.Namespace, instance.Name, err)
+			}
+			observed = params
+		}
+	}
+
+	names := map[string]bool{}
+	for k := range redacted {
+		names[k] = true
+	}
+	for k := range observed {
+		names[k] = true
+	}
+	for name := range names {
+		specValue, inSpec := redacted[name]
+		observedValue, inStatus := observed[name]
+		if inSpec && inStatus && reflect.DeepEqual(specValue, observedValue) {
+			continue
+		}
+		// A parameter from a source that could not be read is not known
+		// to be gone from the spec.
+		if !inSpec && !readable && observedValue == RedactedParameterValue {
+			continue
+		}
+		diff.Changes = append(diff.Changes, ParameterChange{Name: name, Spec: specValue, Observed: observedValue})
+	}
+	sort.Slice(diff.Changes, func(i, j int) bool { return diff.Changes[i].Name < diff.Changes[j].Name })
+
+	// The checksum covers all parameters, so it only tells whether the
+	// redacted ones changed when no other parameter did.
+	if len(instance.Spec.ParametersFrom) > 0 && readable && diff.Applied && len(diff.Changes) == 0 {
+		fullChecksum, err := scparameters.Checksum(full)
+		if err != nil {
+			return nil, err
+		}
+		changed := fullChecksum != checksum
+		diff.RedactedChanged = &changed
+	}
+
+	return diff, nil
+}
+
+// fetchParametersFromSecret returns the parameters held by a secretKeyRef
+// source, or nil for a source svcat cannot read, such as a plugin.
+func (sdk *SDK) fetchParametersFromSecret(namespace string, source v1beta1.ParametersFromSource) (map[string]interface{}, error) {
+	if source.SecretKeyRef == nil {
+		return nil, nil
+	}
+	secret, err := sdk.Core().Secrets(namespace).Get(context.Background(), source.SecretKeyRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{}
+	if err := json.Unmarshal(secret.Data[source.SecretKeyRef.Key], &params); err != nil {
+		return nil, fmt.Errorf("invalid parameters in secret %s/%s (%s)", namespace, source.SecretKeyRef.Name, err)
+	}
+	if err := scparameters.Coerce(params, source.Coerce); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
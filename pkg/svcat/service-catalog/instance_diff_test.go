@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scparameters "github.com/drycc-addons/service-catalog/pkg/util/parameters"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiffInstanceParameters", func() {
+	var (
+		sdk      *SDK
+		instance *v1beta1.ServiceInstance
+		secret   *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		instance = &v1beta1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "foobar_namespace"},
+			Spec: v1beta1.ServiceInstanceSpec{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"size":"large","replicas":3}`)},
+			},
+			Status: v1beta1.ServiceInstanceStatus{
+				ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"size":"small","zone":"a"}`)},
+				},
+			},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "foobar_namespace"},
+			Data:       map[string][]byte{"params": []byte(`{"password":"letmein"}`)},
+		}
+		sdk = &SDK{K8sClient: k8sfake.NewSimpleClientset(secret)}
+	})
+
+	It("returns the changed parameters sorted by name", func() {
+		diff, err := sdk.DiffInstanceParameters(instance)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Applied).To(BeTrue())
+		Expect(diff.InProgress).To(BeFalse())
+		Expect(diff.Changes).To(Equal([]ParameterChange{
+			{Name: "replicas", Spec: float64(3)},
+			{Name: "size", Spec: "large", Observed: "small"},
+			{Name: "zone", Observed: "a"},
+		}))
+		Expect(diff.RedactedChanged).To(BeNil())
+	})
+
+	It("tells when the broker has not accepted any parameters", func() {
+		instance.Status.ExternalProperties = nil
+
+		diff, err := sdk.DiffInstanceParameters(instance)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Applied).To(BeFalse())
+		Expect(diff.Changes).To(HaveLen(2))
+	})
+
+	Context("with parameters from a secret", func() {
+		BeforeEach(func() {
+			instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"size":"small"}`)}
+			instance.Spec.ParametersFrom = []v1beta1.ParametersFromSource{
+				{SecretKeyRef: &v1beta1.SecretKeyReference{Name: "creds", Key: "params"}},
+			}
+			instance.Status.ExternalProperties.Parameters = &runtime.RawExtension{
+				Raw: []byte(`{"size":"small","password":"` + RedactedParameterValue + `"}`),
+			}
+		})
+
+		It("does not report a change of the secret values the broker accepted", func() {
+			checksum, err := scparameters.Checksum(map[string]interface{}{"size": "small", "password": "letmein"})
+			Expect(err).NotTo(HaveOccurred())
+			instance.Status.ExternalProperties.ParameterChecksum = checksum
+
+			diff, err := sdk.DiffInstanceParameters(instance)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Changes).To(BeEmpty())
+			Expect(diff.RedactedChanged).NotTo(BeNil())
+			Expect(*diff.RedactedChanged).To(BeFalse())
+		})
+
+		It("reports a change of the secret values without showing them", func() {
+			checksum, err := scparameters.Checksum(map[string]interface{}{"size": "small", "password": "hunter2"})
+			Expect(err).NotTo(HaveOccurred())
+			instance.Status.ExternalProperties.ParameterChecksum = checksum
+
+			diff, err := sdk.DiffInstanceParameters(instance)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Changes).To(BeEmpty())
+			Expect(diff.RedactedChanged).NotTo(BeNil())
+			Expect(*diff.RedactedChanged).To(BeTrue())
+		})
+
+		It("does not compare the secret values when the secret cannot be read", func() {
+			sdk.K8sClient = k8sfake.NewSimpleClientset()
+
+			diff, err := sdk.DiffInstanceParameters(instance)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Changes).To(BeEmpty())
+			Expect(diff.RedactedChanged).To(BeNil())
+		})
+	})
+})
@@ -93,6 +93,25 @@ var _ = Describe("Instances", func() {
 			Expect(actions[0].Matches("list", "serviceinstances")).To(BeTrue())
 			Expect(actions[0].(testing.ListActionImpl).Namespace).To(Equal(namespace))
 		})
+		It("Lists the instances in chunks", func() {
+			namespace := si.Namespace
+			pagedClient := fake.NewSimpleClientset()
+			pagedClient.PrependReactor("list", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				opts := action.(testing.ListActionImpl).ListOptions
+				Expect(opts.Limit).To(Equal(int64(1)))
+				if opts.Continue == "" {
+					return true, &v1beta1.ServiceInstanceList{ListMeta: metav1.ListMeta{Continue: "next"}, Items: []v1beta1.ServiceInstance{*si}}, nil
+				}
+				return true, &v1beta1.ServiceInstanceList{Items: []v1beta1.ServiceInstance{*si2}}, nil
+			})
+			sdk.ServiceCatalogClient = pagedClient
+
+			instances, err := sdk.RetrieveInstancesInChunks(namespace, "", "", 1)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances.Items).Should(ConsistOf(*si, *si2))
+			Expect(pagedClient.Actions()).To(HaveLen(2))
+		})
 		It("Bubbles up errors", func() {
 			namespace := si.Namespace
 			badClient := fake.NewSimpleClientset()
@@ -109,6 +128,36 @@ var _ = Describe("Instances", func() {
 			Expect(badClient.Actions()[0].Matches("list", "serviceinstances")).To(BeTrue())
 		})
 	})
+	Describe("RetrieveInstancesByCondition", func() {
+		It("Returns the instances with a condition of the given type and status", func() {
+			instances, err := sdk.RetrieveInstancesByCondition("", v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances.Items).Should(ConsistOf(*si2))
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("list", "serviceinstances")).To(BeTrue())
+			Expect(actions[0].(testing.ListActionImpl).Namespace).To(Equal(""))
+		})
+		It("Returns no instances when none has the condition", func() {
+			instances, err := sdk.RetrieveInstancesByCondition(si.Namespace, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances.Items).To(BeEmpty())
+		})
+		It("Bubbles up errors", func() {
+			badClient := fake.NewSimpleClientset()
+			errorMessage := "error retrieving list"
+			badClient.PrependReactor("list", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New(errorMessage)
+			})
+			sdk.ServiceCatalogClient = badClient
+
+			_, err := sdk.RetrieveInstancesByCondition("", v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(errorMessage))
+		})
+	})
 	Describe("RetrieveInstance", func() {
 		It("Calls the generated v1beta1 Get method with the passed in instance", func() {
 			instanceName := si.Name
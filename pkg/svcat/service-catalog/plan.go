@@ -64,8 +64,23 @@ type Plan interface {
 	// GetBindingCreateSchema returns the instance create schema from plan.
 	GetBindingCreateSchema() *runtime.RawExtension
 
+	// GetBindingResponseSchema returns the schema of the credentials returned
+	// when binding to an instance of the plan, if the broker publishes one.
+	GetBindingResponseSchema() *runtime.RawExtension
+
 	// GetDefaultProvisionParameters returns the default provision parameters from plan.
 	GetDefaultProvisionParameters() *runtime.RawExtension
+
+	// GetAvailability returns whether new instances of the plan can be
+	// provisioned and, if not, the reason given by the broker.
+	GetAvailability() (bool, string)
+
+	// GetCosts returns the costs published by the broker, or nil if there
+	// are none.
+	GetCosts() []v1beta1.PlanCost
+
+	// IsHidden returns true if a platform admin has hidden the plan.
+	IsHidden() bool
 }
 
 // RetrievePlans lists all plans defined in the cluster.
@@ -89,6 +104,17 @@ func (sdk *SDK) RetrievePlans(classID string, opts ScopeOptions) ([]Plan, error)
 	return filtered, nil
 }
 
+// VisiblePlans returns the plans that a platform admin has not hidden.
+func VisiblePlans(plans []Plan) []Plan {
+	var visible []Plan
+	for _, plan := range plans {
+		if !plan.IsHidden() {
+			visible = append(visible, plan)
+		}
+	}
+	return visible
+}
+
 func (sdk *SDK) retrievePlansByListOptions(scopeOpts ScopeOptions, listOpts metav1.ListOptions) ([]Plan, error) {
 	var plans []Plan
 
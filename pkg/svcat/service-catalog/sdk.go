@@ -19,6 +19,7 @@ package servicecatalog
 import (
 	"time"
 
+	osb "github.com/drycc-addons/go-open-service-broker-client/v2"
 	apiv1beta1 "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset"
 	"github.com/drycc-addons/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
@@ -41,7 +42,10 @@ type SvcatClient interface {
 	RetrieveBinding(string, string) (*apiv1beta1.ServiceBinding, error)
 	RetrieveBindings(string) (*apiv1beta1.ServiceBindingList, error)
 	RetrieveBindingsByInstance(*apiv1beta1.ServiceInstance) ([]apiv1beta1.ServiceBinding, error)
+	RetryBinding(string, string) (*apiv1beta1.ServiceBinding, error)
+	TouchBinding(string, string, int) error
 	Unbind(string, string) ([]types.NamespacedName, error)
+	UnbindAndWait(string, string, time.Duration, *time.Duration, func(UnbindProgress)) ([]types.NamespacedName, error)
 	WaitForBinding(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceBinding, error)
 	RemoveBindingFinalizerByInstance(string, string) ([]types.NamespacedName, error)
 	RemoveFinalizerForBindings([]types.NamespacedName) ([]types.NamespacedName, error)
@@ -49,12 +53,15 @@ type SvcatClient interface {
 	RemoveFinalizerForInstance(string, string) error
 
 	Deregister(string, *ScopeOptions) error
+	IsBrokerFailed(Broker) bool
 	RetrieveBrokers(opts ScopeOptions) ([]Broker, error)
 	RetrieveBrokerByID(string, ScopeOptions) (Broker, error)
 	RetrieveBrokerByClass(*apiv1beta1.ClusterServiceClass) (*apiv1beta1.ClusterServiceBroker, error)
+	RetrieveBrokerCatalog(Broker) (*osb.CatalogResponse, error)
 	Register(string, string, *RegisterOptions, *ScopeOptions) (Broker, error)
 	Sync(string, ScopeOptions, int) error
 	WaitForBroker(string, *ScopeOptions, time.Duration, *time.Duration) (Broker, error)
+	WaitForBrokerRelist(string, ScopeOptions, time.Duration, *time.Duration) (Broker, error)
 
 	RetrieveClasses(ScopeOptions, string) ([]Class, error)
 	RetrieveClassByName(string, ScopeOptions) (Class, error)
@@ -63,6 +70,7 @@ type SvcatClient interface {
 	CreateClassFrom(CreateClassFromOptions) (Class, error)
 
 	Deprovision(string, string) error
+	DiffInstanceParameters(*apiv1beta1.ServiceInstance) (*InstanceParametersDiff, error)
 	InstanceParentHierarchy(*apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, *apiv1beta1.ClusterServiceBroker, error)
 	InstanceToServiceClassAndPlan(*apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, error)
 	IsInstanceFailed(*apiv1beta1.ServiceInstance) bool
@@ -71,6 +79,9 @@ type SvcatClient interface {
 	RetrieveInstance(string, string) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstanceByBinding(*apiv1beta1.ServiceBinding) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstances(string, string, string) (*apiv1beta1.ServiceInstanceList, error)
+	RetrieveInstancesInChunks(string, string, string, int64) (*apiv1beta1.ServiceInstanceList, error)
+	RetrieveInstancesByCondition(string, apiv1beta1.ServiceInstanceConditionType, apiv1beta1.ConditionStatus) (*apiv1beta1.ServiceInstanceList, error)
+	RetrieveInstancesByConditionInChunks(string, apiv1beta1.ServiceInstanceConditionType, apiv1beta1.ConditionStatus, int64) (*apiv1beta1.ServiceInstanceList, error)
 	RetrieveInstancesByPlan(Plan) ([]apiv1beta1.ServiceInstance, error)
 	TouchInstance(string, string, int) error
 	WaitForInstance(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
@@ -81,6 +92,11 @@ type SvcatClient interface {
 	RetrievePlanByClassAndName(string, string, ScopeOptions) (Plan, error)
 	RetrievePlanByClassIDAndName(string, string, ScopeOptions) (Plan, error)
 	RetrievePlanByID(string, ScopeOptions) (Plan, error)
+	RetrievePlanParameterNames(string, string, bool, ScopeOptions) ([]string, error)
+
+	RetrieveEventsByInstance(*apiv1beta1.ServiceInstance) ([]apicorev1.Event, error)
+
+	RetrieveControllerLogsByInstance(string, *apiv1beta1.ServiceInstance, int64) ([]string, error)
 
 	RetrieveSecretByBinding(*apiv1beta1.ServiceBinding) (*apicorev1.Secret, error)
 
@@ -91,6 +107,8 @@ type SvcatClient interface {
 type SDK struct {
 	K8sClient            kubernetes.Interface
 	ServiceCatalogClient clientset.Interface
+
+	planParameterNames planParameterNamesCache
 }
 
 // ServiceCatalog is the underlying generated Service Catalog versioned interface
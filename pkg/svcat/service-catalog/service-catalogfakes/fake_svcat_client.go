@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	v2 "github.com/drycc-addons/go-open-service-broker-client/v2"
 	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	servicecatalog "github.com/drycc-addons/service-catalog/pkg/svcat/service-catalog"
 	v1 "k8s.io/api/core/v1"
@@ -129,6 +130,19 @@ type FakeSvcatClient struct {
 	deregisterReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiffInstanceParametersStub        func(*v1beta1.ServiceInstance) (*servicecatalog.InstanceParametersDiff, error)
+	diffInstanceParametersMutex       sync.RWMutex
+	diffInstanceParametersArgsForCall []struct {
+		arg1 *v1beta1.ServiceInstance
+	}
+	diffInstanceParametersReturns struct {
+		result1 *servicecatalog.InstanceParametersDiff
+		result2 error
+	}
+	diffInstanceParametersReturnsOnCall map[int]struct {
+		result1 *servicecatalog.InstanceParametersDiff
+		result2 error
+	}
 	InstanceParentHierarchyStub        func(*v1beta1.ServiceInstance) (*v1beta1.ClusterServiceClass, *v1beta1.ClusterServicePlan, *v1beta1.ClusterServiceBroker, error)
 	instanceParentHierarchyMutex       sync.RWMutex
 	instanceParentHierarchyArgsForCall []struct {
@@ -183,6 +197,17 @@ type FakeSvcatClient struct {
 	isBindingReadyReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsBrokerFailedStub        func(servicecatalog.Broker) bool
+	isBrokerFailedMutex       sync.RWMutex
+	isBrokerFailedArgsForCall []struct {
+		arg1 servicecatalog.Broker
+	}
+	isBrokerFailedReturns struct {
+		result1 bool
+	}
+	isBrokerFailedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsInstanceFailedStub        func(*v1beta1.ServiceInstance) bool
 	isInstanceFailedMutex       sync.RWMutex
 	isInstanceFailedArgsForCall []struct {
@@ -355,6 +380,19 @@ type FakeSvcatClient struct {
 		result1 servicecatalog.Broker
 		result2 error
 	}
+	RetrieveBrokerCatalogStub        func(servicecatalog.Broker) (*v2.CatalogResponse, error)
+	retrieveBrokerCatalogMutex       sync.RWMutex
+	retrieveBrokerCatalogArgsForCall []struct {
+		arg1 servicecatalog.Broker
+	}
+	retrieveBrokerCatalogReturns struct {
+		result1 *v2.CatalogResponse
+		result2 error
+	}
+	retrieveBrokerCatalogReturnsOnCall map[int]struct {
+		result1 *v2.CatalogResponse
+		result2 error
+	}
 	RetrieveBrokersStub        func(servicecatalog.ScopeOptions) ([]servicecatalog.Broker, error)
 	retrieveBrokersMutex       sync.RWMutex
 	retrieveBrokersArgsForCall []struct {
@@ -423,6 +461,34 @@ type FakeSvcatClient struct {
 		result1 []servicecatalog.Class
 		result2 error
 	}
+	RetrieveControllerLogsByInstanceStub        func(string, *v1beta1.ServiceInstance, int64) ([]string, error)
+	retrieveControllerLogsByInstanceMutex       sync.RWMutex
+	retrieveControllerLogsByInstanceArgsForCall []struct {
+		arg1 string
+		arg2 *v1beta1.ServiceInstance
+		arg3 int64
+	}
+	retrieveControllerLogsByInstanceReturns struct {
+		result1 []string
+		result2 error
+	}
+	retrieveControllerLogsByInstanceReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	RetrieveEventsByInstanceStub        func(*v1beta1.ServiceInstance) ([]v1.Event, error)
+	retrieveEventsByInstanceMutex       sync.RWMutex
+	retrieveEventsByInstanceArgsForCall []struct {
+		arg1 *v1beta1.ServiceInstance
+	}
+	retrieveEventsByInstanceReturns struct {
+		result1 []v1.Event
+		result2 error
+	}
+	retrieveEventsByInstanceReturnsOnCall map[int]struct {
+		result1 []v1.Event
+		result2 error
+	}
 	RetrieveInstanceStub        func(string, string) (*v1beta1.ServiceInstance, error)
 	retrieveInstanceMutex       sync.RWMutex
 	retrieveInstanceArgsForCall []struct {
@@ -465,6 +531,37 @@ type FakeSvcatClient struct {
 		result1 *v1beta1.ServiceInstanceList
 		result2 error
 	}
+	RetrieveInstancesByConditionStub        func(string, v1beta1.ServiceInstanceConditionType, v1beta1.ConditionStatus) (*v1beta1.ServiceInstanceList, error)
+	retrieveInstancesByConditionMutex       sync.RWMutex
+	retrieveInstancesByConditionArgsForCall []struct {
+		arg1 string
+		arg2 v1beta1.ServiceInstanceConditionType
+		arg3 v1beta1.ConditionStatus
+	}
+	retrieveInstancesByConditionReturns struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}
+	retrieveInstancesByConditionReturnsOnCall map[int]struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}
+	RetrieveInstancesByConditionInChunksStub        func(string, v1beta1.ServiceInstanceConditionType, v1beta1.ConditionStatus, int64) (*v1beta1.ServiceInstanceList, error)
+	retrieveInstancesByConditionInChunksMutex       sync.RWMutex
+	retrieveInstancesByConditionInChunksArgsForCall []struct {
+		arg1 string
+		arg2 v1beta1.ServiceInstanceConditionType
+		arg3 v1beta1.ConditionStatus
+		arg4 int64
+	}
+	retrieveInstancesByConditionInChunksReturns struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}
+	retrieveInstancesByConditionInChunksReturnsOnCall map[int]struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}
 	RetrieveInstancesByPlanStub        func(servicecatalog.Plan) ([]v1beta1.ServiceInstance, error)
 	retrieveInstancesByPlanMutex       sync.RWMutex
 	retrieveInstancesByPlanArgsForCall []struct {
@@ -478,6 +575,22 @@ type FakeSvcatClient struct {
 		result1 []v1beta1.ServiceInstance
 		result2 error
 	}
+	RetrieveInstancesInChunksStub        func(string, string, string, int64) (*v1beta1.ServiceInstanceList, error)
+	retrieveInstancesInChunksMutex       sync.RWMutex
+	retrieveInstancesInChunksArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int64
+	}
+	retrieveInstancesInChunksReturns struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}
+	retrieveInstancesInChunksReturnsOnCall map[int]struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}
 	RetrievePlanByClassAndNameStub        func(string, string, servicecatalog.ScopeOptions) (servicecatalog.Plan, error)
 	retrievePlanByClassAndNameMutex       sync.RWMutex
 	retrievePlanByClassAndNameArgsForCall []struct {
@@ -536,6 +649,22 @@ type FakeSvcatClient struct {
 		result1 servicecatalog.Plan
 		result2 error
 	}
+	RetrievePlanParameterNamesStub        func(string, string, bool, servicecatalog.ScopeOptions) ([]string, error)
+	retrievePlanParameterNamesMutex       sync.RWMutex
+	retrievePlanParameterNamesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 servicecatalog.ScopeOptions
+	}
+	retrievePlanParameterNamesReturns struct {
+		result1 []string
+		result2 error
+	}
+	retrievePlanParameterNamesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	RetrievePlansStub        func(string, servicecatalog.ScopeOptions) ([]servicecatalog.Plan, error)
 	retrievePlansMutex       sync.RWMutex
 	retrievePlansArgsForCall []struct {
@@ -563,6 +692,20 @@ type FakeSvcatClient struct {
 		result1 *v1.Secret
 		result2 error
 	}
+	RetryBindingStub        func(string, string) (*v1beta1.ServiceBinding, error)
+	retryBindingMutex       sync.RWMutex
+	retryBindingArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	retryBindingReturns struct {
+		result1 *v1beta1.ServiceBinding
+		result2 error
+	}
+	retryBindingReturnsOnCall map[int]struct {
+		result1 *v1beta1.ServiceBinding
+		result2 error
+	}
 	ServerVersionStub        func() (*version.Info, error)
 	serverVersionMutex       sync.RWMutex
 	serverVersionArgsForCall []struct {
@@ -588,6 +731,19 @@ type FakeSvcatClient struct {
 	syncReturnsOnCall map[int]struct {
 		result1 error
 	}
+	TouchBindingStub        func(string, string, int) error
+	touchBindingMutex       sync.RWMutex
+	touchBindingArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	touchBindingReturns struct {
+		result1 error
+	}
+	touchBindingReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TouchInstanceStub        func(string, string, int) error
 	touchInstanceMutex       sync.RWMutex
 	touchInstanceArgsForCall []struct {
@@ -615,6 +771,23 @@ type FakeSvcatClient struct {
 		result1 []types.NamespacedName
 		result2 error
 	}
+	UnbindAndWaitStub        func(string, string, time.Duration, *time.Duration, func(servicecatalog.UnbindProgress)) ([]types.NamespacedName, error)
+	unbindAndWaitMutex       sync.RWMutex
+	unbindAndWaitArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Duration
+		arg4 *time.Duration
+		arg5 func(servicecatalog.UnbindProgress)
+	}
+	unbindAndWaitReturns struct {
+		result1 []types.NamespacedName
+		result2 error
+	}
+	unbindAndWaitReturnsOnCall map[int]struct {
+		result1 []types.NamespacedName
+		result2 error
+	}
 	WaitForBindingStub        func(string, string, time.Duration, *time.Duration) (*v1beta1.ServiceBinding, error)
 	waitForBindingMutex       sync.RWMutex
 	waitForBindingArgsForCall []struct {
@@ -647,6 +820,22 @@ type FakeSvcatClient struct {
 		result1 servicecatalog.Broker
 		result2 error
 	}
+	WaitForBrokerRelistStub        func(string, servicecatalog.ScopeOptions, time.Duration, *time.Duration) (servicecatalog.Broker, error)
+	waitForBrokerRelistMutex       sync.RWMutex
+	waitForBrokerRelistArgsForCall []struct {
+		arg1 string
+		arg2 servicecatalog.ScopeOptions
+		arg3 time.Duration
+		arg4 *time.Duration
+	}
+	waitForBrokerRelistReturns struct {
+		result1 servicecatalog.Broker
+		result2 error
+	}
+	waitForBrokerRelistReturnsOnCall map[int]struct {
+		result1 servicecatalog.Broker
+		result2 error
+	}
 	WaitForInstanceStub        func(string, string, time.Duration, *time.Duration) (*v1beta1.ServiceInstance, error)
 	waitForInstanceMutex       sync.RWMutex
 	waitForInstanceArgsForCall []struct {
@@ -1138,6 +1327,69 @@ func (fake *FakeSvcatClient) DeregisterReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) DiffInstanceParameters(arg1 *v1beta1.ServiceInstance) (*servicecatalog.InstanceParametersDiff, error) {
+	fake.diffInstanceParametersMutex.Lock()
+	ret, specificReturn := fake.diffInstanceParametersReturnsOnCall[len(fake.diffInstanceParametersArgsForCall)]
+	fake.diffInstanceParametersArgsForCall = append(fake.diffInstanceParametersArgsForCall, struct {
+		arg1 *v1beta1.ServiceInstance
+	}{arg1})
+	fake.recordInvocation("DiffInstanceParameters", []interface{}{arg1})
+	fake.diffInstanceParametersMutex.Unlock()
+	if fake.DiffInstanceParametersStub != nil {
+		return fake.DiffInstanceParametersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.diffInstanceParametersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) DiffInstanceParametersCallCount() int {
+	fake.diffInstanceParametersMutex.RLock()
+	defer fake.diffInstanceParametersMutex.RUnlock()
+	return len(fake.diffInstanceParametersArgsForCall)
+}
+
+func (fake *FakeSvcatClient) DiffInstanceParametersCalls(stub func(*v1beta1.ServiceInstance) (*servicecatalog.InstanceParametersDiff, error)) {
+	fake.diffInstanceParametersMutex.Lock()
+	defer fake.diffInstanceParametersMutex.Unlock()
+	fake.DiffInstanceParametersStub = stub
+}
+
+func (fake *FakeSvcatClient) DiffInstanceParametersArgsForCall(i int) *v1beta1.ServiceInstance {
+	fake.diffInstanceParametersMutex.RLock()
+	defer fake.diffInstanceParametersMutex.RUnlock()
+	argsForCall := fake.diffInstanceParametersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSvcatClient) DiffInstanceParametersReturns(result1 *servicecatalog.InstanceParametersDiff, result2 error) {
+	fake.diffInstanceParametersMutex.Lock()
+	defer fake.diffInstanceParametersMutex.Unlock()
+	fake.DiffInstanceParametersStub = nil
+	fake.diffInstanceParametersReturns = struct {
+		result1 *servicecatalog.InstanceParametersDiff
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) DiffInstanceParametersReturnsOnCall(i int, result1 *servicecatalog.InstanceParametersDiff, result2 error) {
+	fake.diffInstanceParametersMutex.Lock()
+	defer fake.diffInstanceParametersMutex.Unlock()
+	fake.DiffInstanceParametersStub = nil
+	if fake.diffInstanceParametersReturnsOnCall == nil {
+		fake.diffInstanceParametersReturnsOnCall = make(map[int]struct {
+			result1 *servicecatalog.InstanceParametersDiff
+			result2 error
+		})
+	}
+	fake.diffInstanceParametersReturnsOnCall[i] = struct {
+		result1 *servicecatalog.InstanceParametersDiff
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) InstanceParentHierarchy(arg1 *v1beta1.ServiceInstance) (*v1beta1.ClusterServiceClass, *v1beta1.ClusterServicePlan, *v1beta1.ClusterServiceBroker, error) {
 	fake.instanceParentHierarchyMutex.Lock()
 	ret, specificReturn := fake.instanceParentHierarchyReturnsOnCall[len(fake.instanceParentHierarchyArgsForCall)]
@@ -1393,6 +1645,66 @@ func (fake *FakeSvcatClient) IsBindingReadyReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) IsBrokerFailed(arg1 servicecatalog.Broker) bool {
+	fake.isBrokerFailedMutex.Lock()
+	ret, specificReturn := fake.isBrokerFailedReturnsOnCall[len(fake.isBrokerFailedArgsForCall)]
+	fake.isBrokerFailedArgsForCall = append(fake.isBrokerFailedArgsForCall, struct {
+		arg1 servicecatalog.Broker
+	}{arg1})
+	fake.recordInvocation("IsBrokerFailed", []interface{}{arg1})
+	fake.isBrokerFailedMutex.Unlock()
+	if fake.IsBrokerFailedStub != nil {
+		return fake.IsBrokerFailedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isBrokerFailedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSvcatClient) IsBrokerFailedCallCount() int {
+	fake.isBrokerFailedMutex.RLock()
+	defer fake.isBrokerFailedMutex.RUnlock()
+	return len(fake.isBrokerFailedArgsForCall)
+}
+
+func (fake *FakeSvcatClient) IsBrokerFailedCalls(stub func(servicecatalog.Broker) bool) {
+	fake.isBrokerFailedMutex.Lock()
+	defer fake.isBrokerFailedMutex.Unlock()
+	fake.IsBrokerFailedStub = stub
+}
+
+func (fake *FakeSvcatClient) IsBrokerFailedArgsForCall(i int) servicecatalog.Broker {
+	fake.isBrokerFailedMutex.RLock()
+	defer fake.isBrokerFailedMutex.RUnlock()
+	argsForCall := fake.isBrokerFailedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSvcatClient) IsBrokerFailedReturns(result1 bool) {
+	fake.isBrokerFailedMutex.Lock()
+	defer fake.isBrokerFailedMutex.Unlock()
+	fake.IsBrokerFailedStub = nil
+	fake.isBrokerFailedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSvcatClient) IsBrokerFailedReturnsOnCall(i int, result1 bool) {
+	fake.isBrokerFailedMutex.Lock()
+	defer fake.isBrokerFailedMutex.Unlock()
+	fake.IsBrokerFailedStub = nil
+	if fake.isBrokerFailedReturnsOnCall == nil {
+		fake.isBrokerFailedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isBrokerFailedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeSvcatClient) IsInstanceFailed(arg1 *v1beta1.ServiceInstance) bool {
 	fake.isInstanceFailedMutex.Lock()
 	ret, specificReturn := fake.isInstanceFailedReturnsOnCall[len(fake.isInstanceFailedArgsForCall)]
@@ -2216,6 +2528,69 @@ func (fake *FakeSvcatClient) RetrieveBrokerByIDReturnsOnCall(i int, result1 serv
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveBrokerCatalog(arg1 servicecatalog.Broker) (*v2.CatalogResponse, error) {
+	fake.retrieveBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.retrieveBrokerCatalogReturnsOnCall[len(fake.retrieveBrokerCatalogArgsForCall)]
+	fake.retrieveBrokerCatalogArgsForCall = append(fake.retrieveBrokerCatalogArgsForCall, struct {
+		arg1 servicecatalog.Broker
+	}{arg1})
+	fake.recordInvocation("RetrieveBrokerCatalog", []interface{}{arg1})
+	fake.retrieveBrokerCatalogMutex.Unlock()
+	if fake.RetrieveBrokerCatalogStub != nil {
+		return fake.RetrieveBrokerCatalogStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrieveBrokerCatalogReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveBrokerCatalogCallCount() int {
+	fake.retrieveBrokerCatalogMutex.RLock()
+	defer fake.retrieveBrokerCatalogMutex.RUnlock()
+	return len(fake.retrieveBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveBrokerCatalogCalls(stub func(servicecatalog.Broker) (*v2.CatalogResponse, error)) {
+	fake.retrieveBrokerCatalogMutex.Lock()
+	defer fake.retrieveBrokerCatalogMutex.Unlock()
+	fake.RetrieveBrokerCatalogStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrieveBrokerCatalogArgsForCall(i int) servicecatalog.Broker {
+	fake.retrieveBrokerCatalogMutex.RLock()
+	defer fake.retrieveBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.retrieveBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSvcatClient) RetrieveBrokerCatalogReturns(result1 *v2.CatalogResponse, result2 error) {
+	fake.retrieveBrokerCatalogMutex.Lock()
+	defer fake.retrieveBrokerCatalogMutex.Unlock()
+	fake.RetrieveBrokerCatalogStub = nil
+	fake.retrieveBrokerCatalogReturns = struct {
+		result1 *v2.CatalogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveBrokerCatalogReturnsOnCall(i int, result1 *v2.CatalogResponse, result2 error) {
+	fake.retrieveBrokerCatalogMutex.Lock()
+	defer fake.retrieveBrokerCatalogMutex.Unlock()
+	fake.RetrieveBrokerCatalogStub = nil
+	if fake.retrieveBrokerCatalogReturnsOnCall == nil {
+		fake.retrieveBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 *v2.CatalogResponse
+			result2 error
+		})
+	}
+	fake.retrieveBrokerCatalogReturnsOnCall[i] = struct {
+		result1 *v2.CatalogResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrieveBrokers(arg1 servicecatalog.ScopeOptions) ([]servicecatalog.Broker, error) {
 	fake.retrieveBrokersMutex.Lock()
 	ret, specificReturn := fake.retrieveBrokersReturnsOnCall[len(fake.retrieveBrokersArgsForCall)]
@@ -2534,6 +2909,134 @@ func (fake *FakeSvcatClient) RetrieveClassesReturnsOnCall(i int, result1 []servi
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveControllerLogsByInstance(arg1 string, arg2 *v1beta1.ServiceInstance, arg3 int64) ([]string, error) {
+	fake.retrieveControllerLogsByInstanceMutex.Lock()
+	ret, specificReturn := fake.retrieveControllerLogsByInstanceReturnsOnCall[len(fake.retrieveControllerLogsByInstanceArgsForCall)]
+	fake.retrieveControllerLogsByInstanceArgsForCall = append(fake.retrieveControllerLogsByInstanceArgsForCall, struct {
+		arg1 string
+		arg2 *v1beta1.ServiceInstance
+		arg3 int64
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetrieveControllerLogsByInstance", []interface{}{arg1, arg2, arg3})
+	fake.retrieveControllerLogsByInstanceMutex.Unlock()
+	if fake.RetrieveControllerLogsByInstanceStub != nil {
+		return fake.RetrieveControllerLogsByInstanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrieveControllerLogsByInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveControllerLogsByInstanceCallCount() int {
+	fake.retrieveControllerLogsByInstanceMutex.RLock()
+	defer fake.retrieveControllerLogsByInstanceMutex.RUnlock()
+	return len(fake.retrieveControllerLogsByInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveControllerLogsByInstanceCalls(stub func(string, *v1beta1.ServiceInstance, int64) ([]string, error)) {
+	fake.retrieveControllerLogsByInstanceMutex.Lock()
+	defer fake.retrieveControllerLogsByInstanceMutex.Unlock()
+	fake.RetrieveControllerLogsByInstanceStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrieveControllerLogsByInstanceArgsForCall(i int) (string, *v1beta1.ServiceInstance, int64) {
+	fake.retrieveControllerLogsByInstanceMutex.RLock()
+	defer fake.retrieveControllerLogsByInstanceMutex.RUnlock()
+	argsForCall := fake.retrieveControllerLogsByInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSvcatClient) RetrieveControllerLogsByInstanceReturns(result1 []string, result2 error) {
+	fake.retrieveControllerLogsByInstanceMutex.Lock()
+	defer fake.retrieveControllerLogsByInstanceMutex.Unlock()
+	fake.RetrieveControllerLogsByInstanceStub = nil
+	fake.retrieveControllerLogsByInstanceReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveControllerLogsByInstanceReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.retrieveControllerLogsByInstanceMutex.Lock()
+	defer fake.retrieveControllerLogsByInstanceMutex.Unlock()
+	fake.RetrieveControllerLogsByInstanceStub = nil
+	if fake.retrieveControllerLogsByInstanceReturnsOnCall == nil {
+		fake.retrieveControllerLogsByInstanceReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.retrieveControllerLogsByInstanceReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsByInstance(arg1 *v1beta1.ServiceInstance) ([]v1.Event, error) {
+	fake.retrieveEventsByInstanceMutex.Lock()
+	ret, specificReturn := fake.retrieveEventsByInstanceReturnsOnCall[len(fake.retrieveEventsByInstanceArgsForCall)]
+	fake.retrieveEventsByInstanceArgsForCall = append(fake.retrieveEventsByInstanceArgsForCall, struct {
+		arg1 *v1beta1.ServiceInstance
+	}{arg1})
+	fake.recordInvocation("RetrieveEventsByInstance", []interface{}{arg1})
+	fake.retrieveEventsByInstanceMutex.Unlock()
+	if fake.RetrieveEventsByInstanceStub != nil {
+		return fake.RetrieveEventsByInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrieveEventsByInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsByInstanceCallCount() int {
+	fake.retrieveEventsByInstanceMutex.RLock()
+	defer fake.retrieveEventsByInstanceMutex.RUnlock()
+	return len(fake.retrieveEventsByInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsByInstanceCalls(stub func(*v1beta1.ServiceInstance) ([]v1.Event, error)) {
+	fake.retrieveEventsByInstanceMutex.Lock()
+	defer fake.retrieveEventsByInstanceMutex.Unlock()
+	fake.RetrieveEventsByInstanceStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsByInstanceArgsForCall(i int) *v1beta1.ServiceInstance {
+	fake.retrieveEventsByInstanceMutex.RLock()
+	defer fake.retrieveEventsByInstanceMutex.RUnlock()
+	argsForCall := fake.retrieveEventsByInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsByInstanceReturns(result1 []v1.Event, result2 error) {
+	fake.retrieveEventsByInstanceMutex.Lock()
+	defer fake.retrieveEventsByInstanceMutex.Unlock()
+	fake.RetrieveEventsByInstanceStub = nil
+	fake.retrieveEventsByInstanceReturns = struct {
+		result1 []v1.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsByInstanceReturnsOnCall(i int, result1 []v1.Event, result2 error) {
+	fake.retrieveEventsByInstanceMutex.Lock()
+	defer fake.retrieveEventsByInstanceMutex.Unlock()
+	fake.RetrieveEventsByInstanceStub = nil
+	if fake.retrieveEventsByInstanceReturnsOnCall == nil {
+		fake.retrieveEventsByInstanceReturnsOnCall = make(map[int]struct {
+			result1 []v1.Event
+			result2 error
+		})
+	}
+	fake.retrieveEventsByInstanceReturnsOnCall[i] = struct {
+		result1 []v1.Event
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrieveInstance(arg1 string, arg2 string) (*v1beta1.ServiceInstance, error) {
 	fake.retrieveInstanceMutex.Lock()
 	ret, specificReturn := fake.retrieveInstanceReturnsOnCall[len(fake.retrieveInstanceArgsForCall)]
@@ -2726,6 +3229,137 @@ func (fake *FakeSvcatClient) RetrieveInstancesReturnsOnCall(i int, result1 *v1be
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveInstancesByCondition(arg1 string, arg2 v1beta1.ServiceInstanceConditionType, arg3 v1beta1.ConditionStatus) (*v1beta1.ServiceInstanceList, error) {
+	fake.retrieveInstancesByConditionMutex.Lock()
+	ret, specificReturn := fake.retrieveInstancesByConditionReturnsOnCall[len(fake.retrieveInstancesByConditionArgsForCall)]
+	fake.retrieveInstancesByConditionArgsForCall = append(fake.retrieveInstancesByConditionArgsForCall, struct {
+		arg1 string
+		arg2 v1beta1.ServiceInstanceConditionType
+		arg3 v1beta1.ConditionStatus
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetrieveInstancesByCondition", []interface{}{arg1, arg2, arg3})
+	fake.retrieveInstancesByConditionMutex.Unlock()
+	if fake.RetrieveInstancesByConditionStub != nil {
+		return fake.RetrieveInstancesByConditionStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrieveInstancesByConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionCallCount() int {
+	fake.retrieveInstancesByConditionMutex.RLock()
+	defer fake.retrieveInstancesByConditionMutex.RUnlock()
+	return len(fake.retrieveInstancesByConditionArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionCalls(stub func(string, v1beta1.ServiceInstanceConditionType, v1beta1.ConditionStatus) (*v1beta1.ServiceInstanceList, error)) {
+	fake.retrieveInstancesByConditionMutex.Lock()
+	defer fake.retrieveInstancesByConditionMutex.Unlock()
+	fake.RetrieveInstancesByConditionStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionArgsForCall(i int) (string, v1beta1.ServiceInstanceConditionType, v1beta1.ConditionStatus) {
+	fake.retrieveInstancesByConditionMutex.RLock()
+	defer fake.retrieveInstancesByConditionMutex.RUnlock()
+	argsForCall := fake.retrieveInstancesByConditionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionReturns(result1 *v1beta1.ServiceInstanceList, result2 error) {
+	fake.retrieveInstancesByConditionMutex.Lock()
+	defer fake.retrieveInstancesByConditionMutex.Unlock()
+	fake.RetrieveInstancesByConditionStub = nil
+	fake.retrieveInstancesByConditionReturns = struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionReturnsOnCall(i int, result1 *v1beta1.ServiceInstanceList, result2 error) {
+	fake.retrieveInstancesByConditionMutex.Lock()
+	defer fake.retrieveInstancesByConditionMutex.Unlock()
+	fake.RetrieveInstancesByConditionStub = nil
+	if fake.retrieveInstancesByConditionReturnsOnCall == nil {
+		fake.retrieveInstancesByConditionReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ServiceInstanceList
+			result2 error
+		})
+	}
+	fake.retrieveInstancesByConditionReturnsOnCall[i] = struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionInChunks(arg1 string, arg2 v1beta1.ServiceInstanceConditionType, arg3 v1beta1.ConditionStatus, arg4 int64) (*v1beta1.ServiceInstanceList, error) {
+	fake.retrieveInstancesByConditionInChunksMutex.Lock()
+	ret, specificReturn := fake.retrieveInstancesByConditionInChunksReturnsOnCall[len(fake.retrieveInstancesByConditionInChunksArgsForCall)]
+	fake.retrieveInstancesByConditionInChunksArgsForCall = append(fake.retrieveInstancesByConditionInChunksArgsForCall, struct {
+		arg1 string
+		arg2 v1beta1.ServiceInstanceConditionType
+		arg3 v1beta1.ConditionStatus
+		arg4 int64
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RetrieveInstancesByConditionInChunks", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveInstancesByConditionInChunksMutex.Unlock()
+	if fake.RetrieveInstancesByConditionInChunksStub != nil {
+		return fake.RetrieveInstancesByConditionInChunksStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrieveInstancesByConditionInChunksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionInChunksCallCount() int {
+	fake.retrieveInstancesByConditionInChunksMutex.RLock()
+	defer fake.retrieveInstancesByConditionInChunksMutex.RUnlock()
+	return len(fake.retrieveInstancesByConditionInChunksArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionInChunksCalls(stub func(string, v1beta1.ServiceInstanceConditionType, v1beta1.ConditionStatus, int64) (*v1beta1.ServiceInstanceList, error)) {
+	fake.retrieveInstancesByConditionInChunksMutex.Lock()
+	defer fake.retrieveInstancesByConditionInChunksMutex.Unlock()
+	fake.RetrieveInstancesByConditionInChunksStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionInChunksArgsForCall(i int) (string, v1beta1.ServiceInstanceConditionType, v1beta1.ConditionStatus, int64) {
+	fake.retrieveInstancesByConditionInChunksMutex.RLock()
+	defer fake.retrieveInstancesByConditionInChunksMutex.RUnlock()
+	argsForCall := fake.retrieveInstancesByConditionInChunksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionInChunksReturns(result1 *v1beta1.ServiceInstanceList, result2 error) {
+	fake.retrieveInstancesByConditionInChunksMutex.Lock()
+	defer fake.retrieveInstancesByConditionInChunksMutex.Unlock()
+	fake.RetrieveInstancesByConditionInChunksStub = nil
+	fake.retrieveInstancesByConditionInChunksReturns = struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesByConditionInChunksReturnsOnCall(i int, result1 *v1beta1.ServiceInstanceList, result2 error) {
+	fake.retrieveInstancesByConditionInChunksMutex.Lock()
+	defer fake.retrieveInstancesByConditionInChunksMutex.Unlock()
+	fake.RetrieveInstancesByConditionInChunksStub = nil
+	if fake.retrieveInstancesByConditionInChunksReturnsOnCall == nil {
+		fake.retrieveInstancesByConditionInChunksReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ServiceInstanceList
+			result2 error
+		})
+	}
+	fake.retrieveInstancesByConditionInChunksReturnsOnCall[i] = struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrieveInstancesByPlan(arg1 servicecatalog.Plan) ([]v1beta1.ServiceInstance, error) {
 	fake.retrieveInstancesByPlanMutex.Lock()
 	ret, specificReturn := fake.retrieveInstancesByPlanReturnsOnCall[len(fake.retrieveInstancesByPlanArgsForCall)]
@@ -2789,6 +3423,72 @@ func (fake *FakeSvcatClient) RetrieveInstancesByPlanReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveInstancesInChunks(arg1 string, arg2 string, arg3 string, arg4 int64) (*v1beta1.ServiceInstanceList, error) {
+	fake.retrieveInstancesInChunksMutex.Lock()
+	ret, specificReturn := fake.retrieveInstancesInChunksReturnsOnCall[len(fake.retrieveInstancesInChunksArgsForCall)]
+	fake.retrieveInstancesInChunksArgsForCall = append(fake.retrieveInstancesInChunksArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int64
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RetrieveInstancesInChunks", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveInstancesInChunksMutex.Unlock()
+	if fake.RetrieveInstancesInChunksStub != nil {
+		return fake.RetrieveInstancesInChunksStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrieveInstancesInChunksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesInChunksCallCount() int {
+	fake.retrieveInstancesInChunksMutex.RLock()
+	defer fake.retrieveInstancesInChunksMutex.RUnlock()
+	return len(fake.retrieveInstancesInChunksArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesInChunksCalls(stub func(string, string, string, int64) (*v1beta1.ServiceInstanceList, error)) {
+	fake.retrieveInstancesInChunksMutex.Lock()
+	defer fake.retrieveInstancesInChunksMutex.Unlock()
+	fake.RetrieveInstancesInChunksStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesInChunksArgsForCall(i int) (string, string, string, int64) {
+	fake.retrieveInstancesInChunksMutex.RLock()
+	defer fake.retrieveInstancesInChunksMutex.RUnlock()
+	argsForCall := fake.retrieveInstancesInChunksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesInChunksReturns(result1 *v1beta1.ServiceInstanceList, result2 error) {
+	fake.retrieveInstancesInChunksMutex.Lock()
+	defer fake.retrieveInstancesInChunksMutex.Unlock()
+	fake.RetrieveInstancesInChunksStub = nil
+	fake.retrieveInstancesInChunksReturns = struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesInChunksReturnsOnCall(i int, result1 *v1beta1.ServiceInstanceList, result2 error) {
+	fake.retrieveInstancesInChunksMutex.Lock()
+	defer fake.retrieveInstancesInChunksMutex.Unlock()
+	fake.RetrieveInstancesInChunksStub = nil
+	if fake.retrieveInstancesInChunksReturnsOnCall == nil {
+		fake.retrieveInstancesInChunksReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ServiceInstanceList
+			result2 error
+		})
+	}
+	fake.retrieveInstancesInChunksReturnsOnCall[i] = struct {
+		result1 *v1beta1.ServiceInstanceList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrievePlanByClassAndName(arg1 string, arg2 string, arg3 servicecatalog.ScopeOptions) (servicecatalog.Plan, error) {
 	fake.retrievePlanByClassAndNameMutex.Lock()
 	ret, specificReturn := fake.retrievePlanByClassAndNameReturnsOnCall[len(fake.retrievePlanByClassAndNameArgsForCall)]
@@ -3047,6 +3747,72 @@ func (fake *FakeSvcatClient) RetrievePlanByNameReturnsOnCall(i int, result1 serv
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrievePlanParameterNames(arg1 string, arg2 string, arg3 bool, arg4 servicecatalog.ScopeOptions) ([]string, error) {
+	fake.retrievePlanParameterNamesMutex.Lock()
+	ret, specificReturn := fake.retrievePlanParameterNamesReturnsOnCall[len(fake.retrievePlanParameterNamesArgsForCall)]
+	fake.retrievePlanParameterNamesArgsForCall = append(fake.retrievePlanParameterNamesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 servicecatalog.ScopeOptions
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RetrievePlanParameterNames", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrievePlanParameterNamesMutex.Unlock()
+	if fake.RetrievePlanParameterNamesStub != nil {
+		return fake.RetrievePlanParameterNamesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retrievePlanParameterNamesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrievePlanParameterNamesCallCount() int {
+	fake.retrievePlanParameterNamesMutex.RLock()
+	defer fake.retrievePlanParameterNamesMutex.RUnlock()
+	return len(fake.retrievePlanParameterNamesArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrievePlanParameterNamesCalls(stub func(string, string, bool, servicecatalog.ScopeOptions) ([]string, error)) {
+	fake.retrievePlanParameterNamesMutex.Lock()
+	defer fake.retrievePlanParameterNamesMutex.Unlock()
+	fake.RetrievePlanParameterNamesStub = stub
+}
+
+func (fake *FakeSvcatClient) RetrievePlanParameterNamesArgsForCall(i int) (string, string, bool, servicecatalog.ScopeOptions) {
+	fake.retrievePlanParameterNamesMutex.RLock()
+	defer fake.retrievePlanParameterNamesMutex.RUnlock()
+	argsForCall := fake.retrievePlanParameterNamesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSvcatClient) RetrievePlanParameterNamesReturns(result1 []string, result2 error) {
+	fake.retrievePlanParameterNamesMutex.Lock()
+	defer fake.retrievePlanParameterNamesMutex.Unlock()
+	fake.RetrievePlanParameterNamesStub = nil
+	fake.retrievePlanParameterNamesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrievePlanParameterNamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.retrievePlanParameterNamesMutex.Lock()
+	defer fake.retrievePlanParameterNamesMutex.Unlock()
+	fake.RetrievePlanParameterNamesStub = nil
+	if fake.retrievePlanParameterNamesReturnsOnCall == nil {
+		fake.retrievePlanParameterNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.retrievePlanParameterNamesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrievePlans(arg1 string, arg2 servicecatalog.ScopeOptions) ([]servicecatalog.Plan, error) {
 	fake.retrievePlansMutex.Lock()
 	ret, specificReturn := fake.retrievePlansReturnsOnCall[len(fake.retrievePlansArgsForCall)]
@@ -3174,6 +3940,70 @@ func (fake *FakeSvcatClient) RetrieveSecretByBindingReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetryBinding(arg1 string, arg2 string) (*v1beta1.ServiceBinding, error) {
+	fake.retryBindingMutex.Lock()
+	ret, specificReturn := fake.retryBindingReturnsOnCall[len(fake.retryBindingArgsForCall)]
+	fake.retryBindingArgsForCall = append(fake.retryBindingArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RetryBinding", []interface{}{arg1, arg2})
+	fake.retryBindingMutex.Unlock()
+	if fake.RetryBindingStub != nil {
+		return fake.RetryBindingStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retryBindingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetryBindingCallCount() int {
+	fake.retryBindingMutex.RLock()
+	defer fake.retryBindingMutex.RUnlock()
+	return len(fake.retryBindingArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetryBindingCalls(stub func(string, string) (*v1beta1.ServiceBinding, error)) {
+	fake.retryBindingMutex.Lock()
+	defer fake.retryBindingMutex.Unlock()
+	fake.RetryBindingStub = stub
+}
+
+func (fake *FakeSvcatClient) RetryBindingArgsForCall(i int) (string, string) {
+	fake.retryBindingMutex.RLock()
+	defer fake.retryBindingMutex.RUnlock()
+	argsForCall := fake.retryBindingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSvcatClient) RetryBindingReturns(result1 *v1beta1.ServiceBinding, result2 error) {
+	fake.retryBindingMutex.Lock()
+	defer fake.retryBindingMutex.Unlock()
+	fake.RetryBindingStub = nil
+	fake.retryBindingReturns = struct {
+		result1 *v1beta1.ServiceBinding
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetryBindingReturnsOnCall(i int, result1 *v1beta1.ServiceBinding, result2 error) {
+	fake.retryBindingMutex.Lock()
+	defer fake.retryBindingMutex.Unlock()
+	fake.RetryBindingStub = nil
+	if fake.retryBindingReturnsOnCall == nil {
+		fake.retryBindingReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ServiceBinding
+			result2 error
+		})
+	}
+	fake.retryBindingReturnsOnCall[i] = struct {
+		result1 *v1beta1.ServiceBinding
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) ServerVersion() (*version.Info, error) {
 	fake.serverVersionMutex.Lock()
 	ret, specificReturn := fake.serverVersionReturnsOnCall[len(fake.serverVersionArgsForCall)]
@@ -3291,6 +4121,68 @@ func (fake *FakeSvcatClient) SyncReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) TouchBinding(arg1 string, arg2 string, arg3 int) error {
+	fake.touchBindingMutex.Lock()
+	ret, specificReturn := fake.touchBindingReturnsOnCall[len(fake.touchBindingArgsForCall)]
+	fake.touchBindingArgsForCall = append(fake.touchBindingArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("TouchBinding", []interface{}{arg1, arg2, arg3})
+	fake.touchBindingMutex.Unlock()
+	if fake.TouchBindingStub != nil {
+		return fake.TouchBindingStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.touchBindingReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSvcatClient) TouchBindingCallCount() int {
+	fake.touchBindingMutex.RLock()
+	defer fake.touchBindingMutex.RUnlock()
+	return len(fake.touchBindingArgsForCall)
+}
+
+func (fake *FakeSvcatClient) TouchBindingCalls(stub func(string, string, int) error) {
+	fake.touchBindingMutex.Lock()
+	defer fake.touchBindingMutex.Unlock()
+	fake.TouchBindingStub = stub
+}
+
+func (fake *FakeSvcatClient) TouchBindingArgsForCall(i int) (string, string, int) {
+	fake.touchBindingMutex.RLock()
+	defer fake.touchBindingMutex.RUnlock()
+	argsForCall := fake.touchBindingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSvcatClient) TouchBindingReturns(result1 error) {
+	fake.touchBindingMutex.Lock()
+	defer fake.touchBindingMutex.Unlock()
+	fake.TouchBindingStub = nil
+	fake.touchBindingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSvcatClient) TouchBindingReturnsOnCall(i int, result1 error) {
+	fake.touchBindingMutex.Lock()
+	defer fake.touchBindingMutex.Unlock()
+	fake.TouchBindingStub = nil
+	if fake.touchBindingReturnsOnCall == nil {
+		fake.touchBindingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.touchBindingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSvcatClient) TouchInstance(arg1 string, arg2 string, arg3 int) error {
 	fake.touchInstanceMutex.Lock()
 	ret, specificReturn := fake.touchInstanceReturnsOnCall[len(fake.touchInstanceArgsForCall)]
@@ -3417,6 +4309,73 @@ func (fake *FakeSvcatClient) UnbindReturnsOnCall(i int, result1 []types.Namespac
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) UnbindAndWait(arg1 string, arg2 string, arg3 time.Duration, arg4 *time.Duration, arg5 func(servicecatalog.UnbindProgress)) ([]types.NamespacedName, error) {
+	fake.unbindAndWaitMutex.Lock()
+	ret, specificReturn := fake.unbindAndWaitReturnsOnCall[len(fake.unbindAndWaitArgsForCall)]
+	fake.unbindAndWaitArgsForCall = append(fake.unbindAndWaitArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Duration
+		arg4 *time.Duration
+		arg5 func(servicecatalog.UnbindProgress)
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("UnbindAndWait", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.unbindAndWaitMutex.Unlock()
+	if fake.UnbindAndWaitStub != nil {
+		return fake.UnbindAndWaitStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unbindAndWaitReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) UnbindAndWaitCallCount() int {
+	fake.unbindAndWaitMutex.RLock()
+	defer fake.unbindAndWaitMutex.RUnlock()
+	return len(fake.unbindAndWaitArgsForCall)
+}
+
+func (fake *FakeSvcatClient) UnbindAndWaitCalls(stub func(string, string, time.Duration, *time.Duration, func(servicecatalog.UnbindProgress)) ([]types.NamespacedName, error)) {
+	fake.unbindAndWaitMutex.Lock()
+	defer fake.unbindAndWaitMutex.Unlock()
+	fake.UnbindAndWaitStub = stub
+}
+
+func (fake *FakeSvcatClient) UnbindAndWaitArgsForCall(i int) (string, string, time.Duration, *time.Duration, func(servicecatalog.UnbindProgress)) {
+	fake.unbindAndWaitMutex.RLock()
+	defer fake.unbindAndWaitMutex.RUnlock()
+	argsForCall := fake.unbindAndWaitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeSvcatClient) UnbindAndWaitReturns(result1 []types.NamespacedName, result2 error) {
+	fake.unbindAndWaitMutex.Lock()
+	defer fake.unbindAndWaitMutex.Unlock()
+	fake.UnbindAndWaitStub = nil
+	fake.unbindAndWaitReturns = struct {
+		result1 []types.NamespacedName
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) UnbindAndWaitReturnsOnCall(i int, result1 []types.NamespacedName, result2 error) {
+	fake.unbindAndWaitMutex.Lock()
+	defer fake.unbindAndWaitMutex.Unlock()
+	fake.UnbindAndWaitStub = nil
+	if fake.unbindAndWaitReturnsOnCall == nil {
+		fake.unbindAndWaitReturnsOnCall = make(map[int]struct {
+			result1 []types.NamespacedName
+			result2 error
+		})
+	}
+	fake.unbindAndWaitReturnsOnCall[i] = struct {
+		result1 []types.NamespacedName
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) WaitForBinding(arg1 string, arg2 string, arg3 time.Duration, arg4 *time.Duration) (*v1beta1.ServiceBinding, error) {
 	fake.waitForBindingMutex.Lock()
 	ret, specificReturn := fake.waitForBindingReturnsOnCall[len(fake.waitForBindingArgsForCall)]
@@ -3549,6 +4508,72 @@ func (fake *FakeSvcatClient) WaitForBrokerReturnsOnCall(i int, result1 serviceca
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) WaitForBrokerRelist(arg1 string, arg2 servicecatalog.ScopeOptions, arg3 time.Duration, arg4 *time.Duration) (servicecatalog.Broker, error) {
+	fake.waitForBrokerRelistMutex.Lock()
+	ret, specificReturn := fake.waitForBrokerRelistReturnsOnCall[len(fake.waitForBrokerRelistArgsForCall)]
+	fake.waitForBrokerRelistArgsForCall = append(fake.waitForBrokerRelistArgsForCall, struct {
+		arg1 string
+		arg2 servicecatalog.ScopeOptions
+		arg3 time.Duration
+		arg4 *time.Duration
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("WaitForBrokerRelist", []interface{}{arg1, arg2, arg3, arg4})
+	fake.waitForBrokerRelistMutex.Unlock()
+	if fake.WaitForBrokerRelistStub != nil {
+		return fake.WaitForBrokerRelistStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.waitForBrokerRelistReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSvcatClient) WaitForBrokerRelistCallCount() int {
+	fake.waitForBrokerRelistMutex.RLock()
+	defer fake.waitForBrokerRelistMutex.RUnlock()
+	return len(fake.waitForBrokerRelistArgsForCall)
+}
+
+func (fake *FakeSvcatClient) WaitForBrokerRelistCalls(stub func(string, servicecatalog.ScopeOptions, time.Duration, *time.Duration) (servicecatalog.Broker, error)) {
+	fake.waitForBrokerRelistMutex.Lock()
+	defer fake.waitForBrokerRelistMutex.Unlock()
+	fake.WaitForBrokerRelistStub = stub
+}
+
+func (fake *FakeSvcatClient) WaitForBrokerRelistArgsForCall(i int) (string, servicecatalog.ScopeOptions, time.Duration, *time.Duration) {
+	fake.waitForBrokerRelistMutex.RLock()
+	defer fake.waitForBrokerRelistMutex.RUnlock()
+	argsForCall := fake.waitForBrokerRelistArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSvcatClient) WaitForBrokerRelistReturns(result1 servicecatalog.Broker, result2 error) {
+	fake.waitForBrokerRelistMutex.Lock()
+	defer fake.waitForBrokerRelistMutex.Unlock()
+	fake.WaitForBrokerRelistStub = nil
+	fake.waitForBrokerRelistReturns = struct {
+		result1 servicecatalog.Broker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WaitForBrokerRelistReturnsOnCall(i int, result1 servicecatalog.Broker, result2 error) {
+	fake.waitForBrokerRelistMutex.Lock()
+	defer fake.waitForBrokerRelistMutex.Unlock()
+	fake.WaitForBrokerRelistStub = nil
+	if fake.waitForBrokerRelistReturnsOnCall == nil {
+		fake.waitForBrokerRelistReturnsOnCall = make(map[int]struct {
+			result1 servicecatalog.Broker
+			result2 error
+		})
+	}
+	fake.waitForBrokerRelistReturnsOnCall[i] = struct {
+		result1 servicecatalog.Broker
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) WaitForInstance(arg1 string, arg2 string, arg3 time.Duration, arg4 *time.Duration) (*v1beta1.ServiceInstance, error) {
 	fake.waitForInstanceMutex.Lock()
 	ret, specificReturn := fake.waitForInstanceReturnsOnCall[len(fake.waitForInstanceArgsForCall)]
@@ -3698,6 +4723,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.deprovisionMutex.RUnlock()
 	fake.deregisterMutex.RLock()
 	defer fake.deregisterMutex.RUnlock()
+	fake.diffInstanceParametersMutex.RLock()
+	defer fake.diffInstanceParametersMutex.RUnlock()
 	fake.instanceParentHierarchyMutex.RLock()
 	defer fake.instanceParentHierarchyMutex.RUnlock()
 	fake.instanceToServiceClassAndPlanMutex.RLock()
@@ -3706,6 +4733,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.isBindingFailedMutex.RUnlock()
 	fake.isBindingReadyMutex.RLock()
 	defer fake.isBindingReadyMutex.RUnlock()
+	fake.isBrokerFailedMutex.RLock()
+	defer fake.isBrokerFailedMutex.RUnlock()
 	fake.isInstanceFailedMutex.RLock()
 	defer fake.isInstanceFailedMutex.RUnlock()
 	fake.isInstanceReadyMutex.RLock()
@@ -3732,6 +4761,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveBrokerByClassMutex.RUnlock()
 	fake.retrieveBrokerByIDMutex.RLock()
 	defer fake.retrieveBrokerByIDMutex.RUnlock()
+	fake.retrieveBrokerCatalogMutex.RLock()
+	defer fake.retrieveBrokerCatalogMutex.RUnlock()
 	fake.retrieveBrokersMutex.RLock()
 	defer fake.retrieveBrokersMutex.RUnlock()
 	fake.retrieveClassByIDMutex.RLock()
@@ -3742,14 +4773,24 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveClassByPlanMutex.RUnlock()
 	fake.retrieveClassesMutex.RLock()
 	defer fake.retrieveClassesMutex.RUnlock()
+	fake.retrieveControllerLogsByInstanceMutex.RLock()
+	defer fake.retrieveControllerLogsByInstanceMutex.RUnlock()
+	fake.retrieveEventsByInstanceMutex.RLock()
+	defer fake.retrieveEventsByInstanceMutex.RUnlock()
 	fake.retrieveInstanceMutex.RLock()
 	defer fake.retrieveInstanceMutex.RUnlock()
 	fake.retrieveInstanceByBindingMutex.RLock()
 	defer fake.retrieveInstanceByBindingMutex.RUnlock()
 	fake.retrieveInstancesMutex.RLock()
 	defer fake.retrieveInstancesMutex.RUnlock()
+	fake.retrieveInstancesByConditionMutex.RLock()
+	defer fake.retrieveInstancesByConditionMutex.RUnlock()
+	fake.retrieveInstancesByConditionInChunksMutex.RLock()
+	defer fake.retrieveInstancesByConditionInChunksMutex.RUnlock()
 	fake.retrieveInstancesByPlanMutex.RLock()
 	defer fake.retrieveInstancesByPlanMutex.RUnlock()
+	fake.retrieveInstancesInChunksMutex.RLock()
+	defer fake.retrieveInstancesInChunksMutex.RUnlock()
 	fake.retrievePlanByClassAndNameMutex.RLock()
 	defer fake.retrievePlanByClassAndNameMutex.RUnlock()
 	fake.retrievePlanByClassIDAndNameMutex.RLock()
@@ -3758,22 +4799,32 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrievePlanByIDMutex.RUnlock()
 	fake.retrievePlanByNameMutex.RLock()
 	defer fake.retrievePlanByNameMutex.RUnlock()
+	fake.retrievePlanParameterNamesMutex.RLock()
+	defer fake.retrievePlanParameterNamesMutex.RUnlock()
 	fake.retrievePlansMutex.RLock()
 	defer fake.retrievePlansMutex.RUnlock()
 	fake.retrieveSecretByBindingMutex.RLock()
 	defer fake.retrieveSecretByBindingMutex.RUnlock()
+	fake.retryBindingMutex.RLock()
+	defer fake.retryBindingMutex.RUnlock()
 	fake.serverVersionMutex.RLock()
 	defer fake.serverVersionMutex.RUnlock()
 	fake.syncMutex.RLock()
 	defer fake.syncMutex.RUnlock()
+	fake.touchBindingMutex.RLock()
+	defer fake.touchBindingMutex.RUnlock()
 	fake.touchInstanceMutex.RLock()
 	defer fake.touchInstanceMutex.RUnlock()
 	fake.unbindMutex.RLock()
 	defer fake.unbindMutex.RUnlock()
+	fake.unbindAndWaitMutex.RLock()
+	defer fake.unbindAndWaitMutex.RUnlock()
 	fake.waitForBindingMutex.RLock()
 	defer fake.waitForBindingMutex.RUnlock()
 	fake.waitForBrokerMutex.RLock()
 	defer fake.waitForBrokerMutex.RUnlock()
+	fake.waitForBrokerRelistMutex.RLock()
+	defer fake.waitForBrokerRelistMutex.RUnlock()
 	fake.waitForInstanceMutex.RLock()
 	defer fake.waitForInstanceMutex.RUnlock()
 	fake.waitForInstanceToNotExistMutex.RLock()
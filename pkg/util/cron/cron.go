@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron parses standard five-field cron expressions
+// ("minute hour day-of-month month day-of-week").
+package cron
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domRestricted and dowRestricted record whether the day-of-month and
+	// day-of-week fields were given as something other than "*": when both
+	// are, a time matches if either of them does.
+	domRestricted, dowRestricted bool
+}
+
+type bounds struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteBounds = bounds{"minute", 0, 59}
+	hourBounds   = bounds{"hour", 0, 23}
+	domBounds    = bounds{"day-of-month", 1, 31}
+	monthBounds  = bounds{"month", 1, 12}
+	// Both 0 and 7 are Sunday.
+	dowBounds = bounds{"day-of-week", 0, 7}
+)
+
+// Parse parses a five-field cron expression. Each field is "*", a number, a
+// range "a-b" or a comma-separated list of those, optionally followed by a
+// step "/n".
+func Parse(spec string) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, found %d: %q", len(fields), spec)
+	}
+
+	s := &Schedule{}
+	var err error
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domRestricted = fields[2] != "*"
+	s.dowRestricted = fields[4] != "*"
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, term := range strings.Split(field, ",") {
+		termBits, err := parseTerm(term, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= termBits
+	}
+	return bits, nil
+}
+
+func parseTerm(term string, b bounds) (uint64, error) {
+	rangeExpr, step := term, 1
+	if i := strings.Index(term, "/"); i >= 0 {
+		var err error
+		rangeExpr = term[:i]
+		if step, err = strconv.Atoi(term[i+1:]); err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step in %s field: %q", b.name, term)
+		}
+	}
+
+	start, end := b.min, b.max
+	switch {
+	case rangeExpr == "*":
+	case strings.Contains(rangeExpr, "-"):
+		parts := strings.SplitN(rangeExpr, "-", 2)
+		var err error
+		if start, err = parseValue(parts[0], b); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(parts[1], b); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range in %s field: %q", b.name, term)
+		}
+	default:
+		var err error
+		if start, err = parseValue(rangeExpr, b); err != nil {
+			return 0, err
+		}
+		if step == 1 {
+			end = start
+		}
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s field: %q", b.name, s)
+	}
+	if v < b.min || v > b.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", b.name, v, b.min, b.max)
+	}
+	return v, nil
+}
+
+// Matches returns whether the minute containing t is one of the times given
+// by the schedule. t is evaluated in its own location.
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.month&(1<<uint(t.Month())) != 0 &&
+		s.dayMatches(t) &&
+		s.hour&(1<<uint(t.Hour())) != 0 &&
+		s.minute&(1<<uint(t.Minute())) != 0
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+// ActiveUntil returns whether t falls within a window of length d that
+// starts at one of the times given by the schedule and, if it does, when
+// the most recently started such window ends.
+func (s *Schedule) ActiveUntil(t time.Time, d time.Duration) (time.Time, bool) {
+	start, ok := s.latestStart(t.Truncate(time.Minute), t.Add(-d))
+	if !ok {
+		return time.Time{}, false
+	}
+	return start.Add(d), true
+}
+
+// latestStart returns the latest of the times given by the schedule that is
+// no later than t, which is truncated to the minute, provided it is after
+// earliest. Rather than stepping back a minute at a time, it skips to the
+// end of the previous month, day or hour when t is in one the schedule
+// leaves out, so that it takes a few iterations per day whatever the length
+// of the search.
+func (s *Schedule) latestStart(t, earliest time.Time) (time.Time, bool) {
+	for t.After(earliest) {
+		minutesIntoDay := time.Duration(t.Hour()*60+t.Minute()) * time.Minute
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			// The last minute of the previous month.
+			t = t.Add(-time.Duration(t.Day()-1)*24*time.Hour - minutesIntoDay - time.Minute)
+		case !s.dayMatches(t):
+			// The last minute of the previous day.
+			t = t.Add(-minutesIntoDay - time.Minute)
+		default:
+			hour := latestBit(s.hour, t.Hour())
+			if hour < 0 {
+				t = t.Add(-minutesIntoDay - time.Minute)
+				continue
+			}
+			if hour != t.Hour() {
+				// The last minute of the latest hour of the schedule.
+				t = t.Add(-time.Duration(t.Hour()-hour-1)*time.Hour - time.Duration(t.Minute()+1)*time.Minute)
+				continue
+			}
+			minute := latestBit(s.minute, t.Minute())
+			if minute < 0 {
+				// The last minute of the previous hour.
+				t = t.Add(-time.Duration(t.Minute()+1) * time.Minute)
+				continue
+			}
+			t = t.Add(-time.Duration(t.Minute()-minute) * time.Minute)
+			return t, t.After(earliest)
+		}
+	}
+	return time.Time{}, false
+}
+
+// latestBit returns the highest bit set in field that is no higher than
+// max, or -1 if there is none.
+func latestBit(field uint64, max int) int {
+	return bits.Len64(field&(1<<uint(max+1)-1)) - 1
+}
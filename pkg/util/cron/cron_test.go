@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+	}
+	for _, spec := range cases {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("expected an error parsing %q", spec)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	// 2026-10-16 is a Friday.
+	friday := time.Date(2026, time.October, 16, 2, 30, 45, 0, time.UTC)
+
+	cases := []struct {
+		spec    string
+		t       time.Time
+		matches bool
+	}{
+		{"* * * * *", friday, true},
+		{"30 2 * * *", friday, true},
+		{"31 2 * * *", friday, false},
+		{"*/15 * * * *", friday, true},
+		{"*/20 * * * *", friday, false},
+		{"0-40/10 1-3 * * *", friday, true},
+		{"30 2 * * 5", friday, true},
+		{"30 2 * * 1-4", friday, false},
+		{"30 2 * * 0", friday.AddDate(0, 0, 2), true},
+		{"30 2 * * 7", friday.AddDate(0, 0, 2), true},
+		{"30 2 1,16 10 *", friday, true},
+		{"30 2 1 11 *", friday, false},
+		// Both day fields restricted: either may match.
+		{"30 2 1 * 5", friday, true},
+		{"30 2 16 * 1", friday, true},
+		{"30 2 1 * 1", friday, false},
+	}
+	for _, tc := range cases {
+		s, err := Parse(tc.spec)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", tc.spec, err)
+		}
+		if e, a := tc.matches, s.Matches(tc.t); e != a {
+			t.Errorf("%q matching %v: expected %v, got %v", tc.spec, tc.t, e, a)
+		}
+	}
+}
+
+func TestActiveUntil(t *testing.T) {
+	s, err := Parse("0 2 * * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	start := time.Date(2026, time.October, 16, 2, 0, 0, 0, time.UTC)
+
+	if _, active := s.ActiveUntil(start.Add(-time.Second), time.Hour); active {
+		t.Error("expected the window not to be active before it starts")
+	}
+	end, active := s.ActiveUntil(start.Add(59*time.Minute+59*time.Second), time.Hour)
+	if !active {
+		t.Fatal("expected the window to be active")
+	}
+	if e, a := start.Add(time.Hour), end; !e.Equal(a) {
+		t.Errorf("expected the window to end at %v, got %v", e, a)
+	}
+	if _, active := s.ActiveUntil(start.Add(time.Hour), time.Hour); active {
+		t.Error("expected the window not to be active once it has ended")
+	}
+}
+
+// TestActiveUntilMatchesMinuteScan checks ActiveUntil against a scan of every
+// minute of the window back from t.
+func TestActiveUntilMatchesMinuteScan(t *testing.T) {
+	specs := []string{
+		"0 2 * * *",
+		"*/15 * * * *",
+		"30 22 * * 5",
+		"0 0 1 * *",
+		"45 23 31 12 *",
+		"0 3 1 * 1",
+		"10-20 4,16 * 2-3 *",
+	}
+	durations := []time.Duration{time.Minute, 90 * time.Minute, 24 * time.Hour, 7 * 24 * time.Hour}
+	from := time.Date(2026, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+	for _, spec := range specs {
+		s, err := Parse(spec)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", spec, err)
+		}
+		for _, d := range durations {
+			for i := 0; i < 200; i++ {
+				now := from.Add(time.Duration(i)*53*time.Minute + 13*time.Second)
+
+				var expectedEnd time.Time
+				expectedActive := false
+				for start := now.Truncate(time.Minute); now.Sub(start) < d; start = start.Add(-time.Minute) {
+					if s.Matches(start) {
+						expectedEnd, expectedActive = start.Add(d), true
+						break
+					}
+				}
+
+				end, active := s.ActiveUntil(now, d)
+				if active != expectedActive || !end.Equal(expectedEnd) {
+					t.Fatalf("%q over %v at %v: expected (%v, %v), got (%v, %v)", spec, d, now, expectedEnd, expectedActive, end, active)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parameters holds the canonical encoding and the checksum of the
+// parameters of service instances and bindings. The controller compares the
+// checksum of the parameters it would send with the one recorded in the
+// status to decide whether an update must be sent to the broker, so tools can
+// use this package to predict whether a change triggers a broker call.
+//
+// The encoding and the checksum are stable: changing them would make the
+// controller see a change in the parameters of every existing resource.
+package parameters
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+// Unmarshal produces a map of parameters from raw YAML or JSON input, such as
+// the parameters in the spec of an instance or binding. Numbers are decoded
+// as float64.
+func Unmarshal(in []byte) (map[string]interface{}, error) {
+	parameters := make(map[string]interface{})
+	if len(in) > 0 {
+		if err := yaml.Unmarshal(in, &parameters); err != nil {
+			return parameters, err
+		}
+	}
+	return parameters, nil
+}
+
+// Canonicalize returns the canonical encoding of a map of parameters: compact
+// JSON with the keys of every object sorted, and with the characters <, > and
+// & escaped.
+func Canonicalize(params map[string]interface{}) ([]byte, error) {
+	return json.Marshal(params)
+}
+
+// Checksum returns the checksum of a map of parameters, the hex encoded
+// SHA-256 hash of its canonical encoding. It returns an empty string when
+// there are no parameters.
+//
+// The parameters are those sent to the broker, including the values fetched
+// from parametersFrom sources.
+func Checksum(params map[string]interface{}) (string, error) {
+	if len(params) == 0 {
+		return "", nil
+	}
+	canonical, err := Canonicalize(params)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(canonical)
+	return fmt.Sprintf("%x", hash), nil
+}
+
+// Coerce converts the string values of the given parameters to the types
+// requested by the coerce map of a parametersFrom source. Values that already
+// have the requested type are left as they are, and parameters missing from
+// params are ignored.
+func Coerce(params map[string]interface{}, coerce map[string]v1beta1.ParameterType) error {
+	for name, t := range coerce {
+		value, ok := params[name]
+		if !ok {
+			continue
+		}
+		coerced, err := coerceValue(value, t)
+		if err != nil {
+			return fmt.Errorf("cannot convert parameter %q to %s: %v", name, t, err)
+		}
+		params[name] = coerced
+	}
+	return nil
+}
+
+func coerceValue(value interface{}, t v1beta1.ParameterType) (interface{}, error) {
+	switch t {
+	case v1beta1.ParameterTypeInteger:
+		switch v := value.(type) {
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		case float64:
+			if v == math.Trunc(v) {
+				return v, nil
+			}
+		}
+	case v1beta1.ParameterTypeNumber:
+		switch v := value.(type) {
+		case string:
+			return strconv.ParseFloat(v, 64)
+		case float64:
+			return v, nil
+		}
+	case v1beta1.ParameterTypeBoolean:
+		switch v := value.(type) {
+		case string:
+			return strconv.ParseBool(v)
+		case bool:
+			return v, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown type")
+	}
+	return nil, fmt.Errorf("unexpected value %v", value)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const testParameters = `
+b:
+  d: [true, null]
+  c: <x>
+a: 1
+`
+
+func TestCanonicalize(t *testing.T) {
+	params, err := Unmarshal([]byte(testParameters))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	canonical, err := Canonicalize(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := `{"a":1,"b":{"c":"\u003cx\u003e","d":[true,null]}}`, string(canonical); e != a {
+		t.Errorf("unexpected canonical encoding: expected %v, got %v", e, a)
+	}
+}
+
+// TestChecksum pins the checksum of a set of parameters. The checksum is
+// recorded in the status of existing resources, so it must never change.
+func TestChecksum(t *testing.T) {
+	params, err := Unmarshal([]byte(testParameters))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checksum, err := Checksum(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "e00f85e77b7b6f46aa5e409ee3cb58fb0eb46c28af3f8e497d395d9ddbb1d94c", checksum; e != a {
+		t.Errorf("unexpected checksum: expected %v, got %v", e, a)
+	}
+}
+
+func TestChecksumEmpty(t *testing.T) {
+	for _, params := range []map[string]interface{}{nil, {}} {
+		checksum, err := Checksum(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if checksum != "" {
+			t.Errorf("expected no checksum for %v, got %v", params, checksum)
+		}
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	params := map[string]interface{}{
+		"port":     "5432",
+		"ratio":    "0.5",
+		"tls":      "true",
+		"replicas": float64(3),
+		"name":     "db",
+	}
+	err := Coerce(params, map[string]v1beta1.ParameterType{
+		"port":     v1beta1.ParameterTypeInteger,
+		"ratio":    v1beta1.ParameterTypeNumber,
+		"tls":      v1beta1.ParameterTypeBoolean,
+		"replicas": v1beta1.ParameterTypeInteger,
+		"missing":  v1beta1.ParameterTypeBoolean,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{
+		"port":     int64(5432),
+		"ratio":    0.5,
+		"tls":      true,
+		"replicas": float64(3),
+		"name":     "db",
+	}
+	if !reflect.DeepEqual(expected, params) {
+		t.Errorf("unexpected parameters: expected %v, got %v", expected, params)
+	}
+}
+
+func TestCoerceInvalid(t *testing.T) {
+	cases := []struct {
+		value interface{}
+		t     v1beta1.ParameterType
+	}{
+		{"5432a", v1beta1.ParameterTypeInteger},
+		{float64(1.5), v1beta1.ParameterTypeInteger},
+		{"yes", v1beta1.ParameterTypeBoolean},
+		{true, v1beta1.ParameterTypeNumber},
+		{"5432", "string"},
+	}
+	for _, tc := range cases {
+		params := map[string]interface{}{"p": tc.value}
+		if err := Coerce(params, map[string]v1beta1.ParameterType{"p": tc.t}); err == nil {
+			t.Errorf("expected an error converting %v to %s", tc.value, tc.t)
+		}
+	}
+}
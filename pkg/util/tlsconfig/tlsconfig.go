@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlsconfig builds TLS client configurations from the version and
+// cipher suite names and the server identities used in the service catalog
+// API.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+var versions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// Versions returns the names of the supported TLS versions.
+func Versions() []string {
+	return []string{"1.0", "1.1", "1.2", "1.3"}
+}
+
+// ParseVersion returns the TLS version with the given name, such as "1.2".
+func ParseVersion(name string) (uint16, error) {
+	version, ok := versions[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported TLS version %q", name)
+	}
+	return version, nil
+}
+
+// ParseCipherSuites returns the IDs of the cipher suites with the given IANA
+// names, such as "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". Only the cipher
+// suites that Go considers secure are supported.
+func ParseCipherSuites(names []string) ([]uint16, error) {
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := cipherSuite(name)
+		if !ok {
+			return nil, fmt.Errorf("unsupported cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func cipherSuite(name string) (uint16, bool) {
+	for _, suite := range tls.CipherSuites() {
+		if suite.Name == name {
+			return suite.ID, true
+		}
+	}
+	return 0, false
+}
+
+// New returns a TLS client configuration with the given minimum version and
+// cipher suites. Empty values keep the Go defaults.
+func New(minVersion string, cipherSuites []string) (*tls.Config, error) {
+	config := &tls.Config{}
+	if minVersion != "" {
+		version, err := ParseVersion(minVersion)
+		if err != nil {
+			return nil, err
+		}
+		config.MinVersion = version
+	}
+	if len(cipherSuites) > 0 {
+		ids, err := ParseCipherSuites(cipherSuites)
+		if err != nil {
+			return nil, err
+		}
+		config.CipherSuites = ids
+	}
+	return config, nil
+}
+
+// ValidateSPIFFEID returns an error if id is not a SPIFFE ID, that is a URI
+// of the form spiffe://TRUST-DOMAIN/PATH, without user info, port, query or
+// fragment.
+func ValidateSPIFFEID(id string) error {
+	u, err := url.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid SPIFFE ID %q: %v", id, err)
+	}
+	if u.Scheme != "spiffe" || u.Host == "" || u.Opaque != "" || u.User != nil || u.Port() != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid SPIFFE ID %q: must be of the form spiffe://TRUST-DOMAIN/PATH", id)
+	}
+	return nil
+}
+
+// VerifyServerIdentity returns a function for tls.Config.VerifyConnection
+// that rejects the servers whose certificate holds none of dnsNames as a DNS
+// subject alternative name, or none of spiffeIDs as a URI subject
+// alternative name. An empty list is not checked. The function only checks
+// the identity of the server: its certificate must still be verified
+// against the trusted CAs.
+func VerifyServerIdentity(dnsNames, spiffeIDs []string) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("the server presented no certificate")
+		}
+		cert := state.PeerCertificates[0]
+		if len(dnsNames) > 0 && !hasDNSName(cert, dnsNames) {
+			return fmt.Errorf("the certificate of the server holds none of the expected DNS names %v", dnsNames)
+		}
+		if len(spiffeIDs) > 0 && !hasURI(cert, spiffeIDs) {
+			return fmt.Errorf("the certificate of the server holds none of the expected SPIFFE IDs %v", spiffeIDs)
+		}
+		return nil
+	}
+}
+
+func hasDNSName(cert *x509.Certificate, dnsNames []string) bool {
+	for _, name := range dnsNames {
+		if cert.VerifyHostname(name) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasURI(cert *x509.Certificate, uris []string) bool {
+	for _, certURI := range cert.URIs {
+		for _, uri := range uris {
+			if certURI.String() == uri {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	config, err := New("1.2", []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := uint16(tls.VersionTLS12), config.MinVersion; e != a {
+		t.Errorf("unexpected min version: expected %v, got %v", e, a)
+	}
+	expectedSuites := []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}
+	if e, a := expectedSuites, config.CipherSuites; !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected cipher suites: expected %v, got %v", e, a)
+	}
+}
+
+func TestNewDefaults(t *testing.T) {
+	config, err := New("", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(config, &tls.Config{}) {
+		t.Errorf("expected an empty configuration, got %+v", config)
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	cases := []struct {
+		minVersion   string
+		cipherSuites []string
+	}{
+		{"1.4", nil},
+		{"TLS12", nil},
+		{"", []string{"TLS_NOT_A_CIPHER"}},
+		// Insecure cipher suites are not supported.
+		{"", []string{"TLS_RSA_WITH_RC4_128_SHA"}},
+	}
+	for _, tc := range cases {
+		if _, err := New(tc.minVersion, tc.cipherSuites); err == nil {
+			t.Errorf("expected an error for min version %q and cipher suites %v", tc.minVersion, tc.cipherSuites)
+		}
+	}
+}
+
+func TestValidateSPIFFEID(t *testing.T) {
+	cases := map[string]bool{
+		"spiffe://example.org/ns/brokers/sa/broker": true,
+		"spiffe://example.org":                      true,
+		"https://example.org/broker":                false,
+		"spiffe:///broker":                          false,
+		"spiffe://example.org:8443/broker":          false,
+		"spiffe://user@example.org/broker":          false,
+		"spiffe://example.org/broker?version=1":     false,
+		"spiffe://example.org/broker#frag":          false,
+		"spiffe:example.org":                        false,
+	}
+	for id, valid := range cases {
+		if err := ValidateSPIFFEID(id); (err == nil) != valid {
+			t.Errorf("unexpected result for %q: expected valid %v, got error %v", id, valid, err)
+		}
+	}
+}
+
+func TestVerifyServerIdentity(t *testing.T) {
+	spiffeID, err := url.Parse("spiffe://example.org/ns/brokers/sa/broker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	state := tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{
+			DNSNames: []string{"broker.example.org", "*.brokers.example.org"},
+			URIs:     []*url.URL{spiffeID},
+		}},
+	}
+
+	cases := []struct {
+		name      string
+		dnsNames  []string
+		spiffeIDs []string
+		state     tls.ConnectionState
+		valid     bool
+	}{
+		{name: "no policy", state: state, valid: true},
+		{name: "DNS name", dnsNames: []string{"other.example.org", "broker.example.org"}, state: state, valid: true},
+		{name: "wildcard DNS name", dnsNames: []string{"mysql.brokers.example.org"}, state: state, valid: true},
+		{name: "unexpected DNS name", dnsNames: []string{"other.example.org"}, state: state},
+		{name: "SPIFFE ID", spiffeIDs: []string{"spiffe://example.org/ns/brokers/sa/broker"}, state: state, valid: true},
+		{name: "unexpected SPIFFE ID", spiffeIDs: []string{"spiffe://example.org/ns/brokers/sa/other"}, state: state},
+		{name: "DNS name and unexpected SPIFFE ID", dnsNames: []string{"broker.example.org"}, spiffeIDs: []string{"spiffe://other.org/broker"}, state: state},
+		{name: "no certificate", dnsNames: []string{"broker.example.org"}},
+	}
+	for _, tc := range cases {
+		err := VerifyServerIdentity(tc.dnsNames, tc.spiffeIDs)(tc.state)
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid %v, got error %v", tc.name, tc.valid, err)
+		}
+	}
+}
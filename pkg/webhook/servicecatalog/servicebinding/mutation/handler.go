@@ -90,7 +90,9 @@ func (h *CreateUpdateHandler) mutateOnCreate(ctx context.Context, req admission.
 	// This feature was copied from Service Catalog registry: https://github.com/drycc-addons/service-catalog/blob/master/pkg/registry/servicecatalog/binding/strategy.go
 	// If you want to track previous changes please check there.
 
-	if binding.Spec.ExternalID == "" {
+	// An adopted binding must name the binding that already exists at the
+	// broker, which validation enforces.
+	if binding.Spec.ExternalID == "" && !binding.Spec.AdoptExisting {
 		binding.Spec.ExternalID = string(h.UUID.New())
 	}
 
@@ -112,7 +114,17 @@ func (h *CreateUpdateHandler) mutateOnUpdate(ctx context.Context, req admission.
 	// ValidateUpdate. Also, the check for whether the generation needs
 	// to be updated needs to be un-commented.
 	// If the Spec change is allowed do not forget to update UserInfo (setServiceBindingUserInfo function)
+	updateRequests := newServiceBinding.Spec.UpdateRequests
 	newServiceBinding.Spec = oldServiceBinding.Spec
+
+	// UpdateRequests is the only field that may change, to renew the
+	// binding. Ignore it when it is the default value.
+	if updateRequests != 0 && updateRequests != oldServiceBinding.Spec.UpdateRequests {
+		newServiceBinding.Spec.UpdateRequests = updateRequests
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
+			setServiceBindingUserInfo(req, newServiceBinding)
+		}
+	}
 }
 
 // setServiceBindingUserInfo injects user.Info from the request context
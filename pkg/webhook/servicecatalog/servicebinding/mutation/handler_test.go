@@ -104,6 +104,42 @@ func TestCreateUpdateHandlerHandleCreateSuccess(t *testing.T) {
 				},
 			},
 		},
+		"Should not default externalID of an adopted binding": {
+			givenRawObj: []byte(`{
+				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceBinding",
+  				"metadata": {
+  				  "creationTimestamp": null,
+  				  "name": "test-binding"
+  				},
+  				"spec": {
+				  "instanceRef": {
+					"name": "some-instance"
+				  },
+				  "adoptExisting": true
+  				}
+			}`),
+			expPatches: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/metadata/finalizers",
+					Value: []interface{}{
+						"kubernetes-incubator/service-catalog",
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/secretName",
+					Value:     "test-binding",
+				},
+				// left empty for validation to reject
+				{
+					Operation: "add",
+					Path:      "/spec/externalID",
+					Value:     "",
+				},
+			},
+		},
 	}
 
 	for tn, tc := range tests {
@@ -199,6 +235,44 @@ func TestCreateUpdateHandlerHandleUpdateSuccess(t *testing.T) {
 				},
 			},
 		},
+		"Should keep updateRequests changes": {
+			givenOldRawObj: []byte(`{
+  				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceBinding",
+  				"metadata": {
+  				  "creationTimestamp": null,
+  				  "name": "test-binding"
+  				},
+  				"spec": {
+                  "externalID": "id-0123",
+				  "instanceRef": {
+					"name": "some-instance"
+				  }
+  				}
+			}`),
+			givenNewRawObj: []byte(`{
+  				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceBinding",
+  				"metadata": {
+  				  "creationTimestamp": null,
+  				  "name": "test-binding"
+  				},
+  				"spec": {
+				  "externalID": "id-0123",
+				  "instanceRef": {
+					"name": "some-instance-1"
+				  },
+				  "updateRequests": 1
+  				}
+			}`),
+			expPatches: []jsonpatch.Operation{
+				{
+					Operation: "replace",
+					Path:      "/spec/instanceRef/name",
+					Value:     "some-instance",
+				},
+			},
+		},
 	}
 
 	for tn, tc := range tests {
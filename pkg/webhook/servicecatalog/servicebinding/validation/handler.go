@@ -33,19 +33,29 @@ type Validator interface {
 	Validate(context.Context, admission.Request, *sc.ServiceBinding, *webhookutil.TracedLogger) *webhookutil.WebhookError
 }
 
+// Warner is used to implement admission warnings, which do not deny the
+// request but tell the user about it
+type Warner interface {
+	Warn(admission.Request, *sc.ServiceBinding, *webhookutil.TracedLogger) []string
+}
+
 // SpecValidationHandler handles ServiceBinding validation
 type SpecValidationHandler struct {
 	decoder admission.Decoder
 
 	CreateValidators []Validator
 	UpdateValidators []Validator
+	// Warners are run on the creations and updates that the validators
+	// allowed
+	Warners []Warner
 }
 
 // NewSpecValidationHandler creates new SpecValidationHandler and initializes validators list
-func NewSpecValidationHandler() *SpecValidationHandler {
+func NewSpecValidationHandler(parametersSizeLimits webhookutil.ParametersSizeLimits) *SpecValidationHandler {
 	return &SpecValidationHandler{
-		CreateValidators: []Validator{&ReferenceDeletion{}, &StaticCreate{}},
-		UpdateValidators: []Validator{&StaticUpdate{}},
+		CreateValidators: []Validator{&ReferenceDeletion{}, &StaticCreate{}, &DenyBindingIfMaxBindingsReached{}, &DenyOversizedParameters{Limits: parametersSizeLimits}},
+		UpdateValidators: []Validator{&StaticUpdate{}, &DenyOversizedParameters{Limits: parametersSizeLimits}},
+		Warners:          []Warner{&WarnIgnoredAnnotations{}, &WarnIgnoredFields{}},
 	}
 }
 
@@ -98,8 +108,13 @@ func (h *SpecValidationHandler) Handle(ctx context.Context, req admission.Reques
 		}
 	}
 
+	var warnings []string
+	for _, w := range h.Warners {
+		warnings = append(warnings, w.Warn(req, sb, traced)...)
+	}
+
 	traced.Infof("Completed successfully validation operation: %s for %s: %q", req.Operation, req.Kind.Kind, req.Name)
-	return admission.Allowed("ServiceBinding validation successful")
+	return admission.Allowed("ServiceBinding validation successful").WithWarnings(warnings...)
 }
 
 // InjectDecoder injects the decoder into the handlers
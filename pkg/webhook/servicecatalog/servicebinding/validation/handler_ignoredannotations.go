@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// instanceOnlyAnnotations are the annotations the controller only honors on
+// ServiceInstances.
+var instanceOnlyAnnotations = []string{
+	sc.CaptureRequestAnnotation,
+	sc.SkipContextAnnotation,
+	sc.SkipDefaultsAnnotation,
+}
+
+// WarnIgnoredAnnotations handles ServiceBinding warnings
+type WarnIgnoredAnnotations struct{}
+
+// Warn returns a warning for each annotation of the binding that the
+// controller only honors on ServiceInstances, so that users learn why
+// setting it on the binding has no effect.
+func (h *WarnIgnoredAnnotations) Warn(req admission.Request, sb *sc.ServiceBinding, traced *webhookutil.TracedLogger) []string {
+	var warnings []string
+	for _, annotation := range instanceOnlyAnnotations {
+		if _, ok := sb.Annotations[annotation]; ok {
+			warnings = append(warnings, fmt.Sprintf("the %s annotation is ignored on ServiceBindings: it only applies to ServiceInstances", annotation))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// WarnIgnoredFields handles ServiceBinding warnings
+type WarnIgnoredFields struct{}
+
+// Warn returns a warning for each field of the binding that the controller
+// ignores because the feature it requires is disabled. An ignored
+// adoptExisting matters most: the binding is then bound again at the broker
+// instead of being adopted.
+func (h *WarnIgnoredFields) Warn(req admission.Request, sb *sc.ServiceBinding, traced *webhookutil.TracedLogger) []string {
+	var warnings []string
+	if sb.Spec.AdoptExisting && !utilfeature.DefaultFeatureGate.Enabled(scfeatures.BindingAdoption) {
+		warnings = append(warnings, fmt.Sprintf("spec.adoptExisting is ignored because the %s feature is disabled: the binding will be created at the broker", scfeatures.BindingAdoption))
+	}
+	return warnings
+}
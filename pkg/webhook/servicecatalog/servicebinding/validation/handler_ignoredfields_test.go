@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/servicebinding/validation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestSpecValidationHandlerWarnIgnoredFields(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	decoder := admission.NewDecoder(sch)
+
+	const adoptingBinding = `{
+		"metadata": {"name": "test-binding", "namespace": "test-ns"},
+		"spec": {"instanceRef": {"name": "test-instance"}, "externalID": "manual-binding", "adoptExisting": true}
+	}`
+
+	tests := map[string]struct {
+		enabledFeature   string
+		object           string
+		expectedWarnings []string
+	}{
+		"No ignored fields": {
+			object: `{"metadata": {"name": "test-binding", "namespace": "test-ns"}, "spec": {"instanceRef": {"name": "test-instance"}}}`,
+		},
+		"Adoption without BindingAdoption": {
+			object: adoptingBinding,
+			expectedWarnings: []string{
+				"spec.adoptExisting is ignored because the BindingAdoption feature is disabled: the binding will be created at the broker",
+			},
+		},
+		"Adoption with BindingAdoption": {
+			enabledFeature: fmt.Sprintf("%v=true", scfeatures.BindingAdoption),
+			object:         adoptingBinding,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			if test.enabledFeature != "" {
+				err := utilfeature.DefaultMutableFeatureGate.Set(test.enabledFeature)
+				require.NoError(t, err)
+				defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingAdoption))
+			}
+
+			handler := validation.SpecValidationHandler{}
+			handler.Warners = []validation.Warner{&validation.WarnIgnoredFields{}}
+			err = handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+
+			// when
+			response := handler.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UID:       "uuid",
+					Name:      "test-binding",
+					Namespace: "test-ns",
+					Operation: admissionv1.Create,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceBinding",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object: runtime.RawExtension{Raw: []byte(test.object)},
+				},
+			})
+
+			// then
+			assert.True(t, response.AdmissionResponse.Allowed)
+			assert.Equal(t, test.expectedWarnings, response.AdmissionResponse.Warnings)
+		})
+	}
+}
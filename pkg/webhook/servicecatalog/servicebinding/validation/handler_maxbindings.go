@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DenyBindingIfMaxBindingsReached handles ServiceBinding validation. It
+// rejects new bindings of an instance that already has as many bindings as
+// the MaxBindings of its plan, or the limit published in the plan's external
+// metadata, allows. The bindings being deleted and those that failed do not
+// count.
+//
+// Instances and plans that cannot be resolved are left for the controller
+// to report.
+type DenyBindingIfMaxBindingsReached struct {
+	client client.Client
+}
+
+var _ Validator = &DenyBindingIfMaxBindingsReached{}
+
+// Validate checks if the instance of a new ServiceBinding may have another
+// binding
+func (h *DenyBindingIfMaxBindingsReached) Validate(ctx context.Context, req admission.Request, sb *sc.ServiceBinding, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	traced.Info("Starting validation - DenyBindingIfMaxBindingsReached")
+
+	instance := &sc.ServiceInstance{}
+	if err := h.client.Get(ctx, types.NamespacedName{Namespace: sb.Namespace, Name: sb.Spec.InstanceRef.Name}, instance); err != nil {
+		traced.Infof("Could not get ServiceInstance by name %q, skipping max bindings check: %v", sb.Spec.InstanceRef.Name, err)
+		return nil
+	}
+
+	var plan interface {
+		GetName() string
+		GetMaxBindings() (int, bool)
+	}
+	switch {
+	case instance.Spec.ClusterServicePlanRef != nil:
+		csp := &sc.ClusterServicePlan{}
+		if err := h.client.Get(ctx, types.NamespacedName{Name: instance.Spec.ClusterServicePlanRef.Name}, csp); err != nil {
+			traced.Infof("Could not get ClusterServicePlan, skipping max bindings check: %v", err)
+			return nil
+		}
+		plan = csp
+	case instance.Spec.ServicePlanRef != nil:
+		sp := &sc.ServicePlan{}
+		if err := h.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ServicePlanRef.Name}, sp); err != nil {
+			traced.Infof("Could not get ServicePlan, skipping max bindings check: %v", err)
+			return nil
+		}
+		plan = sp
+	default:
+		return nil
+	}
+	maxBindings, ok := plan.GetMaxBindings()
+	if !ok {
+		return nil
+	}
+
+	bindings := &sc.ServiceBindingList{}
+	if err := h.client.List(ctx, bindings, client.InNamespace(sb.Namespace)); err != nil {
+		traced.Errorf("Could not list ServiceBindings in namespace %q: %v", sb.Namespace, err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusInternalServerError)
+	}
+	count := 0
+	for _, binding := range bindings.Items {
+		if binding.Spec.InstanceRef.Name == instance.Name && binding.Name != sb.Name &&
+			binding.DeletionTimestamp == nil && !isServiceBindingFailed(&binding) {
+			count++
+		}
+	}
+	if count < maxBindings {
+		return nil
+	}
+
+	msg := fmt.Sprintf("The ServiceInstance %s/%s already has %d ServiceBindings and its Service Plan %v allows at most %d", instance.Namespace, instance.Name, count, plan.GetName(), maxBindings)
+	traced.Info(msg)
+	return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+}
+
+// InjectClient injects the client
+func (h *DenyBindingIfMaxBindingsReached) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
+
+func isServiceBindingFailed(binding *sc.ServiceBinding) bool {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == sc.ServiceBindingConditionFailed && condition.Status == sc.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
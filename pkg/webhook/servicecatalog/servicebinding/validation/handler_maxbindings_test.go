@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/servicebinding/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestDenyBindingIfMaxBindingsReached(t *testing.T) {
+	namespace := "test-handler"
+	maxBindings := int32(1)
+
+	instance := &sc.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: namespace},
+		Spec: sc.ServiceInstanceSpec{
+			ClusterServicePlanRef: &sc.ClusterObjectReference{Name: "limited-plan"},
+		},
+	}
+	limitedPlan := &sc.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "limited-plan"},
+		Spec: sc.ClusterServicePlanSpec{
+			CommonServicePlanSpec: sc.CommonServicePlanSpec{MaxBindings: &maxBindings},
+		},
+	}
+	metadataPlan := &sc.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "limited-plan"},
+		Spec: sc.ClusterServicePlanSpec{
+			CommonServicePlanSpec: sc.CommonServicePlanSpec{
+				ExternalMetadata: &runtime.RawExtension{Raw: []byte(`{"maxBindings": 1}`)},
+			},
+		},
+	}
+	unlimitedPlan := &sc.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "limited-plan"},
+	}
+	existing := &sc.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing-binding", Namespace: namespace},
+		Spec:       sc.ServiceBindingSpec{InstanceRef: sc.LocalObjectReference{Name: "test-instance"}},
+	}
+	failed := existing.DeepCopy()
+	failed.Status.Conditions = []sc.ServiceBindingCondition{{
+		Type:   sc.ServiceBindingConditionFailed,
+		Status: sc.ConditionTrue,
+	}}
+
+	request := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UID:       "3333-cccc",
+			Name:      "test-binding",
+			Namespace: namespace,
+			Operation: admissionv1.Create,
+			Kind: metav1.GroupVersionKind{
+				Kind:    "ServiceBinding",
+				Version: "v1beta1",
+				Group:   "servicecatalog.k8s.io",
+			},
+			Object: runtime.RawExtension{Raw: []byte(`{
+  				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceBinding",
+  				"metadata": {
+  				  "name": "test-binding",
+  				  "namespace": "` + namespace + `"
+  				},
+  				"spec": {
+				  "instanceRef": {
+					"name": "test-instance"
+				  },
+				  "externalID": "123-abc",
+				  "secretName": "test-binding"
+  				}
+			}`)},
+		},
+	}
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+	// the lists of bindings are only registered with all the types
+	err = sc.AddToScheme(sch)
+	require.NoError(t, err)
+	decoder := admission.NewDecoder(sch)
+
+	tests := map[string]struct {
+		objects []client.Object
+		allowed bool
+	}{
+		"Request should be denied when the plan limit is reached": {
+			objects: []client.Object{instance, limitedPlan, existing},
+			allowed: false,
+		},
+		"Request should be denied when the metadata limit is reached": {
+			objects: []client.Object{instance, metadataPlan, existing},
+			allowed: false,
+		},
+		"Request should be allowed when the plan has no limit": {
+			objects: []client.Object{instance, unlimitedPlan, existing},
+			allowed: true,
+		},
+		"Request should be allowed when the other binding failed": {
+			objects: []client.Object{instance, limitedPlan, failed},
+			allowed: true,
+		},
+		"Request should be allowed when the instance does not exist": {
+			objects: []client.Object{limitedPlan, existing},
+			allowed: true,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			handler := validation.SpecValidationHandler{}
+			handler.CreateValidators = []validation.Validator{&validation.DenyBindingIfMaxBindingsReached{}}
+			fakeClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(test.objects...).Build()
+
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fakeClient)
+			require.NoError(t, err)
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.allowed, response.AdmissionResponse.Allowed)
+		})
+	}
+}
@@ -34,19 +34,31 @@ type Validator interface {
 	Validate(context.Context, admission.Request, *sc.ServiceInstance, *webhookutil.TracedLogger) *webhookutil.WebhookError
 }
 
+// Warner is used to implement admission warnings, which do not deny the
+// request but tell the user about it
+type Warner interface {
+	Warn(admission.Request, *sc.ServiceInstance, *webhookutil.TracedLogger) []string
+}
+
 // SpecValidationHandler handles ServiceInstance validation
 type SpecValidationHandler struct {
 	decoder admission.Decoder
 
 	CreateValidators []Validator
 	UpdateValidators []Validator
+	DeleteValidators []Validator
+	// Warners are run on the creations and updates that the validators
+	// allowed
+	Warners []Warner
 }
 
 // NewSpecValidationHandler creates new SpecValidationHandler and initializes validators list
-func NewSpecValidationHandler() *SpecValidationHandler {
+func NewSpecValidationHandler(parametersSizeLimits webhookutil.ParametersSizeLimits) *SpecValidationHandler {
 	return &SpecValidationHandler{
-		UpdateValidators: []Validator{&StaticUpdate{}, &DenyPlanChangeIfNotUpdatable{}},
-		CreateValidators: []Validator{&StaticCreate{}},
+		UpdateValidators: []Validator{&StaticUpdate{}, &DenyPlanChangeIfNotUpdatable{}, &DenyOversizedParameters{Limits: parametersSizeLimits}},
+		CreateValidators: []Validator{&StaticCreate{}, &DenyProvisionIfPlanUnavailable{}, &DenyProvisionIfHidden{}, &AccessToContextNamespace{}, &DenyOversizedParameters{Limits: parametersSizeLimits}},
+		DeleteValidators: []Validator{&DenyDeleteIfBindingsExist{}},
+		Warners:          []Warner{&WarnIgnoredFields{}},
 	}
 }
 
@@ -61,7 +73,13 @@ func (h *SpecValidationHandler) Handle(ctx context.Context, req admission.Reques
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	if err := h.decoder.Decode(req, si); err != nil {
+	// The object of a DELETE request is the instance being deleted
+	if req.Operation == admissionTypes.Delete {
+		if err := h.decoder.DecodeRaw(req.OldObject, si); err != nil {
+			traced.Errorf("Could not decode request old object: %v", err)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	} else if err := h.decoder.Decode(req, si); err != nil {
 		traced.Errorf("Could not decode request object: %v", err)
 		return admission.Errored(http.StatusBadRequest, err)
 	}
@@ -85,6 +103,13 @@ func (h *SpecValidationHandler) Handle(ctx context.Context, req admission.Reques
 				break
 			}
 		}
+	case admissionTypes.Delete:
+		for _, v := range h.DeleteValidators {
+			err = v.Validate(ctx, req, si, traced)
+			if err != nil {
+				break
+			}
+		}
 	default:
 		traced.Infof("ServiceInstance validation wehbook does not support action %q", req.Operation)
 		return admission.Allowed("action not taken")
@@ -99,8 +124,15 @@ func (h *SpecValidationHandler) Handle(ctx context.Context, req admission.Reques
 		}
 	}
 
+	var warnings []string
+	if req.Operation != admissionTypes.Delete {
+		for _, w := range h.Warners {
+			warnings = append(warnings, w.Warn(req, si, traced)...)
+		}
+	}
+
 	traced.Infof("Completed successfully validation operation: %s for %s: %q", req.Operation, req.Kind.Kind, req.Name)
-	return admission.Allowed("ServiceInstance validation successful")
+	return admission.Allowed("ServiceInstance validation successful").WithWarnings(warnings...)
 }
 
 // InjectDecoder injects the decoder into the handlers
@@ -119,6 +151,18 @@ func (h *SpecValidationHandler) InjectDecoder(d admission.Decoder) error {
 			return err
 		}
 	}
+	for _, v := range h.DeleteValidators {
+		_, err := inject.DecoderInto(d, v)
+		if err != nil {
+			return err
+		}
+	}
+	for _, w := range h.Warners {
+		_, err := inject.DecoderInto(d, w)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -137,6 +181,12 @@ func (h *SpecValidationHandler) InjectClient(c client.Client) error {
 			return err
 		}
 	}
+	for _, v := range h.DeleteValidators {
+		_, err := inject.ClientInto(c, v)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DenyProvisionIfHidden handles ServiceInstance validation. It rejects new
+// instances of classes and plans that a platform admin has hidden with
+// spec.catalogVisibility.
+//
+// Plans that cannot be resolved are left for the controller to report.
+type DenyProvisionIfHidden struct {
+	client client.Client
+}
+
+var _ Validator = &DenyProvisionIfHidden{}
+
+// Validate checks if the class and plan of a new ServiceInstance are visible
+func (h *DenyProvisionIfHidden) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	traced.Info("Starting validation - DenyProvisionIfHidden")
+
+	var plan, class interface {
+		GetName() string
+		IsHidden() bool
+	}
+	switch {
+	case si.Spec.ClusterServicePlanSpecified():
+		csp, err := getClusterServicePlan(ctx, h.client, si, traced)
+		if err != nil {
+			traced.Infof("Could not resolve cluster service plan, skipping visibility check: %v", err)
+			return nil
+		}
+		csc := &sc.ClusterServiceClass{}
+		if err := h.client.Get(ctx, client.ObjectKey{Name: csp.Spec.ClusterServiceClassRef.Name}, csc); err != nil {
+			traced.Infof("Could not get cluster service class, skipping visibility check: %v", err)
+			return nil
+		}
+		plan, class = csp, csc
+	case si.Spec.ServicePlanSpecified():
+		sp, err := getServicePlan(ctx, h.client, si, traced)
+		if err != nil {
+			traced.Infof("Could not resolve service plan, skipping visibility check: %v", err)
+			return nil
+		}
+		ssc := &sc.ServiceClass{}
+		if err := h.client.Get(ctx, client.ObjectKey{Name: sp.Spec.ServiceClassRef.Name, Namespace: si.Namespace}, ssc); err != nil {
+			traced.Infof("Could not get service class, skipping visibility check: %v", err)
+			return nil
+		}
+		plan, class = sp, ssc
+	default:
+		return nil
+	}
+
+	if class.IsHidden() {
+		msg := fmt.Sprintf("The Service Class %v is hidden and cannot be provisioned", class.GetName())
+		traced.Info(msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+	if plan.IsHidden() {
+		msg := fmt.Sprintf("The Service Plan %v is hidden and cannot be provisioned", plan.GetName())
+		traced.Info(msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	traced.Info("DenyProvisionIfHidden passed - class and plan are visible.")
+	return nil
+}
+
+// InjectClient injects the client
+func (h *DenyProvisionIfHidden) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	authenticationapi "k8s.io/api/authentication/v1"
+	authorizationapi "k8s.io/api/authorization/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// AccessToContextNamespace handles ServiceInstance validation
+type AccessToContextNamespace struct {
+	client client.Client
+}
+
+// Validate checks if the user setting the ContextNamespaceOverride of the
+// instance is allowed to create ServiceInstances in that namespace, so that
+// the OSB context cannot be used to present a namespace the user has no
+// access to. The field is immutable, so it is only checked on creation.
+func (h *AccessToContextNamespace) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	override := si.Spec.ContextNamespaceOverride
+	if override == "" || override == si.Namespace {
+		return nil
+	}
+	traced.Info("Starting validation - AccessToContextNamespace")
+
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ContextNamespaceOverride) {
+		msg := fmt.Sprintf("spec.contextNamespaceOverride requires the %s feature", scfeatures.ContextNamespaceOverride)
+		traced.Info(msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	user := req.UserInfo
+	sar := &authorizationapi.SubjectAccessReview{
+		Spec: authorizationapi.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationapi.ResourceAttributes{
+				Namespace: override,
+				Verb:      "create",
+				Group:     sc.SchemeGroupVersion.Group,
+				Version:   sc.SchemeGroupVersion.Version,
+				Resource:  "serviceinstances",
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			Extra:  convertToSARExtra(user.Extra),
+			UID:    user.UID,
+		},
+	}
+
+	if err := h.client.Create(ctx, sar); err != nil {
+		traced.Errorf("Could not create SubjectAccessReview for %s %q: %v", si.Kind, si.Name, err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+	}
+
+	if !sar.Status.Allowed {
+		msg := fmt.Sprintf(
+			"user %q is not allowed to create ServiceInstances in the context namespace %q: Reason: %s, EvaluationError: %s",
+			user.Username,
+			override,
+			sar.Status.Reason,
+			sar.Status.EvaluationError)
+		traced.Info(msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	return nil
+}
+
+func convertToSARExtra(extra map[string]authenticationapi.ExtraValue) map[string]authorizationapi.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+
+	ret := map[string]authorizationapi.ExtraValue{}
+	for k, v := range extra {
+		ret[k] = authorizationapi.ExtraValue(v)
+	}
+
+	return ret
+}
+
+// InjectClient injects the client
+func (h *AccessToContextNamespace) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// sarClient allows the SubjectAccessReviews of the given user in the given
+// namespace, and denies all others.
+type sarClient struct {
+	client.Client
+	allowedUser      string
+	allowedNamespace string
+}
+
+// Create overrides real client Create method for the test
+func (c *sarClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	sar, ok := obj.(*authorizationv1.SubjectAccessReview)
+	if !ok {
+		return errors.New("Input object is not SubjectAccessReview type")
+	}
+	attributes := sar.Spec.ResourceAttributes
+	if sar.Spec.User == c.allowedUser && attributes.Namespace == c.allowedNamespace &&
+		attributes.Verb == "create" && attributes.Resource == "serviceinstances" {
+		sar.Status.Allowed = true
+	}
+	return nil
+}
+
+func TestSpecValidationHandlerAccessToContextNamespace(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	decoder := admission.NewDecoder(sch)
+
+	newRequest := func(override string) admission.Request {
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       "uuid",
+				Name:      "test-serviceinstance",
+				Namespace: "ns-management",
+				Operation: admissionv1.Create,
+				Kind: metav1.GroupVersionKind{
+					Kind:    "ServiceInstance",
+					Version: "v1beta1",
+					Group:   "servicecatalog.k8s.io",
+				},
+				UserInfo: authenticationv1.UserInfo{Username: "automation"},
+				Object: runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{
+ 				"metadata": {
+ 				  "name": "test-serviceinstance",
+ 				  "namespace": "ns-management"
+ 				},
+ 				"spec": {
+ 				  "contextNamespaceOverride": %q
+ 				}
+			}`, override))},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		featureEnabled  bool
+		override        string
+		responseAllowed bool
+		responseReason  string
+	}{
+		"No override": {
+			featureEnabled:  false,
+			override:        "",
+			responseAllowed: true,
+			responseReason:  "ServiceInstance validation successful",
+		},
+		"Feature disabled": {
+			featureEnabled:  false,
+			override:        "ns-tenant",
+			responseAllowed: false,
+			responseReason:  "spec.contextNamespaceOverride requires the ContextNamespaceOverride feature",
+		},
+		"User allowed in the context namespace": {
+			featureEnabled:  true,
+			override:        "ns-tenant",
+			responseAllowed: true,
+			responseReason:  "ServiceInstance validation successful",
+		},
+		"User not allowed in the context namespace": {
+			featureEnabled:  true,
+			override:        "ns-other-tenant",
+			responseAllowed: false,
+			responseReason:  `user "automation" is not allowed to create ServiceInstances in the context namespace "ns-other-tenant"`,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.ContextNamespaceOverride, test.featureEnabled))
+			require.NoError(t, err)
+			defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ContextNamespaceOverride))
+
+			handler := validation.SpecValidationHandler{}
+			handler.CreateValidators = []validation.Validator{&validation.AccessToContextNamespace{}}
+			err = handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(&sarClient{allowedUser: "automation", allowedNamespace: "ns-tenant"})
+			require.NoError(t, err)
+
+			// when
+			response := handler.Handle(context.Background(), newRequest(test.override))
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			assert.Contains(t, response.AdmissionResponse.Result.Message, test.responseReason)
+		})
+	}
+}
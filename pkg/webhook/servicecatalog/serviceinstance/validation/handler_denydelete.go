@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DenyDeleteIfBindingsExist handles ServiceInstance validation
+type DenyDeleteIfBindingsExist struct {
+	client client.Client
+}
+
+// Validate checks if the instance still has ServiceBindings when the
+// RejectInstanceDeletionWithBindings feature is enabled. The controller does
+// not deprovision such an instance, which would otherwise stay Terminating
+// until its bindings are deleted.
+func (h *DenyDeleteIfBindingsExist) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.RejectInstanceDeletionWithBindings) {
+		return nil
+	}
+	// The controller deletes the bindings of the instance itself
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.CascadingDeletion) {
+		return nil
+	}
+	traced.Info("Starting validation - DenyDeleteIfBindingsExist")
+
+	bindings := &sc.ServiceBindingList{}
+	if err := h.client.List(ctx, bindings, client.InNamespace(si.Namespace)); err != nil {
+		traced.Errorf("Could not list ServiceBindings in namespace %q: %v", si.Namespace, err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusInternalServerError)
+	}
+
+	var names []string
+	for _, binding := range bindings.Items {
+		if binding.Spec.InstanceRef.Name == si.Name {
+			names = append(names, binding.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	sort.Strings(names)
+	msg := fmt.Sprintf("The ServiceInstance %s/%s cannot be deleted while it has ServiceBindings; delete them first: %s", si.Namespace, si.Name, strings.Join(names, ", "))
+	traced.Info(msg)
+	return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+}
+
+// InjectClient injects the client
+func (h *DenyDeleteIfBindingsExist) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
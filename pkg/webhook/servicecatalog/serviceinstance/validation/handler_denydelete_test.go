@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestSpecValidationHandlerDenyDeleteIfBindingsExist(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	request := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UID:       "uuid",
+			Name:      "test-serviceinstance",
+			Namespace: "ns-test",
+			Operation: admissionv1.Delete,
+			Kind: metav1.GroupVersionKind{
+				Kind:    "ServiceInstance",
+				Version: "v1beta1",
+				Group:   "servicecatalog.k8s.io",
+			},
+			OldObject: runtime.RawExtension{Raw: []byte(`{
+ 				"metadata": {
+ 				  "name": "test-serviceinstance",
+ 				  "namespace": "ns-test"
+ 				}
+			}`)},
+		},
+	}
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+	// the lists of bindings are only registered with all the types
+	err = sc.AddToScheme(sch)
+	require.NoError(t, err)
+
+	decoder := admission.NewDecoder(sch)
+
+	binding := func(namespace, name, instanceName string) client.Object {
+		return &sc.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: sc.ServiceBindingSpec{
+				InstanceRef: sc.LocalObjectReference{Name: instanceName},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		featureEnabled  bool
+		bindings        []client.Object
+		responseAllowed bool
+		responseReason  string
+	}{
+		"Feature disabled": {
+			featureEnabled:  false,
+			bindings:        []client.Object{binding("ns-test", "binding-a", "test-serviceinstance")},
+			responseAllowed: true,
+			responseReason:  "ServiceInstance validation successful",
+		},
+		"No bindings to the instance": {
+			featureEnabled: true,
+			bindings: []client.Object{
+				binding("ns-test", "binding-a", "other-serviceinstance"),
+				binding("ns-other", "binding-b", "test-serviceinstance"),
+			},
+			responseAllowed: true,
+			responseReason:  "ServiceInstance validation successful",
+		},
+		"Bindings to the instance": {
+			featureEnabled: true,
+			bindings: []client.Object{
+				binding("ns-test", "binding-b", "test-serviceinstance"),
+				binding("ns-test", "binding-a", "test-serviceinstance"),
+				binding("ns-test", "binding-c", "other-serviceinstance"),
+			},
+			responseAllowed: false,
+			responseReason:  "cannot be deleted while it has ServiceBindings; delete them first: binding-a, binding-b",
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			err := utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.RejectInstanceDeletionWithBindings, test.featureEnabled))
+			require.NoError(t, err)
+			defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.RejectInstanceDeletionWithBindings))
+
+			handler := validation.SpecValidationHandler{}
+			handler.DeleteValidators = []validation.Validator{&validation.DenyDeleteIfBindingsExist{}}
+			fakeClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(test.bindings...).Build()
+			err = handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fakeClient)
+			require.NoError(t, err)
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			assert.Contains(t, response.AdmissionResponse.Result.Message, test.responseReason)
+		})
+	}
+}
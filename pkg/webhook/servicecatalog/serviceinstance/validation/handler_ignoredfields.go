@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	admissionTypes "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/component-base/featuregate"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// WarnIgnoredFields handles ServiceInstance warnings
+type WarnIgnoredFields struct {
+	decoder admission.Decoder
+}
+
+// Warn returns a warning for each field and annotation of the instance that
+// the controller ignores because the feature it requires is disabled, so
+// that users learn why the instance does not behave as they expect.
+func (h *WarnIgnoredFields) Warn(req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) []string {
+	var warnings []string
+	ignored := func(what string, feature featuregate.Feature) {
+		warnings = append(warnings, fmt.Sprintf("%s is ignored because the %s feature is disabled", what, feature))
+	}
+
+	if si.Spec.VerifyBinding && !utilfeature.DefaultFeatureGate.Enabled(scfeatures.BindingVerification) {
+		ignored("spec.verifyBinding", scfeatures.BindingVerification)
+	}
+	if si.Spec.ReadinessPublish != nil && !utilfeature.DefaultFeatureGate.Enabled(scfeatures.InstanceReadinessPublish) {
+		ignored("spec.readinessPublish", scfeatures.InstanceReadinessPublish)
+	}
+	if si.Annotations[sc.CaptureRequestAnnotation] == "true" && !utilfeature.DefaultFeatureGate.Enabled(scfeatures.InstanceRequestSnapshots) {
+		ignored(fmt.Sprintf("the %s annotation", sc.CaptureRequestAnnotation), scfeatures.InstanceRequestSnapshots)
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.DebugAnnotations) {
+		for _, annotation := range []string{sc.SkipContextAnnotation, sc.SkipDefaultsAnnotation} {
+			if si.Annotations[annotation] == "true" {
+				ignored(fmt.Sprintf("the %s annotation", annotation), scfeatures.DebugAnnotations)
+			}
+		}
+	}
+
+	// The dashboard URL a broker returns for an update is only recorded
+	// with UpdateDashboardURL, so an instance whose spec changes keeps
+	// showing the dashboard URL of its provisioning.
+	if req.Operation == admissionTypes.Update && !utilfeature.DefaultFeatureGate.Enabled(scfeatures.UpdateDashboardURL) {
+		origInstance := &sc.ServiceInstance{}
+		if err := h.decoder.DecodeRaw(req.OldObject, origInstance); err != nil {
+			traced.Errorf("Could not decode oldObject: %v", err)
+			return warnings
+		}
+		if origInstance.Status.DashboardURL != nil && *origInstance.Status.DashboardURL != "" &&
+			!equality.Semantic.DeepEqual(origInstance.Spec, si.Spec) {
+			warnings = append(warnings, fmt.Sprintf("the dashboard URL the broker returns for this update is not recorded because the %s feature is disabled", scfeatures.UpdateDashboardURL))
+		}
+	}
+
+	return warnings
+}
+
+// InjectDecoder injects the decoder
+func (h *WarnIgnoredFields) InjectDecoder(d admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
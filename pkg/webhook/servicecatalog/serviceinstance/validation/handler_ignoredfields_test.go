@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/drycc-addons/service-catalog/pkg/features"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestSpecValidationHandlerWarnIgnoredFields(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	decoder := admission.NewDecoder(sch)
+
+	newRequest := func(operation admissionv1.Operation, object, oldObject string) admission.Request {
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       "uuid",
+				Name:      "test-serviceinstance",
+				Namespace: "test-ns",
+				Operation: operation,
+				Kind: metav1.GroupVersionKind{
+					Kind:    "ServiceInstance",
+					Version: "v1beta1",
+					Group:   "servicecatalog.k8s.io",
+				},
+				Object:    runtime.RawExtension{Raw: []byte(object)},
+				OldObject: runtime.RawExtension{Raw: []byte(oldObject)},
+			},
+		}
+	}
+
+	const (
+		plainInstance = `{
+			"metadata": {"name": "test-serviceinstance", "namespace": "test-ns"},
+			"spec": {"updateRequests": 1}
+		}`
+		annotatedInstance = `{
+			"metadata": {
+				"name": "test-serviceinstance",
+				"namespace": "test-ns",
+				"annotations": {"servicecatalog.k8s.io/capture-request": "true", "servicecatalog.k8s.io/skip-context": "true"}
+			},
+			"spec": {"verifyBinding": true, "readinessPublish": {"configMapName": "readiness"}}
+		}`
+		instanceWithDashboard = `{
+			"metadata": {"name": "test-serviceinstance", "namespace": "test-ns"},
+			"spec": {"updateRequests": 0},
+			"status": {"dashboardURL": "https://dashboard.example.com"}
+		}`
+	)
+
+	tests := map[string]struct {
+		enabledFeature   string
+		operation        admissionv1.Operation
+		object           string
+		oldObject        string
+		expectedWarnings []string
+	}{
+		"No ignored fields": {
+			operation: admissionv1.Create,
+			object:    plainInstance,
+		},
+		"Fields and annotations of disabled features": {
+			operation: admissionv1.Create,
+			object:    annotatedInstance,
+			expectedWarnings: []string{
+				"spec.verifyBinding is ignored because the BindingVerification feature is disabled",
+				"spec.readinessPublish is ignored because the InstanceReadinessPublish feature is disabled",
+				"the servicecatalog.k8s.io/capture-request annotation is ignored because the InstanceRequestSnapshots feature is disabled",
+				"the servicecatalog.k8s.io/skip-context annotation is ignored because the DebugAnnotations feature is disabled",
+			},
+		},
+		"Field of an enabled feature": {
+			enabledFeature: fmt.Sprintf("%v=true", scfeatures.BindingVerification),
+			operation:      admissionv1.Create,
+			object:         `{"metadata": {"name": "test-serviceinstance", "namespace": "test-ns"}, "spec": {"verifyBinding": true}}`,
+		},
+		"Update of an instance with a dashboard URL": {
+			operation: admissionv1.Update,
+			object:    plainInstance,
+			oldObject: instanceWithDashboard,
+			expectedWarnings: []string{
+				"the dashboard URL the broker returns for this update is not recorded because the UpdateDashboardURL feature is disabled",
+			},
+		},
+		"Update of an instance with a dashboard URL with UpdateDashboardURL": {
+			enabledFeature: fmt.Sprintf("%v=true", scfeatures.UpdateDashboardURL),
+			operation:      admissionv1.Update,
+			object:         plainInstance,
+			oldObject:      instanceWithDashboard,
+		},
+		"Update of an instance without a dashboard URL": {
+			operation: admissionv1.Update,
+			object:    plainInstance,
+			oldObject: `{"metadata": {"name": "test-serviceinstance", "namespace": "test-ns"}}`,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			if test.enabledFeature != "" {
+				err := utilfeature.DefaultMutableFeatureGate.Set(test.enabledFeature)
+				require.NoError(t, err)
+				defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.BindingVerification))
+				defer utilfeature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.UpdateDashboardURL))
+			}
+
+			handler := validation.SpecValidationHandler{}
+			handler.Warners = []validation.Warner{&validation.WarnIgnoredFields{}}
+			err = handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+
+			// when
+			response := handler.Handle(context.Background(), newRequest(test.operation, test.object, test.oldObject))
+
+			// then
+			assert.True(t, response.AdmissionResponse.Allowed)
+			assert.Equal(t, test.expectedWarnings, response.AdmissionResponse.Warnings)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"net/http"
+
+	admissionTypes "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+)
+
+// DenyOversizedParameters handles ServiceInstance validation. It rejects the
+// ServiceInstances whose parameters, or the Secret keys their parametersFrom
+// reference, are larger than the limits set for the webhook server, so that
+// enormous parameters never reach etcd or the broker. On updates, only
+// changed parameters are checked.
+type DenyOversizedParameters struct {
+	Limits webhookutil.ParametersSizeLimits
+
+	decoder admission.Decoder
+	client  client.Client
+}
+
+var _ Validator = &DenyOversizedParameters{}
+
+// Validate checks the size of the parameters of a ServiceInstance
+func (h *DenyOversizedParameters) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	if h.Limits.MaxParametersSize <= 0 && h.Limits.MaxParametersFromSize <= 0 {
+		return nil
+	}
+	traced.Info("Starting validation - DenyOversizedParameters")
+
+	if req.Operation == admissionTypes.Update {
+		old := &sc.ServiceInstance{}
+		if err := h.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			traced.Errorf("Could not decode oldObject: %v", err)
+			return webhookutil.NewWebhookError(err.Error(), http.StatusBadRequest)
+		}
+		if !webhookutil.ParametersChanged(si.Spec.Parameters, old.Spec.Parameters, si.Spec.ParametersFrom, old.Spec.ParametersFrom) {
+			return nil
+		}
+	}
+
+	return webhookutil.ValidateParametersSize(ctx, h.client, si.Namespace, si.Spec.Parameters, si.Spec.ParametersFrom, h.Limits, traced)
+}
+
+// InjectDecoder injects the decoder
+func (h *DenyOversizedParameters) InjectDecoder(d admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+// InjectClient injects the client
+func (h *DenyOversizedParameters) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestDenyOversizedParameters(t *testing.T) {
+	namespace := "test-handler"
+
+	newInstance := func(parameters string) string {
+		return `{
+			"apiVersion": "servicecatalog.k8s.io/v1beta1",
+			"kind": "ServiceInstance",
+			"metadata": {
+			  "name": "test-instance",
+			  "namespace": "` + namespace + `"
+			},
+			"spec": {
+			  "clusterServiceClassExternalName": "test-class",
+			  "clusterServicePlanExternalName": "test-plan",
+			  "parameters": ` + parameters + `,
+			  "parametersFrom": [{"secretKeyRef": {"name": "test-secret", "key": "params"}}]
+			}
+		}`
+	}
+	newSecret := func(params string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: namespace},
+			Data:       map[string][]byte{"params": []byte(params)},
+		}
+	}
+	small := `{"a":"b"}`
+	large := `{"a":"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}`
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+	require.NoError(t, corev1.AddToScheme(sch))
+	decoder := admission.NewDecoder(sch)
+
+	tests := map[string]struct {
+		operation      admissionv1.Operation
+		object         string
+		oldObject      string
+		objects        []client.Object
+		allowed        bool
+		responseReason string
+	}{
+		"Request should be allowed when the parameters are within the limits": {
+			operation: admissionv1.Create,
+			object:    newInstance(small),
+			objects:   []client.Object{newSecret(small)},
+			allowed:   true,
+		},
+		"Request should be denied when the parameters are too large": {
+			operation:      admissionv1.Create,
+			object:         newInstance(large),
+			objects:        []client.Object{newSecret(small)},
+			allowed:        false,
+			responseReason: "spec.parameters is 48 bytes, which exceeds the maximum of 32 bytes",
+		},
+		"Request should be denied when a secret of parametersFrom is too large": {
+			operation:      admissionv1.Create,
+			object:         newInstance(small),
+			objects:        []client.Object{newSecret(large)},
+			allowed:        false,
+			responseReason: `spec.parametersFrom[0]: key "params" of Secret "test-secret" is 48 bytes, which exceeds the maximum of 32 bytes`,
+		},
+		"Request should be allowed when the secret of parametersFrom does not exist": {
+			operation: admissionv1.Create,
+			object:    newInstance(small),
+			allowed:   true,
+		},
+		"Request should be allowed when the parameters of an update are unchanged": {
+			operation: admissionv1.Update,
+			object:    newInstance(large),
+			oldObject: newInstance(large),
+			allowed:   true,
+		},
+		"Request should be denied when an update makes the parameters too large": {
+			operation:      admissionv1.Update,
+			object:         newInstance(large),
+			oldObject:      newInstance(small),
+			objects:        []client.Object{newSecret(small)},
+			allowed:        false,
+			responseReason: "spec.parameters is 48 bytes",
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			handler := validation.SpecValidationHandler{}
+			validator := &validation.DenyOversizedParameters{
+				Limits: webhookutil.ParametersSizeLimits{MaxParametersSize: 32, MaxParametersFromSize: 32},
+			}
+			handler.CreateValidators = []validation.Validator{validator}
+			handler.UpdateValidators = []validation.Validator{validator}
+			fakeClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(test.objects...).Build()
+
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fakeClient)
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UID:       "4444-dddd",
+					Name:      "test-instance",
+					Namespace: namespace,
+					Operation: test.operation,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceInstance",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object: runtime.RawExtension{Raw: []byte(test.object)},
+				},
+			}
+			if test.oldObject != "" {
+				request.OldObject = runtime.RawExtension{Raw: []byte(test.oldObject)}
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.allowed, response.AdmissionResponse.Allowed)
+			if !test.allowed {
+				assert.Contains(t, response.AdmissionResponse.Result.Message, test.responseReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/util"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DenyProvisionIfPlanUnavailable handles ServiceInstance validation. It
+// rejects new instances of plans that their broker reports as unavailable,
+// for example because they are sold out, as the provision request would be
+// refused by the broker anyway.
+//
+// Plans that cannot be resolved are left for the controller to report.
+type DenyProvisionIfPlanUnavailable struct {
+	client client.Client
+}
+
+var _ Validator = &DenyProvisionIfPlanUnavailable{}
+
+// Validate checks if the plan of a new ServiceInstance is available
+func (h *DenyProvisionIfPlanUnavailable) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	traced.Info("Starting validation - DenyProvisionIfPlanUnavailable")
+
+	var plan interface {
+		GetName() string
+		GetAvailability() (bool, string)
+	}
+	switch {
+	case si.Spec.ClusterServicePlanSpecified():
+		csp, err := getClusterServicePlan(ctx, h.client, si, traced)
+		if err != nil {
+			traced.Infof("Could not resolve cluster service plan, skipping availability check: %v", err)
+			return nil
+		}
+		plan = csp
+	case si.Spec.ServicePlanSpecified():
+		sp, err := getServicePlan(ctx, h.client, si, traced)
+		if err != nil {
+			traced.Infof("Could not resolve service plan, skipping availability check: %v", err)
+			return nil
+		}
+		plan = sp
+	default:
+		return nil
+	}
+
+	if available, reason := plan.GetAvailability(); !available {
+		msg := fmt.Sprintf("The Service Plan %v is not available for provisioning", plan.GetName())
+		if reason != "" {
+			msg = fmt.Sprintf("%s: %s", msg, reason)
+		}
+		traced.Info(msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	traced.Info("DenyProvisionIfPlanUnavailable passed - plan is available.")
+	return nil
+}
+
+// getClusterServicePlan returns the ClusterServicePlan a ServiceInstance
+// refers to.
+func getClusterServicePlan(ctx context.Context, c client.Client, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) (*sc.ClusterServicePlan, error) {
+	ref := si.Spec.PlanReference
+	if ref.ClusterServicePlanName != "" {
+		csp := &sc.ClusterServicePlan{}
+		err := c.Get(ctx, client.ObjectKey{Name: ref.ClusterServicePlanName}, csp)
+		return csp, err
+	}
+
+	className := ref.ClusterServiceClassName
+	if className == "" {
+		classes := &sc.ClusterServiceClassList{}
+		err := c.List(ctx, classes, client.MatchingLabels(map[string]string{
+			ref.GetClusterServiceClassFilterLabelName(): util.GenerateSHA(ref.GetSpecifiedClusterServiceClass()),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if len(classes.Items) != 1 {
+			return nil, fmt.Errorf("found %d ClusterServiceClasses matching %q", len(classes.Items), ref.GetSpecifiedClusterServiceClass())
+		}
+		className = classes.Items[0].Name
+	}
+
+	traced.V(4).Infof("Fetching ClusterServicePlan %q of ClusterServiceClass %q", ref.GetSpecifiedClusterServicePlan(), className)
+	plans := &sc.ClusterServicePlanList{}
+	err := c.List(ctx, plans, client.MatchingLabels(map[string]string{
+		ref.GetClusterServicePlanFilterLabelName():                   util.GenerateSHA(ref.GetSpecifiedClusterServicePlan()),
+		sc.GroupName + "/" + sc.FilterSpecClusterServiceClassRefName: util.GenerateSHA(className),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if len(plans.Items) != 1 {
+		return nil, fmt.Errorf("found %d ClusterServicePlans matching %q", len(plans.Items), ref.GetSpecifiedClusterServicePlan())
+	}
+	return &plans.Items[0], nil
+}
+
+// getServicePlan returns the ServicePlan a ServiceInstance refers to.
+func getServicePlan(ctx context.Context, c client.Client, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) (*sc.ServicePlan, error) {
+	ref := si.Spec.PlanReference
+	if ref.ServicePlanName != "" {
+		sp := &sc.ServicePlan{}
+		err := c.Get(ctx, client.ObjectKey{Name: ref.ServicePlanName, Namespace: si.Namespace}, sp)
+		return sp, err
+	}
+
+	className := ref.ServiceClassName
+	if className == "" {
+		classes := &sc.ServiceClassList{}
+		err := c.List(ctx, classes, client.MatchingLabels(map[string]string{
+			ref.GetServiceClassFilterLabelName(): util.GenerateSHA(ref.GetSpecifiedServiceClass()),
+		}), client.InNamespace(si.Namespace))
+		if err != nil {
+			return nil, err
+		}
+		if len(classes.Items) != 1 {
+			return nil, fmt.Errorf("found %d ServiceClasses matching %q", len(classes.Items), ref.GetSpecifiedServiceClass())
+		}
+		className = classes.Items[0].Name
+	}
+
+	traced.V(4).Infof("Fetching ServicePlan %q of ServiceClass %q", ref.GetSpecifiedServicePlan(), className)
+	plans := &sc.ServicePlanList{}
+	err := c.List(ctx, plans, client.MatchingLabels(map[string]string{
+		ref.GetServicePlanFilterLabelName():                   util.GenerateSHA(ref.GetSpecifiedServicePlan()),
+		sc.GroupName + "/" + sc.FilterSpecServiceClassRefName: util.GenerateSHA(className),
+	}), client.InNamespace(si.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	if len(plans.Items) != 1 {
+		return nil, fmt.Errorf("found %d ServicePlans matching %q", len(plans.Items), ref.GetSpecifiedServicePlan())
+	}
+	return &plans.Items[0], nil
+}
+
+// InjectClient injects the client
+func (h *DenyProvisionIfPlanUnavailable) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/drycc-addons/service-catalog/pkg/util"
+	"github.com/drycc-addons/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/drycc-addons/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestSpecValidationHandlerDenyProvisionIfPlanUnavailable(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+	err = sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	decoder := admission.NewDecoder(sch)
+
+	class := &sc.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "csc-id",
+			Labels: map[string]string{
+				sc.GroupName + "/" + sc.FilterSpecExternalName: util.GenerateSHA("mysql"),
+			},
+		},
+	}
+	newPlan := func(metadata string) *sc.ClusterServicePlan {
+		plan := &sc.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "csp-id",
+				Labels: map[string]string{
+					sc.GroupName + "/" + sc.FilterSpecExternalName:               util.GenerateSHA("small"),
+					sc.GroupName + "/" + sc.FilterSpecClusterServiceClassRefName: util.GenerateSHA("csc-id"),
+				},
+			},
+		}
+		if metadata != "" {
+			plan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(metadata)}
+		}
+		return plan
+	}
+
+	byExternalName := `{
+		"metadata": {"name": "test-serviceinstance"},
+		"spec": {"clusterServiceClassExternalName": "mysql", "clusterServicePlanExternalName": "small"}
+	}`
+	byK8SName := `{
+		"metadata": {"name": "test-serviceinstance"},
+		"spec": {"clusterServiceClassName": "csc-id", "clusterServicePlanName": "csp-id"}
+	}`
+
+	tests := map[string]struct {
+		instance        string
+		plan            *sc.ClusterServicePlan
+		responseAllowed bool
+		responseReason  string
+	}{
+		"Plan without availability metadata": {
+			instance:        byExternalName,
+			plan:            newPlan(`{"displayName": "Small"}`),
+			responseAllowed: true,
+			responseReason:  "ServiceInstance validation successful",
+		},
+		"Available plan": {
+			instance:        byK8SName,
+			plan:            newPlan(`{"availability": {"available": true}}`),
+			responseAllowed: true,
+			responseReason:  "ServiceInstance validation successful",
+		},
+		"Unavailable plan referenced by external name": {
+			instance:        byExternalName,
+			plan:            newPlan(`{"availability": {"available": false, "reason": "sold out"}}`),
+			responseAllowed: false,
+			responseReason:  "The Service Plan csp-id is not available for provisioning: sold out",
+		},
+		"Unavailable plan referenced by k8s name": {
+			instance:        byK8SName,
+			plan:            newPlan(`{"availability": {"available": false}}`),
+			responseAllowed: false,
+			responseReason:  "The Service Plan csp-id is not available for provisioning",
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			handler := validation.SpecValidationHandler{}
+			handler.CreateValidators = []validation.Validator{&validation.DenyProvisionIfPlanUnavailable{}}
+			fakeClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(class, test.plan).Build()
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fakeClient)
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UID:       "uuid",
+					Name:      "test-serviceinstance",
+					Namespace: "ns-test",
+					Operation: admissionv1.Create,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceInstance",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object: runtime.RawExtension{Raw: []byte(test.instance)},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			assert.Contains(t, response.AdmissionResponse.Result.Message, test.responseReason)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhookutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	sc "github.com/drycc-addons/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ParametersSizeLimits holds the maximum sizes, in bytes, of the parameters
+// of ServiceInstances and ServiceBindings. Zero means no limit.
+type ParametersSizeLimits struct {
+	// MaxParametersSize limits the size of spec.parameters.
+	MaxParametersSize int64
+	// MaxParametersFromSize limits the size of the value of the Secret key
+	// referenced by each source of spec.parametersFrom.
+	MaxParametersFromSize int64
+}
+
+// ParametersChanged returns whether the parameters or the sources of
+// parameters of an updated object differ from those of the old object, so
+// that objects created before the limits were set can still be updated, and
+// deleted, as long as their parameters are left alone.
+func ParametersChanged(parameters, oldParameters *runtime.RawExtension, parametersFrom, oldParametersFrom []sc.ParametersFromSource) bool {
+	return !reflect.DeepEqual(parameters, oldParameters) || !reflect.DeepEqual(parametersFrom, oldParametersFrom)
+}
+
+// ValidateParametersSize checks the parameters of an object of namespace
+// against limits. The Secrets referenced by parametersFrom that cannot be
+// read are left for the controller to report.
+func ValidateParametersSize(ctx context.Context, c client.Client, namespace string, parameters *runtime.RawExtension, parametersFrom []sc.ParametersFromSource, limits ParametersSizeLimits, traced *TracedLogger) *WebhookError {
+	if limits.MaxParametersSize > 0 && parameters != nil && int64(len(parameters.Raw)) > limits.MaxParametersSize {
+		msg := fmt.Sprintf("spec.parameters is %d bytes, which exceeds the maximum of %d bytes", len(parameters.Raw), limits.MaxParametersSize)
+		traced.Info(msg)
+		return NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	if limits.MaxParametersFromSize <= 0 {
+		return nil
+	}
+	for i, source := range parametersFrom {
+		if source.SecretKeyRef == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: source.SecretKeyRef.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				traced.Infof("Secret %q does not exist, skipping its size check", source.SecretKeyRef.Name)
+				continue
+			}
+			traced.Errorf("Could not get Secret %q: %v", source.SecretKeyRef.Name, err)
+			return NewWebhookError(err.Error(), http.StatusInternalServerError)
+		}
+		size := int64(len(secret.Data[source.SecretKeyRef.Key]))
+		if size > limits.MaxParametersFromSize {
+			msg := fmt.Sprintf("spec.parametersFrom[%d]: key %q of Secret %q is %d bytes, which exceeds the maximum of %d bytes", i, source.SecretKeyRef.Key, source.SecretKeyRef.Name, size, limits.MaxParametersFromSize)
+			traced.Info(msg)
+			return NewWebhookError(msg, http.StatusForbidden)
+		}
+	}
+	return nil
+}